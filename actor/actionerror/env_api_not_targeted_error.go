@@ -0,0 +1,14 @@
+package actionerror
+
+import "fmt"
+
+// EnvAPINotTargetedError is returned when the API endpoint given in CF_API is
+// not the API of the foundation selected with the global '--foundation' flag.
+type EnvAPINotTargetedError struct {
+	API        string
+	BinaryName string
+}
+
+func (e EnvAPINotTargetedError) Error() string {
+	return fmt.Sprintf("API endpoint '%s' from CF_API is not targeted.", e.API)
+}
@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// EnvOrganizationNotFoundError is returned when the organization named in
+// CF_ORG is not found.
+type EnvOrganizationNotFoundError struct {
+	Name string
+}
+
+func (e EnvOrganizationNotFoundError) Error() string {
+	return fmt.Sprintf("Organization '%s' from CF_ORG not found.", e.Name)
+}
@@ -0,0 +1,14 @@
+package actionerror
+
+import "fmt"
+
+// EnvSpaceNotFoundError is returned when the space named in CF_SPACE is not
+// found in the targeted organization.
+type EnvSpaceNotFoundError struct {
+	Name             string
+	OrganizationName string
+}
+
+func (e EnvSpaceNotFoundError) Error() string {
+	return fmt.Sprintf("Space '%s' from CF_SPACE not found in org '%s'.", e.Name, e.OrganizationName)
+}
@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// FoundationNotFoundError is returned when no foundation profile with the
+// given name is saved in the config.
+type FoundationNotFoundError struct {
+	Name string
+}
+
+func (e FoundationNotFoundError) Error() string {
+	return fmt.Sprintf("Foundation '%s' not found.", e.Name)
+}
@@ -0,0 +1,15 @@
+package actionerror
+
+import "fmt"
+
+// InvalidFoundationsFileError is returned when the file given with
+// --foundations-file cannot be parsed or does not describe the foundations
+// needed by the command.
+type InvalidFoundationsFileError struct {
+	Path    string
+	Message string
+}
+
+func (e InvalidFoundationsFileError) Error() string {
+	return fmt.Sprintf("Invalid foundations file '%s': %s", e.Path, e.Message)
+}
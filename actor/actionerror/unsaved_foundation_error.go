@@ -0,0 +1,14 @@
+package actionerror
+
+import "fmt"
+
+// UnsavedFoundationError is returned when switching foundations would lose
+// the target and session of an API that is not saved as a foundation.
+type UnsavedFoundationError struct {
+	API        string
+	BinaryName string
+}
+
+func (e UnsavedFoundationError) Error() string {
+	return fmt.Sprintf("The target %s is not saved as a foundation.", e.API)
+}
@@ -6,10 +6,20 @@ type AuthActor interface {
 	IsLoggedIn() bool
 }
 
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . TargetResolver
+
+// TargetResolver looks up the GUIDs of the org and space named in CF_ORG and
+// CF_SPACE.
+type TargetResolver interface {
+	ResolveOrganizationGUID(orgName string) (string, error)
+	ResolveSpaceGUID(spaceName string, orgGUID string) (string, error)
+}
+
 // Actor handles all shared actions
 type Actor struct {
 	Config Config
 	AuthActor
+	TargetResolver TargetResolver
 }
 
 // NewActor returns an Actor with default settings
@@ -1,10 +1,22 @@
 package sharedaction
 
-import "code.cloudfoundry.org/cli/actor/actionerror"
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/util/configv3"
+)
 
 // CheckTarget confirms that the user is logged in. Optionally it will also
-// check if an organization and space are targeted.
+// check if an organization and space are targeted. An org or space named in
+// CF_ORG or CF_SPACE is looked up on first use.
 func (actor Actor) CheckTarget(targetedOrganizationRequired bool, targetedSpaceRequired bool) error {
+	envTarget := actor.Config.EnvTarget()
+	if envTarget.API != "" && !configv3.IsSameAPI(envTarget.API, actor.Config.Target()) {
+		return actionerror.EnvAPINotTargetedError{
+			API:        envTarget.API,
+			BinaryName: actor.Config.BinaryName(),
+		}
+	}
+
 	if !actor.IsLoggedIn() {
 		return actionerror.NotLoggedInError{
 			BinaryName: actor.Config.BinaryName(),
@@ -18,12 +30,22 @@ func (actor Actor) CheckTarget(targetedOrganizationRequired bool, targetedSpaceR
 			}
 		}
 
+		err := actor.resolveEnvOrganization()
+		if err != nil {
+			return err
+		}
+
 		if targetedSpaceRequired {
 			if !actor.IsSpaceTargeted() {
 				return actionerror.NoSpaceTargetedError{
 					BinaryName: actor.Config.BinaryName(),
 				}
 			}
+
+			err := actor.resolveEnvSpace()
+			if err != nil {
+				return err
+			}
 		}
 	}
 
@@ -40,6 +62,9 @@ func (actor Actor) RequireCurrentUser() (string, error) {
 	return actor.Config.CurrentUserName()
 }
 
+// RequireTargetedOrg returns the name of the targeted org. The GUID of an org
+// targeted with CF_ORG is looked up, so that it can be read from the config
+// afterwards.
 func (actor Actor) RequireTargetedOrg() (string, error) {
 	if !actor.IsOrgTargeted() {
 		return "", actionerror.NoOrganizationTargetedError{
@@ -47,5 +72,47 @@ func (actor Actor) RequireTargetedOrg() (string, error) {
 		}
 	}
 
+	err := actor.resolveEnvOrganization()
+	if err != nil {
+		return "", err
+	}
+
 	return actor.Config.TargetedOrganizationName(), nil
 }
+
+func (actor Actor) resolveEnvOrganization() error {
+	org := actor.Config.TargetedOrganization()
+	if org.GUID != "" || actor.TargetResolver == nil {
+		return nil
+	}
+
+	guid, err := actor.TargetResolver.ResolveOrganizationGUID(org.Name)
+	if _, ok := err.(actionerror.OrganizationNotFoundError); ok {
+		return actionerror.EnvOrganizationNotFoundError{Name: org.Name}
+	}
+	if err != nil {
+		return err
+	}
+
+	actor.Config.SetEnvOrganizationGUID(guid)
+	return nil
+}
+
+func (actor Actor) resolveEnvSpace() error {
+	space := actor.Config.TargetedSpace()
+	if space.GUID != "" || actor.TargetResolver == nil {
+		return nil
+	}
+
+	org := actor.Config.TargetedOrganization()
+	guid, err := actor.TargetResolver.ResolveSpaceGUID(space.Name, org.GUID)
+	if _, ok := err.(actionerror.SpaceNotFoundError); ok {
+		return actionerror.EnvSpaceNotFoundError{Name: space.Name, OrganizationName: org.Name}
+	}
+	if err != nil {
+		return err
+	}
+
+	actor.Config.SetEnvSpaceGUID(guid)
+	return nil
+}
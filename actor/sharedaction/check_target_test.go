@@ -6,6 +6,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	. "code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/sharedaction/sharedactionfakes"
+	"code.cloudfoundry.org/cli/util/configv3"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
@@ -84,6 +85,120 @@ var _ = Describe("Checking target", func() {
 					Entry("it does not return an error", true, true, nil),
 				)
 			})
+
+			When("the org and space are targeted with CF_ORG and CF_SPACE", func() {
+				var fakeTargetResolver *sharedactionfakes.FakeTargetResolver
+
+				BeforeEach(func() {
+					fakeTargetResolver = new(sharedactionfakes.FakeTargetResolver)
+					actor.TargetResolver = fakeTargetResolver
+					fakeConfig.EnvTargetReturns(configv3.EnvTarget{Organization: "env-org", Space: "env-space"})
+					fakeConfig.HasTargetedOrganizationReturns(true)
+					fakeConfig.HasTargetedSpaceReturns(true)
+					fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "env-org"})
+					fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "env-space"})
+				})
+
+				It("looks up the org and space GUIDs", func() {
+					fakeTargetResolver.ResolveOrganizationGUIDStub = func(string) (string, error) {
+						fakeConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "env-org-guid", Name: "env-org"})
+						return "env-org-guid", nil
+					}
+					fakeTargetResolver.ResolveSpaceGUIDReturns("env-space-guid", nil)
+
+					Expect(actor.CheckTarget(true, true)).To(Succeed())
+
+					Expect(fakeTargetResolver.ResolveOrganizationGUIDArgsForCall(0)).To(Equal("env-org"))
+					Expect(fakeConfig.SetEnvOrganizationGUIDArgsForCall(0)).To(Equal("env-org-guid"))
+
+					spaceName, orgGUID := fakeTargetResolver.ResolveSpaceGUIDArgsForCall(0)
+					Expect(spaceName).To(Equal("env-space"))
+					Expect(orgGUID).To(Equal("env-org-guid"))
+					Expect(fakeConfig.SetEnvSpaceGUIDArgsForCall(0)).To(Equal("env-space-guid"))
+				})
+
+				It("does not look up the space when no space is required", func() {
+					Expect(actor.CheckTarget(true, false)).To(Succeed())
+					Expect(fakeTargetResolver.ResolveOrganizationGUIDCallCount()).To(Equal(1))
+					Expect(fakeTargetResolver.ResolveSpaceGUIDCallCount()).To(Equal(0))
+				})
+
+				When("the GUIDs have already been looked up", func() {
+					BeforeEach(func() {
+						fakeConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "env-org-guid", Name: "env-org"})
+						fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "env-space-guid", Name: "env-space"})
+					})
+
+					It("does not look them up again", func() {
+						Expect(actor.CheckTarget(true, true)).To(Succeed())
+						Expect(fakeTargetResolver.ResolveOrganizationGUIDCallCount()).To(Equal(0))
+						Expect(fakeTargetResolver.ResolveSpaceGUIDCallCount()).To(Equal(0))
+					})
+				})
+
+				When("the org does not exist", func() {
+					BeforeEach(func() {
+						fakeTargetResolver.ResolveOrganizationGUIDReturns("", actionerror.OrganizationNotFoundError{Name: "env-org"})
+					})
+
+					It("returns an EnvOrganizationNotFoundError", func() {
+						err := actor.CheckTarget(true, true)
+						Expect(err).To(MatchError(actionerror.EnvOrganizationNotFoundError{Name: "env-org"}))
+					})
+				})
+
+				When("the space does not exist", func() {
+					BeforeEach(func() {
+						fakeTargetResolver.ResolveSpaceGUIDReturns("", actionerror.SpaceNotFoundError{Name: "env-space"})
+					})
+
+					It("returns an EnvSpaceNotFoundError", func() {
+						err := actor.CheckTarget(true, true)
+						Expect(err).To(MatchError(actionerror.EnvSpaceNotFoundError{Name: "env-space", OrganizationName: "env-org"}))
+					})
+				})
+
+				When("looking up the org fails", func() {
+					BeforeEach(func() {
+						fakeTargetResolver.ResolveOrganizationGUIDReturns("", errors.New("some-error"))
+					})
+
+					It("returns the error", func() {
+						Expect(actor.CheckTarget(true, false)).To(MatchError("some-error"))
+					})
+				})
+			})
+		})
+
+		When("CF_API is set", func() {
+			BeforeEach(func() {
+				fakeConfig.AccessTokenReturns("some-access-token")
+				fakeConfig.EnvTargetReturns(configv3.EnvTarget{API: "https://api.example.com/"})
+			})
+
+			When("it is the targeted API", func() {
+				BeforeEach(func() {
+					fakeConfig.TargetReturns("https://API.example.com")
+				})
+
+				It("does not return an error", func() {
+					Expect(actor.CheckTarget(false, false)).To(Succeed())
+				})
+			})
+
+			When("it is not the targeted API", func() {
+				BeforeEach(func() {
+					fakeConfig.TargetReturns("https://api.other.com")
+				})
+
+				It("returns an EnvAPINotTargetedError", func() {
+					err := actor.CheckTarget(false, false)
+					Expect(err).To(MatchError(actionerror.EnvAPINotTargetedError{
+						API:        "https://api.example.com/",
+						BinaryName: binaryName,
+					}))
+				})
+			})
 		})
 	})
 
@@ -199,5 +314,35 @@ var _ = Describe("Checking target", func() {
 				Expect(org).To(Equal("some-name"))
 			})
 		})
+
+		When("the org is targeted with CF_ORG", func() {
+			var fakeTargetResolver *sharedactionfakes.FakeTargetResolver
+
+			BeforeEach(func() {
+				fakeTargetResolver = new(sharedactionfakes.FakeTargetResolver)
+				actor.TargetResolver = fakeTargetResolver
+				fakeConfig.HasTargetedOrganizationReturns(true)
+				fakeConfig.TargetedOrganizationNameReturns("env-org")
+				fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "env-org"})
+				fakeTargetResolver.ResolveOrganizationGUIDReturns("env-org-guid", nil)
+			})
+
+			It("looks up the org GUID", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(org).To(Equal("env-org"))
+				Expect(fakeTargetResolver.ResolveOrganizationGUIDArgsForCall(0)).To(Equal("env-org"))
+				Expect(fakeConfig.SetEnvOrganizationGUIDArgsForCall(0)).To(Equal("env-org-guid"))
+			})
+
+			When("the org does not exist", func() {
+				BeforeEach(func() {
+					fakeTargetResolver.ResolveOrganizationGUIDReturns("", actionerror.OrganizationNotFoundError{Name: "env-org"})
+				})
+
+				It("returns an EnvOrganizationNotFoundError", func() {
+					Expect(err).To(MatchError(actionerror.EnvOrganizationNotFoundError{Name: "env-org"}))
+				})
+			})
+		})
 	})
 })
@@ -1,5 +1,7 @@
 package sharedaction
 
+import "code.cloudfoundry.org/cli/util/configv3"
+
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . Config
 
 // Config a way of getting basic CF configuration
@@ -7,10 +9,16 @@ type Config interface {
 	AccessToken() string
 	BinaryName() string
 	CurrentUserName() (string, error)
+	EnvTarget() configv3.EnvTarget
 	HasTargetedOrganization() bool
 	HasTargetedSpace() bool
 	IsCFOnK8s() bool
 	RefreshToken() string
+	SetEnvOrganizationGUID(guid string)
+	SetEnvSpaceGUID(guid string)
+	Target() string
+	TargetedOrganization() configv3.Organization
 	TargetedOrganizationName() string
+	TargetedSpace() configv3.Space
 	Verbose() (bool, []string)
 }
@@ -5,6 +5,7 @@ import (
 	"sync"
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/util/configv3"
 )
 
 type FakeConfig struct {
@@ -40,6 +41,16 @@ type FakeConfig struct {
 		result1 string
 		result2 error
 	}
+	EnvTargetStub        func() configv3.EnvTarget
+	envTargetMutex       sync.RWMutex
+	envTargetArgsForCall []struct {
+	}
+	envTargetReturns struct {
+		result1 configv3.EnvTarget
+	}
+	envTargetReturnsOnCall map[int]struct {
+		result1 configv3.EnvTarget
+	}
 	HasTargetedOrganizationStub        func() bool
 	hasTargetedOrganizationMutex       sync.RWMutex
 	hasTargetedOrganizationArgsForCall []struct {
@@ -80,6 +91,36 @@ type FakeConfig struct {
 	refreshTokenReturnsOnCall map[int]struct {
 		result1 string
 	}
+	SetEnvOrganizationGUIDStub        func(string)
+	setEnvOrganizationGUIDMutex       sync.RWMutex
+	setEnvOrganizationGUIDArgsForCall []struct {
+		arg1 string
+	}
+	SetEnvSpaceGUIDStub        func(string)
+	setEnvSpaceGUIDMutex       sync.RWMutex
+	setEnvSpaceGUIDArgsForCall []struct {
+		arg1 string
+	}
+	TargetStub        func() string
+	targetMutex       sync.RWMutex
+	targetArgsForCall []struct {
+	}
+	targetReturns struct {
+		result1 string
+	}
+	targetReturnsOnCall map[int]struct {
+		result1 string
+	}
+	TargetedOrganizationStub        func() configv3.Organization
+	targetedOrganizationMutex       sync.RWMutex
+	targetedOrganizationArgsForCall []struct {
+	}
+	targetedOrganizationReturns struct {
+		result1 configv3.Organization
+	}
+	targetedOrganizationReturnsOnCall map[int]struct {
+		result1 configv3.Organization
+	}
 	TargetedOrganizationNameStub        func() string
 	targetedOrganizationNameMutex       sync.RWMutex
 	targetedOrganizationNameArgsForCall []struct {
@@ -90,6 +131,16 @@ type FakeConfig struct {
 	targetedOrganizationNameReturnsOnCall map[int]struct {
 		result1 string
 	}
+	TargetedSpaceStub        func() configv3.Space
+	targetedSpaceMutex       sync.RWMutex
+	targetedSpaceArgsForCall []struct {
+	}
+	targetedSpaceReturns struct {
+		result1 configv3.Space
+	}
+	targetedSpaceReturnsOnCall map[int]struct {
+		result1 configv3.Space
+	}
 	VerboseStub        func() (bool, []string)
 	verboseMutex       sync.RWMutex
 	verboseArgsForCall []struct {
@@ -265,6 +316,58 @@ func (fake *FakeConfig) CurrentUserNameReturnsOnCall(i int, result1 string, resu
 	}{result1, result2}
 }
 
+func (fake *FakeConfig) EnvTarget() configv3.EnvTarget {
+	fake.envTargetMutex.Lock()
+	ret, specificReturn := fake.envTargetReturnsOnCall[len(fake.envTargetArgsForCall)]
+	fake.envTargetArgsForCall = append(fake.envTargetArgsForCall, struct {
+	}{})
+	fake.recordInvocation("EnvTarget", []interface{}{})
+	fake.envTargetMutex.Unlock()
+	if fake.EnvTargetStub != nil {
+		return fake.EnvTargetStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.envTargetReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) EnvTargetCallCount() int {
+	fake.envTargetMutex.RLock()
+	defer fake.envTargetMutex.RUnlock()
+	return len(fake.envTargetArgsForCall)
+}
+
+func (fake *FakeConfig) EnvTargetCalls(stub func() configv3.EnvTarget) {
+	fake.envTargetMutex.Lock()
+	defer fake.envTargetMutex.Unlock()
+	fake.EnvTargetStub = stub
+}
+
+func (fake *FakeConfig) EnvTargetReturns(result1 configv3.EnvTarget) {
+	fake.envTargetMutex.Lock()
+	defer fake.envTargetMutex.Unlock()
+	fake.EnvTargetStub = nil
+	fake.envTargetReturns = struct {
+		result1 configv3.EnvTarget
+	}{result1}
+}
+
+func (fake *FakeConfig) EnvTargetReturnsOnCall(i int, result1 configv3.EnvTarget) {
+	fake.envTargetMutex.Lock()
+	defer fake.envTargetMutex.Unlock()
+	fake.EnvTargetStub = nil
+	if fake.envTargetReturnsOnCall == nil {
+		fake.envTargetReturnsOnCall = make(map[int]struct {
+			result1 configv3.EnvTarget
+		})
+	}
+	fake.envTargetReturnsOnCall[i] = struct {
+		result1 configv3.EnvTarget
+	}{result1}
+}
+
 func (fake *FakeConfig) HasTargetedOrganization() bool {
 	fake.hasTargetedOrganizationMutex.Lock()
 	ret, specificReturn := fake.hasTargetedOrganizationReturnsOnCall[len(fake.hasTargetedOrganizationArgsForCall)]
@@ -473,6 +576,172 @@ func (fake *FakeConfig) RefreshTokenReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeConfig) SetEnvOrganizationGUID(arg1 string) {
+	fake.setEnvOrganizationGUIDMutex.Lock()
+	fake.setEnvOrganizationGUIDArgsForCall = append(fake.setEnvOrganizationGUIDArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("SetEnvOrganizationGUID", []interface{}{arg1})
+	fake.setEnvOrganizationGUIDMutex.Unlock()
+	if fake.SetEnvOrganizationGUIDStub != nil {
+		fake.SetEnvOrganizationGUIDStub(arg1)
+	}
+}
+
+func (fake *FakeConfig) SetEnvOrganizationGUIDCallCount() int {
+	fake.setEnvOrganizationGUIDMutex.RLock()
+	defer fake.setEnvOrganizationGUIDMutex.RUnlock()
+	return len(fake.setEnvOrganizationGUIDArgsForCall)
+}
+
+func (fake *FakeConfig) SetEnvOrganizationGUIDCalls(stub func(string)) {
+	fake.setEnvOrganizationGUIDMutex.Lock()
+	defer fake.setEnvOrganizationGUIDMutex.Unlock()
+	fake.SetEnvOrganizationGUIDStub = stub
+}
+
+func (fake *FakeConfig) SetEnvOrganizationGUIDArgsForCall(i int) string {
+	fake.setEnvOrganizationGUIDMutex.RLock()
+	defer fake.setEnvOrganizationGUIDMutex.RUnlock()
+	argsForCall := fake.setEnvOrganizationGUIDArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeConfig) SetEnvSpaceGUID(arg1 string) {
+	fake.setEnvSpaceGUIDMutex.Lock()
+	fake.setEnvSpaceGUIDArgsForCall = append(fake.setEnvSpaceGUIDArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("SetEnvSpaceGUID", []interface{}{arg1})
+	fake.setEnvSpaceGUIDMutex.Unlock()
+	if fake.SetEnvSpaceGUIDStub != nil {
+		fake.SetEnvSpaceGUIDStub(arg1)
+	}
+}
+
+func (fake *FakeConfig) SetEnvSpaceGUIDCallCount() int {
+	fake.setEnvSpaceGUIDMutex.RLock()
+	defer fake.setEnvSpaceGUIDMutex.RUnlock()
+	return len(fake.setEnvSpaceGUIDArgsForCall)
+}
+
+func (fake *FakeConfig) SetEnvSpaceGUIDCalls(stub func(string)) {
+	fake.setEnvSpaceGUIDMutex.Lock()
+	defer fake.setEnvSpaceGUIDMutex.Unlock()
+	fake.SetEnvSpaceGUIDStub = stub
+}
+
+func (fake *FakeConfig) SetEnvSpaceGUIDArgsForCall(i int) string {
+	fake.setEnvSpaceGUIDMutex.RLock()
+	defer fake.setEnvSpaceGUIDMutex.RUnlock()
+	argsForCall := fake.setEnvSpaceGUIDArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeConfig) Target() string {
+	fake.targetMutex.Lock()
+	ret, specificReturn := fake.targetReturnsOnCall[len(fake.targetArgsForCall)]
+	fake.targetArgsForCall = append(fake.targetArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Target", []interface{}{})
+	fake.targetMutex.Unlock()
+	if fake.TargetStub != nil {
+		return fake.TargetStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.targetReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) TargetCallCount() int {
+	fake.targetMutex.RLock()
+	defer fake.targetMutex.RUnlock()
+	return len(fake.targetArgsForCall)
+}
+
+func (fake *FakeConfig) TargetCalls(stub func() string) {
+	fake.targetMutex.Lock()
+	defer fake.targetMutex.Unlock()
+	fake.TargetStub = stub
+}
+
+func (fake *FakeConfig) TargetReturns(result1 string) {
+	fake.targetMutex.Lock()
+	defer fake.targetMutex.Unlock()
+	fake.TargetStub = nil
+	fake.targetReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) TargetReturnsOnCall(i int, result1 string) {
+	fake.targetMutex.Lock()
+	defer fake.targetMutex.Unlock()
+	fake.TargetStub = nil
+	if fake.targetReturnsOnCall == nil {
+		fake.targetReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.targetReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) TargetedOrganization() configv3.Organization {
+	fake.targetedOrganizationMutex.Lock()
+	ret, specificReturn := fake.targetedOrganizationReturnsOnCall[len(fake.targetedOrganizationArgsForCall)]
+	fake.targetedOrganizationArgsForCall = append(fake.targetedOrganizationArgsForCall, struct {
+	}{})
+	fake.recordInvocation("TargetedOrganization", []interface{}{})
+	fake.targetedOrganizationMutex.Unlock()
+	if fake.TargetedOrganizationStub != nil {
+		return fake.TargetedOrganizationStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.targetedOrganizationReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) TargetedOrganizationCallCount() int {
+	fake.targetedOrganizationMutex.RLock()
+	defer fake.targetedOrganizationMutex.RUnlock()
+	return len(fake.targetedOrganizationArgsForCall)
+}
+
+func (fake *FakeConfig) TargetedOrganizationCalls(stub func() configv3.Organization) {
+	fake.targetedOrganizationMutex.Lock()
+	defer fake.targetedOrganizationMutex.Unlock()
+	fake.TargetedOrganizationStub = stub
+}
+
+func (fake *FakeConfig) TargetedOrganizationReturns(result1 configv3.Organization) {
+	fake.targetedOrganizationMutex.Lock()
+	defer fake.targetedOrganizationMutex.Unlock()
+	fake.TargetedOrganizationStub = nil
+	fake.targetedOrganizationReturns = struct {
+		result1 configv3.Organization
+	}{result1}
+}
+
+func (fake *FakeConfig) TargetedOrganizationReturnsOnCall(i int, result1 configv3.Organization) {
+	fake.targetedOrganizationMutex.Lock()
+	defer fake.targetedOrganizationMutex.Unlock()
+	fake.TargetedOrganizationStub = nil
+	if fake.targetedOrganizationReturnsOnCall == nil {
+		fake.targetedOrganizationReturnsOnCall = make(map[int]struct {
+			result1 configv3.Organization
+		})
+	}
+	fake.targetedOrganizationReturnsOnCall[i] = struct {
+		result1 configv3.Organization
+	}{result1}
+}
+
 func (fake *FakeConfig) TargetedOrganizationName() string {
 	fake.targetedOrganizationNameMutex.Lock()
 	ret, specificReturn := fake.targetedOrganizationNameReturnsOnCall[len(fake.targetedOrganizationNameArgsForCall)]
@@ -525,6 +794,58 @@ func (fake *FakeConfig) TargetedOrganizationNameReturnsOnCall(i int, result1 str
 	}{result1}
 }
 
+func (fake *FakeConfig) TargetedSpace() configv3.Space {
+	fake.targetedSpaceMutex.Lock()
+	ret, specificReturn := fake.targetedSpaceReturnsOnCall[len(fake.targetedSpaceArgsForCall)]
+	fake.targetedSpaceArgsForCall = append(fake.targetedSpaceArgsForCall, struct {
+	}{})
+	fake.recordInvocation("TargetedSpace", []interface{}{})
+	fake.targetedSpaceMutex.Unlock()
+	if fake.TargetedSpaceStub != nil {
+		return fake.TargetedSpaceStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.targetedSpaceReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) TargetedSpaceCallCount() int {
+	fake.targetedSpaceMutex.RLock()
+	defer fake.targetedSpaceMutex.RUnlock()
+	return len(fake.targetedSpaceArgsForCall)
+}
+
+func (fake *FakeConfig) TargetedSpaceCalls(stub func() configv3.Space) {
+	fake.targetedSpaceMutex.Lock()
+	defer fake.targetedSpaceMutex.Unlock()
+	fake.TargetedSpaceStub = stub
+}
+
+func (fake *FakeConfig) TargetedSpaceReturns(result1 configv3.Space) {
+	fake.targetedSpaceMutex.Lock()
+	defer fake.targetedSpaceMutex.Unlock()
+	fake.TargetedSpaceStub = nil
+	fake.targetedSpaceReturns = struct {
+		result1 configv3.Space
+	}{result1}
+}
+
+func (fake *FakeConfig) TargetedSpaceReturnsOnCall(i int, result1 configv3.Space) {
+	fake.targetedSpaceMutex.Lock()
+	defer fake.targetedSpaceMutex.Unlock()
+	fake.TargetedSpaceStub = nil
+	if fake.targetedSpaceReturnsOnCall == nil {
+		fake.targetedSpaceReturnsOnCall = make(map[int]struct {
+			result1 configv3.Space
+		})
+	}
+	fake.targetedSpaceReturnsOnCall[i] = struct {
+		result1 configv3.Space
+	}{result1}
+}
+
 func (fake *FakeConfig) Verbose() (bool, []string) {
 	fake.verboseMutex.Lock()
 	ret, specificReturn := fake.verboseReturnsOnCall[len(fake.verboseArgsForCall)]
@@ -589,6 +910,8 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.binaryNameMutex.RUnlock()
 	fake.currentUserNameMutex.RLock()
 	defer fake.currentUserNameMutex.RUnlock()
+	fake.envTargetMutex.RLock()
+	defer fake.envTargetMutex.RUnlock()
 	fake.hasTargetedOrganizationMutex.RLock()
 	defer fake.hasTargetedOrganizationMutex.RUnlock()
 	fake.hasTargetedSpaceMutex.RLock()
@@ -597,8 +920,18 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.isCFOnK8sMutex.RUnlock()
 	fake.refreshTokenMutex.RLock()
 	defer fake.refreshTokenMutex.RUnlock()
+	fake.setEnvOrganizationGUIDMutex.RLock()
+	defer fake.setEnvOrganizationGUIDMutex.RUnlock()
+	fake.setEnvSpaceGUIDMutex.RLock()
+	defer fake.setEnvSpaceGUIDMutex.RUnlock()
+	fake.targetMutex.RLock()
+	defer fake.targetMutex.RUnlock()
+	fake.targetedOrganizationMutex.RLock()
+	defer fake.targetedOrganizationMutex.RUnlock()
 	fake.targetedOrganizationNameMutex.RLock()
 	defer fake.targetedOrganizationNameMutex.RUnlock()
+	fake.targetedSpaceMutex.RLock()
+	defer fake.targetedSpaceMutex.RUnlock()
 	fake.verboseMutex.RLock()
 	defer fake.verboseMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -2,6 +2,7 @@
 package v7action
 
 import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/api/uaa/constant"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
 	"code.cloudfoundry.org/cli/util/configv3"
@@ -37,7 +38,9 @@ type Actor struct {
 	AuthActor
 }
 
-// NewActor returns a new V7 actor.
+// NewActor returns a new V7 actor. It looks up the org and space named in
+// CF_ORG and CF_SPACE for the given shared actor unless that already has a
+// TargetResolver.
 func NewActor(
 	client CloudControllerClient,
 	config Config,
@@ -51,7 +54,7 @@ func NewActor(
 		authActor = NewKubernetesAuthActor(config, NewDefaultKubernetesConfigGetter(), client)
 	}
 
-	return &Actor{
+	actor := &Actor{
 		CloudControllerClient: client,
 		Config:                config,
 		SharedActor:           sharedActor,
@@ -60,4 +63,10 @@ func NewActor(
 		Clock:                 clk,
 		AuthActor:             authActor,
 	}
+
+	if shared, ok := sharedActor.(*sharedaction.Actor); ok && shared.TargetResolver == nil {
+		shared.TargetResolver = actor
+	}
+
+	return actor
 }
@@ -0,0 +1,108 @@
+package v7action
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+)
+
+// AuditEvent is an audit event recorded by the Cloud Controller, such as an
+// app being updated or a user being given a role.
+type AuditEvent struct {
+	GUID             string
+	Time             time.Time
+	Type             string
+	ActorGUID        string
+	ActorType        string
+	ActorName        string
+	TargetGUID       string
+	TargetType       string
+	TargetName       string
+	SpaceGUID        string
+	OrganizationGUID string
+	Description      string
+	Data             map[string]interface{}
+}
+
+// AuditEventFilter selects the audit events returned by GetAuditEvents. Empty
+// fields do not filter the events.
+type AuditEventFilter struct {
+	OrganizationGUID string
+	SpaceGUID        string
+	TargetGUID       string
+	// TargetName and Actor are matched by the CLI because the Cloud
+	// Controller cannot filter audit events by them. Actor matches the name
+	// or the GUID of the actor.
+	TargetName string
+	Actor      string
+	Types      []string
+	Since      time.Time
+	Until      time.Time
+}
+
+// GetAuditEvents returns every audit event matching the filter, oldest first.
+func (actor Actor) GetAuditEvents(filter AuditEventFilter) ([]AuditEvent, Warnings, error) {
+	ccEvents, warnings, err := actor.CloudControllerClient.GetAuditEvents(filter.queries()...)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	var events []AuditEvent
+	for _, ccEvent := range ccEvents {
+		if filter.TargetName != "" && ccEvent.TargetName != filter.TargetName {
+			continue
+		}
+		if filter.Actor != "" && ccEvent.ActorName != filter.Actor && ccEvent.ActorGUID != filter.Actor {
+			continue
+		}
+
+		events = append(events, AuditEvent{
+			GUID:             ccEvent.GUID,
+			Time:             ccEvent.CreatedAt,
+			Type:             ccEvent.Type,
+			ActorGUID:        ccEvent.ActorGUID,
+			ActorType:        ccEvent.ActorType,
+			ActorName:        ccEvent.ActorName,
+			TargetGUID:       ccEvent.TargetGUID,
+			TargetType:       ccEvent.TargetType,
+			TargetName:       ccEvent.TargetName,
+			SpaceGUID:        ccEvent.SpaceGUID,
+			OrganizationGUID: ccEvent.OrganizationGUID,
+			Description:      generateDescription(ccEvent.Data),
+			Data:             ccEvent.Data,
+		})
+	}
+
+	return events, Warnings(warnings), nil
+}
+
+func (filter AuditEventFilter) queries() []ccv3.Query {
+	var queries []ccv3.Query
+	if filter.OrganizationGUID != "" {
+		queries = append(queries, ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: []string{filter.OrganizationGUID}})
+	}
+	if filter.SpaceGUID != "" {
+		queries = append(queries, ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{filter.SpaceGUID}})
+	}
+	if filter.TargetGUID != "" {
+		queries = append(queries, ccv3.Query{Key: ccv3.TargetGUIDFilter, Values: []string{filter.TargetGUID}})
+	}
+	if len(filter.Types) > 0 {
+		queries = append(queries, ccv3.Query{Key: ccv3.EventTypesFilter, Values: filter.Types})
+	}
+	queries = append(queries, createdAtQueries(filter.Since, filter.Until)...)
+	return append(queries, ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}})
+}
+
+// createdAtQueries returns the queries selecting resources created between
+// since and until. A zero time does not limit the range on that side.
+func createdAtQueries(since time.Time, until time.Time) []ccv3.Query {
+	var queries []ccv3.Query
+	if !since.IsZero() {
+		queries = append(queries, ccv3.Query{Key: ccv3.CreatedAtsGreaterThanOrEqualFilter, Values: []string{since.UTC().Format(time.RFC3339)}})
+	}
+	if !until.IsZero() {
+		queries = append(queries, ccv3.Query{Key: ccv3.CreatedAtsLessThanOrEqualFilter, Values: []string{until.UTC().Format(time.RFC3339)}})
+	}
+	return queries
+}
@@ -0,0 +1,172 @@
+package v7action_test
+
+import (
+	"errors"
+	"time"
+
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Audit Event Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		actor, fakeCloudControllerClient, _, _, _, _, _ = NewTestActor()
+	})
+
+	Describe("GetAuditEvents", func() {
+		var (
+			filter     AuditEventFilter
+			events     []AuditEvent
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			filter = AuditEventFilter{}
+		})
+
+		JustBeforeEach(func() {
+			events, warnings, executeErr = actor.GetAuditEvents(filter)
+		})
+
+		When("the cloud controller returns events", func() {
+			var createdAt time.Time
+
+			BeforeEach(func() {
+				createdAt = time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC)
+				fakeCloudControllerClient.GetAuditEventsReturns(
+					[]ccv3.Event{
+						{
+							GUID:             "event-1",
+							CreatedAt:        createdAt,
+							Type:             "audit.app.update",
+							ActorGUID:        "user-guid",
+							ActorType:        "user",
+							ActorName:        "admin",
+							TargetGUID:       "app-guid",
+							TargetType:       "app",
+							TargetName:       "some-app",
+							SpaceGUID:        "space-guid",
+							OrganizationGUID: "org-guid",
+							Data:             map[string]interface{}{"request": map[string]interface{}{"instances": float64(3)}},
+						},
+						{
+							GUID:       "event-2",
+							Type:       "audit.app.start",
+							ActorGUID:  "client-guid",
+							ActorType:  "user",
+							ActorName:  "ci-client",
+							TargetGUID: "other-app-guid",
+							TargetName: "other-app",
+						},
+					},
+					ccv3.Warnings{"some-warning"},
+					nil,
+				)
+			})
+
+			It("returns the events and warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("some-warning"))
+				Expect(events).To(HaveLen(2))
+				Expect(events[0]).To(Equal(AuditEvent{
+					GUID:             "event-1",
+					Time:             createdAt,
+					Type:             "audit.app.update",
+					ActorGUID:        "user-guid",
+					ActorType:        "user",
+					ActorName:        "admin",
+					TargetGUID:       "app-guid",
+					TargetType:       "app",
+					TargetName:       "some-app",
+					SpaceGUID:        "space-guid",
+					OrganizationGUID: "org-guid",
+					Description:      "instances: 3",
+					Data:             map[string]interface{}{"request": map[string]interface{}{"instances": float64(3)}},
+				}))
+				Expect(events[1].GUID).To(Equal("event-2"))
+			})
+
+			It("only asks for the largest pages", func() {
+				Expect(fakeCloudControllerClient.GetAuditEventsCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetAuditEventsArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+				))
+			})
+
+			When("the filter selects events the cloud controller can filter", func() {
+				BeforeEach(func() {
+					filter = AuditEventFilter{
+						OrganizationGUID: "org-guid",
+						SpaceGUID:        "space-guid",
+						TargetGUID:       "app-guid",
+						Types:            []string{"audit.app.update", "audit.app.start"},
+						Since:            time.Date(2021, 3, 4, 0, 0, 0, 0, time.FixedZone("UTC+1", 3600)),
+						Until:            time.Date(2021, 3, 5, 0, 0, 0, 0, time.UTC),
+					}
+				})
+
+				It("passes the filter to the cloud controller with UTC timestamps", func() {
+					Expect(fakeCloudControllerClient.GetAuditEventsArgsForCall(0)).To(ConsistOf(
+						ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: []string{"org-guid"}},
+						ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{"space-guid"}},
+						ccv3.Query{Key: ccv3.TargetGUIDFilter, Values: []string{"app-guid"}},
+						ccv3.Query{Key: ccv3.EventTypesFilter, Values: []string{"audit.app.update", "audit.app.start"}},
+						ccv3.Query{Key: ccv3.CreatedAtsGreaterThanOrEqualFilter, Values: []string{"2021-03-03T23:00:00Z"}},
+						ccv3.Query{Key: ccv3.CreatedAtsLessThanOrEqualFilter, Values: []string{"2021-03-05T00:00:00Z"}},
+						ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+					))
+				})
+			})
+
+			When("the filter selects a target name", func() {
+				BeforeEach(func() {
+					filter.TargetName = "other-app"
+				})
+
+				It("only returns the events of targets with that name", func() {
+					Expect(events).To(HaveLen(1))
+					Expect(events[0].GUID).To(Equal("event-2"))
+				})
+			})
+
+			When("the filter selects an actor", func() {
+				It("matches the name of the actor", func() {
+					filter.Actor = "admin"
+					events, _, executeErr = actor.GetAuditEvents(filter)
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(events).To(HaveLen(1))
+					Expect(events[0].GUID).To(Equal("event-1"))
+				})
+
+				It("matches the GUID of the actor", func() {
+					filter.Actor = "client-guid"
+					events, _, executeErr = actor.GetAuditEvents(filter)
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(events).To(HaveLen(1))
+					Expect(events[0].GUID).To(Equal("event-2"))
+				})
+			})
+		})
+
+		When("the cloud controller returns an error", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetAuditEventsReturns(nil, ccv3.Warnings{"some-warning"}, errors.New("some-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(warnings).To(ConsistOf("some-warning"))
+				Expect(events).To(BeEmpty())
+			})
+		})
+	})
+})
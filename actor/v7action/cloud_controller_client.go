@@ -74,6 +74,8 @@ type CloudControllerClient interface {
 	GetApplicationRoutes(appGUID string) ([]resources.Route, ccv3.Warnings, error)
 	GetApplicationTasks(appGUID string, query ...ccv3.Query) ([]resources.Task, ccv3.Warnings, error)
 	GetApplications(query ...ccv3.Query) ([]resources.Application, ccv3.Warnings, error)
+	GetAppUsageEvents(query ...ccv3.Query) ([]ccv3.AppUsageEvent, ccv3.Warnings, error)
+	GetAuditEvents(query ...ccv3.Query) ([]ccv3.Event, ccv3.Warnings, error)
 	GetBuild(guid string) (resources.Build, ccv3.Warnings, error)
 	GetBuildpacks(query ...ccv3.Query) ([]resources.Buildpack, ccv3.Warnings, error)
 	GetDefaultDomain(orgGuid string) (resources.Domain, ccv3.Warnings, error)
@@ -126,6 +128,7 @@ type CloudControllerClient interface {
 	GetServicePlans(query ...ccv3.Query) ([]resources.ServicePlan, ccv3.Warnings, error)
 	GetServicePlansWithOfferings(query ...ccv3.Query) ([]ccv3.ServiceOfferingWithPlans, ccv3.Warnings, error)
 	GetServicePlansWithSpaceAndOrganization(query ...ccv3.Query) ([]ccv3.ServicePlanWithSpaceAndOrganization, ccv3.Warnings, error)
+	GetServiceUsageEvents(query ...ccv3.Query) ([]ccv3.ServiceUsageEvent, ccv3.Warnings, error)
 	GetSpaceFeature(spaceGUID string, featureName string) (bool, ccv3.Warnings, error)
 	GetSpaceIsolationSegment(spaceGUID string) (resources.Relationship, ccv3.Warnings, error)
 	GetSpaceManifestDiff(spaceGUID string, rawManifest []byte) (resources.ManifestDiff, ccv3.Warnings, error)
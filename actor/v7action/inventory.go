@@ -0,0 +1,236 @@
+package v7action
+
+import (
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/batcher"
+	"code.cloudfoundry.org/cli/util/extract"
+	"code.cloudfoundry.org/cli/util/lookuptable"
+	"code.cloudfoundry.org/cli/util/railway"
+)
+
+// Inventory lists every org, space, app, route, service instance and role
+// visible to the current user.
+type Inventory struct {
+	Organizations    []resources.Organization
+	Spaces           []InventorySpace
+	Applications     []InventoryApplication
+	Routes           []InventoryRoute
+	ServiceInstances []InventoryServiceInstance
+	Roles            []InventoryRole
+}
+
+// InventorySpace is a space together with the name of its org.
+type InventorySpace struct {
+	resources.Space
+	OrganizationName string
+}
+
+// InventoryApplication is an app together with the names of its org and
+// space.
+type InventoryApplication struct {
+	resources.Application
+	OrganizationName string
+	SpaceName        string
+}
+
+// InventoryRoute is a route together with the names of its org and space.
+type InventoryRoute struct {
+	resources.Route
+	OrganizationName string
+	SpaceName        string
+}
+
+// InventoryServiceInstance is a service instance together with the names of
+// its org, space, service offering and plan. The offering and plan names are
+// empty for user-provided service instances.
+type InventoryServiceInstance struct {
+	resources.ServiceInstance
+	OrganizationName    string
+	SpaceName           string
+	ServiceOfferingName string
+	ServicePlanName     string
+}
+
+// InventoryRole is an org or space role together with the names of the org
+// and space it applies to. SpaceName is empty for org roles.
+type InventoryRole struct {
+	resources.Role
+	OrganizationName string
+	SpaceName        string
+}
+
+// GetInventory walks every org and space visible to the current user. The
+// resources in them are requested in batches of org or space GUIDs rather
+// than one request per org or space.
+func (actor Actor) GetInventory() (Inventory, Warnings, error) {
+	var (
+		orgs             []resources.Organization
+		spaces           []resources.Space
+		apps             []resources.Application
+		routes           []resources.Route
+		serviceInstances []resources.ServiceInstance
+		plans            ccv3.IncludedResources
+		roles            []resources.Role
+		users            []resources.User
+	)
+
+	getRoles := func(filterKey ccv3.QueryKey, guids []string) (ccv3.Warnings, error) {
+		return batcher.RequestByGUID(guids, func(guids []string) (ccv3.Warnings, error) {
+			batch, included, warnings, err := actor.CloudControllerClient.GetRoles(
+				ccv3.Query{Key: filterKey, Values: guids},
+				ccv3.Query{Key: ccv3.Include, Values: []string{"user"}},
+				ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+			)
+			roles = append(roles, batch...)
+			users = append(users, included.Users...)
+			return warnings, err
+		})
+	}
+
+	warnings, err := railway.Sequentially(
+		func() (warnings ccv3.Warnings, err error) {
+			orgs, warnings, err = actor.CloudControllerClient.GetOrganizations(
+				ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.NameOrder}},
+				ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+			)
+			return
+		},
+		func() (ccv3.Warnings, error) {
+			return batcher.RequestByGUID(extract.UniqueList("GUID", orgs), func(guids []string) (ccv3.Warnings, error) {
+				batch, _, warnings, err := actor.CloudControllerClient.GetSpaces(
+					ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: guids},
+					ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.NameOrder}},
+					ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+				)
+				spaces = append(spaces, batch...)
+				return warnings, err
+			})
+		},
+		func() (ccv3.Warnings, error) {
+			return batcher.RequestByGUID(extract.UniqueList("GUID", spaces), func(guids []string) (ccv3.Warnings, error) {
+				batch, warnings, err := actor.CloudControllerClient.GetApplications(
+					ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: guids},
+					ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.NameOrder}},
+					ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+				)
+				apps = append(apps, batch...)
+				return warnings, err
+			})
+		},
+		func() (ccv3.Warnings, error) {
+			return batcher.RequestByGUID(extract.UniqueList("GUID", spaces), func(guids []string) (ccv3.Warnings, error) {
+				batch, warnings, err := actor.CloudControllerClient.GetRoutes(
+					ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: guids},
+					ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+				)
+				routes = append(routes, batch...)
+				return warnings, err
+			})
+		},
+		func() (ccv3.Warnings, error) {
+			return batcher.RequestByGUID(extract.UniqueList("GUID", spaces), func(guids []string) (ccv3.Warnings, error) {
+				batch, included, warnings, err := actor.CloudControllerClient.GetServiceInstances(
+					ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: guids},
+					ccv3.Query{Key: ccv3.FieldsServicePlan, Values: []string{"guid", "name", "relationships.service_offering"}},
+					ccv3.Query{Key: ccv3.FieldsServicePlanServiceOffering, Values: []string{"guid", "name"}},
+					ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.NameOrder}},
+					ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+				)
+				serviceInstances = append(serviceInstances, batch...)
+				plans.ServicePlans = append(plans.ServicePlans, included.ServicePlans...)
+				plans.ServiceOfferings = append(plans.ServiceOfferings, included.ServiceOfferings...)
+				return warnings, err
+			})
+		},
+		func() (ccv3.Warnings, error) {
+			return getRoles(ccv3.OrganizationGUIDFilter, extract.UniqueList("GUID", orgs))
+		},
+		func() (ccv3.Warnings, error) {
+			return getRoles(ccv3.SpaceGUIDFilter, extract.UniqueList("GUID", spaces))
+		},
+	)
+	if err != nil {
+		return Inventory{}, Warnings(warnings), err
+	}
+
+	return buildInventory(orgs, spaces, apps, routes, serviceInstances, plans, roles, users), Warnings(warnings), nil
+}
+
+func buildInventory(
+	orgs []resources.Organization,
+	spaces []resources.Space,
+	apps []resources.Application,
+	routes []resources.Route,
+	serviceInstances []resources.ServiceInstance,
+	plans ccv3.IncludedResources,
+	roles []resources.Role,
+	users []resources.User,
+) Inventory {
+	orgNames := lookuptable.NameFromGUID(orgs)
+	spaceLookup := lookuptable.SpaceFromGUID(spaces)
+	planLookup := buildPlanDetailsLookup(plans)
+	userLookup := make(map[string]resources.User, len(users))
+	for _, user := range users {
+		userLookup[user.GUID] = user
+	}
+
+	orgAndSpaceNames := func(spaceGUID string) (string, string) {
+		space := spaceLookup[spaceGUID]
+		return orgNames[space.Relationships[constant.RelationshipTypeOrganization].GUID], space.Name
+	}
+
+	inventory := Inventory{Organizations: orgs}
+
+	for _, space := range spaces {
+		orgName, _ := orgAndSpaceNames(space.GUID)
+		inventory.Spaces = append(inventory.Spaces, InventorySpace{Space: space, OrganizationName: orgName})
+	}
+
+	for _, app := range apps {
+		orgName, spaceName := orgAndSpaceNames(app.SpaceGUID)
+		inventory.Applications = append(inventory.Applications, InventoryApplication{
+			Application:      app,
+			OrganizationName: orgName,
+			SpaceName:        spaceName,
+		})
+	}
+
+	for _, route := range routes {
+		orgName, spaceName := orgAndSpaceNames(route.SpaceGUID)
+		inventory.Routes = append(inventory.Routes, InventoryRoute{
+			Route:            route,
+			OrganizationName: orgName,
+			SpaceName:        spaceName,
+		})
+	}
+
+	for _, instance := range serviceInstances {
+		orgName, spaceName := orgAndSpaceNames(instance.SpaceGUID)
+		names := planLookup[instance.ServicePlanGUID]
+		inventory.ServiceInstances = append(inventory.ServiceInstances, InventoryServiceInstance{
+			ServiceInstance:     instance,
+			OrganizationName:    orgName,
+			SpaceName:           spaceName,
+			ServiceOfferingName: names.offering,
+			ServicePlanName:     names.plan,
+		})
+	}
+
+	for _, role := range roles {
+		user := userLookup[role.UserGUID]
+		role.Username = user.Username
+		role.Origin = user.Origin
+
+		inventoryRole := InventoryRole{Role: role}
+		if role.SpaceGUID != "" {
+			inventoryRole.OrganizationName, inventoryRole.SpaceName = orgAndSpaceNames(role.SpaceGUID)
+		} else {
+			inventoryRole.OrganizationName = orgNames[role.OrgGUID]
+		}
+		inventory.Roles = append(inventory.Roles, inventoryRole)
+	}
+
+	return inventory
+}
@@ -0,0 +1,158 @@
+package v7action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/resources"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Inventory Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v7actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, nil, nil, nil)
+	})
+
+	Describe("GetInventory", func() {
+		var (
+			inventory  Inventory
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetOrganizationsReturns(
+				[]resources.Organization{{GUID: "org-guid", Name: "some-org"}},
+				ccv3.Warnings{"orgs-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetSpacesReturns(
+				[]resources.Space{{
+					GUID: "space-guid",
+					Name: "some-space",
+					Relationships: resources.Relationships{
+						constant.RelationshipTypeOrganization: resources.Relationship{GUID: "org-guid"},
+					},
+				}},
+				ccv3.IncludedResources{},
+				ccv3.Warnings{"spaces-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetApplicationsReturns(
+				[]resources.Application{{GUID: "app-guid", Name: "some-app", SpaceGUID: "space-guid"}},
+				ccv3.Warnings{"apps-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetRoutesReturns(
+				[]resources.Route{{GUID: "route-guid", URL: "some-host.example.com", SpaceGUID: "space-guid"}},
+				ccv3.Warnings{"routes-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetServiceInstancesReturns(
+				[]resources.ServiceInstance{{GUID: "instance-guid", Name: "some-db", SpaceGUID: "space-guid", ServicePlanGUID: "plan-guid"}},
+				ccv3.IncludedResources{
+					ServicePlans:     []resources.ServicePlan{{GUID: "plan-guid", Name: "small", ServiceOfferingGUID: "offering-guid"}},
+					ServiceOfferings: []resources.ServiceOffering{{GUID: "offering-guid", Name: "postgres"}},
+				},
+				ccv3.Warnings{"service-instances-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetRolesReturnsOnCall(0,
+				[]resources.Role{{GUID: "org-role-guid", Type: constant.OrgManagerRole, UserGUID: "user-guid", OrgGUID: "org-guid"}},
+				ccv3.IncludedResources{Users: []resources.User{{GUID: "user-guid", Username: "some-user", Origin: "uaa"}}},
+				ccv3.Warnings{"org-roles-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetRolesReturnsOnCall(1,
+				[]resources.Role{{GUID: "space-role-guid", Type: constant.SpaceDeveloperRole, UserGUID: "user-guid", SpaceGUID: "space-guid"}},
+				ccv3.IncludedResources{Users: []resources.User{{GUID: "user-guid", Username: "some-user", Origin: "uaa"}}},
+				ccv3.Warnings{"space-roles-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			inventory, warnings, executeErr = actor.GetInventory()
+		})
+
+		It("requests the resources in every org and space by GUID", func() {
+			Expect(fakeCloudControllerClient.GetSpacesArgsForCall(0)).To(ContainElement(
+				ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: []string{"org-guid"}},
+			))
+			Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(ContainElement(
+				ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{"space-guid"}},
+			))
+			Expect(fakeCloudControllerClient.GetRoutesArgsForCall(0)).To(ContainElement(
+				ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{"space-guid"}},
+			))
+			Expect(fakeCloudControllerClient.GetServiceInstancesArgsForCall(0)).To(ContainElement(
+				ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{"space-guid"}},
+			))
+
+			Expect(fakeCloudControllerClient.GetRolesCallCount()).To(Equal(2))
+			Expect(fakeCloudControllerClient.GetRolesArgsForCall(0)).To(ContainElements(
+				ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: []string{"org-guid"}},
+				ccv3.Query{Key: ccv3.Include, Values: []string{"user"}},
+			))
+			Expect(fakeCloudControllerClient.GetRolesArgsForCall(1)).To(ContainElements(
+				ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{"space-guid"}},
+				ccv3.Query{Key: ccv3.Include, Values: []string{"user"}},
+			))
+		})
+
+		It("returns the resources with the names of their org and space", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf(
+				"orgs-warning", "spaces-warning", "apps-warning", "routes-warning",
+				"service-instances-warning", "org-roles-warning", "space-roles-warning",
+			))
+
+			Expect(inventory.Organizations).To(Equal([]resources.Organization{{GUID: "org-guid", Name: "some-org"}}))
+			Expect(inventory.Spaces).To(HaveLen(1))
+			Expect(inventory.Spaces[0].OrganizationName).To(Equal("some-org"))
+
+			Expect(inventory.Applications).To(HaveLen(1))
+			Expect(inventory.Applications[0].Name).To(Equal("some-app"))
+			Expect(inventory.Applications[0].OrganizationName).To(Equal("some-org"))
+			Expect(inventory.Applications[0].SpaceName).To(Equal("some-space"))
+
+			Expect(inventory.Routes).To(HaveLen(1))
+			Expect(inventory.Routes[0].SpaceName).To(Equal("some-space"))
+
+			Expect(inventory.ServiceInstances).To(HaveLen(1))
+			Expect(inventory.ServiceInstances[0].SpaceName).To(Equal("some-space"))
+			Expect(inventory.ServiceInstances[0].ServiceOfferingName).To(Equal("postgres"))
+			Expect(inventory.ServiceInstances[0].ServicePlanName).To(Equal("small"))
+
+			Expect(inventory.Roles).To(HaveLen(2))
+			Expect(inventory.Roles[0].Username).To(Equal("some-user"))
+			Expect(inventory.Roles[0].OrganizationName).To(Equal("some-org"))
+			Expect(inventory.Roles[0].SpaceName).To(BeEmpty())
+			Expect(inventory.Roles[1].Type).To(Equal(constant.SpaceDeveloperRole))
+			Expect(inventory.Roles[1].OrganizationName).To(Equal("some-org"))
+			Expect(inventory.Roles[1].SpaceName).To(Equal("some-space"))
+		})
+
+		When("a request fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"apps-warning"}, errors.New("apps-error"))
+			})
+
+			It("returns the error and the warnings so far", func() {
+				Expect(executeErr).To(MatchError("apps-error"))
+				Expect(warnings).To(ConsistOf("orgs-warning", "spaces-warning", "apps-warning"))
+				Expect(fakeCloudControllerClient.GetRoutesCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
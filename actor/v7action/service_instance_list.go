@@ -14,6 +14,7 @@ import (
 
 type ServiceInstance struct {
 	Type                resources.ServiceInstanceType
+	GUID                string
 	Name                string
 	ServicePlanName     string
 	ServiceOfferingName string
@@ -21,6 +22,9 @@ type ServiceInstance struct {
 	BoundApps           []string
 	LastOperation       string
 	UpgradeAvailable    types.OptionalBoolean
+	CreatedAt           string
+	UpdatedAt           string
+	Metadata            *resources.Metadata
 }
 
 type planDetails struct {
@@ -74,6 +78,7 @@ func (actor Actor) GetServiceInstancesForSpace(spaceGUID string, omitApps bool)
 	for i, instance := range instances {
 		names := planDetailsFromPlanGUIDLookup[instance.ServicePlanGUID]
 		result[i] = ServiceInstance{
+			GUID:                instance.GUID,
 			Name:                instance.Name,
 			Type:                instance.Type,
 			UpgradeAvailable:    instance.UpgradeAvailable,
@@ -82,6 +87,9 @@ func (actor Actor) GetServiceInstancesForSpace(spaceGUID string, omitApps bool)
 			ServiceBrokerName:   names.broker,
 			BoundApps:           boundAppsNamesFromInstanceGUIDLookup[instance.GUID],
 			LastOperation:       lastOperation(instance.LastOperation),
+			CreatedAt:           instance.CreatedAt,
+			UpdatedAt:           instance.UpdatedAt,
+			Metadata:            instance.Metadata,
 		}
 	}
 
@@ -36,6 +36,9 @@ var _ = Describe("Service Instance List Action", func() {
 						Type:  resources.CreateOperation,
 						State: resources.OperationSucceeded,
 					},
+					CreatedAt: "2021-01-01T00:00:00Z",
+					UpdatedAt: "2021-01-02T00:00:00Z",
+					Metadata:  &resources.Metadata{Labels: map[string]types.NullString{"env": types.NewNullString("prod")}},
 				},
 				{
 					GUID:             "fake-guid-2",
@@ -238,6 +241,7 @@ var _ = Describe("Service Instance List Action", func() {
 
 				Expect(serviceInstances).To(Equal([]ServiceInstance{
 					{
+						GUID:                "fake-guid-1",
 						Name:                "msi1",
 						Type:                resources.ManagedServiceInstance,
 						ServicePlanName:     "fake-plan-1",
@@ -246,8 +250,12 @@ var _ = Describe("Service Instance List Action", func() {
 						UpgradeAvailable:    types.NewOptionalBoolean(true),
 						BoundApps:           []string{"great-app-1", "great-app-2"},
 						LastOperation:       "create succeeded",
+						CreatedAt:           "2021-01-01T00:00:00Z",
+						UpdatedAt:           "2021-01-02T00:00:00Z",
+						Metadata:            &resources.Metadata{Labels: map[string]types.NullString{"env": types.NewNullString("prod")}},
 					},
 					{
+						GUID:                "fake-guid-2",
 						Name:                "msi2",
 						Type:                resources.ManagedServiceInstance,
 						ServicePlanName:     "fake-plan-2",
@@ -258,6 +266,7 @@ var _ = Describe("Service Instance List Action", func() {
 						LastOperation:       "update succeeded",
 					},
 					{
+						GUID:                "fake-guid-3",
 						Name:                "msi3",
 						Type:                resources.ManagedServiceInstance,
 						ServicePlanName:     "fake-plan-3",
@@ -267,6 +276,7 @@ var _ = Describe("Service Instance List Action", func() {
 						LastOperation:       "create in progress",
 					},
 					{
+						GUID:                "fake-guid-4",
 						Name:                "msi4",
 						Type:                resources.ManagedServiceInstance,
 						ServicePlanName:     "fake-plan-4",
@@ -277,6 +287,7 @@ var _ = Describe("Service Instance List Action", func() {
 						LastOperation:       "create failed",
 					},
 					{
+						GUID:                "fake-guid-5",
 						Name:                "msi5",
 						Type:                resources.ManagedServiceInstance,
 						ServicePlanName:     "fake-plan-4",
@@ -287,6 +298,7 @@ var _ = Describe("Service Instance List Action", func() {
 						LastOperation:       "delete in progress",
 					},
 					{
+						GUID:      "fake-guid-6",
 						Name:      "upsi",
 						Type:      resources.UserProvidedServiceInstance,
 						BoundApps: nil,
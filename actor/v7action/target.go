@@ -26,12 +26,14 @@ func (actor Actor) SetTarget(settings TargetSettings) (Warnings, error) {
 		Auth:              rootInfo.Login(),
 		MinCLIVersion:     "", // Oldest supported V3 version should be OK
 		Doppler:           rootInfo.Logging(),
+		FailoverURLs:      settings.FailoverURLs,
 		LogCache:          rootInfo.LogCache(),
 		NetworkPolicyV1:   rootInfo.NetworkPolicyV1(),
 		Routing:           rootInfo.Routing(),
 		SkipSSLValidation: settings.SkipSSLValidation,
 		UAA:               rootInfo.UAA(),
 		CFOnK8s:           rootInfo.CFOnK8s,
+		TLSCertificates:   settings.TLSCertificates,
 	})
 
 	actor.Config.SetTokenInformation("", "", "")
@@ -46,3 +48,17 @@ func (actor Actor) ClearTarget() {
 	actor.Config.SetTokenInformation("", "", "")
 	actor.Config.SetKubernetesAuthInfo("")
 }
+
+// ResolveOrganizationGUID returns the GUID of the org with the given name. It
+// is used to look up the org named in CF_ORG.
+func (actor Actor) ResolveOrganizationGUID(orgName string) (string, error) {
+	org, _, err := actor.GetOrganizationByName(orgName)
+	return org.GUID, err
+}
+
+// ResolveSpaceGUID returns the GUID of the space with the given name in the
+// given org. It is used to look up the space named in CF_SPACE.
+func (actor Actor) ResolveSpaceGUID(spaceName string, orgGUID string) (string, error) {
+	space, _, err := actor.GetSpaceByNameAndOrganization(spaceName, orgGUID)
+	return space.GUID, err
+}
@@ -3,10 +3,14 @@ package v7action_test
 import (
 	"errors"
 
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction/sharedactionfakes"
 	. "code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -82,7 +86,9 @@ var _ = Describe("Targeting", func() {
 		JustBeforeEach(func() {
 			settings = TargetSettings{
 				SkipSSLValidation: skipSSLValidation,
+				TLSCertificates:   util.TLSCertificates{CACert: "some-ca-cert"},
 				URL:               targetedURL,
+				FailoverURLs:      []string{"https://api-b.foo.com"},
 			}
 			warnings, err = actor.SetTarget(settings)
 		})
@@ -92,6 +98,8 @@ var _ = Describe("Targeting", func() {
 			connectionSettings := fakeCloudControllerClient.TargetCFArgsForCall(0)
 			Expect(connectionSettings.URL).To(Equal(expectedAPI))
 			Expect(connectionSettings.SkipSSLValidation).To(BeTrue())
+			Expect(connectionSettings.TLSCertificates.CACert).To(Equal("some-ca-cert"))
+			Expect(connectionSettings.FailoverURLs).To(Equal([]string{"https://api-b.foo.com"}))
 		})
 
 		When("getting root info fails", func() {
@@ -119,6 +127,8 @@ var _ = Describe("Targeting", func() {
 			Expect(targetInfoArgs.LogCache).To(Equal(expectedLogCache))
 			Expect(targetInfoArgs.Routing).To(Equal(expectedRouting))
 			Expect(targetInfoArgs.SkipSSLValidation).To(Equal(skipSSLValidation))
+			Expect(targetInfoArgs.TLSCertificates).To(Equal(util.TLSCertificates{CACert: "some-ca-cert"}))
+			Expect(targetInfoArgs.FailoverURLs).To(Equal([]string{"https://api-b.foo.com"}))
 			Expect(targetInfoArgs.CFOnK8s).To(BeFalse())
 		})
 
@@ -193,4 +203,66 @@ var _ = Describe("Targeting", func() {
 			Expect(authInfo).To(BeEmpty())
 		})
 	})
+
+	Describe("NewActor", func() {
+		It("resolves CF_ORG and CF_SPACE for the shared actor", func() {
+			sharedActor := sharedaction.NewActor(new(sharedactionfakes.FakeConfig))
+			actor := NewActor(fakeCloudControllerClient, fakeConfig, sharedActor, nil, nil, nil)
+			Expect(sharedActor.TargetResolver).To(BeIdenticalTo(actor))
+		})
+
+		It("keeps a TargetResolver the shared actor already has", func() {
+			sharedActor := sharedaction.NewActor(new(sharedactionfakes.FakeConfig))
+			resolver := new(sharedactionfakes.FakeTargetResolver)
+			sharedActor.TargetResolver = resolver
+
+			NewActor(fakeCloudControllerClient, fakeConfig, sharedActor, nil, nil, nil)
+			Expect(sharedActor.TargetResolver).To(BeIdenticalTo(resolver))
+		})
+	})
+
+	Describe("ResolveOrganizationGUID", func() {
+		It("returns the GUID of the org", func() {
+			fakeCloudControllerClient.GetOrganizationsReturns([]resources.Organization{{GUID: "some-org-guid"}}, nil, nil)
+
+			guid, err := actor.ResolveOrganizationGUID("some-org")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(guid).To(Equal("some-org-guid"))
+			Expect(fakeCloudControllerClient.GetOrganizationsArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.NameFilter, Values: []string{"some-org"}},
+			))
+		})
+
+		When("the org does not exist", func() {
+			It("returns an OrganizationNotFoundError", func() {
+				fakeCloudControllerClient.GetOrganizationsReturns(nil, nil, nil)
+
+				_, err := actor.ResolveOrganizationGUID("some-org")
+				Expect(err).To(MatchError(actionerror.OrganizationNotFoundError{Name: "some-org"}))
+			})
+		})
+	})
+
+	Describe("ResolveSpaceGUID", func() {
+		It("returns the GUID of the space in the org", func() {
+			fakeCloudControllerClient.GetSpacesReturns([]resources.Space{{GUID: "some-space-guid"}}, ccv3.IncludedResources{}, nil, nil)
+
+			guid, err := actor.ResolveSpaceGUID("some-space", "some-org-guid")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(guid).To(Equal("some-space-guid"))
+			Expect(fakeCloudControllerClient.GetSpacesArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.NameFilter, Values: []string{"some-space"}},
+				ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: []string{"some-org-guid"}},
+			))
+		})
+
+		When("the space does not exist", func() {
+			It("returns a SpaceNotFoundError", func() {
+				fakeCloudControllerClient.GetSpacesReturns(nil, ccv3.IncludedResources{}, nil, nil)
+
+				_, err := actor.ResolveSpaceGUID("some-space", "some-org-guid")
+				Expect(err).To(MatchError(actionerror.SpaceNotFoundError{Name: "some-space"}))
+			})
+		})
+	})
 })
@@ -0,0 +1,368 @@
+package v7action
+
+import (
+	"sort"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/util/batcher"
+)
+
+const (
+	appUsageStarted     = "STARTED"
+	appUsageStopped     = "STOPPED"
+	appUsageTaskStarted = "TASK_STARTED"
+	appUsageTaskStopped = "TASK_STOPPED"
+
+	serviceUsageCreated = "CREATED"
+	serviceUsageDeleted = "DELETED"
+)
+
+// AppUsageEvent is an app process starting, stopping or scaling, a task
+// running, or an app staging.
+type AppUsageEvent ccv3.AppUsageEvent
+
+// ServiceUsageEvent is a service instance being created, updated or deleted.
+type ServiceUsageEvent ccv3.ServiceUsageEvent
+
+// UsageEventFilter selects the usage events returned by GetAppUsageEvents and
+// GetServiceUsageEvents. Empty fields do not filter the events.
+type UsageEventFilter struct {
+	// AfterGUID only selects the events that happened after the event with
+	// this GUID, so that the events can be read incrementally.
+	AfterGUID string
+	Since     time.Time
+	Until     time.Time
+}
+
+// AppUsageSummary is the usage of the apps of a space over a time window.
+// Memory is counted in gigabytes per hour of every running instance.
+type AppUsageSummary struct {
+	OrganizationGUID string
+	OrganizationName string
+	SpaceGUID        string
+	SpaceName        string
+	InstanceHours    float64
+	MemoryGBHours    float64
+}
+
+// ServiceUsageSummary is the usage of the service instances of a space over
+// a time window.
+type ServiceUsageSummary struct {
+	OrganizationGUID string
+	OrganizationName string
+	SpaceGUID        string
+	SpaceName        string
+	ServiceInstances int
+	InstanceHours    float64
+}
+
+// GetAppUsageEvents returns every app usage event matching the filter,
+// oldest first.
+func (actor Actor) GetAppUsageEvents(filter UsageEventFilter) ([]AppUsageEvent, Warnings, error) {
+	ccEvents, warnings, err := actor.CloudControllerClient.GetAppUsageEvents(filter.queries()...)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	var events []AppUsageEvent
+	for _, ccEvent := range ccEvents {
+		events = append(events, AppUsageEvent(ccEvent))
+	}
+	return events, Warnings(warnings), nil
+}
+
+// GetServiceUsageEvents returns every service usage event matching the
+// filter, oldest first.
+func (actor Actor) GetServiceUsageEvents(filter UsageEventFilter) ([]ServiceUsageEvent, Warnings, error) {
+	ccEvents, warnings, err := actor.CloudControllerClient.GetServiceUsageEvents(filter.queries()...)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	var events []ServiceUsageEvent
+	for _, ccEvent := range ccEvents {
+		events = append(events, ServiceUsageEvent(ccEvent))
+	}
+	return events, Warnings(warnings), nil
+}
+
+// GetAppUsageSummary returns the instance-hours and memory-hours of the apps
+// of every space, from the app usage events matching the filter. The time
+// window ends at Until, or now. It starts at Since; without Since, usage is
+// only counted from the first event of each process or task, because what
+// was running before is unknown. The events before Since are read as well,
+// so that processes that were started before the window and kept running
+// through it are counted.
+func (actor Actor) GetAppUsageSummary(filter UsageEventFilter) ([]AppUsageSummary, Warnings, error) {
+	events, allWarnings, err := actor.GetAppUsageEvents(filter.withoutStart())
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	usage := usageWindow{start: filter.Since, end: actor.windowEnd(filter)}
+	summaries := map[string]*AppUsageSummary{}
+	running := map[string]AppUsageEvent{}
+	seen := map[string]bool{}
+
+	accrue := func(event AppUsageEvent, from time.Time, to time.Time, instances int, memoryInMB int) {
+		if usage.endsBefore(to) {
+			return
+		}
+		hours := usage.hours(from, to)
+		summary, ok := summaries[event.SpaceGUID]
+		if !ok {
+			summary = &AppUsageSummary{
+				OrganizationGUID: event.OrganizationGUID,
+				SpaceGUID:        event.SpaceGUID,
+				SpaceName:        event.SpaceName,
+			}
+			summaries[event.SpaceGUID] = summary
+		}
+		summary.InstanceHours += float64(instances) * hours
+		summary.MemoryGBHours += float64(instances) * float64(memoryInMB) / 1024 * hours
+	}
+
+	for _, event := range events {
+		if !isAppUsageStart(event.State) && !isAppUsageStop(event.State) {
+			continue
+		}
+
+		key := appUsageKey(event)
+		if previous, ok := running[key]; ok {
+			accrue(previous, previous.CreatedAt, event.CreatedAt, previous.InstanceCount, previous.MemoryInMBPerInstance)
+			delete(running, key)
+		} else if !seen[key] && isAppUsageStart(event.PreviousState) {
+			instances, memoryInMB := event.PreviousInstanceCount, event.PreviousMemoryInMBPerInstance
+			if instances == 0 {
+				instances, memoryInMB = event.InstanceCount, event.MemoryInMBPerInstance
+			}
+			accrue(event, usage.start, event.CreatedAt, instances, memoryInMB)
+		}
+		seen[key] = true
+
+		if isAppUsageStart(event.State) {
+			running[key] = event
+		}
+	}
+	for _, event := range running {
+		accrue(event, event.CreatedAt, usage.end, event.InstanceCount, event.MemoryInMBPerInstance)
+	}
+
+	var (
+		result   []AppUsageSummary
+		orgGUIDs []string
+	)
+	for _, summary := range summaries {
+		result = append(result, *summary)
+		orgGUIDs = append(orgGUIDs, summary.OrganizationGUID)
+	}
+
+	orgNames, warnings, err := actor.organizationNames(orgGUIDs)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+	for i := range result {
+		result[i].OrganizationName = orgNames[result[i].OrganizationGUID]
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].OrganizationName != result[j].OrganizationName {
+			return result[i].OrganizationName < result[j].OrganizationName
+		}
+		return result[i].SpaceName < result[j].SpaceName
+	})
+
+	return result, allWarnings, nil
+}
+
+// GetServiceUsageSummary returns the number of service instances and their
+// instance-hours in every space, from the service usage events matching the
+// filter. The time window and the events read are the same as for
+// GetAppUsageSummary.
+func (actor Actor) GetServiceUsageSummary(filter UsageEventFilter) ([]ServiceUsageSummary, Warnings, error) {
+	events, allWarnings, err := actor.GetServiceUsageEvents(filter.withoutStart())
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	usage := usageWindow{start: filter.Since, end: actor.windowEnd(filter)}
+	summaries := map[string]*ServiceUsageSummary{}
+	counted := map[string]bool{}
+	existing := map[string]ServiceUsageEvent{}
+
+	accrue := func(event ServiceUsageEvent, from time.Time, to time.Time) {
+		if usage.endsBefore(to) {
+			return
+		}
+		summary, ok := summaries[event.SpaceGUID]
+		if !ok {
+			summary = &ServiceUsageSummary{
+				OrganizationGUID: event.OrganizationGUID,
+				SpaceGUID:        event.SpaceGUID,
+				SpaceName:        event.SpaceName,
+			}
+			summaries[event.SpaceGUID] = summary
+		}
+		if !counted[event.ServiceInstanceGUID] {
+			counted[event.ServiceInstanceGUID] = true
+			summary.ServiceInstances++
+		}
+		summary.InstanceHours += usage.hours(from, to)
+	}
+
+	seen := map[string]bool{}
+	for _, event := range events {
+		key := event.ServiceInstanceGUID
+		if previous, ok := existing[key]; ok {
+			if event.State == serviceUsageDeleted {
+				accrue(previous, previous.CreatedAt, event.CreatedAt)
+				delete(existing, key)
+			}
+		} else if !seen[key] {
+			switch event.State {
+			case serviceUsageCreated:
+				existing[key] = event
+			case serviceUsageDeleted:
+				accrue(event, usage.start, event.CreatedAt)
+			default:
+				event.CreatedAt = usage.start
+				existing[key] = event
+			}
+		}
+		seen[key] = true
+	}
+	for _, event := range existing {
+		accrue(event, event.CreatedAt, usage.end)
+	}
+
+	var (
+		result   []ServiceUsageSummary
+		orgGUIDs []string
+	)
+	for _, summary := range summaries {
+		result = append(result, *summary)
+		orgGUIDs = append(orgGUIDs, summary.OrganizationGUID)
+	}
+
+	orgNames, warnings, err := actor.organizationNames(orgGUIDs)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+	for i := range result {
+		result[i].OrganizationName = orgNames[result[i].OrganizationGUID]
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].OrganizationName != result[j].OrganizationName {
+			return result[i].OrganizationName < result[j].OrganizationName
+		}
+		return result[i].SpaceName < result[j].SpaceName
+	})
+
+	return result, allWarnings, nil
+}
+
+func (actor Actor) windowEnd(filter UsageEventFilter) time.Time {
+	if filter.Until.IsZero() {
+		return actor.Clock.Now()
+	}
+	return filter.Until
+}
+
+// organizationNames returns the names of the orgs with the given GUIDs, by
+// GUID.
+func (actor Actor) organizationNames(orgGUIDs []string) (map[string]string, Warnings, error) {
+	names := map[string]string{}
+
+	var guids []string
+	for _, guid := range orgGUIDs {
+		if _, ok := names[guid]; !ok && guid != "" {
+			names[guid] = ""
+			guids = append(guids, guid)
+		}
+	}
+	if len(guids) == 0 {
+		return names, nil, nil
+	}
+	sort.Strings(guids)
+
+	warnings, err := batcher.RequestByGUID(guids, func(guids []string) (ccv3.Warnings, error) {
+		orgs, warnings, err := actor.CloudControllerClient.GetOrganizations(ccv3.Query{Key: ccv3.GUIDFilter, Values: guids})
+		for _, org := range orgs {
+			names[org.GUID] = org.Name
+		}
+		return warnings, err
+	})
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+	return names, Warnings(warnings), nil
+}
+
+func (filter UsageEventFilter) queries() []ccv3.Query {
+	var queries []ccv3.Query
+	if filter.AfterGUID != "" {
+		queries = append(queries, ccv3.Query{Key: ccv3.AfterGUIDFilter, Values: []string{filter.AfterGUID}})
+	}
+	queries = append(queries, createdAtQueries(filter.Since, filter.Until)...)
+	return append(queries, ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}})
+}
+
+// withoutStart returns the filter without Since, to read the events that
+// happened before a usage window starts.
+func (filter UsageEventFilter) withoutStart() UsageEventFilter {
+	filter.Since = time.Time{}
+	return filter
+}
+
+// usageWindow is the time window usage is counted in. A zero start means
+// the window starts with the events.
+type usageWindow struct {
+	start time.Time
+	end   time.Time
+}
+
+// endsBefore returns whether usage that ended at 'to' ended before the window
+// started, so that it is not counted at all.
+func (window usageWindow) endsBefore(to time.Time) bool {
+	return !window.start.IsZero() && to.Before(window.start)
+}
+
+// hours returns how many hours of the time from 'from' to 'to' fall in the
+// window. Nothing is counted from a zero time.
+func (window usageWindow) hours(from time.Time, to time.Time) float64 {
+	if from.IsZero() {
+		return 0
+	}
+	if !window.start.IsZero() && from.Before(window.start) {
+		from = window.start
+	}
+	if to.After(window.end) {
+		to = window.end
+	}
+	if !to.After(from) {
+		return 0
+	}
+	return to.Sub(from).Hours()
+}
+
+// appUsageKey identifies the process or task an app usage event is about.
+func appUsageKey(event AppUsageEvent) string {
+	switch {
+	case event.ProcessGUID != "":
+		return event.ProcessGUID
+	case event.TaskGUID != "":
+		return event.TaskGUID
+	default:
+		return event.AppGUID + "/" + event.ProcessType
+	}
+}
+
+func isAppUsageStart(state string) bool {
+	return state == appUsageStarted || state == appUsageTaskStarted
+}
+
+func isAppUsageStop(state string) bool {
+	return state == appUsageStopped || state == appUsageTaskStopped
+}
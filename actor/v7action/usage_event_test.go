@@ -0,0 +1,374 @@
+package v7action_test
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/batcher"
+	"code.cloudfoundry.org/clock/fakeclock"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Usage Event Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+		fakeClock                 *fakeclock.FakeClock
+		start                     time.Time
+		filter                    UsageEventFilter
+	)
+
+	BeforeEach(func() {
+		actor, fakeCloudControllerClient, _, _, _, _, fakeClock = NewTestActor()
+		start = time.Date(2021, 3, 4, 0, 0, 0, 0, time.UTC)
+		filter = UsageEventFilter{Since: start, Until: start.Add(10 * time.Hour)}
+
+		fakeCloudControllerClient.GetOrganizationsReturns([]resources.Organization{
+			{GUID: "org-1", Name: "b-org"},
+			{GUID: "org-2", Name: "a-org"},
+		}, ccv3.Warnings{"orgs-warning"}, nil)
+	})
+
+	at := func(hours int) time.Time {
+		return start.Add(time.Duration(hours) * time.Hour)
+	}
+
+	Describe("GetAppUsageEvents", func() {
+		BeforeEach(func() {
+			filter = UsageEventFilter{AfterGUID: "some-event-guid", Since: start, Until: at(10)}
+			fakeCloudControllerClient.GetAppUsageEventsReturns(
+				[]ccv3.AppUsageEvent{{GUID: "event-1", State: "STARTED"}},
+				ccv3.Warnings{"events-warning"},
+				nil,
+			)
+		})
+
+		It("passes the filter to the cloud controller and returns the events", func() {
+			events, warnings, err := actor.GetAppUsageEvents(filter)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("events-warning"))
+			Expect(events).To(Equal([]AppUsageEvent{{GUID: "event-1", State: "STARTED"}}))
+
+			Expect(fakeCloudControllerClient.GetAppUsageEventsArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.AfterGUIDFilter, Values: []string{"some-event-guid"}},
+				ccv3.Query{Key: ccv3.CreatedAtsGreaterThanOrEqualFilter, Values: []string{"2021-03-04T00:00:00Z"}},
+				ccv3.Query{Key: ccv3.CreatedAtsLessThanOrEqualFilter, Values: []string{"2021-03-04T10:00:00Z"}},
+				ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+			))
+		})
+	})
+
+	Describe("GetServiceUsageEvents", func() {
+		When("the cloud controller returns an error", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceUsageEventsReturns(nil, ccv3.Warnings{"events-warning"}, errors.New("some-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				events, warnings, err := actor.GetServiceUsageEvents(UsageEventFilter{})
+				Expect(err).To(MatchError("some-error"))
+				Expect(warnings).To(ConsistOf("events-warning"))
+				Expect(events).To(BeEmpty())
+
+				Expect(fakeCloudControllerClient.GetServiceUsageEventsArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+				))
+			})
+		})
+	})
+
+	Describe("GetAppUsageSummary", func() {
+		BeforeEach(func() {
+			spaceA := AppUsageEvent{SpaceGUID: "space-a", SpaceName: "space-a-name", OrganizationGUID: "org-1"}
+			spaceB := AppUsageEvent{SpaceGUID: "space-b", SpaceName: "space-b-name", OrganizationGUID: "org-2"}
+			event := func(space AppUsageEvent, hours int, state string, previousState string, processGUID string, taskGUID string, instances int, memory int) ccv3.AppUsageEvent {
+				return ccv3.AppUsageEvent{
+					CreatedAt:             at(hours),
+					State:                 state,
+					PreviousState:         previousState,
+					ProcessGUID:           processGUID,
+					TaskGUID:              taskGUID,
+					SpaceGUID:             space.SpaceGUID,
+					SpaceName:             space.SpaceName,
+					OrganizationGUID:      space.OrganizationGUID,
+					InstanceCount:         instances,
+					MemoryInMBPerInstance: memory,
+				}
+			}
+
+			runningBefore := event(spaceA, 2, "STOPPED", "STARTED", "process-1", "", 2, 1024)
+			runningBefore.PreviousInstanceCount = 2
+			runningBefore.PreviousMemoryInMBPerInstance = 1024
+
+			fakeCloudControllerClient.GetAppUsageEventsReturns(
+				[]ccv3.AppUsageEvent{
+					event(spaceA, 1, "STARTED", "STOPPED", "process-2", "", 1, 512),
+					runningBefore,
+					event(spaceA, 3, "STARTED", "STARTED", "process-2", "", 3, 512),
+					event(spaceA, 4, "STAGING_STARTED", "", "", "", 1, 1024),
+					event(spaceA, 5, "STOPPED", "STARTED", "process-2", "", 3, 512),
+					event(spaceB, 8, "TASK_STARTED", "", "", "task-1", 1, 2048),
+				},
+				ccv3.Warnings{"events-warning"},
+				nil,
+			)
+		})
+
+		It("sums up the instance-hours and memory-hours of every space in the window", func() {
+			summaries, warnings, err := actor.GetAppUsageSummary(filter)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("events-warning", "orgs-warning"))
+
+			Expect(summaries).To(Equal([]AppUsageSummary{
+				{
+					OrganizationGUID: "org-2",
+					OrganizationName: "a-org",
+					SpaceGUID:        "space-b",
+					SpaceName:        "space-b-name",
+					InstanceHours:    2,
+					MemoryGBHours:    4,
+				},
+				{
+					OrganizationGUID: "org-1",
+					OrganizationName: "b-org",
+					SpaceGUID:        "space-a",
+					SpaceName:        "space-a-name",
+					InstanceHours:    12,
+					MemoryGBHours:    8,
+				},
+			}))
+
+			Expect(fakeCloudControllerClient.GetOrganizationsArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.GUIDFilter, Values: []string{"org-1", "org-2"}},
+			))
+		})
+
+		It("reads the events before the window too", func() {
+			_, _, err := actor.GetAppUsageSummary(filter)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeCloudControllerClient.GetAppUsageEventsArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.CreatedAtsLessThanOrEqualFilter, Values: []string{"2021-03-04T10:00:00Z"}},
+				ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+			))
+		})
+
+		When("processes were started before the window", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetAppUsageEventsReturns(
+					[]ccv3.AppUsageEvent{
+						{
+							CreatedAt:             at(-5),
+							State:                 "STARTED",
+							PreviousState:         "STOPPED",
+							ProcessGUID:           "process-1",
+							SpaceGUID:             "space-a",
+							SpaceName:             "space-a-name",
+							OrganizationGUID:      "org-1",
+							InstanceCount:         2,
+							MemoryInMBPerInstance: 512,
+						},
+						{
+							CreatedAt:             at(-5),
+							State:                 "STARTED",
+							PreviousState:         "STOPPED",
+							ProcessGUID:           "process-2",
+							SpaceGUID:             "space-b",
+							SpaceName:             "space-b-name",
+							OrganizationGUID:      "org-2",
+							InstanceCount:         1,
+							MemoryInMBPerInstance: 1024,
+						},
+						{
+							CreatedAt:             at(-1),
+							State:                 "STOPPED",
+							PreviousState:         "STARTED",
+							ProcessGUID:           "process-2",
+							SpaceGUID:             "space-b",
+							SpaceName:             "space-b-name",
+							OrganizationGUID:      "org-2",
+							InstanceCount:         1,
+							MemoryInMBPerInstance: 1024,
+						},
+					},
+					nil,
+					nil,
+				)
+			})
+
+			It("counts the ones still running for the whole window and leaves out the others", func() {
+				summaries, _, err := actor.GetAppUsageSummary(filter)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(summaries).To(Equal([]AppUsageSummary{
+					{
+						OrganizationGUID: "org-1",
+						OrganizationName: "b-org",
+						SpaceGUID:        "space-a",
+						SpaceName:        "space-a-name",
+						InstanceHours:    20,
+						MemoryGBHours:    10,
+					},
+				}))
+			})
+		})
+
+		When("the events are in more orgs than fit in one request", func() {
+			BeforeEach(func() {
+				var events []ccv3.AppUsageEvent
+				for i := 0; i < batcher.BatchSize+1; i++ {
+					events = append(events, ccv3.AppUsageEvent{
+						CreatedAt:        at(1),
+						State:            "STARTED",
+						ProcessGUID:      fmt.Sprintf("process-%d", i),
+						SpaceGUID:        fmt.Sprintf("space-%d", i),
+						OrganizationGUID: fmt.Sprintf("org-%03d", i),
+						InstanceCount:    1,
+					})
+				}
+				fakeCloudControllerClient.GetAppUsageEventsReturns(events, nil, nil)
+			})
+
+			It("looks up the org names in batches", func() {
+				_, warnings, err := actor.GetAppUsageSummary(filter)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("orgs-warning", "orgs-warning"))
+
+				Expect(fakeCloudControllerClient.GetOrganizationsCallCount()).To(Equal(2))
+				Expect(fakeCloudControllerClient.GetOrganizationsArgsForCall(0)[0].Values).To(HaveLen(batcher.BatchSize))
+				Expect(fakeCloudControllerClient.GetOrganizationsArgsForCall(1)[0].Values).To(Equal([]string{"org-200"}))
+			})
+		})
+
+		When("the window has no end", func() {
+			BeforeEach(func() {
+				filter = UsageEventFilter{}
+				fakeCloudControllerClient.GetAppUsageEventsReturns(
+					[]ccv3.AppUsageEvent{
+						{
+							CreatedAt:             fakeClock.Now().Add(-2 * time.Hour),
+							State:                 "STARTED",
+							ProcessGUID:           "process-1",
+							SpaceGUID:             "space-a",
+							OrganizationGUID:      "org-1",
+							InstanceCount:         1,
+							MemoryInMBPerInstance: 1024,
+						},
+					},
+					nil,
+					nil,
+				)
+			})
+
+			It("counts running processes until now", func() {
+				summaries, _, err := actor.GetAppUsageSummary(filter)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(summaries).To(HaveLen(1))
+				Expect(summaries[0].InstanceHours).To(BeNumerically("~", 2, 0.001))
+				Expect(summaries[0].MemoryGBHours).To(BeNumerically("~", 2, 0.001))
+			})
+		})
+
+		When("getting the orgs fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationsReturns(nil, ccv3.Warnings{"orgs-warning"}, errors.New("orgs-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := actor.GetAppUsageSummary(filter)
+				Expect(err).To(MatchError("orgs-error"))
+				Expect(warnings).To(ConsistOf("events-warning", "orgs-warning"))
+			})
+		})
+	})
+
+	Describe("GetServiceUsageSummary", func() {
+		BeforeEach(func() {
+			event := func(spaceGUID string, orgGUID string, hours int, state string, instanceGUID string) ccv3.ServiceUsageEvent {
+				return ccv3.ServiceUsageEvent{
+					CreatedAt:           at(hours),
+					State:               state,
+					ServiceInstanceGUID: instanceGUID,
+					SpaceGUID:           spaceGUID,
+					SpaceName:           spaceGUID + "-name",
+					OrganizationGUID:    orgGUID,
+				}
+			}
+
+			fakeCloudControllerClient.GetServiceUsageEventsReturns(
+				[]ccv3.ServiceUsageEvent{
+					event("space-a", "org-1", 1, "CREATED", "instance-1"),
+					event("space-a", "org-1", 2, "UPDATED", "instance-2"),
+					event("space-a", "org-1", 4, "DELETED", "instance-1"),
+					event("space-b", "org-2", 5, "DELETED", "instance-3"),
+				},
+				ccv3.Warnings{"events-warning"},
+				nil,
+			)
+		})
+
+		When("service instances were created before the window", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceUsageEventsReturns(
+					[]ccv3.ServiceUsageEvent{
+						{CreatedAt: at(-24), State: "CREATED", ServiceInstanceGUID: "instance-1", SpaceGUID: "space-a", SpaceName: "space-a-name", OrganizationGUID: "org-1"},
+						{CreatedAt: at(-24), State: "CREATED", ServiceInstanceGUID: "instance-2", SpaceGUID: "space-b", SpaceName: "space-b-name", OrganizationGUID: "org-2"},
+						{CreatedAt: at(-12), State: "DELETED", ServiceInstanceGUID: "instance-2", SpaceGUID: "space-b", SpaceName: "space-b-name", OrganizationGUID: "org-2"},
+					},
+					nil,
+					nil,
+				)
+			})
+
+			It("counts the ones that still exist for the whole window and leaves out the others", func() {
+				summaries, _, err := actor.GetServiceUsageSummary(filter)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(summaries).To(Equal([]ServiceUsageSummary{
+					{
+						OrganizationGUID: "org-1",
+						OrganizationName: "b-org",
+						SpaceGUID:        "space-a",
+						SpaceName:        "space-a-name",
+						ServiceInstances: 1,
+						InstanceHours:    10,
+					},
+				}))
+
+				Expect(fakeCloudControllerClient.GetServiceUsageEventsArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.CreatedAtsLessThanOrEqualFilter, Values: []string{"2021-03-04T10:00:00Z"}},
+					ccv3.Query{Key: ccv3.PerPage, Values: []string{ccv3.MaxPerPage}},
+				))
+			})
+		})
+
+		It("sums up the service instances and their instance-hours in every space in the window", func() {
+			summaries, warnings, err := actor.GetServiceUsageSummary(filter)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("events-warning", "orgs-warning"))
+
+			Expect(summaries).To(Equal([]ServiceUsageSummary{
+				{
+					OrganizationGUID: "org-2",
+					OrganizationName: "a-org",
+					SpaceGUID:        "space-b",
+					SpaceName:        "space-b-name",
+					ServiceInstances: 1,
+					InstanceHours:    5,
+				},
+				{
+					OrganizationGUID: "org-1",
+					OrganizationName: "b-org",
+					SpaceGUID:        "space-a",
+					SpaceName:        "space-a-name",
+					ServiceInstances: 2,
+					InstanceHours:    13,
+				},
+			}))
+		})
+	})
+})
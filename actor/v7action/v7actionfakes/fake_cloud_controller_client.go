@@ -786,6 +786,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	GetAppUsageEventsStub        func(...ccv3.Query) ([]ccv3.AppUsageEvent, ccv3.Warnings, error)
+	getAppUsageEventsMutex       sync.RWMutex
+	getAppUsageEventsArgsForCall []struct {
+		arg1 []ccv3.Query
+	}
+	getAppUsageEventsReturns struct {
+		result1 []ccv3.AppUsageEvent
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getAppUsageEventsReturnsOnCall map[int]struct {
+		result1 []ccv3.AppUsageEvent
+		result2 ccv3.Warnings
+		result3 error
+	}
 	GetApplicationByNameAndSpaceStub        func(string, string) (resources.Application, ccv3.Warnings, error)
 	getApplicationByNameAndSpaceMutex       sync.RWMutex
 	getApplicationByNameAndSpaceArgsForCall []struct {
@@ -955,6 +970,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	GetAuditEventsStub        func(...ccv3.Query) ([]ccv3.Event, ccv3.Warnings, error)
+	getAuditEventsMutex       sync.RWMutex
+	getAuditEventsArgsForCall []struct {
+		arg1 []ccv3.Query
+	}
+	getAuditEventsReturns struct {
+		result1 []ccv3.Event
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getAuditEventsReturnsOnCall map[int]struct {
+		result1 []ccv3.Event
+		result2 ccv3.Warnings
+		result3 error
+	}
 	GetBuildStub        func(string) (resources.Build, ccv3.Warnings, error)
 	getBuildMutex       sync.RWMutex
 	getBuildArgsForCall []struct {
@@ -1778,6 +1808,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	GetServiceUsageEventsStub        func(...ccv3.Query) ([]ccv3.ServiceUsageEvent, ccv3.Warnings, error)
+	getServiceUsageEventsMutex       sync.RWMutex
+	getServiceUsageEventsArgsForCall []struct {
+		arg1 []ccv3.Query
+	}
+	getServiceUsageEventsReturns struct {
+		result1 []ccv3.ServiceUsageEvent
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getServiceUsageEventsReturnsOnCall map[int]struct {
+		result1 []ccv3.ServiceUsageEvent
+		result2 ccv3.Warnings
+		result3 error
+	}
 	GetSpaceFeatureStub        func(string, string) (bool, ccv3.Warnings, error)
 	getSpaceFeatureMutex       sync.RWMutex
 	getSpaceFeatureArgsForCall []struct {
@@ -6129,6 +6174,72 @@ func (fake *FakeCloudControllerClient) GetAppFeatureReturnsOnCall(i int, result1
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetAppUsageEvents(arg1 ...ccv3.Query) ([]ccv3.AppUsageEvent, ccv3.Warnings, error) {
+	fake.getAppUsageEventsMutex.Lock()
+	ret, specificReturn := fake.getAppUsageEventsReturnsOnCall[len(fake.getAppUsageEventsArgsForCall)]
+	fake.getAppUsageEventsArgsForCall = append(fake.getAppUsageEventsArgsForCall, struct {
+		arg1 []ccv3.Query
+	}{arg1})
+	fake.recordInvocation("GetAppUsageEvents", []interface{}{arg1})
+	fake.getAppUsageEventsMutex.Unlock()
+	if fake.GetAppUsageEventsStub != nil {
+		return fake.GetAppUsageEventsStub(arg1...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getAppUsageEventsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetAppUsageEventsCallCount() int {
+	fake.getAppUsageEventsMutex.RLock()
+	defer fake.getAppUsageEventsMutex.RUnlock()
+	return len(fake.getAppUsageEventsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetAppUsageEventsCalls(stub func(...ccv3.Query) ([]ccv3.AppUsageEvent, ccv3.Warnings, error)) {
+	fake.getAppUsageEventsMutex.Lock()
+	defer fake.getAppUsageEventsMutex.Unlock()
+	fake.GetAppUsageEventsStub = stub
+}
+
+func (fake *FakeCloudControllerClient) GetAppUsageEventsArgsForCall(i int) []ccv3.Query {
+	fake.getAppUsageEventsMutex.RLock()
+	defer fake.getAppUsageEventsMutex.RUnlock()
+	argsForCall := fake.getAppUsageEventsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) GetAppUsageEventsReturns(result1 []ccv3.AppUsageEvent, result2 ccv3.Warnings, result3 error) {
+	fake.getAppUsageEventsMutex.Lock()
+	defer fake.getAppUsageEventsMutex.Unlock()
+	fake.GetAppUsageEventsStub = nil
+	fake.getAppUsageEventsReturns = struct {
+		result1 []ccv3.AppUsageEvent
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetAppUsageEventsReturnsOnCall(i int, result1 []ccv3.AppUsageEvent, result2 ccv3.Warnings, result3 error) {
+	fake.getAppUsageEventsMutex.Lock()
+	defer fake.getAppUsageEventsMutex.Unlock()
+	fake.GetAppUsageEventsStub = nil
+	if fake.getAppUsageEventsReturnsOnCall == nil {
+		fake.getAppUsageEventsReturnsOnCall = make(map[int]struct {
+			result1 []ccv3.AppUsageEvent
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getAppUsageEventsReturnsOnCall[i] = struct {
+		result1 []ccv3.AppUsageEvent
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetApplicationByNameAndSpace(arg1 string, arg2 string) (resources.Application, ccv3.Warnings, error) {
 	fake.getApplicationByNameAndSpaceMutex.Lock()
 	ret, specificReturn := fake.getApplicationByNameAndSpaceReturnsOnCall[len(fake.getApplicationByNameAndSpaceArgsForCall)]
@@ -6859,6 +6970,72 @@ func (fake *FakeCloudControllerClient) GetApplicationsReturnsOnCall(i int, resul
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetAuditEvents(arg1 ...ccv3.Query) ([]ccv3.Event, ccv3.Warnings, error) {
+	fake.getAuditEventsMutex.Lock()
+	ret, specificReturn := fake.getAuditEventsReturnsOnCall[len(fake.getAuditEventsArgsForCall)]
+	fake.getAuditEventsArgsForCall = append(fake.getAuditEventsArgsForCall, struct {
+		arg1 []ccv3.Query
+	}{arg1})
+	fake.recordInvocation("GetAuditEvents", []interface{}{arg1})
+	fake.getAuditEventsMutex.Unlock()
+	if fake.GetAuditEventsStub != nil {
+		return fake.GetAuditEventsStub(arg1...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getAuditEventsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetAuditEventsCallCount() int {
+	fake.getAuditEventsMutex.RLock()
+	defer fake.getAuditEventsMutex.RUnlock()
+	return len(fake.getAuditEventsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetAuditEventsCalls(stub func(...ccv3.Query) ([]ccv3.Event, ccv3.Warnings, error)) {
+	fake.getAuditEventsMutex.Lock()
+	defer fake.getAuditEventsMutex.Unlock()
+	fake.GetAuditEventsStub = stub
+}
+
+func (fake *FakeCloudControllerClient) GetAuditEventsArgsForCall(i int) []ccv3.Query {
+	fake.getAuditEventsMutex.RLock()
+	defer fake.getAuditEventsMutex.RUnlock()
+	argsForCall := fake.getAuditEventsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) GetAuditEventsReturns(result1 []ccv3.Event, result2 ccv3.Warnings, result3 error) {
+	fake.getAuditEventsMutex.Lock()
+	defer fake.getAuditEventsMutex.Unlock()
+	fake.GetAuditEventsStub = nil
+	fake.getAuditEventsReturns = struct {
+		result1 []ccv3.Event
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetAuditEventsReturnsOnCall(i int, result1 []ccv3.Event, result2 ccv3.Warnings, result3 error) {
+	fake.getAuditEventsMutex.Lock()
+	defer fake.getAuditEventsMutex.Unlock()
+	fake.GetAuditEventsStub = nil
+	if fake.getAuditEventsReturnsOnCall == nil {
+		fake.getAuditEventsReturnsOnCall = make(map[int]struct {
+			result1 []ccv3.Event
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getAuditEventsReturnsOnCall[i] = struct {
+		result1 []ccv3.Event
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetBuild(arg1 string) (resources.Build, ccv3.Warnings, error) {
 	fake.getBuildMutex.Lock()
 	ret, specificReturn := fake.getBuildReturnsOnCall[len(fake.getBuildArgsForCall)]
@@ -10426,6 +10603,72 @@ func (fake *FakeCloudControllerClient) GetServicePlansWithSpaceAndOrganizationRe
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetServiceUsageEvents(arg1 ...ccv3.Query) ([]ccv3.ServiceUsageEvent, ccv3.Warnings, error) {
+	fake.getServiceUsageEventsMutex.Lock()
+	ret, specificReturn := fake.getServiceUsageEventsReturnsOnCall[len(fake.getServiceUsageEventsArgsForCall)]
+	fake.getServiceUsageEventsArgsForCall = append(fake.getServiceUsageEventsArgsForCall, struct {
+		arg1 []ccv3.Query
+	}{arg1})
+	fake.recordInvocation("GetServiceUsageEvents", []interface{}{arg1})
+	fake.getServiceUsageEventsMutex.Unlock()
+	if fake.GetServiceUsageEventsStub != nil {
+		return fake.GetServiceUsageEventsStub(arg1...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getServiceUsageEventsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetServiceUsageEventsCallCount() int {
+	fake.getServiceUsageEventsMutex.RLock()
+	defer fake.getServiceUsageEventsMutex.RUnlock()
+	return len(fake.getServiceUsageEventsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetServiceUsageEventsCalls(stub func(...ccv3.Query) ([]ccv3.ServiceUsageEvent, ccv3.Warnings, error)) {
+	fake.getServiceUsageEventsMutex.Lock()
+	defer fake.getServiceUsageEventsMutex.Unlock()
+	fake.GetServiceUsageEventsStub = stub
+}
+
+func (fake *FakeCloudControllerClient) GetServiceUsageEventsArgsForCall(i int) []ccv3.Query {
+	fake.getServiceUsageEventsMutex.RLock()
+	defer fake.getServiceUsageEventsMutex.RUnlock()
+	argsForCall := fake.getServiceUsageEventsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) GetServiceUsageEventsReturns(result1 []ccv3.ServiceUsageEvent, result2 ccv3.Warnings, result3 error) {
+	fake.getServiceUsageEventsMutex.Lock()
+	defer fake.getServiceUsageEventsMutex.Unlock()
+	fake.GetServiceUsageEventsStub = nil
+	fake.getServiceUsageEventsReturns = struct {
+		result1 []ccv3.ServiceUsageEvent
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetServiceUsageEventsReturnsOnCall(i int, result1 []ccv3.ServiceUsageEvent, result2 ccv3.Warnings, result3 error) {
+	fake.getServiceUsageEventsMutex.Lock()
+	defer fake.getServiceUsageEventsMutex.Unlock()
+	fake.GetServiceUsageEventsStub = nil
+	if fake.getServiceUsageEventsReturnsOnCall == nil {
+		fake.getServiceUsageEventsReturnsOnCall = make(map[int]struct {
+			result1 []ccv3.ServiceUsageEvent
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceUsageEventsReturnsOnCall[i] = struct {
+		result1 []ccv3.ServiceUsageEvent
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetSpaceFeature(arg1 string, arg2 string) (bool, ccv3.Warnings, error) {
 	fake.getSpaceFeatureMutex.Lock()
 	ret, specificReturn := fake.getSpaceFeatureReturnsOnCall[len(fake.getSpaceFeatureArgsForCall)]
@@ -14806,6 +15049,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.entitleIsolationSegmentToOrganizationsMutex.RUnlock()
 	fake.getAppFeatureMutex.RLock()
 	defer fake.getAppFeatureMutex.RUnlock()
+	fake.getAppUsageEventsMutex.RLock()
+	defer fake.getAppUsageEventsMutex.RUnlock()
 	fake.getApplicationByNameAndSpaceMutex.RLock()
 	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
 	fake.getApplicationDropletCurrentMutex.RLock()
@@ -14828,6 +15073,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getApplicationTasksMutex.RUnlock()
 	fake.getApplicationsMutex.RLock()
 	defer fake.getApplicationsMutex.RUnlock()
+	fake.getAuditEventsMutex.RLock()
+	defer fake.getAuditEventsMutex.RUnlock()
 	fake.getBuildMutex.RLock()
 	defer fake.getBuildMutex.RUnlock()
 	fake.getBuildpacksMutex.RLock()
@@ -14936,6 +15183,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getServicePlansWithOfferingsMutex.RUnlock()
 	fake.getServicePlansWithSpaceAndOrganizationMutex.RLock()
 	defer fake.getServicePlansWithSpaceAndOrganizationMutex.RUnlock()
+	fake.getServiceUsageEventsMutex.RLock()
+	defer fake.getServiceUsageEventsMutex.RUnlock()
 	fake.getSpaceFeatureMutex.RLock()
 	defer fake.getSpaceFeatureMutex.RUnlock()
 	fake.getSpaceIsolationSegmentMutex.RLock()
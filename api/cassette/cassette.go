@@ -0,0 +1,352 @@
+// Package cassette records the requests the CLI makes to the Cloud
+// Controller, UAA, Log Cache and the routing API in a cassette file, and
+// replays them from it instead of making them.
+//
+// The clients of every API share the recording, so a cassette holds all the
+// traffic of a command. Credentials are redacted from the recorded bodies the
+// same way as in the trace output.
+package cassette
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+// Cassette is a recording of requests and the responses to them.
+type Cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Interaction is a request and the response to it.
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+// Request is the part of a request that is matched on replay.
+type Request struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+	Body   string `json:"body,omitempty"`
+}
+
+// Response is a recorded response.
+type Response struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+// UnrecordedRequestError is returned when a cassette being replayed has no
+// response for a request.
+type UnrecordedRequestError struct {
+	Method string
+	URL    string
+}
+
+func (e UnrecordedRequestError) Error() string {
+	return fmt.Sprintf("No response recorded in the cassette for %s %s", e.Method, e.URL)
+}
+
+// Read reads a cassette written by a Recorder.
+func Read(path string) (Cassette, error) {
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		return Cassette{}, err
+	}
+
+	var cassette Cassette
+	err = json.Unmarshal(raw, &cassette)
+	if err != nil {
+		return Cassette{}, fmt.Errorf("reading cassette %s: %w", path, err)
+	}
+	return cassette, nil
+}
+
+// NewRequest returns the request as it is recorded and matched on replay.
+// JSON and form bodies are kept with their credentials redacted, other bodies
+// are left out.
+func NewRequest(request *http.Request, body []byte) Request {
+	cassetteRequest := Request{
+		Method: request.Method,
+		URL:    request.URL.String(),
+	}
+
+	contentType := request.Header.Get("Content-Type")
+	if RecordsBody(contentType) {
+		cassetteRequest.Body = redactBody(contentType, body)
+	}
+
+	return cassetteRequest
+}
+
+// RecordsBody returns whether bodies of the given content type are recorded
+// and matched on replay. Only JSON and form bodies are.
+func RecordsBody(contentType string) bool {
+	return isJSON(contentType) || isForm(contentType)
+}
+
+// ReadBody reads the body of the request and replaces it, so the request can
+// still be made.
+func ReadBody(request *http.Request) ([]byte, error) {
+	if request.Body == nil {
+		return nil, nil
+	}
+
+	body, err := ioutil.ReadAll(request.Body)
+	request.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	request.Body = ioutil.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
+
+// Recorder records requests and their responses in a cassette file. The file
+// is rewritten after every request so it is complete whenever the command
+// exits.
+type Recorder struct {
+	path string
+
+	lock     sync.Mutex
+	cassette Cassette
+}
+
+var (
+	recordersLock sync.Mutex
+	recorders     = map[string]*Recorder{}
+)
+
+// NewRecorder returns the Recorder for the cassette file at the given path,
+// replacing anything already in the file. The clients of every API that
+// record to the same path share the Recorder.
+func NewRecorder(path string) *Recorder {
+	recordersLock.Lock()
+	defer recordersLock.Unlock()
+
+	if recorder, ok := recorders[path]; ok {
+		return recorder
+	}
+
+	recorder := &Recorder{
+		path:     path,
+		cassette: Cassette{Interactions: []Interaction{}},
+	}
+	recorders[path] = recorder
+	return recorder
+}
+
+// Record adds the request and its response to the cassette and rewrites the
+// cassette file. Credentials are redacted from JSON and form bodies, and
+// cookies and authorization headers are left out.
+func (recorder *Recorder) Record(request Request, response *http.Response, responseBody []byte) error {
+	header := ui.RedactHeaders(response.Header.Clone())
+	header.Del("Set-Cookie")
+	header.Del("Authorization")
+
+	contentType := response.Header.Get("Content-Type")
+	body := string(responseBody)
+	if RecordsBody(contentType) {
+		body = redactBody(contentType, responseBody)
+	}
+
+	recorder.lock.Lock()
+	defer recorder.lock.Unlock()
+	recorder.cassette.Interactions = append(recorder.cassette.Interactions, Interaction{
+		Request: request,
+		Response: Response{
+			StatusCode: response.StatusCode,
+			Header:     header,
+			Body:       body,
+		},
+	})
+
+	return recorder.write()
+}
+
+func (recorder *Recorder) write() error {
+	raw, err := json.MarshalIndent(recorder.cassette, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(recorder.path), 0700)
+	if err != nil {
+		return err
+	}
+
+	tempFile, err := ioutil.TempFile(filepath.Dir(recorder.path), "cassette")
+	if err != nil {
+		return err
+	}
+	_, err = tempFile.Write(raw)
+	closeErr := tempFile.Close()
+	if err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tempFile.Name(), recorder.path)
+	}
+	if err != nil {
+		_ = os.Remove(tempFile.Name())
+	}
+	return err
+}
+
+// Player answers requests with the responses in a cassette. Requests are
+// matched on method, URL and body. Matching responses are returned in the
+// order they were recorded, and the last one is repeated once they have all
+// been returned, so polling ends the way it did when recording.
+type Player struct {
+	lock         sync.Mutex
+	interactions []Interaction
+	played       []bool
+}
+
+// NewPlayer returns a pointer to a Player that replays the given cassette.
+func NewPlayer(cassette Cassette) *Player {
+	return &Player{
+		interactions: cassette.Interactions,
+		played:       make([]bool, len(cassette.Interactions)),
+	}
+}
+
+var (
+	playersLock sync.Mutex
+	players     = map[string]*Player{}
+)
+
+// ReadPlayer returns the Player for the cassette file at the given path,
+// reading the file the first time. The clients of every API that replay the
+// same path share the Player, so a response played to one client is not
+// played again to another.
+func ReadPlayer(path string) (*Player, error) {
+	playersLock.Lock()
+	defer playersLock.Unlock()
+
+	if player, ok := players[path]; ok {
+		return player, nil
+	}
+
+	recorded, err := Read(path)
+	if err != nil {
+		return nil, err
+	}
+
+	player := NewPlayer(recorded)
+	players[path] = player
+	return player, nil
+}
+
+// Play returns the recorded response to the request.
+func (player *Player) Play(request Request) (Response, bool) {
+	player.lock.Lock()
+	defer player.lock.Unlock()
+
+	last := -1
+	for i, interaction := range player.interactions {
+		if interaction.Request != request {
+			continue
+		}
+		if !player.played[i] {
+			player.played[i] = true
+			return interaction.Response, true
+		}
+		last = i
+	}
+
+	if last == -1 {
+		return Response{}, false
+	}
+	return player.interactions[last].Response, true
+}
+
+// RoundTrip answers the request with the recorded response, for clients that
+// are built on an http.RoundTripper rather than a connection.
+func (player *Player) RoundTrip(request *http.Request) (*http.Response, error) {
+	body, err := ReadBody(request)
+	if err != nil {
+		return nil, err
+	}
+
+	cassetteRequest := NewRequest(request, body)
+	recorded, found := player.Play(cassetteRequest)
+	if !found {
+		return nil, UnrecordedRequestError{Method: cassetteRequest.Method, URL: cassetteRequest.URL}
+	}
+	return recorded.Transport().RoundTrip(request)
+}
+
+// Transport returns an http.RoundTripper that answers every request with the
+// response, so that it is parsed exactly like a response from the API.
+func (response Response) Transport() http.RoundTripper {
+	return transport{response: response}
+}
+
+type transport struct {
+	response Response
+}
+
+func (transport transport) RoundTrip(request *http.Request) (*http.Response, error) {
+	// Uploads are written to a pipe, so the body is read to let them finish.
+	if request.Body != nil {
+		_, _ = io.Copy(ioutil.Discard, request.Body)
+		request.Body.Close()
+	}
+
+	header := transport.response.Header.Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+
+	return &http.Response{
+		Status:     fmt.Sprintf("%d %s", transport.response.StatusCode, http.StatusText(transport.response.StatusCode)),
+		StatusCode: transport.response.StatusCode,
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Header:     header,
+		Body:       ioutil.NopCloser(strings.NewReader(transport.response.Body)),
+		Request:    request,
+	}, nil
+}
+
+// redactBody returns a JSON or form body with its credentials redacted. A
+// body that cannot be parsed is kept as it is.
+func redactBody(contentType string, body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	if isForm(contentType) {
+		sanitized, err := ui.SanitizeForm(string(body))
+		if err == nil {
+			return sanitized
+		}
+		return string(body)
+	}
+
+	sanitized, err := ui.SanitizeJSON(body)
+	if err != nil {
+		return string(body)
+	}
+	return string(bytes.TrimSpace(sanitized))
+}
+
+func isJSON(contentType string) bool {
+	return strings.Contains(contentType, "json")
+}
+
+func isForm(contentType string) bool {
+	return strings.Contains(contentType, "x-www-form-urlencoded")
+}
@@ -0,0 +1,163 @@
+package cassette_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "code.cloudfoundry.org/cli/api/cassette"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Cassette", func() {
+	var (
+		cassetteDir  string
+		cassettePath string
+	)
+
+	newRequest := func(method string, url string, contentType string, body string) *http.Request {
+		request, err := http.NewRequest(method, url, strings.NewReader(body))
+		Expect(err).NotTo(HaveOccurred())
+		if contentType != "" {
+			request.Header.Set("Content-Type", contentType)
+		}
+		return request
+	}
+
+	newResponse := func(statusCode int, contentType string) *http.Response {
+		return &http.Response{
+			StatusCode: statusCode,
+			Header: http.Header{
+				"Content-Type":  {contentType},
+				"Set-Cookie":    {"some-cookie"},
+				"X-Cf-Warnings": {"some-warning"},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		var err error
+		cassetteDir, err = ioutil.TempDir("", "cassette")
+		Expect(err).NotTo(HaveOccurred())
+		cassettePath = filepath.Join(cassetteDir, "cassette.json")
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(cassetteDir)).To(Succeed())
+	})
+
+	Describe("Recorder", func() {
+		BeforeEach(func() {
+			uaaRequest := newRequest(http.MethodPost, "https://uaa.example.com/oauth/token", "application/x-www-form-urlencoded", "grant_type=password&password=some-password&username=admin")
+			body, err := ReadBody(uaaRequest)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(NewRecorder(cassettePath).Record(
+				NewRequest(uaaRequest, body),
+				newResponse(http.StatusOK, "application/json;charset=UTF-8"),
+				[]byte(`{"access_token": "some-access-token", "refresh_token": "some-refresh-token", "token_type": "bearer"}`),
+			)).To(Succeed())
+
+			ccRequest := newRequest(http.MethodGet, "https://api.example.com/v3/service_credential_bindings/some-guid/details", "", "")
+			Expect(NewRecorder(cassettePath).Record(
+				NewRequest(ccRequest, nil),
+				newResponse(http.StatusOK, "application/json"),
+				[]byte(`{"credentials": {"username": "some-user", "password": "some-password"}}`),
+			)).To(Succeed())
+		})
+
+		It("records the traffic of every client recording to the same path in one cassette", func() {
+			recorded, err := Read(cassettePath)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(recorded.Interactions).To(HaveLen(2))
+			Expect(recorded.Interactions[0].Request.URL).To(Equal("https://uaa.example.com/oauth/token"))
+			Expect(recorded.Interactions[1].Request.URL).To(Equal("https://api.example.com/v3/service_credential_bindings/some-guid/details"))
+		})
+
+		It("redacts credentials from the request and response bodies", func() {
+			raw, err := ioutil.ReadFile(cassettePath)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(raw)).NotTo(ContainSubstring("some-password"))
+			Expect(string(raw)).NotTo(ContainSubstring("some-access-token"))
+			Expect(string(raw)).NotTo(ContainSubstring("some-refresh-token"))
+
+			recorded, err := Read(cassettePath)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(recorded.Interactions[0].Request.Body).To(ContainSubstring("username=admin"))
+			Expect(recorded.Interactions[0].Response.Body).To(MatchJSON(`{"access_token": "` + ui.RedactedValue + `", "refresh_token": "` + ui.RedactedValue + `", "token_type": "bearer"}`))
+			Expect(recorded.Interactions[1].Response.Body).To(MatchJSON(`{"credentials": {"username": "some-user", "password": "` + ui.RedactedValue + `"}}`))
+		})
+
+		It("leaves out cookies", func() {
+			recorded, err := Read(cassettePath)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(recorded.Interactions[0].Response.Header).NotTo(HaveKey("Set-Cookie"))
+			Expect(recorded.Interactions[0].Response.Header).To(HaveKeyWithValue("X-Cf-Warnings", []string{"some-warning"}))
+		})
+
+		When("the cassette is replayed", func() {
+			var player *Player
+
+			BeforeEach(func() {
+				recorded, err := Read(cassettePath)
+				Expect(err).NotTo(HaveOccurred())
+				player = NewPlayer(recorded)
+			})
+
+			It("matches requests with redacted credentials", func() {
+				uaaRequest := newRequest(http.MethodPost, "https://uaa.example.com/oauth/token", "application/x-www-form-urlencoded", "grant_type=password&password=some-password&username=admin")
+				response, err := player.RoundTrip(uaaRequest)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+				Expect(response.Header.Get("X-Cf-Warnings")).To(Equal("some-warning"))
+
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(body).To(ContainSubstring(`"token_type": "bearer"`))
+			})
+
+			It("returns an UnrecordedRequestError for other requests", func() {
+				_, err := player.RoundTrip(newRequest(http.MethodGet, "https://log-cache.example.com/api/v1/read/some-guid", "", ""))
+				Expect(err).To(MatchError(UnrecordedRequestError{
+					Method: http.MethodGet,
+					URL:    "https://log-cache.example.com/api/v1/read/some-guid",
+				}))
+			})
+		})
+	})
+
+	Describe("ReadPlayer", func() {
+		BeforeEach(func() {
+			request := newRequest(http.MethodGet, "https://api.example.com/v3/apps", "", "")
+			Expect(NewRecorder(cassettePath).Record(NewRequest(request, nil), newResponse(http.StatusOK, "application/json"), []byte(`{"page": 1}`))).To(Succeed())
+			Expect(NewRecorder(cassettePath).Record(NewRequest(request, nil), newResponse(http.StatusOK, "application/json"), []byte(`{"page": 2}`))).To(Succeed())
+		})
+
+		It("shares the played responses between the players of the same path", func() {
+			player, err := ReadPlayer(cassettePath)
+			Expect(err).NotTo(HaveOccurred())
+			response, found := player.Play(Request{Method: http.MethodGet, URL: "https://api.example.com/v3/apps"})
+			Expect(found).To(BeTrue())
+			Expect(response.Body).To(MatchJSON(`{"page": 1}`))
+
+			otherPlayer, err := ReadPlayer(cassettePath)
+			Expect(err).NotTo(HaveOccurred())
+			response, found = otherPlayer.Play(Request{Method: http.MethodGet, URL: "https://api.example.com/v3/apps"})
+			Expect(found).To(BeTrue())
+			Expect(response.Body).To(MatchJSON(`{"page": 2}`))
+		})
+	})
+
+	Describe("Read", func() {
+		When("the cassette is not valid", func() {
+			It("returns an error", func() {
+				Expect(ioutil.WriteFile(cassettePath, []byte("not json"), 0600)).To(Succeed())
+				_, err := Read(cassettePath)
+				Expect(err).To(MatchError(ContainSubstring(cassettePath)))
+			})
+		})
+	})
+})
@@ -0,0 +1,14 @@
+package ccerror
+
+import "time"
+
+// RateLimitExceededError wraps a http 429 error. ResetAt is the time the rate
+// limit resets, it is zero when the Cloud Controller did not say.
+type RateLimitExceededError struct {
+	Message string
+	ResetAt time.Time
+}
+
+func (e RateLimitExceededError) Error() string {
+	return e.Message
+}
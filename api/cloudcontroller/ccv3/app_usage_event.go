@@ -0,0 +1,111 @@
+package ccv3
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
+)
+
+// AppUsageEvent is a change in the usage of an app: its processes starting,
+// stopping or scaling, its tasks running, or it staging. The previous values
+// are the ones before the change.
+type AppUsageEvent struct {
+	GUID                          string
+	CreatedAt                     time.Time
+	State                         string
+	PreviousState                 string
+	AppGUID                       string
+	AppName                       string
+	ProcessGUID                   string
+	ProcessType                   string
+	TaskGUID                      string
+	TaskName                      string
+	SpaceGUID                     string
+	SpaceName                     string
+	OrganizationGUID              string
+	InstanceCount                 int
+	PreviousInstanceCount         int
+	MemoryInMBPerInstance         int
+	PreviousMemoryInMBPerInstance int
+}
+
+func (e *AppUsageEvent) UnmarshalJSON(data []byte) error {
+	var ccEvent struct {
+		GUID      string    `json:"guid"`
+		CreatedAt time.Time `json:"created_at"`
+		State     struct {
+			Current  string `json:"current"`
+			Previous string `json:"previous"`
+		} `json:"state"`
+		App struct {
+			GUID string `json:"guid"`
+			Name string `json:"name"`
+		} `json:"app"`
+		Process struct {
+			GUID string `json:"guid"`
+			Type string `json:"type"`
+		} `json:"process"`
+		Task struct {
+			GUID string `json:"guid"`
+			Name string `json:"name"`
+		} `json:"task"`
+		Space struct {
+			GUID string `json:"guid"`
+			Name string `json:"name"`
+		} `json:"space"`
+		Organization struct {
+			GUID string `json:"guid"`
+		} `json:"organization"`
+		InstanceCount struct {
+			Current  int `json:"current"`
+			Previous int `json:"previous"`
+		} `json:"instance_count"`
+		MemoryInMBPerInstance struct {
+			Current  int `json:"current"`
+			Previous int `json:"previous"`
+		} `json:"memory_in_mb_per_instance"`
+	}
+	err := cloudcontroller.DecodeJSON(data, &ccEvent)
+	if err != nil {
+		return err
+	}
+
+	e.GUID = ccEvent.GUID
+	e.CreatedAt = ccEvent.CreatedAt
+	e.State = ccEvent.State.Current
+	e.PreviousState = ccEvent.State.Previous
+	e.AppGUID = ccEvent.App.GUID
+	e.AppName = ccEvent.App.Name
+	e.ProcessGUID = ccEvent.Process.GUID
+	e.ProcessType = ccEvent.Process.Type
+	e.TaskGUID = ccEvent.Task.GUID
+	e.TaskName = ccEvent.Task.Name
+	e.SpaceGUID = ccEvent.Space.GUID
+	e.SpaceName = ccEvent.Space.Name
+	e.OrganizationGUID = ccEvent.Organization.GUID
+	e.InstanceCount = ccEvent.InstanceCount.Current
+	e.PreviousInstanceCount = ccEvent.InstanceCount.Previous
+	e.MemoryInMBPerInstance = ccEvent.MemoryInMBPerInstance.Current
+	e.PreviousMemoryInMBPerInstance = ccEvent.MemoryInMBPerInstance.Previous
+
+	return nil
+}
+
+// GetAppUsageEvents lists the app usage events matching the query from the
+// /v3/app_usage_events endpoint, fetching every page of results.
+func (client *Client) GetAppUsageEvents(query ...Query) ([]AppUsageEvent, Warnings, error) {
+	var events []AppUsageEvent
+
+	_, warnings, err := client.MakeListRequest(RequestParams{
+		RequestName:  internal.GetAppUsageEventsRequest,
+		Query:        query,
+		ResponseBody: AppUsageEvent{},
+		AppendToList: func(item interface{}) error {
+			events = append(events, item.(AppUsageEvent))
+			return nil
+		},
+	})
+
+	return events, warnings, err
+}
@@ -0,0 +1,157 @@
+package ccv3_test
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("AppUsageEvent", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client, _ = NewTestClient()
+	})
+
+	Describe("GetAppUsageEvents", func() {
+		var (
+			events     []AppUsageEvent
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			events, warnings, executeErr = client.GetAppUsageEvents(
+				Query{Key: AfterGUIDFilter, Values: []string{"some-event-guid"}},
+			)
+		})
+
+		When("the events span several pages", func() {
+			BeforeEach(func() {
+				response1 := fmt.Sprintf(`{
+  "pagination": {
+    "next": {
+      "href": "%s/v3/app_usage_events?after_guid=some-event-guid&page=2"
+    }
+  },
+  "resources": [
+    {
+      "guid": "event-1",
+      "created_at": "2021-03-04T05:06:07Z",
+      "state": {"current": "STARTED", "previous": "STOPPED"},
+      "app": {"guid": "app-guid", "name": "some-app"},
+      "process": {"guid": "process-guid", "type": "web"},
+      "space": {"guid": "space-guid", "name": "some-space"},
+      "organization": {"guid": "org-guid"},
+      "buildpack": {"guid": null, "name": null},
+      "task": {"guid": null, "name": null},
+      "memory_in_mb_per_instance": {"current": 512, "previous": 256},
+      "instance_count": {"current": 3, "previous": 1}
+    }
+  ]
+}`, server.URL())
+				response2 := `{
+  "pagination": {
+    "next": null
+  },
+  "resources": [
+    {
+      "guid": "event-2",
+      "created_at": "2021-03-04T06:06:07Z",
+      "state": {"current": "TASK_STARTED", "previous": null},
+      "app": {"guid": "app-guid", "name": "some-app"},
+      "process": {"guid": null, "type": null},
+      "space": {"guid": "space-guid", "name": "some-space"},
+      "organization": {"guid": "org-guid"},
+      "task": {"guid": "task-guid", "name": "migrate"},
+      "memory_in_mb_per_instance": {"current": 1024, "previous": null},
+      "instance_count": {"current": 1, "previous": null}
+    }
+  ]
+}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/app_usage_events", "after_guid=some-event-guid"),
+						RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/app_usage_events", "after_guid=some-event-guid&page=2"),
+						RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"warning-2"}}),
+					),
+				)
+			})
+
+			It("returns the events from every page", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+				Expect(events).To(Equal([]AppUsageEvent{
+					{
+						GUID:                          "event-1",
+						CreatedAt:                     time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC),
+						State:                         "STARTED",
+						PreviousState:                 "STOPPED",
+						AppGUID:                       "app-guid",
+						AppName:                       "some-app",
+						ProcessGUID:                   "process-guid",
+						ProcessType:                   "web",
+						SpaceGUID:                     "space-guid",
+						SpaceName:                     "some-space",
+						OrganizationGUID:              "org-guid",
+						InstanceCount:                 3,
+						PreviousInstanceCount:         1,
+						MemoryInMBPerInstance:         512,
+						PreviousMemoryInMBPerInstance: 256,
+					},
+					{
+						GUID:                  "event-2",
+						CreatedAt:             time.Date(2021, 3, 4, 6, 6, 7, 0, time.UTC),
+						State:                 "TASK_STARTED",
+						AppGUID:               "app-guid",
+						AppName:               "some-app",
+						TaskGUID:              "task-guid",
+						TaskName:              "migrate",
+						SpaceGUID:             "space-guid",
+						SpaceName:             "some-space",
+						OrganizationGUID:      "org-guid",
+						InstanceCount:         1,
+						MemoryInMBPerInstance: 1024,
+					},
+				}))
+			})
+		})
+
+		When("the request fails", func() {
+			BeforeEach(func() {
+				response := `{
+  "errors": [
+    {
+      "code": 10003,
+      "detail": "You are not authorized to perform the requested action",
+      "title": "CF-NotAuthorized"
+    }
+  ]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/app_usage_events"),
+						RespondWith(http.StatusForbidden, response, http.Header{"X-Cf-Warnings": {"warning"}}),
+					),
+				)
+			})
+
+			It("returns CC warnings and error", func() {
+				Expect(executeErr).To(MatchError(ccerror.ForbiddenError{
+					Message: "You are not authorized to perform the requested action",
+				}))
+				Expect(warnings).To(ConsistOf("warning"))
+			})
+		})
+	})
+})
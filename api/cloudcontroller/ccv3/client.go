@@ -109,6 +109,15 @@ type Config struct {
 	// JobPollingInterval is the wait time between job polls.
 	JobPollingInterval time.Duration
 
+	// PageConcurrency is the number of pages of a list that are fetched at the
+	// same time. Pages are fetched one at a time when it is less than 2.
+	PageConcurrency int
+
+	// RawWrappers apply to the client connection before Cloud Controller
+	// errors are converted, so they see responses as they were received, such
+	// as a cassette recorder or player.
+	RawWrappers []ConnectionWrapper
+
 	// Wrappers that apply to the client connection.
 	Wrappers []ConnectionWrapper
 }
@@ -69,6 +69,8 @@ func convert400(rawHTTPStatusErr ccerror.RawHTTPStatusError, request *cloudcontr
 		return handleNotFound(firstErr, request)
 	case http.StatusUnprocessableEntity: // 422
 		return handleUnprocessableEntity(firstErr)
+	case http.StatusTooManyRequests: // 429
+		return ccerror.RateLimitExceededError{Message: firstErr.Detail}
 	case http.StatusServiceUnavailable: // 503
 		if firstErr.Title == taskWorkersUnavailable {
 			return ccerror.TaskWorkersUnavailableError{Message: firstErr.Detail}
@@ -169,6 +169,16 @@ var _ = Describe("Error Wrapper", func() {
 					})
 				})
 
+				Context("(429) Too Many Requests", func() {
+					BeforeEach(func() {
+						serverResponseCode = http.StatusTooManyRequests
+					})
+
+					It("returns a RateLimitExceededError", func() {
+						Expect(makeError).To(MatchError(ccerror.RateLimitExceededError{Message: "SomeCC Error Message"}))
+					})
+				})
+
 				Context("(404) Not Found", func() {
 					BeforeEach(func() {
 						serverResponseCode = http.StatusNotFound
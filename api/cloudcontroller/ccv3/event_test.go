@@ -99,10 +99,17 @@ var _ = Describe("Event", func() {
 				Expect(warnings).To(ConsistOf("warning"))
 				Expect(events).To(ConsistOf(
 					Event{
-						GUID:      "some-event-guid",
-						CreatedAt: timestamp,
-						Type:      "audit.app.update",
-						ActorName: "admin",
+						GUID:             "some-event-guid",
+						CreatedAt:        timestamp,
+						Type:             "audit.app.update",
+						ActorGUID:        "d144abe3-3d7b-40d4-b63f-2584798d3ee5",
+						ActorType:        "user",
+						ActorName:        "admin",
+						TargetGUID:       "2e3151ba-9a63-4345-9c5b-6d8c238f4e55",
+						TargetType:       "app",
+						TargetName:       "my-app",
+						SpaceGUID:        "cb97dd25-d4f7-4185-9e6f-ad6e585c207c",
+						OrganizationGUID: "d9be96f5-ea8f-4549-923f-bec882e32e3c",
 						Data: map[string]interface{}{
 							"request": map[string]interface{}{
 								"recursive": true,
@@ -158,4 +165,110 @@ var _ = Describe("Event", func() {
 			})
 		})
 	})
+
+	Describe("GetAuditEvents", func() {
+		var (
+			events     []Event
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			events, warnings, executeErr = client.GetAuditEvents(
+				Query{Key: EventTypesFilter, Values: []string{"audit.app.update", "audit.app.start"}},
+				Query{Key: CreatedAtsGreaterThanOrEqualFilter, Values: []string{"2016-06-08T00:00:00Z"}},
+			)
+		})
+
+		When("the events span several pages", func() {
+			BeforeEach(func() {
+				response1 := fmt.Sprintf(`{
+  "pagination": {
+    "next": {
+      "href": "%s/v3/audit_events?page=2"
+    }
+  },
+  "resources": [
+    {
+      "guid": "event-1",
+      "created_at": "2016-06-08T16:41:23Z",
+      "type": "audit.app.update",
+      "actor": {"guid": "user-guid", "type": "user", "name": "admin"},
+      "target": {"guid": "app-guid", "type": "app", "name": "my-app"},
+      "space": {"guid": "space-guid"},
+      "organization": {"guid": "org-guid"},
+      "data": {}
+    }
+  ]
+}`, server.URL())
+				response2 := `{
+  "pagination": {
+    "next": null
+  },
+  "resources": [
+    {
+      "guid": "event-2",
+      "created_at": "2016-06-08T16:42:23Z",
+      "type": "audit.app.start",
+      "actor": {"guid": "user-guid", "type": "user", "name": "admin"},
+      "target": {"guid": "app-guid", "type": "app", "name": "my-app"},
+      "space": {"guid": "space-guid"},
+      "organization": {"guid": "org-guid"},
+      "data": {}
+    }
+  ]
+}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/audit_events", "types=audit.app.update,audit.app.start&created_ats[gte]=2016-06-08T00:00:00Z"),
+						RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/audit_events", "page=2"),
+						RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"warning-2"}}),
+					),
+				)
+			})
+
+			It("returns the events from every page", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+				Expect(events).To(HaveLen(2))
+				Expect(events[0].GUID).To(Equal("event-1"))
+				Expect(events[0].TargetName).To(Equal("my-app"))
+				Expect(events[0].OrganizationGUID).To(Equal("org-guid"))
+				Expect(events[1].GUID).To(Equal("event-2"))
+				Expect(events[1].Type).To(Equal("audit.app.start"))
+			})
+		})
+
+		When("the request fails", func() {
+			BeforeEach(func() {
+				response := `{
+  "errors": [
+    {
+      "code": 10005,
+      "detail": "The query parameter is invalid: created_ats",
+      "title": "CF-BadQueryParameter"
+    }
+  ]
+}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/audit_events"),
+						RespondWith(http.StatusBadRequest, response, http.Header{"X-Cf-Warnings": {"warning"}}),
+					),
+				)
+			})
+
+			It("returns CC warnings and error", func() {
+				Expect(executeErr).To(MatchError(ccerror.BadRequestError{
+					Message: "The query parameter is invalid: created_ats",
+				}))
+				Expect(warnings).To(ConsistOf("warning"))
+			})
+		})
+	})
 })
@@ -46,6 +46,7 @@ const (
 	GetApplicationRoutesRequest                                 = "GetApplicationRoutes"
 	GetApplicationTasksRequest                                  = "GetApplicationTasks"
 	GetApplicationsRequest                                      = "GetApplications"
+	GetAppUsageEventsRequest                                    = "GetAppUsageEvents"
 	GetBuildRequest                                             = "GetBuild"
 	GetBuildpacksRequest                                        = "GetBuildpacks"
 	GetDefaultDomainRequest                                     = "GetDefaultDomain"
@@ -93,6 +94,7 @@ const (
 	GetServiceOfferingsRequest                                  = "GetServiceOfferings"
 	GetServicePlanRequest                                       = "GetServicePlan"
 	GetServicePlansRequest                                      = "GetServicePlans"
+	GetServiceUsageEventsRequest                                = "GetServiceUsageEvents"
 	GetServicePlanVisibilityRequest                             = "GetServicePlanVisibility"
 	GetSpaceFeatureRequest                                      = "GetSpaceFeatureRequest"
 	GetSpaceRelationshipIsolationSegmentRequest                 = "GetSpaceRelationshipIsolationSegment"
@@ -181,6 +183,7 @@ const (
 
 // APIRoutes is a list of routes used by the router to construct request URLs.
 var APIRoutes = map[string]Route{
+	GetAppUsageEventsRequest:                                    {Path: "/v3/app_usage_events", Method: http.MethodGet},
 	GetApplicationsRequest:                                      {Path: "/v3/apps", Method: http.MethodGet},
 	PostApplicationRequest:                                      {Path: "/v3/apps", Method: http.MethodPost},
 	DeleteApplicationRequest:                                    {Path: "/v3/apps/:app_guid", Method: http.MethodDelete},
@@ -321,6 +324,7 @@ var APIRoutes = map[string]Route{
 	PostRouteBindingRequest:                                     {Path: "/v3/service_route_bindings", Method: http.MethodPost},
 	GetRouteBindingsRequest:                                     {Path: "/v3/service_route_bindings", Method: http.MethodGet},
 	DeleteRouteBindingRequest:                                   {Path: "/v3/service_route_bindings/:route_binding_guid", Method: http.MethodDelete},
+	GetServiceUsageEventsRequest:                                {Path: "/v3/service_usage_events", Method: http.MethodGet},
 	GetSpacesRequest:                                            {Path: "/v3/spaces", Method: http.MethodGet},
 	PostSpaceRequest:                                            {Path: "/v3/spaces", Method: http.MethodPost},
 	DeleteSpaceRequest:                                          {Path: "/v3/spaces/:space_guid", Method: http.MethodDelete},
@@ -2,13 +2,16 @@ package ccv3_test
 
 import (
 	"fmt"
+	"io/ioutil"
 	"net/http"
+	"os"
 	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/ccv3fakes"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/wrapper"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
@@ -374,6 +377,35 @@ var _ = Describe("Job", func() {
 			itSkipsEmptyURLs()
 			itFinishesWhenCompleteOrFailed()
 			itRespectsTimeouts()
+
+			When("the response cache is on", func() {
+				var cacheDir string
+
+				BeforeEach(func() {
+					var err error
+					cacheDir, err = ioutil.TempDir("", "response-cache")
+					Expect(err).NotTo(HaveOccurred())
+
+					client, _ = NewTestClient(Config{
+						JobPollingTimeout: time.Minute,
+						Wrappers:          []ConnectionWrapper{wrapper.NewResponseCache(cacheDir, time.Hour)},
+					})
+
+					appendHandler("PROCESSING", Warnings{"warning-1"})
+					appendHandler("PROCESSING", Warnings{"warning-2"})
+					appendHandler("COMPLETE", Warnings{"warning-3"})
+				})
+
+				AfterEach(func() {
+					Expect(os.RemoveAll(cacheDir)).To(Succeed())
+				})
+
+				It("still sees the job change state", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(warnings).To(ConsistOf("warning-1", "warning-2", "warning-3"))
+					Expect(server.ReceivedRequests()).To(HaveLen(3))
+				})
+			})
 		})
 
 		Describe("PollJobForState", func() {
@@ -1,35 +1,65 @@
 package ccv3
 
 import (
+	"errors"
 	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 )
 
+// errPageSkipped is the error of a page that was not fetched because an
+// earlier page failed.
+var errPageSkipped = errors.New("page skipped after an earlier page failed")
+
+// listPage is a page of a paginated list fetched by a paginate worker.
+type listPage struct {
+	wrapper  *PaginatedResources
+	list     []interface{}
+	warnings Warnings
+	err      error
+}
+
 func (requester RealRequester) paginate(request *cloudcontroller.Request, obj interface{}, appendToExternalList func(interface{}) error) (IncludedResources, Warnings, error) {
 	fullWarningsList := Warnings{}
 	var includes IncludedResources
 
-	for {
-		wrapper, warnings, err := requester.wrapFirstPage(request, obj, appendToExternalList)
-		fullWarningsList = append(fullWarningsList, warnings...)
-		if err != nil {
-			return IncludedResources{}, fullWarningsList, err
-		}
+	wrapper, warnings, err := requester.wrapFirstPage(request, obj, appendToExternalList)
+	fullWarningsList = append(fullWarningsList, warnings...)
+	if err != nil {
+		return IncludedResources{}, fullWarningsList, err
+	}
+	includes.merge(wrapper.IncludedResources)
+
+	// The remaining pages are fetched concurrently when the first page says how
+	// many there are. They are appended in page order once they have all been
+	// fetched, so the result is the same as when following the next links.
+	pageURLs := wrapper.remainingPageURLs()
+	if requester.pageConcurrency > 1 && len(pageURLs) > 1 {
+		pages := requester.fetchPages(pageURLs, obj)
+		for _, page := range pages {
+			fullWarningsList = append(fullWarningsList, page.warnings...)
+			if page.err != nil {
+				return IncludedResources{}, fullWarningsList, page.err
+			}
 
-		includes.Apps = append(includes.Apps, wrapper.IncludedResources.Apps...)
-		includes.Users = append(includes.Users, wrapper.IncludedResources.Users...)
-		includes.Organizations = append(includes.Organizations, wrapper.IncludedResources.Organizations...)
-		includes.Spaces = append(includes.Spaces, wrapper.IncludedResources.Spaces...)
-		includes.ServiceBrokers = append(includes.ServiceBrokers, wrapper.IncludedResources.ServiceBrokers...)
-		includes.ServiceInstances = append(includes.ServiceInstances, wrapper.IncludedResources.ServiceInstances...)
-		includes.ServiceOfferings = append(includes.ServiceOfferings, wrapper.IncludedResources.ServiceOfferings...)
-		includes.ServicePlans = append(includes.ServicePlans, wrapper.IncludedResources.ServicePlans...)
-
-		if wrapper.NextPage() == "" {
-			break
+			for _, item := range page.list {
+				err = appendToExternalList(item)
+				if err != nil {
+					return IncludedResources{}, fullWarningsList, err
+				}
+			}
+			includes.merge(page.wrapper.IncludedResources)
 		}
 
+		// Pages added while the list was being fetched are followed one at a
+		// time.
+		wrapper = pages[len(pages)-1].wrapper
+	}
+
+	for wrapper.NextPage() != "" {
 		request, err = requester.newHTTPRequest(requestOptions{
 			URL:    wrapper.NextPage(),
 			Method: http.MethodGet,
@@ -37,12 +67,97 @@ func (requester RealRequester) paginate(request *cloudcontroller.Request, obj in
 		if err != nil {
 			return IncludedResources{}, fullWarningsList, err
 		}
+
+		wrapper, warnings, err = requester.wrapFirstPage(request, obj, appendToExternalList)
+		fullWarningsList = append(fullWarningsList, warnings...)
+		if err != nil {
+			return IncludedResources{}, fullWarningsList, err
+		}
+		includes.merge(wrapper.IncludedResources)
 	}
 
 	return includes, fullWarningsList, nil
 }
 
+// fetchPages fetches the given pages with at most pageConcurrency requests in
+// flight and returns them in the order of the URLs. Pages that are not
+// fetched yet are skipped once a page fails.
+func (requester RealRequester) fetchPages(pageURLs []string, obj interface{}) []listPage {
+	pages := make([]listPage, len(pageURLs))
+	indexes := make(chan int)
+
+	var (
+		waitGroup sync.WaitGroup
+		failed    bool
+		lock      sync.Mutex
+	)
+
+	workers := requester.pageConcurrency
+	if workers > len(pageURLs) {
+		workers = len(pageURLs)
+	}
+	for i := 0; i < workers; i++ {
+		waitGroup.Add(1)
+		go func() {
+			defer waitGroup.Done()
+			for index := range indexes {
+				lock.Lock()
+				skip := failed
+				lock.Unlock()
+				if skip {
+					pages[index].err = errPageSkipped
+					continue
+				}
+
+				pages[index] = requester.fetchPage(pageURLs[index], obj)
+				if pages[index].err != nil {
+					lock.Lock()
+					failed = true
+					lock.Unlock()
+				}
+			}
+		}()
+	}
+
+	for index := range pageURLs {
+		indexes <- index
+	}
+	close(indexes)
+	waitGroup.Wait()
+
+	return pages
+}
+
+func (requester RealRequester) fetchPage(pageURL string, obj interface{}) listPage {
+	request, err := requester.newHTTPRequest(requestOptions{
+		URL:    pageURL,
+		Method: http.MethodGet,
+	})
+	if err != nil {
+		return listPage{err: err}
+	}
+
+	wrapper, list, warnings, err := requester.makePageRequest(request, obj)
+	return listPage{wrapper: wrapper, list: list, warnings: warnings, err: err}
+}
+
 func (requester RealRequester) wrapFirstPage(request *cloudcontroller.Request, obj interface{}, appendToExternalList func(interface{}) error) (*PaginatedResources, Warnings, error) {
+	wrapper, list, warnings, err := requester.makePageRequest(request, obj)
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	for _, item := range list {
+		err = appendToExternalList(item)
+		if err != nil {
+			return nil, warnings, err
+		}
+	}
+
+	return wrapper, warnings, nil
+}
+
+func (requester RealRequester) makePageRequest(request *cloudcontroller.Request, obj interface{}) (*PaginatedResources, []interface{}, Warnings, error) {
 	warnings := Warnings{}
 	wrapper := NewPaginatedResources(obj)
 	response := cloudcontroller.Response{
@@ -52,20 +167,52 @@ func (requester RealRequester) wrapFirstPage(request *cloudcontroller.Request, o
 	err := requester.connection.Make(request, &response)
 	warnings = append(warnings, response.Warnings...)
 	if err != nil {
-		return nil, warnings, err
+		return nil, nil, warnings, err
 	}
 
 	list, err := wrapper.Resources()
 	if err != nil {
-		return nil, warnings, err
+		return nil, nil, warnings, err
 	}
 
-	for _, item := range list {
-		err = appendToExternalList(item)
-		if err != nil {
-			return nil, warnings, err
-		}
+	return wrapper, list, warnings, nil
+}
+
+// remainingPageURLs returns the URLs of the pages after this one, built from
+// the next link and the total number of pages. It returns nil when the
+// remaining pages can only be found by following the next links.
+func (pr PaginatedResources) remainingPageURLs() []string {
+	if pr.NextPage() == "" || pr.Pagination.TotalPages < 2 {
+		return nil
 	}
 
-	return wrapper, warnings, nil
+	next, err := url.Parse(pr.NextPage())
+	if err != nil {
+		return nil
+	}
+
+	query := next.Query()
+	nextPage, err := strconv.Atoi(query.Get("page"))
+	if err != nil || nextPage > pr.Pagination.TotalPages {
+		return nil
+	}
+
+	var pageURLs []string
+	for pageNumber := nextPage; pageNumber <= pr.Pagination.TotalPages; pageNumber++ {
+		query.Set("page", strconv.Itoa(pageNumber))
+		next.RawQuery = query.Encode()
+		pageURLs = append(pageURLs, next.String())
+	}
+	return pageURLs
+}
+
+func (includes *IncludedResources) merge(page IncludedResources) {
+	includes.Apps = append(includes.Apps, page.Apps...)
+	includes.Users = append(includes.Users, page.Users...)
+	includes.Organizations = append(includes.Organizations, page.Organizations...)
+	includes.Spaces = append(includes.Spaces, page.Spaces...)
+	includes.ServiceBrokers = append(includes.ServiceBrokers, page.ServiceBrokers...)
+	includes.ServiceInstances = append(includes.ServiceInstances, page.ServiceInstances...)
+	includes.ServiceOfferings = append(includes.ServiceOfferings, page.ServiceOfferings...)
+	includes.ServicePlans = append(includes.ServicePlans, page.ServicePlans...)
 }
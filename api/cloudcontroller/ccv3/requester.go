@@ -72,12 +72,18 @@ type RealRequester struct {
 	router     *internal.Router
 	userAgent  string
 	wrappers   []ConnectionWrapper
+
+	pageConcurrency int
 }
 
 func (requester *RealRequester) InitializeConnection(settings TargetSettings) {
 	requester.connection = cloudcontroller.NewConnection(cloudcontroller.Config{
 		DialTimeout:       settings.DialTimeout,
 		SkipSSLValidation: settings.SkipSSLValidation,
+		TLSCertificates:   settings.TLSCertificates,
+		URL:               settings.URL,
+		FailoverURLs:      settings.FailoverURLs,
+		CircuitBreaker:    cloudcontroller.NewCircuitBreaker(settings.CircuitBreakerFile, cloudcontroller.DefaultCircuitBreakerCooldown),
 	})
 
 	for _, wrapper := range requester.wrappers {
@@ -220,9 +226,15 @@ func NewRequester(config Config) *RealRequester {
 		runtime.GOOS,
 	)
 
+	var wrappers []ConnectionWrapper
+	wrappers = append(wrappers, config.RawWrappers...)
+	wrappers = append(wrappers, newErrorWrapper())
+	wrappers = append(wrappers, config.Wrappers...)
+
 	return &RealRequester{
-		userAgent: userAgent,
-		wrappers:  append([]ConnectionWrapper{newErrorWrapper()}, config.Wrappers...),
+		userAgent:       userAgent,
+		wrappers:        wrappers,
+		pageConcurrency: config.PageConcurrency,
 	}
 }
 
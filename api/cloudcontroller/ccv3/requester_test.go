@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"strconv"
 	"strings"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
@@ -938,6 +939,97 @@ var _ = Describe("shared request helpers", func() {
 				})
 			})
 		})
+		When("the first page gives the total number of pages", func() {
+			var (
+				resourceList []resources.Role
+				failingPage  int
+			)
+
+			BeforeEach(func() {
+				client, _ = NewTestClient(Config{AppName: "CF CLI API V3 Test", AppVersion: "Unknown", PageConcurrency: 3})
+
+				resourceList = []resources.Role{}
+				failingPage = 0
+				requestParams = RequestParams{
+					RequestName:  internal.GetRolesRequest,
+					Query:        []Query{{Key: OrganizationGUIDFilter, Values: []string{"some-org-name"}}},
+					ResponseBody: resources.Role{},
+					AppendToList: func(item interface{}) error {
+						resourceList = append(resourceList, item.(resources.Role))
+						return nil
+					},
+				}
+
+				server.RouteToHandler(http.MethodGet, "/v3/roles", func(w http.ResponseWriter, req *http.Request) {
+					pageNumber := 1
+					if req.URL.Query().Get("page") != "" {
+						pageNumber, _ = strconv.Atoi(req.URL.Query().Get("page"))
+					}
+					w.Header().Set("X-Cf-Warnings", fmt.Sprintf("warning-%d", pageNumber))
+
+					if pageNumber == failingPage {
+						w.WriteHeader(http.StatusTeapot)
+						fmt.Fprint(w, `{"errors": [{"code": 10010, "detail": "Org not found", "title": "CF-ResourceNotFound"}, {"code": 10008, "detail": "Invalid", "title": "CF-UnprocessableEntity"}]}`)
+						return
+					}
+
+					next := "null"
+					if pageNumber < 4 {
+						next = fmt.Sprintf(`{"href": "%s/v3/roles?organization_guids=some-org-name&page=%d&per_page=1"}`, server.URL(), pageNumber+1)
+					}
+					fmt.Fprintf(w, `{
+						"pagination": {"total_pages": 4, "next": %s},
+						"resources": [{"guid": "role-guid-%d", "type": "organization_user"}],
+						"included": {"users": [{"guid": "user-guid-%d"}]}
+					}`, next, pageNumber, pageNumber)
+				})
+			})
+
+			It("fetches every page once and returns them in page order", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(Equal(Warnings{"warning-1", "warning-2", "warning-3", "warning-4"}))
+				Expect(resourceList).To(Equal([]resources.Role{
+					{GUID: "role-guid-1", Type: constant.OrgUserRole},
+					{GUID: "role-guid-2", Type: constant.OrgUserRole},
+					{GUID: "role-guid-3", Type: constant.OrgUserRole},
+					{GUID: "role-guid-4", Type: constant.OrgUserRole},
+				}))
+				Expect(includedResources.Users).To(Equal([]resources.User{
+					{GUID: "user-guid-1"}, {GUID: "user-guid-2"}, {GUID: "user-guid-3"}, {GUID: "user-guid-4"},
+				}))
+
+				var pages []string
+				for _, request := range server.ReceivedRequests() {
+					Expect(request.URL.Query().Get("organization_guids")).To(Equal("some-org-name"))
+					pages = append(pages, request.URL.Query().Get("page"))
+				}
+				Expect(pages).To(ConsistOf("", "2", "3", "4"))
+			})
+
+			When("a page fails", func() {
+				BeforeEach(func() {
+					failingPage = 3
+				})
+
+				It("returns the error and the warnings of the pages before it", func() {
+					Expect(executeErr).To(BeAssignableToTypeOf(ccerror.MultiError{}))
+					Expect(warnings).To(Equal(Warnings{"warning-1", "warning-2", "warning-3"}))
+				})
+			})
+
+			When("the page concurrency is not set", func() {
+				BeforeEach(func() {
+					client, _ = NewTestClient()
+				})
+
+				It("follows the next links one page at a time", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(warnings).To(Equal(Warnings{"warning-1", "warning-2", "warning-3", "warning-4"}))
+					Expect(resourceList).To(HaveLen(4))
+					Expect(server.ReceivedRequests()).To(HaveLen(4))
+				})
+			})
+		})
 	})
 
 	Describe("MakeRequestReceiveRaw", func() {
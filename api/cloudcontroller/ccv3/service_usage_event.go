@@ -0,0 +1,94 @@
+package ccv3
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
+)
+
+// ServiceUsageEvent is a change in the usage of a service instance, such as
+// it being created, updated or deleted.
+type ServiceUsageEvent struct {
+	GUID                string
+	CreatedAt           time.Time
+	State               string
+	ServiceInstanceGUID string
+	ServiceInstanceName string
+	ServiceInstanceType string
+	ServicePlanGUID     string
+	ServicePlanName     string
+	ServiceOfferingName string
+	ServiceBrokerName   string
+	SpaceGUID           string
+	SpaceName           string
+	OrganizationGUID    string
+}
+
+func (e *ServiceUsageEvent) UnmarshalJSON(data []byte) error {
+	var ccEvent struct {
+		GUID            string    `json:"guid"`
+		CreatedAt       time.Time `json:"created_at"`
+		State           string    `json:"state"`
+		ServiceInstance struct {
+			GUID string `json:"guid"`
+			Name string `json:"name"`
+			Type string `json:"type"`
+		} `json:"service_instance"`
+		ServicePlan struct {
+			GUID string `json:"guid"`
+			Name string `json:"name"`
+		} `json:"service_plan"`
+		ServiceOffering struct {
+			Name string `json:"name"`
+		} `json:"service_offering"`
+		ServiceBroker struct {
+			Name string `json:"name"`
+		} `json:"service_broker"`
+		Space struct {
+			GUID string `json:"guid"`
+			Name string `json:"name"`
+		} `json:"space"`
+		Organization struct {
+			GUID string `json:"guid"`
+		} `json:"organization"`
+	}
+	err := cloudcontroller.DecodeJSON(data, &ccEvent)
+	if err != nil {
+		return err
+	}
+
+	e.GUID = ccEvent.GUID
+	e.CreatedAt = ccEvent.CreatedAt
+	e.State = ccEvent.State
+	e.ServiceInstanceGUID = ccEvent.ServiceInstance.GUID
+	e.ServiceInstanceName = ccEvent.ServiceInstance.Name
+	e.ServiceInstanceType = ccEvent.ServiceInstance.Type
+	e.ServicePlanGUID = ccEvent.ServicePlan.GUID
+	e.ServicePlanName = ccEvent.ServicePlan.Name
+	e.ServiceOfferingName = ccEvent.ServiceOffering.Name
+	e.ServiceBrokerName = ccEvent.ServiceBroker.Name
+	e.SpaceGUID = ccEvent.Space.GUID
+	e.SpaceName = ccEvent.Space.Name
+	e.OrganizationGUID = ccEvent.Organization.GUID
+
+	return nil
+}
+
+// GetServiceUsageEvents lists the service usage events matching the query
+// from the /v3/service_usage_events endpoint, fetching every page of results.
+func (client *Client) GetServiceUsageEvents(query ...Query) ([]ServiceUsageEvent, Warnings, error) {
+	var events []ServiceUsageEvent
+
+	_, warnings, err := client.MakeListRequest(RequestParams{
+		RequestName:  internal.GetServiceUsageEventsRequest,
+		Query:        query,
+		ResponseBody: ServiceUsageEvent{},
+		AppendToList: func(item interface{}) error {
+			events = append(events, item.(ServiceUsageEvent))
+			return nil
+		},
+	})
+
+	return events, warnings, err
+}
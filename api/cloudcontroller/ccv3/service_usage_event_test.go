@@ -0,0 +1,113 @@
+package ccv3_test
+
+import (
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ServiceUsageEvent", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client, _ = NewTestClient()
+	})
+
+	Describe("GetServiceUsageEvents", func() {
+		var (
+			events     []ServiceUsageEvent
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			events, warnings, executeErr = client.GetServiceUsageEvents(
+				Query{Key: CreatedAtsGreaterThanOrEqualFilter, Values: []string{"2021-03-04T00:00:00Z"}},
+			)
+		})
+
+		When("the request succeeds", func() {
+			BeforeEach(func() {
+				response := `{
+  "pagination": {
+    "next": null
+  },
+  "resources": [
+    {
+      "guid": "event-1",
+      "created_at": "2021-03-04T05:06:07Z",
+      "state": "CREATED",
+      "space": {"guid": "space-guid", "name": "some-space"},
+      "organization": {"guid": "org-guid"},
+      "service_instance": {"guid": "instance-guid", "name": "some-db", "type": "managed_service_instance"},
+      "service_plan": {"guid": "plan-guid", "name": "small"},
+      "service_offering": {"guid": "offering-guid", "name": "postgres"},
+      "service_broker": {"guid": "broker-guid", "name": "some-broker"}
+    }
+  ]
+}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/service_usage_events", "created_ats[gte]=2021-03-04T00:00:00Z"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"warning"}}),
+					),
+				)
+			})
+
+			It("returns the events", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning"))
+				Expect(events).To(Equal([]ServiceUsageEvent{
+					{
+						GUID:                "event-1",
+						CreatedAt:           time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC),
+						State:               "CREATED",
+						ServiceInstanceGUID: "instance-guid",
+						ServiceInstanceName: "some-db",
+						ServiceInstanceType: "managed_service_instance",
+						ServicePlanGUID:     "plan-guid",
+						ServicePlanName:     "small",
+						ServiceOfferingName: "postgres",
+						ServiceBrokerName:   "some-broker",
+						SpaceGUID:           "space-guid",
+						SpaceName:           "some-space",
+						OrganizationGUID:    "org-guid",
+					},
+				}))
+			})
+		})
+
+		When("the request fails", func() {
+			BeforeEach(func() {
+				response := `{
+  "errors": [
+    {
+      "code": 10003,
+      "detail": "You are not authorized to perform the requested action",
+      "title": "CF-NotAuthorized"
+    }
+  ]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/service_usage_events"),
+						RespondWith(http.StatusForbidden, response, http.Header{"X-Cf-Warnings": {"warning"}}),
+					),
+				)
+			})
+
+			It("returns CC warnings and error", func() {
+				Expect(executeErr).To(MatchError(ccerror.ForbiddenError{
+					Message: "You are not authorized to perform the requested action",
+				}))
+				Expect(warnings).To(ConsistOf("warning"))
+			})
+		})
+	})
+})
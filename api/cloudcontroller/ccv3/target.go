@@ -2,6 +2,8 @@ package ccv3
 
 import (
 	"time"
+
+	"code.cloudfoundry.org/cli/util"
 )
 
 // TargetSettings represents configuration for establishing a connection to the
@@ -20,8 +22,20 @@ type TargetSettings struct {
 	// be used only for testing.
 	SkipSSLValidation bool
 
+	// TLSCertificates are the additional trusted CA and the client certificate
+	// used when connecting to the Cloud Controller.
+	TLSCertificates util.TLSCertificates
+
 	// URL is a fully qualified URL to the Cloud Controller API.
 	URL string
+
+	// FailoverURLs are fully qualified URLs to the same Cloud Controller API as
+	// URL, which requests fail over to in order when URL is unavailable.
+	FailoverURLs []string
+
+	// CircuitBreakerFile is the file that remembers which of the URLs are
+	// unavailable. They are only remembered by the client when it is empty.
+	CircuitBreakerFile string
 }
 
 // TargetCF sets the client to use the Cloud Controller specified in the
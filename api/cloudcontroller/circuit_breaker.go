@@ -0,0 +1,123 @@
+package cloudcontroller
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// DefaultCircuitBreakerCooldown is how long an endpoint that failed is
+// skipped for before requests are sent to it again.
+const DefaultCircuitBreakerCooldown = 5 * time.Minute
+
+// CircuitBreaker remembers which Cloud Controller endpoints recently failed,
+// so that requests go straight to an endpoint that is likely to answer. When
+// it has a state file, what it remembers is shared with later commands.
+type CircuitBreaker struct {
+	statePath string
+	cooldown  time.Duration
+
+	lock           sync.Mutex
+	loaded         bool
+	unhealthyUntil map[string]time.Time
+}
+
+// NewCircuitBreaker returns a CircuitBreaker that skips failed endpoints for
+// the cooldown. It keeps its state in the file at statePath, or only in
+// memory when statePath is empty.
+func NewCircuitBreaker(statePath string, cooldown time.Duration) *CircuitBreaker {
+	return &CircuitBreaker{
+		statePath:      statePath,
+		cooldown:       cooldown,
+		unhealthyUntil: map[string]time.Time{},
+	}
+}
+
+// Healthy returns false if the endpoint failed less than the cooldown ago.
+func (breaker *CircuitBreaker) Healthy(endpoint string) bool {
+	breaker.lock.Lock()
+	defer breaker.lock.Unlock()
+
+	breaker.load()
+	return !time.Now().Before(breaker.unhealthyUntil[endpoint])
+}
+
+// RecordFailure marks the endpoint unhealthy for the cooldown.
+func (breaker *CircuitBreaker) RecordFailure(endpoint string) {
+	breaker.lock.Lock()
+	defer breaker.lock.Unlock()
+
+	breaker.load()
+	breaker.unhealthyUntil[endpoint] = time.Now().Add(breaker.cooldown)
+	breaker.save()
+}
+
+// RecordSuccess marks the endpoint healthy again.
+func (breaker *CircuitBreaker) RecordSuccess(endpoint string) {
+	breaker.lock.Lock()
+	defer breaker.lock.Unlock()
+
+	breaker.load()
+	if _, ok := breaker.unhealthyUntil[endpoint]; !ok {
+		return
+	}
+	delete(breaker.unhealthyUntil, endpoint)
+	breaker.save()
+}
+
+// load reads the state file the first time the breaker is used. A missing or
+// unreadable state file is the same as every endpoint being healthy.
+func (breaker *CircuitBreaker) load() {
+	if breaker.loaded || breaker.statePath == "" {
+		return
+	}
+	breaker.loaded = true
+
+	raw, err := ioutil.ReadFile(breaker.statePath)
+	if err != nil {
+		return
+	}
+
+	var unhealthyUntil map[string]time.Time
+	if json.Unmarshal(raw, &unhealthyUntil) != nil {
+		return
+	}
+	for endpoint, until := range unhealthyUntil {
+		if time.Now().Before(until) {
+			breaker.unhealthyUntil[endpoint] = until
+		}
+	}
+}
+
+// save writes the state file. The state only saves time, so failing to write
+// it is not an error. The state is written to a temporary file that replaces
+// the state file, so that commands running at the same time never read a
+// partly written state file.
+func (breaker *CircuitBreaker) save() {
+	if breaker.statePath == "" {
+		return
+	}
+
+	raw, err := json.Marshal(breaker.unhealthyUntil)
+	if err != nil {
+		return
+	}
+	dir := filepath.Dir(breaker.statePath)
+	if os.MkdirAll(dir, os.ModeDir|os.ModePerm) != nil {
+		return
+	}
+
+	tempStateFile, err := ioutil.TempFile(dir, filepath.Base(breaker.statePath)+"-")
+	if err != nil {
+		return
+	}
+	tempStateFile.Close()
+
+	if ioutil.WriteFile(tempStateFile.Name(), raw, 0600) != nil ||
+		os.Rename(tempStateFile.Name(), breaker.statePath) != nil {
+		_ = os.Remove(tempStateFile.Name())
+	}
+}
@@ -0,0 +1,84 @@
+package cloudcontroller_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "code.cloudfoundry.org/cli/api/cloudcontroller"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CircuitBreaker", func() {
+	var (
+		stateDir  string
+		statePath string
+		breaker   *CircuitBreaker
+	)
+
+	BeforeEach(func() {
+		var err error
+		stateDir, err = ioutil.TempDir("", "circuit-breaker")
+		Expect(err).ToNot(HaveOccurred())
+		statePath = filepath.Join(stateDir, "state", "circuit_breaker.json")
+
+		breaker = NewCircuitBreaker(statePath, time.Hour)
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(stateDir)).To(Succeed())
+	})
+
+	It("considers endpoints healthy until they fail", func() {
+		Expect(breaker.Healthy("https://api.foo.com")).To(BeTrue())
+
+		breaker.RecordFailure("https://api.foo.com")
+		Expect(breaker.Healthy("https://api.foo.com")).To(BeFalse())
+		Expect(breaker.Healthy("https://api-b.foo.com")).To(BeTrue())
+
+		breaker.RecordSuccess("https://api.foo.com")
+		Expect(breaker.Healthy("https://api.foo.com")).To(BeTrue())
+	})
+
+	It("shares the failed endpoints through the state file", func() {
+		breaker.RecordFailure("https://api.foo.com")
+
+		Expect(NewCircuitBreaker(statePath, time.Hour).Healthy("https://api.foo.com")).To(BeFalse())
+	})
+
+	It("leaves only the state file behind when it saves", func() {
+		breaker.RecordFailure("https://api.foo.com")
+		breaker.RecordFailure("https://api-b.foo.com")
+
+		files, err := ioutil.ReadDir(filepath.Dir(statePath))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(files).To(HaveLen(1))
+		Expect(files[0].Name()).To(Equal("circuit_breaker.json"))
+	})
+
+	When("the cooldown has passed", func() {
+		BeforeEach(func() {
+			breaker = NewCircuitBreaker(statePath, -time.Second)
+		})
+
+		It("considers the endpoint healthy again", func() {
+			breaker.RecordFailure("https://api.foo.com")
+
+			Expect(breaker.Healthy("https://api.foo.com")).To(BeTrue())
+			Expect(NewCircuitBreaker(statePath, time.Hour).Healthy("https://api.foo.com")).To(BeTrue())
+		})
+	})
+
+	When("the state file is not valid", func() {
+		BeforeEach(func() {
+			Expect(os.MkdirAll(filepath.Dir(statePath), 0700)).To(Succeed())
+			Expect(ioutil.WriteFile(statePath, []byte("not json"), 0600)).To(Succeed())
+		})
+
+		It("considers every endpoint healthy", func() {
+			Expect(breaker.Healthy("https://api.foo.com")).To(BeTrue())
+		})
+	})
+})
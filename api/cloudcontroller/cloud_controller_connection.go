@@ -2,6 +2,8 @@ package cloudcontroller
 
 import (
 	"crypto/x509"
+	"errors"
+	"io"
 	"io/ioutil"
 	"net"
 	"net/http"
@@ -17,6 +19,19 @@ import (
 type Config struct {
 	DialTimeout       time.Duration
 	SkipSSLValidation bool
+	TLSCertificates   util.TLSCertificates
+
+	// URL is the URL of the Cloud Controller API that requests are made to.
+	URL string
+
+	// FailoverURLs are URLs of the same Cloud Controller API as URL. Requests
+	// fail over to them, in order, when the endpoints before them are
+	// unavailable.
+	FailoverURLs []string
+
+	// CircuitBreaker remembers which endpoints are unavailable. When it is
+	// nil, endpoints are only remembered by the connection.
+	CircuitBreaker *CircuitBreaker
 }
 
 // CloudControllerConnection represents a connection to the Cloud Controller
@@ -24,13 +39,19 @@ type Config struct {
 type CloudControllerConnection struct {
 	HTTPClient *http.Client
 	UserAgent  string
+
+	endpoints      []string
+	circuitBreaker *CircuitBreaker
+	tlsErr         error
 }
 
 // NewConnection returns a new CloudControllerConnection with provided
-// configuration.
+// configuration. If the TLS certificates cannot be loaded, every request made
+// with the connection returns the error.
 func NewConnection(config Config) *CloudControllerConnection {
+	tlsConfig, tlsErr := util.NewTLSConfigWithCertificates(config.TLSCertificates, config.SkipSSLValidation)
 	tr := &http.Transport{
-		TLSClientConfig: util.NewTLSConfig(nil, config.SkipSSLValidation),
+		TLSClientConfig: tlsConfig,
 		Proxy:           http.ProxyFromEnvironment,
 		DialContext: (&net.Dialer{
 			KeepAlive: 30 * time.Second,
@@ -38,9 +59,24 @@ func NewConnection(config Config) *CloudControllerConnection {
 		}).DialContext,
 	}
 
-	return &CloudControllerConnection{
+	connection := &CloudControllerConnection{
 		HTTPClient: &http.Client{Transport: tr},
+		tlsErr:     tlsErr,
+	}
+
+	if len(config.FailoverURLs) > 0 {
+		connection.endpoints = append([]string{config.URL}, config.FailoverURLs...)
+		for i, endpoint := range connection.endpoints {
+			connection.endpoints[i] = strings.TrimSuffix(endpoint, "/")
+		}
+
+		connection.circuitBreaker = config.CircuitBreaker
+		if connection.circuitBreaker == nil {
+			connection.circuitBreaker = NewCircuitBreaker("", DefaultCircuitBreakerCooldown)
+		}
 	}
+
+	return connection
 }
 
 // Make performs the request and parses the response.
@@ -50,6 +86,14 @@ func (connection *CloudControllerConnection) Make(request *Request, passedRespon
 	// error and we don't repopulate it in populateResponse.
 	passedResponse.reset()
 
+	if connection.tlsErr != nil {
+		return connection.tlsErr
+	}
+
+	if len(connection.endpoints) > 0 {
+		return connection.makeWithFailover(request, passedResponse)
+	}
+
 	response, err := connection.HTTPClient.Do(request.Request)
 	if err != nil {
 		return connection.processRequestErrors(request.Request, err)
@@ -58,6 +102,94 @@ func (connection *CloudControllerConnection) Make(request *Request, passedRespon
 	return connection.populateResponse(response, passedResponse)
 }
 
+// makeWithFailover sends the request to each endpoint in turn until one of
+// them is available. Endpoints that recently failed are tried last. The URL
+// of the request is left pointing at the endpoint that answered.
+//
+// Requests that change state only fail over when they could not connect to
+// the endpoint, because an endpoint behind an unavailable gateway may still
+// have carried them out.
+func (connection *CloudControllerConnection) makeWithFailover(request *Request, passedResponse *Response) error {
+	path, ok := connection.pathOnEndpoint(request.URL.String())
+	if !ok {
+		response, err := connection.HTTPClient.Do(request.Request)
+		if err != nil {
+			return connection.processRequestErrors(request.Request, err)
+		}
+		return connection.populateResponse(response, passedResponse)
+	}
+
+	endpoints := connection.orderedEndpoints()
+	for i, endpoint := range endpoints {
+		lastEndpoint := i == len(endpoints)-1
+
+		if i > 0 {
+			err := request.ResetBody()
+			if err != nil {
+				return err
+			}
+			passedResponse.reset()
+		}
+
+		endpointURL, err := url.Parse(endpoint + path)
+		if err != nil {
+			return err
+		}
+		request.URL = endpointURL
+		request.Host = endpointURL.Host
+
+		response, err := connection.HTTPClient.Do(request.Request)
+		if err != nil {
+			connection.circuitBreaker.RecordFailure(endpoint)
+			if !lastEndpoint && (isIdempotent(request.Method) || isDialError(err)) {
+				continue
+			}
+			return connection.processRequestErrors(request.Request, err)
+		}
+
+		if isGatewayError(response.StatusCode) {
+			connection.circuitBreaker.RecordFailure(endpoint)
+			if !lastEndpoint && isIdempotent(request.Method) {
+				_, _ = io.Copy(ioutil.Discard, response.Body)
+				response.Body.Close()
+				continue
+			}
+		} else {
+			connection.circuitBreaker.RecordSuccess(endpoint)
+		}
+
+		return connection.populateResponse(response, passedResponse)
+	}
+
+	return nil
+}
+
+// pathOnEndpoint returns the part of the request URL that follows the
+// endpoint it is made to. It returns false if the URL is not on any of the
+// endpoints, for example when it is a link to another API.
+func (connection *CloudControllerConnection) pathOnEndpoint(requestURL string) (string, bool) {
+	for _, endpoint := range connection.endpoints {
+		if requestURL == endpoint || strings.HasPrefix(requestURL, endpoint+"/") || strings.HasPrefix(requestURL, endpoint+"?") {
+			return strings.TrimPrefix(requestURL, endpoint), true
+		}
+	}
+	return "", false
+}
+
+// orderedEndpoints returns the healthy endpoints followed by the ones that
+// recently failed, each in the order they were configured in.
+func (connection *CloudControllerConnection) orderedEndpoints() []string {
+	var healthy, unhealthy []string
+	for _, endpoint := range connection.endpoints {
+		if connection.circuitBreaker.Healthy(endpoint) {
+			healthy = append(healthy, endpoint)
+		} else {
+			unhealthy = append(unhealthy, endpoint)
+		}
+	}
+	return append(healthy, unhealthy...)
+}
+
 func (*CloudControllerConnection) handleStatusCodes(response *http.Response, passedResponse *Response) error {
 	if response.StatusCode == http.StatusNoContent {
 		passedResponse.RawResponse = []byte("{}")
@@ -149,3 +281,25 @@ func (*CloudControllerConnection) processRequestErrors(request *http.Request, er
 		return err
 	}
 }
+
+func isIdempotent(httpMethod string) bool {
+	switch httpMethod {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// isDialError returns true if the request failed before it was sent, because
+// no connection to the endpoint could be made.
+func isDialError(err error) bool {
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+func isGatewayError(statusCode int) bool {
+	return statusCode == http.StatusBadGateway ||
+		statusCode == http.StatusServiceUnavailable ||
+		statusCode == http.StatusGatewayTimeout
+}
@@ -229,6 +229,179 @@ var _ = Describe("Cloud Controller Connection", func() {
 			})
 		})
 
+		Describe("Failover", func() {
+			var (
+				failoverServer *Server
+				breaker        *CircuitBreaker
+			)
+
+			BeforeEach(func() {
+				failoverServer = NewTLSServer()
+				breaker = NewCircuitBreaker("", DefaultCircuitBreakerCooldown)
+
+				connection = NewConnection(Config{
+					SkipSSLValidation: true,
+					URL:               server.URL(),
+					FailoverURLs:      []string{failoverServer.URL()},
+					CircuitBreaker:    breaker,
+				})
+			})
+
+			AfterEach(func() {
+				failoverServer.Close()
+			})
+
+			When("the endpoint responds", func() {
+				BeforeEach(func() {
+					server.AppendHandlers(
+						CombineHandlers(
+							VerifyRequest(http.MethodGet, "/v2/foo"),
+							RespondWith(http.StatusOK, `{"val1":"foo"}`),
+						),
+					)
+				})
+
+				It("does not fail over", func() {
+					req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/v2/foo", server.URL()), nil)
+					Expect(err).ToNot(HaveOccurred())
+					request := &Request{Request: req}
+
+					var body DummyResponse
+					response := Response{DecodeJSONResponseInto: &body}
+					err = connection.Make(request, &response)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(body.Val1).To(Equal("foo"))
+					Expect(failoverServer.ReceivedRequests()).To(BeEmpty())
+					Expect(breaker.Healthy(server.URL())).To(BeTrue())
+				})
+			})
+
+			When("the endpoint responds with a gateway error", func() {
+				BeforeEach(func() {
+					server.AppendHandlers(
+						RespondWith(http.StatusServiceUnavailable, "unavailable"),
+					)
+				})
+
+				When("the request is idempotent", func() {
+					BeforeEach(func() {
+						failoverServer.AppendHandlers(
+							CombineHandlers(
+								VerifyRequest(http.MethodGet, "/v2/foo", "q=bar"),
+								RespondWith(http.StatusOK, `{"val1":"foo"}`),
+							),
+						)
+					})
+
+					It("makes the request to the next endpoint and remembers the failed endpoint", func() {
+						req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/v2/foo?q=bar", server.URL()), nil)
+						Expect(err).ToNot(HaveOccurred())
+						request := &Request{Request: req}
+
+						var body DummyResponse
+						response := Response{DecodeJSONResponseInto: &body}
+						err = connection.Make(request, &response)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(body.Val1).To(Equal("foo"))
+						Expect(request.URL.Host).To(Equal(strings.TrimPrefix(failoverServer.URL(), "https://")))
+						Expect(breaker.Healthy(server.URL())).To(BeFalse())
+						Expect(breaker.Healthy(failoverServer.URL())).To(BeTrue())
+					})
+
+					It("makes later requests to the healthy endpoint first", func() {
+						req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/v2/foo?q=bar", server.URL()), nil)
+						Expect(err).ToNot(HaveOccurred())
+						err = connection.Make(&Request{Request: req}, &Response{})
+						Expect(err).NotTo(HaveOccurred())
+
+						failoverServer.AppendHandlers(
+							CombineHandlers(
+								VerifyRequest(http.MethodGet, "/v2/foo", "q=bar"),
+								RespondWith(http.StatusOK, `{"val1":"foo"}`),
+							),
+						)
+						req, err = http.NewRequest(http.MethodGet, fmt.Sprintf("%s/v2/foo?q=bar", server.URL()), nil)
+						Expect(err).ToNot(HaveOccurred())
+						err = connection.Make(&Request{Request: req}, &Response{})
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(server.ReceivedRequests()).To(HaveLen(1))
+						Expect(failoverServer.ReceivedRequests()).To(HaveLen(2))
+					})
+				})
+
+				When("the request is not idempotent", func() {
+					It("returns the error without failing over", func() {
+						req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/v2/foo", server.URL()), strings.NewReader("{}"))
+						Expect(err).ToNot(HaveOccurred())
+						request := NewRequest(req, strings.NewReader("{}"))
+
+						var response Response
+						err = connection.Make(request, &response)
+						Expect(err).To(MatchError(ccerror.RawHTTPStatusError{
+							StatusCode:  http.StatusServiceUnavailable,
+							RawResponse: []byte("unavailable"),
+						}))
+
+						Expect(failoverServer.ReceivedRequests()).To(BeEmpty())
+					})
+				})
+			})
+
+			When("the endpoint cannot be connected to", func() {
+				var closedServer *Server
+
+				BeforeEach(func() {
+					closedServer = NewTLSServer()
+					closedServer.Close()
+
+					connection = NewConnection(Config{
+						SkipSSLValidation: true,
+						URL:               closedServer.URL(),
+						FailoverURLs:      []string{failoverServer.URL()},
+						CircuitBreaker:    breaker,
+					})
+
+					failoverServer.AppendHandlers(
+						CombineHandlers(
+							VerifyRequest(http.MethodPost, "/v2/foo"),
+							VerifyBody([]byte("{}")),
+							RespondWith(http.StatusCreated, `{"val1":"foo"}`),
+						),
+					)
+				})
+
+				It("makes the request to the next endpoint, even if it is not idempotent", func() {
+					req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/v2/foo", closedServer.URL()), strings.NewReader("{}"))
+					Expect(err).ToNot(HaveOccurred())
+					request := NewRequest(req, strings.NewReader("{}"))
+
+					var body DummyResponse
+					response := Response{DecodeJSONResponseInto: &body}
+					err = connection.Make(request, &response)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(body.Val1).To(Equal("foo"))
+					Expect(response.HTTPResponse.StatusCode).To(Equal(http.StatusCreated))
+					Expect(breaker.Healthy(closedServer.URL())).To(BeFalse())
+				})
+			})
+
+			When("the request is to another API", func() {
+				It("does not fail over", func() {
+					req, err := http.NewRequest(http.MethodGet, "http://garbledyguk.com/v2/foo", nil)
+					Expect(err).ToNot(HaveOccurred())
+
+					var response Response
+					err = connection.Make(&Request{Request: req}, &response)
+					Expect(err).To(BeAssignableToTypeOf(ccerror.RequestError{}))
+					Expect(failoverServer.ReceivedRequests()).To(BeEmpty())
+				})
+			})
+		})
+
 		Describe("Errors", func() {
 			When("the server does not exist", func() {
 				BeforeEach(func() {
@@ -0,0 +1,116 @@
+package wrapper
+
+import (
+	"io/ioutil"
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cassette"
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+)
+
+// CassetteRecorder is a wrapper that records every request made to the Cloud
+// Controller and its response in a cassette, which a CassettePlayer can
+// replay. It must wrap the connection before Cloud Controller errors are
+// converted.
+type CassetteRecorder struct {
+	connection cloudcontroller.Connection
+	recorder   *cassette.Recorder
+}
+
+// NewCassetteRecorder returns a pointer to a CassetteRecorder wrapper that
+// records with the given recorder.
+func NewCassetteRecorder(recorder *cassette.Recorder) *CassetteRecorder {
+	return &CassetteRecorder{
+		recorder: recorder,
+	}
+}
+
+// Make makes the request and records it when a response is received.
+func (recorder *CassetteRecorder) Make(request *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
+	cassetteRequest, err := newCassetteRequest(request)
+	if err != nil {
+		return err
+	}
+
+	err = recorder.connection.Make(request, passedResponse)
+	if passedResponse.HTTPResponse == nil {
+		return err
+	}
+
+	recordErr := recorder.recorder.Record(cassetteRequest, passedResponse.HTTPResponse, passedResponse.RawResponse)
+	if err == nil {
+		err = recordErr
+	}
+	return err
+}
+
+// Wrap sets the connection on the CassetteRecorder and returns itself.
+func (recorder *CassetteRecorder) Wrap(innerconnection cloudcontroller.Connection) cloudcontroller.Connection {
+	recorder.connection = innerconnection
+	return recorder
+}
+
+// CassettePlayer is a wrapper that answers requests with the responses in a
+// cassette instead of making them. It must wrap the connection before Cloud
+// Controller errors are converted, so replayed errors are converted the same
+// way.
+type CassettePlayer struct {
+	player *cassette.Player
+}
+
+// NewCassettePlayer returns a pointer to a CassettePlayer wrapper that
+// replays with the given player.
+func NewCassettePlayer(player *cassette.Player) *CassettePlayer {
+	return &CassettePlayer{
+		player: player,
+	}
+}
+
+// Make answers the request with the recorded response.
+func (player *CassettePlayer) Make(request *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
+	cassetteRequest, err := newCassetteRequest(request)
+	if err != nil {
+		return err
+	}
+
+	recorded, found := player.player.Play(cassetteRequest)
+	if !found {
+		return cassette.UnrecordedRequestError{Method: cassetteRequest.Method, URL: cassetteRequest.URL}
+	}
+
+	// The recorded response goes through a real connection so it is parsed
+	// exactly like a response from the Cloud Controller.
+	connection := cloudcontroller.CloudControllerConnection{
+		HTTPClient: &http.Client{
+			Transport: recorded.Transport(),
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+	}
+	return connection.Make(request, passedResponse)
+}
+
+// Wrap returns the CassettePlayer. The wrapped connection is never used.
+func (player *CassettePlayer) Wrap(cloudcontroller.Connection) cloudcontroller.Connection {
+	return player
+}
+
+// newCassetteRequest reads the body of requests that are matched on it. Other
+// bodies, such as uploads, are streamed and cannot be read twice.
+func newCassetteRequest(request *cloudcontroller.Request) (cassette.Request, error) {
+	var body []byte
+	if request.Body != nil && cassette.RecordsBody(request.Header.Get("Content-Type")) {
+		var err error
+		body, err = ioutil.ReadAll(request.Body)
+		if err != nil {
+			return cassette.Request{}, err
+		}
+		err = request.ResetBody()
+		if err != nil {
+			return cassette.Request{}, err
+		}
+	}
+
+	return cassette.NewRequest(request.Request, body), nil
+}
@@ -0,0 +1,162 @@
+package wrapper_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"code.cloudfoundry.org/cli/api/cassette"
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/cloudcontrollerfakes"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/wrapper"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Cassette", func() {
+	var (
+		fakeConnection *cloudcontrollerfakes.FakeConnection
+		cassetteDir    string
+		cassettePath   string
+	)
+
+	type app struct {
+		Name  string `json:"name"`
+		State string `json:"state"`
+	}
+
+	makeRequest := func(connection cloudcontroller.Connection, method string, url string, body string) (app, *cloudcontroller.Response, error) {
+		requestBody := strings.NewReader(body)
+		req, err := http.NewRequest(method, url, requestBody)
+		Expect(err).NotTo(HaveOccurred())
+		req.Header.Set("Content-Type", "application/json")
+
+		var result app
+		response := &cloudcontroller.Response{DecodeJSONResponseInto: &result}
+		err = connection.Make(cloudcontroller.NewRequest(req, requestBody), response)
+		return result, response, err
+	}
+
+	BeforeEach(func() {
+		var err error
+		cassetteDir, err = ioutil.TempDir("", "cassette")
+		Expect(err).NotTo(HaveOccurred())
+		cassettePath = filepath.Join(cassetteDir, "cassette.json")
+
+		fakeConnection = new(cloudcontrollerfakes.FakeConnection)
+		fakeConnection.MakeStub = func(request *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
+			body, err := ioutil.ReadAll(request.Body)
+			Expect(err).NotTo(HaveOccurred())
+
+			switch {
+			case request.Method == http.MethodPost && string(body) == `{"name":"some-app"}`:
+				passedResponse.HTTPResponse = &http.Response{StatusCode: http.StatusCreated, Header: http.Header{"X-Cf-Warnings": {"some-warning"}, "Set-Cookie": {"some-cookie"}}}
+				passedResponse.RawResponse = []byte(`{"name": "some-app", "state": "STOPPED"}`)
+			case request.Method == http.MethodGet:
+				state := "STARTING"
+				if fakeConnection.MakeCallCount() > 2 {
+					state = "STARTED"
+				}
+				passedResponse.HTTPResponse = &http.Response{StatusCode: http.StatusOK, Header: http.Header{}}
+				passedResponse.RawResponse = []byte(`{"name": "some-app", "state": "` + state + `"}`)
+			default:
+				passedResponse.HTTPResponse = &http.Response{StatusCode: http.StatusUnprocessableEntity, Header: http.Header{}}
+				passedResponse.RawResponse = []byte(`{"errors": []}`)
+				return ccerror.RawHTTPStatusError{StatusCode: http.StatusUnprocessableEntity, RawResponse: passedResponse.RawResponse}
+			}
+			return nil
+		}
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(cassetteDir)).To(Succeed())
+	})
+
+	When("recording", func() {
+		var recorder cloudcontroller.Connection
+
+		BeforeEach(func() {
+			recorder = NewCassetteRecorder(cassette.NewRecorder(cassettePath)).Wrap(fakeConnection)
+
+			_, _, err := makeRequest(recorder, http.MethodPost, "https://api.example.com/v3/apps", `{"name":"some-app"}`)
+			Expect(err).NotTo(HaveOccurred())
+			_, _, err = makeRequest(recorder, http.MethodGet, "https://api.example.com/v3/apps/some-guid", "")
+			Expect(err).NotTo(HaveOccurred())
+			_, _, err = makeRequest(recorder, http.MethodGet, "https://api.example.com/v3/apps/some-guid", "")
+			Expect(err).NotTo(HaveOccurred())
+			_, _, err = makeRequest(recorder, http.MethodPost, "https://api.example.com/v3/apps", `{"name":"other-app"}`)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("writes every interaction to the cassette", func() {
+			recorded, err := cassette.Read(cassettePath)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(recorded.Interactions).To(HaveLen(4))
+
+			Expect(recorded.Interactions[0].Request.Method).To(Equal(http.MethodPost))
+			Expect(recorded.Interactions[0].Request.URL).To(Equal("https://api.example.com/v3/apps"))
+			Expect(recorded.Interactions[0].Request.Body).To(MatchJSON(`{"name":"some-app"}`))
+			Expect(recorded.Interactions[0].Response.StatusCode).To(Equal(http.StatusCreated))
+			Expect(recorded.Interactions[0].Response.Header).NotTo(HaveKey("Set-Cookie"))
+			Expect(recorded.Interactions[3].Response.StatusCode).To(Equal(http.StatusUnprocessableEntity))
+		})
+
+		It("leaves the request body for the connection", func() {
+			Expect(fakeConnection.MakeCallCount()).To(Equal(4))
+		})
+
+		When("the cassette is replayed", func() {
+			var player cloudcontroller.Connection
+
+			BeforeEach(func() {
+				recorded, err := cassette.Read(cassettePath)
+				Expect(err).NotTo(HaveOccurred())
+				player = NewCassettePlayer(cassette.NewPlayer(recorded)).Wrap(nil)
+			})
+
+			It("returns the recorded responses without making requests", func() {
+				result, response, err := makeRequest(player, http.MethodPost, "https://api.example.com/v3/apps", `{"name":"some-app"}`)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(app{Name: "some-app", State: "STOPPED"}))
+				Expect(response.HTTPResponse.StatusCode).To(Equal(http.StatusCreated))
+				Expect(response.Warnings).To(ConsistOf("some-warning"))
+
+				result, _, err = makeRequest(player, http.MethodGet, "https://api.example.com/v3/apps/some-guid", "")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result.State).To(Equal("STARTING"))
+
+				result, _, err = makeRequest(player, http.MethodGet, "https://api.example.com/v3/apps/some-guid", "")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result.State).To(Equal("STARTED"))
+
+				By("repeating the last matching response", func() {
+					result, _, err = makeRequest(player, http.MethodGet, "https://api.example.com/v3/apps/some-guid", "")
+					Expect(err).NotTo(HaveOccurred())
+					Expect(result.State).To(Equal("STARTED"))
+				})
+
+				Expect(fakeConnection.MakeCallCount()).To(Equal(4))
+			})
+
+			It("returns recorded errors the way the connection does", func() {
+				_, _, err := makeRequest(player, http.MethodPost, "https://api.example.com/v3/apps", `{"name":"other-app"}`)
+				Expect(err).To(BeAssignableToTypeOf(ccerror.RawHTTPStatusError{}))
+				Expect(err.(ccerror.RawHTTPStatusError).StatusCode).To(Equal(http.StatusUnprocessableEntity))
+				Expect(err.(ccerror.RawHTTPStatusError).RawResponse).To(MatchJSON(`{"errors": []}`))
+			})
+
+			When("the request was not recorded", func() {
+				It("returns an UnrecordedRequestError", func() {
+					_, _, err := makeRequest(player, http.MethodDelete, "https://api.example.com/v3/apps/some-guid", "")
+					Expect(err).To(MatchError(cassette.UnrecordedRequestError{
+						Method: http.MethodDelete,
+						URL:    "https://api.example.com/v3/apps/some-guid",
+					}))
+				})
+			})
+		})
+	})
+})
@@ -0,0 +1,60 @@
+package wrapper
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . HARWriter
+
+// HARWriter is the interface for recording requests in an HTTP Archive
+type HARWriter interface {
+	WriteEntry(request *http.Request, requestBody []byte, response *http.Response, responseBody []byte, startedAt time.Time, requestErr error)
+}
+
+// HARLogger is the wrapper that records requests to and responses from the
+// Cloud Controller server in an HTTP Archive
+type HARLogger struct {
+	connection cloudcontroller.Connection
+	output     HARWriter
+}
+
+// NewHARLogger returns a pointer to a HARLogger wrapper
+func NewHARLogger(output HARWriter) *HARLogger {
+	return &HARLogger{
+		output: output,
+	}
+}
+
+// Make records the request and the response in the HTTP Archive
+func (logger *HARLogger) Make(request *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
+	var requestBody []byte
+	contentType := request.Header.Get("Content-Type")
+	if request.Body != nil && (strings.Contains(contentType, "json") || strings.Contains(contentType, "x-www-form-urlencoded")) {
+		var err error
+		requestBody, err = ioutil.ReadAll(request.Body)
+		if err != nil {
+			return err
+		}
+		err = request.ResetBody()
+		if err != nil {
+			return err
+		}
+	}
+
+	startedAt := time.Now()
+	err := logger.connection.Make(request, passedResponse)
+	logger.output.WriteEntry(request.Request, requestBody, passedResponse.HTTPResponse, passedResponse.RawResponse, startedAt, err)
+
+	return err
+}
+
+// Wrap sets the connection on the HARLogger and returns itself
+func (logger *HARLogger) Wrap(innerconnection cloudcontroller.Connection) cloudcontroller.Connection {
+	logger.connection = innerconnection
+	return logger
+}
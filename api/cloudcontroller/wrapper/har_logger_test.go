@@ -0,0 +1,98 @@
+package wrapper_test
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/cloudcontrollerfakes"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/wrapper"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/wrapper/wrapperfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("HAR Logger", func() {
+	var (
+		fakeConnection *cloudcontrollerfakes.FakeConnection
+		fakeOutput     *wrapperfakes.FakeHARWriter
+
+		wrapper cloudcontroller.Connection
+
+		request  *cloudcontroller.Request
+		response *cloudcontroller.Response
+		makeErr  error
+	)
+
+	BeforeEach(func() {
+		fakeConnection = new(cloudcontrollerfakes.FakeConnection)
+		fakeConnection.MakeStub = func(_ *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
+			passedResponse.HTTPResponse = &http.Response{StatusCode: http.StatusCreated}
+			passedResponse.RawResponse = []byte(`{"name": "some-app"}`)
+			return nil
+		}
+		fakeOutput = new(wrapperfakes.FakeHARWriter)
+		wrapper = NewHARLogger(fakeOutput).Wrap(fakeConnection)
+
+		body := bytes.NewReader([]byte(`{"name": "some-app"}`))
+		req, err := http.NewRequest(http.MethodPost, "https://api.example.com/v3/apps", body)
+		Expect(err).NotTo(HaveOccurred())
+		req.Header.Set("Content-Type", "application/json")
+		request = cloudcontroller.NewRequest(req, body)
+		response = &cloudcontroller.Response{}
+	})
+
+	JustBeforeEach(func() {
+		makeErr = wrapper.Make(request, response)
+	})
+
+	It("records the request and the response", func() {
+		Expect(makeErr).NotTo(HaveOccurred())
+		Expect(fakeOutput.WriteEntryCallCount()).To(Equal(1))
+
+		req, requestBody, resp, responseBody, startedAt, requestErr := fakeOutput.WriteEntryArgsForCall(0)
+		Expect(req).To(Equal(request.Request))
+		Expect(string(requestBody)).To(Equal(`{"name": "some-app"}`))
+		Expect(resp.StatusCode).To(Equal(http.StatusCreated))
+		Expect(string(responseBody)).To(Equal(`{"name": "some-app"}`))
+		Expect(startedAt).NotTo(BeZero())
+		Expect(requestErr).NotTo(HaveOccurred())
+	})
+
+	It("leaves the request body for the connection", func() {
+		sentRequest, _ := fakeConnection.MakeArgsForCall(0)
+		sentBody, err := ioutil.ReadAll(sentRequest.Body)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(sentBody)).To(Equal(`{"name": "some-app"}`))
+	})
+
+	When("the request body is not JSON or a form", func() {
+		BeforeEach(func() {
+			request.Header.Set("Content-Type", "application/zip")
+		})
+
+		It("does not record it", func() {
+			_, requestBody, _, _, _, _ := fakeOutput.WriteEntryArgsForCall(0)
+			Expect(requestBody).To(BeEmpty())
+		})
+	})
+
+	When("the request fails", func() {
+		BeforeEach(func() {
+			fakeConnection.MakeStub = func(_ *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
+				return ccerror.RequestError{Err: errors.New("connection refused")}
+			}
+		})
+
+		It("records the error and returns it", func() {
+			Expect(makeErr).To(MatchError(ccerror.RequestError{Err: errors.New("connection refused")}))
+
+			_, _, resp, _, _, requestErr := fakeOutput.WriteEntryArgsForCall(0)
+			Expect(resp).To(BeNil())
+			Expect(requestErr).To(MatchError(makeErr))
+		})
+	})
+})
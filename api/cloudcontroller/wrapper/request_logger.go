@@ -48,8 +48,16 @@ func (logger *RequestLogger) Make(request *cloudcontroller.Request, passedRespon
 		logger.output.HandleInternalError(err)
 	}
 
+	requestedHost := request.URL.Host
 	err = logger.connection.Make(request, passedResponse)
 
+	if request.URL.Host != requestedHost {
+		displayErr := logger.displayFailover(request.URL.Host)
+		if displayErr != nil {
+			logger.output.HandleInternalError(displayErr)
+		}
+	}
+
 	if passedResponse.HTTPResponse != nil {
 		displayErr := logger.displayResponse(passedResponse)
 		if displayErr != nil {
@@ -118,6 +126,18 @@ func (logger *RequestLogger) displayRequest(request *cloudcontroller.Request) er
 	return nil
 }
 
+// displayFailover shows the endpoint a request failed over to, when it was
+// not sent to the host it was made for.
+func (logger *RequestLogger) displayFailover(host string) error {
+	err := logger.output.Start()
+	if err != nil {
+		return err
+	}
+	defer logger.output.Stop()
+
+	return logger.output.DisplayMessage(fmt.Sprintf("[Failed over to endpoint %s]", host))
+}
+
 func (logger *RequestLogger) displayResponse(passedResponse *cloudcontroller.Response) error {
 	err := logger.output.Start()
 	if err != nil {
@@ -348,6 +348,22 @@ var _ = Describe("Request Logger", func() {
 			})
 		})
 
+		When("the connection fails over to another endpoint", func() {
+			BeforeEach(func() {
+				fakeConnection.MakeStub = func(request *cloudcontroller.Request, _ *cloudcontroller.Response) error {
+					request.URL.Host = "foo.baz.com"
+					return nil
+				}
+			})
+
+			It("outputs the endpoint the request was sent to", func() {
+				Expect(makeErr).NotTo(HaveOccurred())
+
+				Expect(fakeOutput.DisplayMessageCallCount()).To(Equal(1))
+				Expect(fakeOutput.DisplayMessageArgsForCall(0)).To(Equal("[Failed over to endpoint foo.baz.com]"))
+			})
+		})
+
 		When("an error occurs while trying to log the response", func() {
 			var (
 				originalErr error
@@ -0,0 +1,44 @@
+package wrapper
+
+import (
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . TimingsRecorder
+
+// TimingsRecorder is the interface for recording how long requests take
+type TimingsRecorder interface {
+	RecordRequest(request *http.Request, response *http.Response, responseSize int, startedAt time.Time)
+}
+
+// RequestTimer is the wrapper that records the duration of every request to
+// the Cloud Controller server
+type RequestTimer struct {
+	connection cloudcontroller.Connection
+	output     TimingsRecorder
+}
+
+// NewRequestTimer returns a pointer to a RequestTimer wrapper
+func NewRequestTimer(output TimingsRecorder) *RequestTimer {
+	return &RequestTimer{
+		output: output,
+	}
+}
+
+// Make records how long the request and the response took
+func (timer *RequestTimer) Make(request *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
+	startedAt := time.Now()
+	err := timer.connection.Make(request, passedResponse)
+	timer.output.RecordRequest(request.Request, passedResponse.HTTPResponse, len(passedResponse.RawResponse), startedAt)
+
+	return err
+}
+
+// Wrap sets the connection on the RequestTimer and returns itself
+func (timer *RequestTimer) Wrap(innerconnection cloudcontroller.Connection) cloudcontroller.Connection {
+	timer.connection = innerconnection
+	return timer
+}
@@ -0,0 +1,72 @@
+package wrapper_test
+
+import (
+	"errors"
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/cloudcontrollerfakes"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/wrapper"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/wrapper/wrapperfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Request Timer", func() {
+	var (
+		fakeConnection *cloudcontrollerfakes.FakeConnection
+		fakeOutput     *wrapperfakes.FakeTimingsRecorder
+
+		request  *cloudcontroller.Request
+		response *cloudcontroller.Response
+		makeErr  error
+	)
+
+	BeforeEach(func() {
+		fakeConnection = new(cloudcontrollerfakes.FakeConnection)
+		fakeConnection.MakeStub = func(_ *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
+			passedResponse.HTTPResponse = &http.Response{StatusCode: http.StatusOK}
+			passedResponse.RawResponse = []byte(`{"resources": []}`)
+			return nil
+		}
+		fakeOutput = new(wrapperfakes.FakeTimingsRecorder)
+
+		req, err := http.NewRequest(http.MethodGet, "https://api.example.com/v3/apps", nil)
+		Expect(err).NotTo(HaveOccurred())
+		request = cloudcontroller.NewRequest(req, nil)
+		response = &cloudcontroller.Response{}
+	})
+
+	JustBeforeEach(func() {
+		makeErr = NewRequestTimer(fakeOutput).Wrap(fakeConnection).Make(request, response)
+	})
+
+	It("records the request, the response and its size", func() {
+		Expect(makeErr).NotTo(HaveOccurred())
+		Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+		Expect(fakeOutput.RecordRequestCallCount()).To(Equal(1))
+
+		req, resp, size, startedAt := fakeOutput.RecordRequestArgsForCall(0)
+		Expect(req).To(Equal(request.Request))
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		Expect(size).To(Equal(len(`{"resources": []}`)))
+		Expect(startedAt).NotTo(BeZero())
+	})
+
+	When("the request fails", func() {
+		BeforeEach(func() {
+			fakeConnection.MakeStub = func(_ *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
+				return ccerror.RequestError{Err: errors.New("connection refused")}
+			}
+		})
+
+		It("records the request without a response and returns the error", func() {
+			Expect(makeErr).To(MatchError(ccerror.RequestError{Err: errors.New("connection refused")}))
+
+			_, resp, size, _ := fakeOutput.RecordRequestArgsForCall(0)
+			Expect(resp).To(BeNil())
+			Expect(size).To(BeZero())
+		})
+	})
+})
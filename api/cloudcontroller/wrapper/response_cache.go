@@ -0,0 +1,228 @@
+package wrapper
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+)
+
+// cachedResponse is a GET response stored by the ResponseCache.
+type cachedResponse struct {
+	URL          string    `json:"url"`
+	ETag         string    `json:"etag,omitempty"`
+	LastModified string    `json:"last_modified,omitempty"`
+	StoredAt     time.Time `json:"stored_at"`
+	Warnings     []string  `json:"warnings,omitempty"`
+	Body         []byte    `json:"body"`
+}
+
+// cacheableCollections are the resources whose GET responses are stored by
+// the ResponseCache. They rarely change and hold nothing secret. Anything
+// that is polled for a change of state, such as jobs, process stats and
+// deployments, or that holds credentials, such as app environments and
+// service credential bindings, must never be added here.
+var cacheableCollections = []string{
+	"/v3/buildpacks",
+	"/v3/domains",
+	"/v3/feature_flags",
+	"/v3/service_offerings",
+	"/v3/service_plans",
+	"/v3/stacks",
+}
+
+// ResponseCache is a wrapper that stores successful GET responses of rarely
+// changing collections in a directory on disk. A stored response younger than
+// the TTL is returned without making a request. An older one is revalidated
+// with If-None-Match and If-Modified-Since and returned again when the Cloud
+// Controller answers 304 Not Modified. Other GET requests are passed through
+// untouched, and any other request empties the cache, so changes made with
+// the CLI are seen by the next command.
+type ResponseCache struct {
+	connection cloudcontroller.Connection
+	directory  string
+	ttl        time.Duration
+}
+
+// NewResponseCache returns a pointer to a ResponseCache wrapper that stores
+// responses in the given directory. The directory should be different for
+// every API target and user.
+func NewResponseCache(directory string, ttl time.Duration) *ResponseCache {
+	return &ResponseCache{
+		directory: directory,
+		ttl:       ttl,
+	}
+}
+
+// Make returns the stored response for GET requests when it is still fresh
+// or has not been modified, and otherwise makes the request and stores the
+// response.
+func (cache *ResponseCache) Make(request *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
+	if request.Method != http.MethodGet {
+		err := cache.connection.Make(request, passedResponse)
+		_ = os.RemoveAll(cache.directory)
+		return err
+	}
+
+	if !isCacheable(request) {
+		return cache.connection.Make(request, passedResponse)
+	}
+
+	path := cache.path(request)
+	entry, found := cache.read(path, request.URL.String())
+	if found && time.Since(entry.StoredAt) < cache.ttl {
+		return cache.respondWith(entry, request, passedResponse)
+	}
+
+	if found {
+		if entry.ETag != "" {
+			request.Header.Set("If-None-Match", entry.ETag)
+		}
+		if entry.LastModified != "" {
+			request.Header.Set("If-Modified-Since", entry.LastModified)
+		}
+	}
+
+	// The response is decoded here instead of in the wrapped connection
+	// because a 304 Not Modified response has no body to decode.
+	response := cloudcontroller.Response{}
+	err := cache.connection.Make(request, &response)
+	passedResponse.RawResponse = response.RawResponse
+	passedResponse.Warnings = response.Warnings
+	passedResponse.HTTPResponse = response.HTTPResponse
+	passedResponse.ResourceLocationURL = response.ResourceLocationURL
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case found && response.HTTPResponse.StatusCode == http.StatusNotModified:
+		entry.StoredAt = time.Now()
+		if len(response.Warnings) > 0 {
+			entry.Warnings = response.Warnings
+		}
+		cache.write(path, entry)
+		return cache.respondWith(entry, request, passedResponse)
+	case response.HTTPResponse.StatusCode == http.StatusOK:
+		cache.write(path, cachedResponse{
+			URL:          request.URL.String(),
+			ETag:         response.HTTPResponse.Header.Get("ETag"),
+			LastModified: response.HTTPResponse.Header.Get("Last-Modified"),
+			StoredAt:     time.Now(),
+			Warnings:     response.Warnings,
+			Body:         response.RawResponse,
+		})
+	}
+
+	return cache.decode(passedResponse)
+}
+
+// Wrap sets the connection on the ResponseCache and returns itself.
+func (cache *ResponseCache) Wrap(innerconnection cloudcontroller.Connection) cloudcontroller.Connection {
+	cache.connection = innerconnection
+	return cache
+}
+
+// isCacheable returns true when the request is for one of the
+// cacheableCollections or for a single resource in one of them.
+func isCacheable(request *cloudcontroller.Request) bool {
+	path := strings.TrimSuffix(request.URL.Path, "/")
+	for _, collection := range cacheableCollections {
+		if path == collection {
+			return true
+		}
+		if guid := strings.TrimPrefix(path, collection+"/"); guid != path && !strings.Contains(guid, "/") {
+			return true
+		}
+	}
+	return false
+}
+
+func (cache *ResponseCache) respondWith(entry cachedResponse, request *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
+	header := http.Header{}
+	if entry.ETag != "" {
+		header.Set("ETag", entry.ETag)
+	}
+	if entry.LastModified != "" {
+		header.Set("Last-Modified", entry.LastModified)
+	}
+
+	passedResponse.RawResponse = entry.Body
+	passedResponse.Warnings = entry.Warnings
+	passedResponse.HTTPResponse = &http.Response{
+		Status:     "200 OK",
+		StatusCode: http.StatusOK,
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Header:     header,
+		Body:       http.NoBody,
+		Request:    request.Request,
+	}
+	return cache.decode(passedResponse)
+}
+
+func (*ResponseCache) decode(passedResponse *cloudcontroller.Response) error {
+	if passedResponse.DecodeJSONResponseInto == nil {
+		return nil
+	}
+	return cloudcontroller.DecodeJSON(passedResponse.RawResponse, passedResponse.DecodeJSONResponseInto)
+}
+
+func (cache *ResponseCache) path(request *cloudcontroller.Request) string {
+	sum := sha256.Sum256([]byte(request.URL.String()))
+	return filepath.Join(cache.directory, hex.EncodeToString(sum[:])+".json")
+}
+
+// read returns the response stored for the URL. Unreadable entries are
+// treated as missing.
+func (*ResponseCache) read(path string, url string) (cachedResponse, bool) {
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		return cachedResponse{}, false
+	}
+
+	var entry cachedResponse
+	err = json.Unmarshal(raw, &entry)
+	if err != nil || entry.URL != url {
+		return cachedResponse{}, false
+	}
+	return entry, true
+}
+
+// write stores the response. Failing to store a response does not fail the
+// request, so errors are ignored.
+func (cache *ResponseCache) write(path string, entry cachedResponse) {
+	raw, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+
+	err = os.MkdirAll(cache.directory, 0700)
+	if err != nil {
+		return
+	}
+
+	tempFile, err := ioutil.TempFile(cache.directory, "response")
+	if err != nil {
+		return
+	}
+	_, err = tempFile.Write(raw)
+	closeErr := tempFile.Close()
+	if err != nil || closeErr != nil {
+		_ = os.Remove(tempFile.Name())
+		return
+	}
+
+	err = os.Rename(tempFile.Name(), path)
+	if err != nil {
+		_ = os.Remove(tempFile.Name())
+	}
+}
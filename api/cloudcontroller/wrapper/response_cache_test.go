@@ -0,0 +1,215 @@
+package wrapper_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/cloudcontrollerfakes"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/wrapper"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Response Cache", func() {
+	var (
+		fakeConnection *cloudcontrollerfakes.FakeConnection
+		cacheDir       string
+		ttl            time.Duration
+		wrapper        cloudcontroller.Connection
+		statusCode     int
+		header         http.Header
+	)
+
+	type stack struct {
+		Name string `json:"name"`
+	}
+
+	makeRequestTo := func(method string, path string) (stack, *cloudcontroller.Response, error) {
+		req, err := http.NewRequest(method, "https://api.example.com"+path, nil)
+		Expect(err).NotTo(HaveOccurred())
+		req.Header = http.Header{}
+
+		var result stack
+		response := &cloudcontroller.Response{DecodeJSONResponseInto: &result}
+		err = wrapper.Make(cloudcontroller.NewRequest(req, nil), response)
+		return result, response, err
+	}
+
+	makeRequest := func(method string) (stack, *cloudcontroller.Response, error) {
+		return makeRequestTo(method, "/v3/stacks")
+	}
+
+	BeforeEach(func() {
+		var err error
+		cacheDir, err = ioutil.TempDir("", "response-cache")
+		Expect(err).NotTo(HaveOccurred())
+
+		ttl = time.Hour
+		statusCode = http.StatusOK
+		header = http.Header{"Etag": {`"some-etag"`}, "Last-Modified": {"Mon, 02 Jan 2006 15:04:05 GMT"}}
+
+		fakeConnection = new(cloudcontrollerfakes.FakeConnection)
+		fakeConnection.MakeStub = func(request *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
+			passedResponse.HTTPResponse = &http.Response{StatusCode: statusCode, Header: header}
+			passedResponse.Warnings = []string{"some-warning"}
+			if statusCode == http.StatusOK {
+				passedResponse.RawResponse = []byte(`{"name": "cflinuxfs4"}`)
+			}
+			if statusCode >= 400 {
+				return ccerror.RawHTTPStatusError{StatusCode: statusCode}
+			}
+			return nil
+		}
+	})
+
+	JustBeforeEach(func() {
+		wrapper = NewResponseCache(cacheDir, ttl).Wrap(fakeConnection)
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(cacheDir)).To(Succeed())
+	})
+
+	It("makes the first GET request and decodes the response", func() {
+		result, response, err := makeRequest(http.MethodGet)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Name).To(Equal("cflinuxfs4"))
+		Expect(response.Warnings).To(ConsistOf("some-warning"))
+		Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+
+		request, _ := fakeConnection.MakeArgsForCall(0)
+		Expect(request.Header.Get("If-None-Match")).To(BeEmpty())
+	})
+
+	When("a fresh response is stored", func() {
+		JustBeforeEach(func() {
+			_, _, err := makeRequest(http.MethodGet)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("returns it without making a request", func() {
+			result, response, err := makeRequest(http.MethodGet)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Name).To(Equal("cflinuxfs4"))
+			Expect(response.Warnings).To(ConsistOf("some-warning"))
+			Expect(response.HTTPResponse.StatusCode).To(Equal(http.StatusOK))
+			Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+		})
+
+		When("another request is made", func() {
+			It("empties the cache", func() {
+				_, _, err := makeRequest(http.MethodPost)
+				Expect(err).NotTo(HaveOccurred())
+
+				_, _, err = makeRequest(http.MethodGet)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fakeConnection.MakeCallCount()).To(Equal(3))
+			})
+		})
+	})
+
+	DescribeTable("it never stores responses that change often or hold secrets",
+		func(path string) {
+			_, _, err := makeRequestTo(http.MethodGet, path)
+			Expect(err).NotTo(HaveOccurred())
+			_, _, err = makeRequestTo(http.MethodGet, path)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeConnection.MakeCallCount()).To(Equal(2))
+			files, err := ioutil.ReadDir(cacheDir)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(files).To(BeEmpty())
+		},
+
+		Entry("jobs", "/v3/jobs/some-job-guid"),
+		Entry("process stats", "/v3/processes/some-process-guid/stats"),
+		Entry("deployments", "/v3/deployments/some-deployment-guid"),
+		Entry("app environments", "/v3/apps/some-app-guid/env"),
+		Entry("credential binding details", "/v3/service_credential_bindings/some-binding-guid/details"),
+		Entry("apps", "/v3/apps"),
+		Entry("nested resources of cacheable collections", "/v3/domains/some-domain-guid/route_reservations"),
+	)
+
+	DescribeTable("it stores responses of rarely changing collections",
+		func(path string) {
+			_, _, err := makeRequestTo(http.MethodGet, path)
+			Expect(err).NotTo(HaveOccurred())
+			_, _, err = makeRequestTo(http.MethodGet, path)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+		},
+
+		Entry("stacks", "/v3/stacks?names=cflinuxfs4"),
+		Entry("buildpacks", "/v3/buildpacks"),
+		Entry("feature flags", "/v3/feature_flags/diego_docker"),
+		Entry("domains", "/v3/domains"),
+		Entry("service offerings", "/v3/service_offerings"),
+		Entry("service plans", "/v3/service_plans/some-plan-guid"),
+	)
+
+	When("the stored response is older than the TTL", func() {
+		BeforeEach(func() {
+			ttl = time.Nanosecond
+		})
+
+		JustBeforeEach(func() {
+			_, _, err := makeRequest(http.MethodGet)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("revalidates it", func() {
+			_, _, err := makeRequest(http.MethodGet)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fakeConnection.MakeCallCount()).To(Equal(2))
+
+			request, _ := fakeConnection.MakeArgsForCall(1)
+			Expect(request.Header.Get("If-None-Match")).To(Equal(`"some-etag"`))
+			Expect(request.Header.Get("If-Modified-Since")).To(Equal("Mon, 02 Jan 2006 15:04:05 GMT"))
+		})
+
+		When("the response has not been modified", func() {
+			It("returns the stored response", func() {
+				statusCode = http.StatusNotModified
+
+				result, response, err := makeRequest(http.MethodGet)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result.Name).To(Equal("cflinuxfs4"))
+				Expect(response.HTTPResponse.StatusCode).To(Equal(http.StatusOK))
+			})
+		})
+
+		When("the request fails", func() {
+			It("returns the error", func() {
+				statusCode = http.StatusInternalServerError
+
+				_, response, err := makeRequest(http.MethodGet)
+				Expect(err).To(MatchError(ccerror.RawHTTPStatusError{StatusCode: http.StatusInternalServerError}))
+				Expect(response.Warnings).To(ConsistOf("some-warning"))
+			})
+		})
+	})
+
+	When("the stored response is not valid", func() {
+		It("makes the request", func() {
+			_, _, err := makeRequest(http.MethodGet)
+			Expect(err).NotTo(HaveOccurred())
+
+			files, err := ioutil.ReadDir(cacheDir)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(files).To(HaveLen(1))
+			Expect(ioutil.WriteFile(filepath.Join(cacheDir, files[0].Name()), []byte("not json"), 0600)).To(Succeed())
+
+			result, _, err := makeRequest(http.MethodGet)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Name).To(Equal("cflinuxfs4"))
+			Expect(fakeConnection.MakeCallCount()).To(Equal(2))
+		})
+	})
+})
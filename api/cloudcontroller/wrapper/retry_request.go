@@ -1,17 +1,44 @@
 package wrapper
 
 import (
+	"math"
+	"math/rand"
 	"net/http"
+	"strconv"
+	"sync"
+	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 )
 
+const (
+	// maxRateLimitRetries is the number of times a rate limited request is
+	// retried. These retries are on top of the retries for 5XX errors.
+	maxRateLimitRetries = 5
+
+	// maxRateLimitWait is the longest the CLI waits for a rate limit to reset.
+	// Requests that would have to wait longer fail straight away.
+	maxRateLimitWait = 5 * time.Minute
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . RateLimitNotifier
+
+// RateLimitNotifier is told when a request waits for a rate limit to reset.
+type RateLimitNotifier interface {
+	RateLimited(wait time.Duration)
+}
+
 // RetryRequest is a wrapper that retries failed requests if they contain a 5XX
-// status code.
+// status code. Idempotent requests that are rate limited (429) are retried
+// once the rate limit resets.
 type RetryRequest struct {
 	maxRetries int
 	connection cloudcontroller.Connection
+	notifier   RateLimitNotifier
+
+	lock         sync.Mutex
+	blockedUntil time.Time
 }
 
 // NewRetryRequest returns a pointer to a RetryRequest wrapper.
@@ -21,18 +48,41 @@ func NewRetryRequest(maxRetries int) *RetryRequest {
 	}
 }
 
-// Make retries the request if it comes back with a 5XX status code.
+// SetRateLimitNotifier sets the notifier that is told when a request waits
+// for a rate limit to reset.
+func (retry *RetryRequest) SetRateLimitNotifier(notifier RateLimitNotifier) {
+	retry.notifier = notifier
+}
+
+// Make retries the request if it comes back with a 5XX status code, or with a
+// 429 status code for idempotent requests.
 func (retry *RetryRequest) Make(request *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
 	var err error
 
-	for i := 0; i < retry.maxRetries+1; i++ {
+	serverRetries, rateLimitRetries := 0, 0
+	for {
+		retry.waitForRateLimitReset()
+
 		err = retry.connection.Make(request, passedResponse)
+		retry.recordRateLimit(passedResponse.HTTPResponse)
 		if err == nil {
 			return nil
 		}
 
-		if retry.skipRetry(request.Method, passedResponse.HTTPResponse) {
-			break
+		if isRateLimited(passedResponse.HTTPResponse) {
+			resetAt := rateLimitResetAt(passedResponse.HTTPResponse)
+			wait := rateLimitWait(resetAt, rateLimitRetries)
+			if !isIdempotent(request.Method) || rateLimitRetries == maxRateLimitRetries || wait > maxRateLimitWait {
+				return rateLimitExceededError(err, resetAt)
+			}
+
+			rateLimitRetries++
+			retry.wait(wait)
+		} else {
+			if retry.skipRetry(request.Method, passedResponse.HTTPResponse) || serverRetries == retry.maxRetries {
+				break
+			}
+			serverRetries++
 		}
 
 		// Reset the request body prior to the next retry
@@ -63,3 +113,99 @@ func (*RetryRequest) skipRetry(httpMethod string, response *http.Response) bool
 			response.StatusCode != http.StatusServiceUnavailable &&
 			response.StatusCode != http.StatusGatewayTimeout
 }
+
+// recordRateLimit remembers when the rate limit resets if the response says
+// there are no requests left, so the next request waits instead of being
+// rejected.
+func (retry *RetryRequest) recordRateLimit(response *http.Response) {
+	if response == nil || response.Header.Get("X-RateLimit-Remaining") != "0" {
+		return
+	}
+
+	resetAt := rateLimitResetAt(response)
+	if resetAt.IsZero() {
+		return
+	}
+
+	retry.lock.Lock()
+	defer retry.lock.Unlock()
+	if resetAt.After(retry.blockedUntil) {
+		retry.blockedUntil = resetAt
+	}
+}
+
+func (retry *RetryRequest) waitForRateLimitReset() {
+	retry.lock.Lock()
+	wait := time.Until(retry.blockedUntil)
+	retry.lock.Unlock()
+
+	if wait > 0 && wait <= maxRateLimitWait {
+		retry.wait(wait)
+	}
+}
+
+// wait sleeps for the given time plus up to a fifth more, so that concurrent
+// requests do not all retry at the same moment.
+func (retry *RetryRequest) wait(wait time.Duration) {
+	wait += time.Duration(rand.Int63n(int64(wait/5) + 1))
+	if retry.notifier != nil {
+		retry.notifier.RateLimited(wait)
+	}
+	time.Sleep(wait)
+}
+
+func isRateLimited(response *http.Response) bool {
+	return response != nil && response.StatusCode == http.StatusTooManyRequests
+}
+
+func isIdempotent(httpMethod string) bool {
+	switch httpMethod {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// rateLimitResetAt returns the time from the Retry-After header, which is
+// either a number of seconds or a date, or else from the X-RateLimit-Reset
+// header, which is a Unix time. It returns the zero time when neither is set.
+func rateLimitResetAt(response *http.Response) time.Time {
+	if retryAfter := response.Header.Get("Retry-After"); retryAfter != "" {
+		if seconds, err := strconv.Atoi(retryAfter); err == nil {
+			return time.Now().Add(time.Duration(seconds) * time.Second)
+		}
+		if date, err := http.ParseTime(retryAfter); err == nil {
+			return date
+		}
+	}
+
+	if reset, err := strconv.ParseInt(response.Header.Get("X-RateLimit-Reset"), 10, 64); err == nil {
+		return time.Unix(reset, 0)
+	}
+
+	return time.Time{}
+}
+
+// rateLimitWait returns how long to wait before retrying a rate limited
+// request. Without a reset time it backs off exponentially from one second.
+func rateLimitWait(resetAt time.Time, retries int) time.Duration {
+	if resetAt.IsZero() {
+		return time.Duration(math.Pow(2, float64(retries))) * time.Second
+	}
+
+	wait := time.Until(resetAt)
+	if wait < 0 {
+		return 0
+	}
+	return wait
+}
+
+func rateLimitExceededError(err error, resetAt time.Time) error {
+	rateLimitErr, ok := err.(ccerror.RateLimitExceededError)
+	if !ok {
+		rateLimitErr = ccerror.RateLimitExceededError{Message: err.Error()}
+	}
+	rateLimitErr.ResetAt = resetAt
+	return rateLimitErr
+}
@@ -4,12 +4,15 @@ import (
 	"errors"
 	"io/ioutil"
 	"net/http"
+	"strconv"
 	"strings"
+	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/cloudcontrollerfakes"
 	. "code.cloudfoundry.org/cli/api/cloudcontroller/wrapper"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/wrapper/wrapperfakes"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
@@ -114,4 +117,148 @@ var _ = Describe("Retry Request", func() {
 			Expect(fakeConnection.MakeCallCount()).To(Equal(1))
 		})
 	})
+
+	When("the request is rate limited", func() {
+		var (
+			requestMethod string
+			header        http.Header
+			request       *cloudcontroller.Request
+			response      *cloudcontroller.Response
+			rateLimitErr  ccerror.RateLimitExceededError
+
+			fakeConnection *cloudcontrollerfakes.FakeConnection
+			fakeNotifier   *wrapperfakes.FakeRateLimitNotifier
+			makeErr        error
+		)
+
+		BeforeEach(func() {
+			requestMethod = http.MethodGet
+			header = http.Header{"Retry-After": {"0"}}
+			rateLimitErr = ccerror.RateLimitExceededError{Message: "Rate Limit Exceeded"}
+
+			fakeConnection = new(cloudcontrollerfakes.FakeConnection)
+			fakeConnection.MakeStub = func(_ *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
+				passedResponse.HTTPResponse = &http.Response{StatusCode: http.StatusTooManyRequests, Header: header}
+				return rateLimitErr
+			}
+			fakeNotifier = new(wrapperfakes.FakeRateLimitNotifier)
+		})
+
+		JustBeforeEach(func() {
+			req, err := http.NewRequest(requestMethod, "https://foo.bar.com/banana", nil)
+			Expect(err).NotTo(HaveOccurred())
+			request = cloudcontroller.NewRequest(req, nil)
+			response = &cloudcontroller.Response{}
+
+			retry := NewRetryRequest(2)
+			retry.SetRateLimitNotifier(fakeNotifier)
+			makeErr = retry.Wrap(fakeConnection).Make(request, response)
+		})
+
+		When("the rate limit resets before the retries run out", func() {
+			BeforeEach(func() {
+				fakeConnection.MakeStub = func(_ *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
+					if fakeConnection.MakeCallCount() < 4 {
+						passedResponse.HTTPResponse = &http.Response{StatusCode: http.StatusTooManyRequests, Header: header}
+						return rateLimitErr
+					}
+					passedResponse.HTTPResponse = &http.Response{StatusCode: http.StatusOK}
+					return nil
+				}
+			})
+
+			It("retries the request and tells the notifier it is waiting", func() {
+				Expect(makeErr).NotTo(HaveOccurred())
+				Expect(fakeConnection.MakeCallCount()).To(Equal(4))
+				Expect(fakeNotifier.RateLimitedCallCount()).To(Equal(3))
+			})
+		})
+
+		It("gives up after 5 retries and returns when the limit resets", func() {
+			Expect(makeErr).To(BeAssignableToTypeOf(ccerror.RateLimitExceededError{}))
+			Expect(makeErr).To(MatchError("Rate Limit Exceeded"))
+			Expect(makeErr.(ccerror.RateLimitExceededError).ResetAt).To(BeTemporally("~", time.Now(), time.Second))
+			Expect(fakeConnection.MakeCallCount()).To(Equal(6))
+		})
+
+		When("the reset time is given as a Unix time", func() {
+			var resetAt time.Time
+
+			BeforeEach(func() {
+				resetAt = time.Now().Add(-time.Minute).Truncate(time.Second)
+				header = http.Header{"X-Ratelimit-Reset": {strconv.FormatInt(resetAt.Unix(), 10)}}
+			})
+
+			It("returns the reset time in the error", func() {
+				Expect(makeErr.(ccerror.RateLimitExceededError).ResetAt).To(BeTemporally("==", resetAt))
+				Expect(fakeConnection.MakeCallCount()).To(Equal(6))
+			})
+		})
+
+		When("the limit resets too far in the future", func() {
+			BeforeEach(func() {
+				header = http.Header{"Retry-After": {"3600"}}
+			})
+
+			It("does not wait and returns the error", func() {
+				Expect(makeErr).To(BeAssignableToTypeOf(ccerror.RateLimitExceededError{}))
+				Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+				Expect(fakeNotifier.RateLimitedCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the request is not idempotent", func() {
+			BeforeEach(func() {
+				requestMethod = http.MethodPost
+			})
+
+			It("does not retry the request", func() {
+				Expect(makeErr).To(BeAssignableToTypeOf(ccerror.RateLimitExceededError{}))
+				Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+			})
+		})
+
+		When("the error is not a RateLimitExceededError", func() {
+			BeforeEach(func() {
+				requestMethod = http.MethodPost
+				fakeConnection.MakeStub = func(_ *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
+					passedResponse.HTTPResponse = &http.Response{StatusCode: http.StatusTooManyRequests, Header: header}
+					return ccerror.RawHTTPStatusError{StatusCode: http.StatusTooManyRequests}
+				}
+			})
+
+			It("converts it to a RateLimitExceededError", func() {
+				Expect(makeErr).To(BeAssignableToTypeOf(ccerror.RateLimitExceededError{}))
+			})
+		})
+	})
+
+	When("a response says there are no requests left", func() {
+		It("waits for the limit to reset before the next request", func() {
+			fakeConnection := new(cloudcontrollerfakes.FakeConnection)
+			fakeConnection.MakeStub = func(_ *cloudcontroller.Request, passedResponse *cloudcontroller.Response) error {
+				passedResponse.HTTPResponse = &http.Response{
+					StatusCode: http.StatusOK,
+					Header: http.Header{
+						"X-Ratelimit-Remaining": {"0"},
+						"X-Ratelimit-Reset":     {strconv.FormatInt(time.Now().Add(2*time.Second).Unix(), 10)},
+					},
+				}
+				return nil
+			}
+			fakeNotifier := new(wrapperfakes.FakeRateLimitNotifier)
+
+			retry := NewRetryRequest(2)
+			retry.SetRateLimitNotifier(fakeNotifier)
+			wrapper := retry.Wrap(fakeConnection)
+
+			req, err := http.NewRequest(http.MethodGet, "https://foo.bar.com/banana", nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(wrapper.Make(cloudcontroller.NewRequest(req, nil), &cloudcontroller.Response{})).To(Succeed())
+			Expect(fakeNotifier.RateLimitedCallCount()).To(Equal(0))
+
+			Expect(wrapper.Make(cloudcontroller.NewRequest(req, nil), &cloudcontroller.Response{})).To(Succeed())
+			Expect(fakeNotifier.RateLimitedCallCount()).To(Equal(1))
+		})
+	})
 })
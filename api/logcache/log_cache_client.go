@@ -1,7 +1,9 @@
 package logcache
 
 import (
+	"bytes"
 	"fmt"
+	"io/ioutil"
 	"net"
 	"net/http"
 	"runtime"
@@ -11,6 +13,7 @@ import (
 	logcache "code.cloudfoundry.org/go-log-cache"
 
 	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cassette"
 	"code.cloudfoundry.org/cli/api/shared"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/util"
@@ -105,19 +108,120 @@ func (c *httpDebugClient) Do(req *http.Request) (*http.Response, error) {
 	return resp, err
 }
 
+// HARWriter is the interface for recording requests in an HTTP Archive
+type HARWriter interface {
+	WriteEntry(request *http.Request, requestBody []byte, response *http.Response, responseBody []byte, startedAt time.Time, requestErr error)
+}
+
+type httpHARClient struct {
+	output HARWriter
+	c      logcache.HTTPClient
+}
+
+func (c *httpHARClient) Do(req *http.Request) (*http.Response, error) {
+	startedAt := time.Now()
+	resp, err := c.c.Do(req)
+	if err != nil {
+		c.output.WriteEntry(req, nil, nil, nil, startedAt, err)
+		return nil, err
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		c.output.WriteEntry(req, nil, nil, nil, startedAt, err)
+		return nil, err
+	}
+
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	c.output.WriteEntry(req, nil, resp, body, startedAt, nil)
+
+	return resp, nil
+}
+
+type httpCassetteClient struct {
+	recorder *cassette.Recorder
+	c        logcache.HTTPClient
+}
+
+func (c *httpCassetteClient) Do(req *http.Request) (*http.Response, error) {
+	resp, err := c.c.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	err = c.recorder.Record(cassette.NewRequest(req, nil), resp, body)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// TimingsRecorder is the interface for recording how long requests take
+type TimingsRecorder interface {
+	RecordRequest(request *http.Request, response *http.Response, responseSize int, startedAt time.Time)
+}
+
+type httpTimingsClient struct {
+	output TimingsRecorder
+	c      logcache.HTTPClient
+}
+
+func (c *httpTimingsClient) Do(req *http.Request) (*http.Response, error) {
+	startedAt := time.Now()
+	resp, err := c.c.Do(req)
+	if err != nil {
+		c.output.RecordRequest(req, nil, 0, startedAt)
+		return nil, err
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	c.output.RecordRequest(req, resp, len(body), startedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	return resp, nil
+}
+
 // NewClient returns back a configured Log Cache Client.
 func NewClient(logCacheEndpoint string, config command.Config, ui command.UI, k8sConfigGetter v7action.KubernetesConfigGetter) (*logcache.Client, error) {
+	certs, err := config.TLSCertificates()
+	if err != nil {
+		return nil, err
+	}
+	tlsConfig, err := util.NewTLSConfigWithCertificates(certs, config.SkipSSLValidation())
+	if err != nil {
+		return nil, err
+	}
+
 	var tr http.RoundTripper = &http.Transport{
 		Proxy:           http.ProxyFromEnvironment,
-		TLSClientConfig: util.NewTLSConfig(nil, config.SkipSSLValidation()),
+		TLSClientConfig: tlsConfig,
 		DialContext: (&net.Dialer{
 			KeepAlive: 30 * time.Second,
 			Timeout:   config.DialTimeout(),
 		}).DialContext,
 	}
 
+	if cassettePath := config.ReplayCassette(); cassettePath != "" {
+		tr, err = cassette.ReadPlayer(cassettePath)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	if config.IsCFOnK8s() {
-		var err error
 		tr, err = shared.WrapForCFOnK8sAuth(config, k8sConfigGetter, tr)
 		if err != nil {
 			return nil, err
@@ -130,6 +234,14 @@ func NewClient(logCacheEndpoint string, config command.Config, ui command.UI, k8
 		userAgent: fmt.Sprintf("%s/%s (%s; %s %s)", config.BinaryName(), config.BinaryVersion(), runtime.Version(), runtime.GOARCH, runtime.GOOS),
 	}
 
+	if cassettePath := config.RecordCassette(); cassettePath != "" && config.ReplayCassette() == "" {
+		client = &httpCassetteClient{recorder: cassette.NewRecorder(cassettePath), c: client}
+	}
+
+	if config.Timings() && ui != nil {
+		client = &httpTimingsClient{output: ui.RequestTimings(), c: client}
+	}
+
 	verbose, location := config.Verbose()
 	if verbose && ui != nil {
 		printer := DebugPrinter{}
@@ -141,6 +253,10 @@ func NewClient(logCacheEndpoint string, config command.Config, ui command.UI, k8
 		client = &httpDebugClient{printer: printer, c: client}
 	}
 
+	if harPath := config.TraceHAR(); harPath != "" && ui != nil {
+		client = &httpHARClient{output: ui.RequestLoggerHARWriter(harPath), c: client}
+	}
+
 	if !config.IsCFOnK8s() {
 		client = &tokenHTTPClient{
 			c:           client,
@@ -19,17 +19,23 @@ import (
 type ConnectionConfig struct {
 	DialTimeout       time.Duration
 	SkipSSLValidation bool
+	TLSCertificates   util.TLSCertificates
 }
 
 // RouterConnection represents the connection to Router
 type RouterConnection struct {
 	HTTPClient *http.Client
+
+	tlsErr error
 }
 
-// NewConnection returns a pointer to a new RouterConnection with the provided configuration
+// NewConnection returns a pointer to a new RouterConnection with the provided
+// configuration. If the TLS certificates cannot be loaded, every request made
+// with the connection returns the error.
 func NewConnection(config ConnectionConfig) *RouterConnection {
+	tlsConfig, tlsErr := util.NewTLSConfigWithCertificates(config.TLSCertificates, config.SkipSSLValidation)
 	tr := &http.Transport{
-		TLSClientConfig: util.NewTLSConfig(nil, config.SkipSSLValidation),
+		TLSClientConfig: tlsConfig,
 		Proxy:           http.ProxyFromEnvironment,
 		DialContext: (&net.Dialer{
 			KeepAlive: 30 * time.Second,
@@ -39,6 +45,7 @@ func NewConnection(config ConnectionConfig) *RouterConnection {
 
 	return &RouterConnection{
 		HTTPClient: &http.Client{Transport: tr},
+		tlsErr:     tlsErr,
 	}
 }
 
@@ -49,6 +56,10 @@ func (connection *RouterConnection) Make(request *Request, responseToPopulate *R
 	// error and we don't repopulate it in populateResponse.
 	responseToPopulate.reset()
 
+	if connection.tlsErr != nil {
+		return connection.tlsErr
+	}
+
 	httpResponse, err := connection.HTTPClient.Do(request.Request)
 	if err != nil {
 		// request could not be made, e.g., ssl handshake or tcp dial timeout
@@ -0,0 +1,103 @@
+package wrapper
+
+import (
+	"io/ioutil"
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cassette"
+	"code.cloudfoundry.org/cli/api/router"
+)
+
+// CassetteRecorder is the wrapper that records requests to and responses
+// from the routing API in a cassette. It must wrap the connection before
+// routing API errors are converted.
+type CassetteRecorder struct {
+	connection router.Connection
+	recorder   *cassette.Recorder
+}
+
+// NewCassetteRecorder returns a pointer to a CassetteRecorder wrapper
+func NewCassetteRecorder(recorder *cassette.Recorder) *CassetteRecorder {
+	return &CassetteRecorder{
+		recorder: recorder,
+	}
+}
+
+// Make makes the request and records it when a response is received
+func (recorder *CassetteRecorder) Make(request *router.Request, passedResponse *router.Response) error {
+	cassetteRequest, err := newCassetteRequest(request)
+	if err != nil {
+		return err
+	}
+
+	err = recorder.connection.Make(request, passedResponse)
+	if passedResponse.HTTPResponse == nil {
+		return err
+	}
+
+	recordErr := recorder.recorder.Record(cassetteRequest, passedResponse.HTTPResponse, passedResponse.RawResponse)
+	if err == nil {
+		err = recordErr
+	}
+	return err
+}
+
+// Wrap sets the connection on the CassetteRecorder and returns itself
+func (recorder *CassetteRecorder) Wrap(innerconnection router.Connection) router.Connection {
+	recorder.connection = innerconnection
+	return recorder
+}
+
+// CassettePlayer is the wrapper that answers requests to the routing API with
+// the responses in a cassette instead of making them. It must wrap the
+// connection before routing API errors are converted.
+type CassettePlayer struct {
+	player *cassette.Player
+}
+
+// NewCassettePlayer returns a pointer to a CassettePlayer wrapper
+func NewCassettePlayer(player *cassette.Player) *CassettePlayer {
+	return &CassettePlayer{
+		player: player,
+	}
+}
+
+// Make answers the request with the recorded response
+func (player *CassettePlayer) Make(request *router.Request, passedResponse *router.Response) error {
+	cassetteRequest, err := newCassetteRequest(request)
+	if err != nil {
+		return err
+	}
+
+	recorded, found := player.player.Play(cassetteRequest)
+	if !found {
+		return cassette.UnrecordedRequestError{Method: cassetteRequest.Method, URL: cassetteRequest.URL}
+	}
+
+	connection := router.RouterConnection{
+		HTTPClient: &http.Client{Transport: recorded.Transport()},
+	}
+	return connection.Make(request, passedResponse)
+}
+
+// Wrap returns the CassettePlayer. The wrapped connection is never used.
+func (player *CassettePlayer) Wrap(router.Connection) router.Connection {
+	return player
+}
+
+func newCassetteRequest(request *router.Request) (cassette.Request, error) {
+	var body []byte
+	if request.Body != nil && cassette.RecordsBody(request.Header.Get("Content-Type")) {
+		var err error
+		body, err = ioutil.ReadAll(request.Body)
+		if err != nil {
+			return cassette.Request{}, err
+		}
+		err = request.ResetBody()
+		if err != nil {
+			return cassette.Request{}, err
+		}
+	}
+
+	return cassette.NewRequest(request.Request, body), nil
+}
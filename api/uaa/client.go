@@ -33,10 +33,16 @@ func NewClient(config Config) *Client {
 		runtime.GOOS,
 	)
 
+	certs, err := config.TLSCertificates()
+	connection := NewConnection(config.SkipSSLValidation(), config.UAADisableKeepAlives(), config.DialTimeout(), certs)
+	if err != nil {
+		connection.tlsErr = err
+	}
+
 	client := Client{
 		config: config,
 
-		connection: NewConnection(config.SkipSSLValidation(), config.UAADisableKeepAlives(), config.DialTimeout()),
+		connection: connection,
 		userAgent:  userAgent,
 	}
 	client.WrapConnection(NewErrorWrapper())
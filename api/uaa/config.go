@@ -1,6 +1,10 @@
 package uaa
 
-import "time"
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/util"
+)
 
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . Config
 
@@ -29,6 +33,11 @@ type Config interface {
 	// be used only for testing.
 	SkipSSLValidation() bool
 
+	// TLSCertificates returns the additional trusted CA and the client
+	// certificate used when connecting to UAA, or an error if they cannot be
+	// read.
+	TLSCertificates() (util.TLSCertificates, error)
+
 	// UAADisableKeepAlives controls whether the UAA client will reuse TCP connections
 	// for multiple requests. If true, the client will always use a new TCP request
 	// and set Connection: close in the request header. If false, the client
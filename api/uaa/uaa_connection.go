@@ -16,10 +16,15 @@ import (
 // UAAConnection represents the connection to UAA
 type UAAConnection struct {
 	HTTPClient *http.Client
+
+	tlsErr error
 }
 
-// NewConnection returns a pointer to a new UAA Connection
-func NewConnection(skipSSLValidation bool, disableKeepAlives bool, dialTimeout time.Duration) *UAAConnection {
+// NewConnection returns a pointer to a new UAA Connection. If the TLS
+// certificates cannot be loaded, every request made with the connection
+// returns the error.
+func NewConnection(skipSSLValidation bool, disableKeepAlives bool, dialTimeout time.Duration, certs util.TLSCertificates) *UAAConnection {
+	tlsConfig, tlsErr := util.NewTLSConfigWithCertificates(certs, skipSSLValidation)
 	tr := &http.Transport{
 		DialContext: (&net.Dialer{
 			KeepAlive: 30 * time.Second,
@@ -27,7 +32,7 @@ func NewConnection(skipSSLValidation bool, disableKeepAlives bool, dialTimeout t
 		}).DialContext,
 		DisableKeepAlives: disableKeepAlives,
 		Proxy:             http.ProxyFromEnvironment,
-		TLSClientConfig:   util.NewTLSConfig(nil, skipSSLValidation),
+		TLSClientConfig:   tlsConfig,
 	}
 
 	return &UAAConnection{
@@ -40,6 +45,7 @@ func NewConnection(skipSSLValidation bool, disableKeepAlives bool, dialTimeout t
 				return http.ErrUseLastResponse
 			},
 		},
+		tlsErr: tlsErr,
 	}
 }
 
@@ -51,6 +57,10 @@ func (connection *UAAConnection) Make(request *http.Request, passedResponse *Res
 	// error and we don't repopulate it in populateResponse.
 	passedResponse.reset()
 
+	if connection.tlsErr != nil {
+		return connection.tlsErr
+	}
+
 	response, err := connection.HTTPClient.Do(request)
 	if err != nil {
 		return connection.processRequestErrors(request, err)
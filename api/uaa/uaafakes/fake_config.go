@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/util"
 )
 
 type FakeConfig struct {
@@ -54,6 +55,18 @@ type FakeConfig struct {
 	skipSSLValidationReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	TLSCertificatesStub        func() (util.TLSCertificates, error)
+	tLSCertificatesMutex       sync.RWMutex
+	tLSCertificatesArgsForCall []struct {
+	}
+	tLSCertificatesReturns struct {
+		result1 util.TLSCertificates
+		result2 error
+	}
+	tLSCertificatesReturnsOnCall map[int]struct {
+		result1 util.TLSCertificates
+		result2 error
+	}
 	UAADisableKeepAlivesStub        func() bool
 	uAADisableKeepAlivesMutex       sync.RWMutex
 	uAADisableKeepAlivesArgsForCall []struct {
@@ -337,6 +350,61 @@ func (fake *FakeConfig) SkipSSLValidationReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
+func (fake *FakeConfig) TLSCertificates() (util.TLSCertificates, error) {
+	fake.tLSCertificatesMutex.Lock()
+	ret, specificReturn := fake.tLSCertificatesReturnsOnCall[len(fake.tLSCertificatesArgsForCall)]
+	fake.tLSCertificatesArgsForCall = append(fake.tLSCertificatesArgsForCall, struct {
+	}{})
+	fake.recordInvocation("TLSCertificates", []interface{}{})
+	fake.tLSCertificatesMutex.Unlock()
+	if fake.TLSCertificatesStub != nil {
+		return fake.TLSCertificatesStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.tLSCertificatesReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeConfig) TLSCertificatesCallCount() int {
+	fake.tLSCertificatesMutex.RLock()
+	defer fake.tLSCertificatesMutex.RUnlock()
+	return len(fake.tLSCertificatesArgsForCall)
+}
+
+func (fake *FakeConfig) TLSCertificatesCalls(stub func() (util.TLSCertificates, error)) {
+	fake.tLSCertificatesMutex.Lock()
+	defer fake.tLSCertificatesMutex.Unlock()
+	fake.TLSCertificatesStub = stub
+}
+
+func (fake *FakeConfig) TLSCertificatesReturns(result1 util.TLSCertificates, result2 error) {
+	fake.tLSCertificatesMutex.Lock()
+	defer fake.tLSCertificatesMutex.Unlock()
+	fake.TLSCertificatesStub = nil
+	fake.tLSCertificatesReturns = struct {
+		result1 util.TLSCertificates
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeConfig) TLSCertificatesReturnsOnCall(i int, result1 util.TLSCertificates, result2 error) {
+	fake.tLSCertificatesMutex.Lock()
+	defer fake.tLSCertificatesMutex.Unlock()
+	fake.TLSCertificatesStub = nil
+	if fake.tLSCertificatesReturnsOnCall == nil {
+		fake.tLSCertificatesReturnsOnCall = make(map[int]struct {
+			result1 util.TLSCertificates
+			result2 error
+		})
+	}
+	fake.tLSCertificatesReturnsOnCall[i] = struct {
+		result1 util.TLSCertificates
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeConfig) UAADisableKeepAlives() bool {
 	fake.uAADisableKeepAlivesMutex.Lock()
 	ret, specificReturn := fake.uAADisableKeepAlivesReturnsOnCall[len(fake.uAADisableKeepAlivesArgsForCall)]
@@ -558,6 +626,8 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.setUAAEndpointMutex.RUnlock()
 	fake.skipSSLValidationMutex.RLock()
 	defer fake.skipSSLValidationMutex.RUnlock()
+	fake.tLSCertificatesMutex.RLock()
+	defer fake.tLSCertificatesMutex.RUnlock()
 	fake.uAADisableKeepAlivesMutex.RLock()
 	defer fake.uAADisableKeepAlivesMutex.RUnlock()
 	fake.uAAGrantTypeMutex.RLock()
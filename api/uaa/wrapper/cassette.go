@@ -0,0 +1,91 @@
+package wrapper
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cassette"
+	"code.cloudfoundry.org/cli/api/uaa"
+)
+
+// CassetteRecorder is the wrapper that records requests to and responses
+// from the UAA server in a cassette
+type CassetteRecorder struct {
+	connection uaa.Connection
+	recorder   *cassette.Recorder
+}
+
+// NewCassetteRecorder returns a pointer to a CassetteRecorder wrapper
+func NewCassetteRecorder(recorder *cassette.Recorder) *CassetteRecorder {
+	return &CassetteRecorder{
+		recorder: recorder,
+	}
+}
+
+// Make makes the request and records it when a response is received
+func (recorder *CassetteRecorder) Make(request *http.Request, passedResponse *uaa.Response) error {
+	body, err := cassette.ReadBody(request)
+	if err != nil {
+		return err
+	}
+	cassetteRequest := cassette.NewRequest(request, body)
+
+	err = recorder.connection.Make(request, passedResponse)
+	if passedResponse.HTTPResponse == nil {
+		return err
+	}
+
+	recordErr := recorder.recorder.Record(cassetteRequest, passedResponse.HTTPResponse, passedResponse.RawResponse)
+	if err == nil {
+		err = recordErr
+	}
+	return err
+}
+
+// Wrap sets the connection on the CassetteRecorder and returns itself
+func (recorder *CassetteRecorder) Wrap(innerconnection uaa.Connection) uaa.Connection {
+	recorder.connection = innerconnection
+	return recorder
+}
+
+// CassettePlayer is the wrapper that answers requests to the UAA server with
+// the responses in a cassette instead of making them. Replayed errors are
+// converted the same way as errors from the UAA server.
+type CassettePlayer struct {
+	player *cassette.Player
+}
+
+// NewCassettePlayer returns a pointer to a CassettePlayer wrapper
+func NewCassettePlayer(player *cassette.Player) *CassettePlayer {
+	return &CassettePlayer{
+		player: player,
+	}
+}
+
+// Make answers the request with the recorded response
+func (player *CassettePlayer) Make(request *http.Request, passedResponse *uaa.Response) error {
+	body, err := cassette.ReadBody(request)
+	if err != nil {
+		return err
+	}
+	cassetteRequest := cassette.NewRequest(request, body)
+
+	recorded, found := player.player.Play(cassetteRequest)
+	if !found {
+		return cassette.UnrecordedRequestError{Method: cassetteRequest.Method, URL: cassetteRequest.URL}
+	}
+
+	connection := uaa.NewErrorWrapper().Wrap(&uaa.UAAConnection{
+		HTTPClient: &http.Client{
+			Transport: recorded.Transport(),
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+	})
+	return connection.Make(request, passedResponse)
+}
+
+// Wrap returns the CassettePlayer. The wrapped connection is never used.
+func (player *CassettePlayer) Wrap(uaa.Connection) uaa.Connection {
+	return player
+}
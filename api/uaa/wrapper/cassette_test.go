@@ -0,0 +1,86 @@
+package wrapper_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"code.cloudfoundry.org/cli/api/cassette"
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/api/uaa/uaafakes"
+	. "code.cloudfoundry.org/cli/api/uaa/wrapper"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Cassette", func() {
+	var (
+		fakeConnection *uaafakes.FakeConnection
+		cassetteDir    string
+		cassettePath   string
+	)
+
+	makeRequest := func(connection uaa.Connection, password string) (*uaa.Response, error) {
+		request, err := http.NewRequest(http.MethodPost, "https://uaa.example.com/oauth/token", strings.NewReader("grant_type=password&password="+password))
+		Expect(err).NotTo(HaveOccurred())
+		request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+		response := &uaa.Response{}
+		return response, connection.Make(request, response)
+	}
+
+	BeforeEach(func() {
+		var err error
+		cassetteDir, err = ioutil.TempDir("", "cassette")
+		Expect(err).NotTo(HaveOccurred())
+		cassettePath = filepath.Join(cassetteDir, "cassette.json")
+
+		fakeConnection = new(uaafakes.FakeConnection)
+		fakeConnection.MakeStub = func(request *http.Request, passedResponse *uaa.Response) error {
+			body, err := ioutil.ReadAll(request.Body)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(body)).To(HavePrefix("grant_type=password"))
+
+			passedResponse.HTTPResponse = &http.Response{StatusCode: http.StatusOK, Header: http.Header{"Content-Type": {"application/json"}}}
+			passedResponse.RawResponse = []byte(`{"access_token": "some-token", "token_type": "bearer"}`)
+			return nil
+		}
+
+		response, err := makeRequest(NewCassetteRecorder(cassette.NewRecorder(cassettePath)).Wrap(fakeConnection), "some-password")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(response.RawResponse)).To(ContainSubstring("some-token"))
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(cassetteDir)).To(Succeed())
+	})
+
+	It("records the request and the response without credentials", func() {
+		raw, err := ioutil.ReadFile(cassettePath)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(raw)).To(ContainSubstring("https://uaa.example.com/oauth/token"))
+		Expect(string(raw)).NotTo(ContainSubstring("some-password"))
+		Expect(string(raw)).NotTo(ContainSubstring("some-token"))
+	})
+
+	When("the cassette is replayed", func() {
+		var player uaa.Connection
+
+		BeforeEach(func() {
+			recorded, err := cassette.Read(cassettePath)
+			Expect(err).NotTo(HaveOccurred())
+			player = NewCassettePlayer(cassette.NewPlayer(recorded)).Wrap(nil)
+		})
+
+		It("returns the recorded response without making the request", func() {
+			response, err := makeRequest(player, "some-password")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response.HTTPResponse.StatusCode).To(Equal(http.StatusOK))
+			Expect(string(response.RawResponse)).To(ContainSubstring(`"token_type": "bearer"`))
+			Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+		})
+	})
+})
@@ -0,0 +1,57 @@
+package wrapper
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/uaa"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . HARWriter
+
+// HARWriter is the interface for recording requests in an HTTP Archive
+type HARWriter interface {
+	WriteEntry(request *http.Request, requestBody []byte, response *http.Response, responseBody []byte, startedAt time.Time, requestErr error)
+}
+
+// HARLogger is the wrapper that records requests to and responses from the
+// UAA server in an HTTP Archive
+type HARLogger struct {
+	connection uaa.Connection
+	output     HARWriter
+}
+
+// NewHARLogger returns a pointer to a HARLogger wrapper
+func NewHARLogger(output HARWriter) *HARLogger {
+	return &HARLogger{
+		output: output,
+	}
+}
+
+// Make records the request and the response in the HTTP Archive
+func (logger *HARLogger) Make(request *http.Request, passedResponse *uaa.Response) error {
+	var requestBody []byte
+	if request.Body != nil {
+		var err error
+		requestBody, err = ioutil.ReadAll(request.Body)
+		request.Body.Close()
+		if err != nil {
+			return err
+		}
+		request.Body = ioutil.NopCloser(bytes.NewBuffer(requestBody))
+	}
+
+	startedAt := time.Now()
+	err := logger.connection.Make(request, passedResponse)
+	logger.output.WriteEntry(request, requestBody, passedResponse.HTTPResponse, passedResponse.RawResponse, startedAt, err)
+
+	return err
+}
+
+// Wrap sets the connection on the HARLogger and returns itself
+func (logger *HARLogger) Wrap(innerconnection uaa.Connection) uaa.Connection {
+	logger.connection = innerconnection
+	return logger
+}
@@ -0,0 +1,62 @@
+package wrapper_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/api/uaa/uaafakes"
+	. "code.cloudfoundry.org/cli/api/uaa/wrapper"
+	"code.cloudfoundry.org/cli/api/uaa/wrapper/wrapperfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("HAR Logger", func() {
+	var (
+		fakeConnection *uaafakes.FakeConnection
+		fakeOutput     *wrapperfakes.FakeHARWriter
+
+		request  *http.Request
+		response *uaa.Response
+		makeErr  error
+	)
+
+	BeforeEach(func() {
+		fakeConnection = new(uaafakes.FakeConnection)
+		fakeConnection.MakeStub = func(_ *http.Request, passedResponse *uaa.Response) error {
+			passedResponse.HTTPResponse = &http.Response{StatusCode: http.StatusOK}
+			passedResponse.RawResponse = []byte(`{"access_token": "some-token"}`)
+			return nil
+		}
+		fakeOutput = new(wrapperfakes.FakeHARWriter)
+
+		var err error
+		request, err = http.NewRequest(http.MethodPost, "https://uaa.example.com/oauth/token", strings.NewReader("grant_type=password&password=some-password"))
+		Expect(err).NotTo(HaveOccurred())
+		response = &uaa.Response{}
+
+		makeErr = NewHARLogger(fakeOutput).Wrap(fakeConnection).Make(request, response)
+	})
+
+	It("records the request and the response", func() {
+		Expect(makeErr).NotTo(HaveOccurred())
+		Expect(fakeOutput.WriteEntryCallCount()).To(Equal(1))
+
+		req, requestBody, resp, responseBody, _, requestErr := fakeOutput.WriteEntryArgsForCall(0)
+		Expect(req).To(Equal(request))
+		Expect(string(requestBody)).To(Equal("grant_type=password&password=some-password"))
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		Expect(string(responseBody)).To(Equal(`{"access_token": "some-token"}`))
+		Expect(requestErr).NotTo(HaveOccurred())
+	})
+
+	It("leaves the request body for the connection", func() {
+		sentRequest, _ := fakeConnection.MakeArgsForCall(0)
+		sentBody, err := ioutil.ReadAll(sentRequest.Body)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(sentBody)).To(Equal("grant_type=password&password=some-password"))
+	})
+})
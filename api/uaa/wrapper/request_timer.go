@@ -0,0 +1,44 @@
+package wrapper
+
+import (
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/uaa"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . TimingsRecorder
+
+// TimingsRecorder is the interface for recording how long requests take
+type TimingsRecorder interface {
+	RecordRequest(request *http.Request, response *http.Response, responseSize int, startedAt time.Time)
+}
+
+// RequestTimer is the wrapper that records the duration of every request to
+// the UAA server
+type RequestTimer struct {
+	connection uaa.Connection
+	output     TimingsRecorder
+}
+
+// NewRequestTimer returns a pointer to a RequestTimer wrapper
+func NewRequestTimer(output TimingsRecorder) *RequestTimer {
+	return &RequestTimer{
+		output: output,
+	}
+}
+
+// Make records how long the request and the response took
+func (timer *RequestTimer) Make(request *http.Request, passedResponse *uaa.Response) error {
+	startedAt := time.Now()
+	err := timer.connection.Make(request, passedResponse)
+	timer.output.RecordRequest(request, passedResponse.HTTPResponse, len(passedResponse.RawResponse), startedAt)
+
+	return err
+}
+
+// Wrap sets the connection on the RequestTimer and returns itself
+func (timer *RequestTimer) Wrap(innerconnection uaa.Connection) uaa.Connection {
+	timer.connection = innerconnection
+	return timer
+}
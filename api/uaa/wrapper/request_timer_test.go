@@ -0,0 +1,52 @@
+package wrapper_test
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/api/uaa/uaafakes"
+	. "code.cloudfoundry.org/cli/api/uaa/wrapper"
+	"code.cloudfoundry.org/cli/api/uaa/wrapper/wrapperfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Request Timer", func() {
+	var (
+		fakeConnection *uaafakes.FakeConnection
+		fakeOutput     *wrapperfakes.FakeTimingsRecorder
+
+		request  *http.Request
+		response *uaa.Response
+		makeErr  error
+	)
+
+	BeforeEach(func() {
+		fakeConnection = new(uaafakes.FakeConnection)
+		fakeConnection.MakeStub = func(_ *http.Request, passedResponse *uaa.Response) error {
+			passedResponse.HTTPResponse = &http.Response{StatusCode: http.StatusOK}
+			passedResponse.RawResponse = []byte(`{"access_token": "some-token"}`)
+			return nil
+		}
+		fakeOutput = new(wrapperfakes.FakeTimingsRecorder)
+
+		var err error
+		request, err = http.NewRequest(http.MethodPost, "https://uaa.example.com/oauth/token", nil)
+		Expect(err).NotTo(HaveOccurred())
+		response = &uaa.Response{}
+
+		makeErr = NewRequestTimer(fakeOutput).Wrap(fakeConnection).Make(request, response)
+	})
+
+	It("records the request, the response and its size", func() {
+		Expect(makeErr).NotTo(HaveOccurred())
+		Expect(fakeOutput.RecordRequestCallCount()).To(Equal(1))
+
+		req, resp, size, startedAt := fakeOutput.RecordRequestArgsForCall(0)
+		Expect(req).To(Equal(request))
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		Expect(size).To(Equal(len(`{"access_token": "some-token"}`)))
+		Expect(startedAt).NotTo(BeZero())
+	})
+})
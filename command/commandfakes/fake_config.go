@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/util"
 	"code.cloudfoundry.org/cli/util/configv3"
 )
 
@@ -30,6 +31,21 @@ type FakeConfig struct {
 	accessTokenReturnsOnCall map[int]struct {
 		result1 string
 	}
+	ActiveFoundationStub        func() string
+	activeFoundationMutex       sync.RWMutex
+	activeFoundationArgsForCall []struct {
+	}
+	activeFoundationReturns struct {
+		result1 string
+	}
+	activeFoundationReturnsOnCall map[int]struct {
+		result1 string
+	}
+	AddFoundationStub        func(string)
+	addFoundationMutex       sync.RWMutex
+	addFoundationArgsForCall []struct {
+		arg1 string
+	}
 	AddPluginStub        func(configv3.Plugin)
 	addPluginMutex       sync.RWMutex
 	addPluginArgsForCall []struct {
@@ -91,6 +107,16 @@ type FakeConfig struct {
 	cFUsernameReturnsOnCall map[int]struct {
 		result1 string
 	}
+	CircuitBreakerFileStub        func() string
+	circuitBreakerFileMutex       sync.RWMutex
+	circuitBreakerFileArgsForCall []struct {
+	}
+	circuitBreakerFileReturns struct {
+		result1 string
+	}
+	circuitBreakerFileReturnsOnCall map[int]struct {
+		result1 string
+	}
 	ColorEnabledStub        func() configv3.ColorSetting
 	colorEnabledMutex       sync.RWMutex
 	colorEnabledArgsForCall []struct {
@@ -145,6 +171,26 @@ type FakeConfig struct {
 	dockerPasswordReturnsOnCall map[int]struct {
 		result1 string
 	}
+	EnableEncryptedTokenStoreStub        func() error
+	enableEncryptedTokenStoreMutex       sync.RWMutex
+	enableEncryptedTokenStoreArgsForCall []struct {
+	}
+	enableEncryptedTokenStoreReturns struct {
+		result1 error
+	}
+	enableEncryptedTokenStoreReturnsOnCall map[int]struct {
+		result1 error
+	}
+	EnvTargetStub        func() configv3.EnvTarget
+	envTargetMutex       sync.RWMutex
+	envTargetArgsForCall []struct {
+	}
+	envTargetReturns struct {
+		result1 configv3.EnvTarget
+	}
+	envTargetReturnsOnCall map[int]struct {
+		result1 configv3.EnvTarget
+	}
 	ExperimentalStub        func() bool
 	experimentalMutex       sync.RWMutex
 	experimentalArgsForCall []struct {
@@ -155,6 +201,40 @@ type FakeConfig struct {
 	experimentalReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	ForFoundationStub        func(configv3.Foundation) *configv3.Config
+	forFoundationMutex       sync.RWMutex
+	forFoundationArgsForCall []struct {
+		arg1 configv3.Foundation
+	}
+	forFoundationReturns struct {
+		result1 *configv3.Config
+	}
+	forFoundationReturnsOnCall map[int]struct {
+		result1 *configv3.Config
+	}
+	FoundationsStub        func() []configv3.Foundation
+	foundationsMutex       sync.RWMutex
+	foundationsArgsForCall []struct {
+	}
+	foundationsReturns struct {
+		result1 []configv3.Foundation
+	}
+	foundationsReturnsOnCall map[int]struct {
+		result1 []configv3.Foundation
+	}
+	GetFoundationStub        func(string) (configv3.Foundation, bool)
+	getFoundationMutex       sync.RWMutex
+	getFoundationArgsForCall []struct {
+		arg1 string
+	}
+	getFoundationReturns struct {
+		result1 configv3.Foundation
+		result2 bool
+	}
+	getFoundationReturnsOnCall map[int]struct {
+		result1 configv3.Foundation
+		result2 bool
+	}
 	GetPluginStub        func(string) (configv3.Plugin, bool)
 	getPluginMutex       sync.RWMutex
 	getPluginArgsForCall []struct {
@@ -181,6 +261,26 @@ type FakeConfig struct {
 		result1 configv3.Plugin
 		result2 bool
 	}
+	HTTPCacheDirectoryStub        func() string
+	hTTPCacheDirectoryMutex       sync.RWMutex
+	hTTPCacheDirectoryArgsForCall []struct {
+	}
+	hTTPCacheDirectoryReturns struct {
+		result1 string
+	}
+	hTTPCacheDirectoryReturnsOnCall map[int]struct {
+		result1 string
+	}
+	HTTPCacheTTLStub        func() time.Duration
+	hTTPCacheTTLMutex       sync.RWMutex
+	hTTPCacheTTLArgsForCall []struct {
+	}
+	hTTPCacheTTLReturns struct {
+		result1 time.Duration
+	}
+	hTTPCacheTTLReturnsOnCall map[int]struct {
+		result1 time.Duration
+	}
 	HasTargetedOrganizationStub        func() bool
 	hasTargetedOrganizationMutex       sync.RWMutex
 	hasTargetedOrganizationArgsForCall []struct {
@@ -281,6 +381,16 @@ type FakeConfig struct {
 	overallPollingTimeoutReturnsOnCall map[int]struct {
 		result1 time.Duration
 	}
+	PageConcurrencyStub        func() int
+	pageConcurrencyMutex       sync.RWMutex
+	pageConcurrencyArgsForCall []struct {
+	}
+	pageConcurrencyReturns struct {
+		result1 int
+	}
+	pageConcurrencyReturnsOnCall map[int]struct {
+		result1 int
+	}
 	PluginHomeStub        func() string
 	pluginHomeMutex       sync.RWMutex
 	pluginHomeArgsForCall []struct {
@@ -321,6 +431,32 @@ type FakeConfig struct {
 	pollingIntervalReturnsOnCall map[int]struct {
 		result1 time.Duration
 	}
+	PreviousTargetStub        func() (configv3.TargetHistoryEntry, bool)
+	previousTargetMutex       sync.RWMutex
+	previousTargetArgsForCall []struct {
+	}
+	previousTargetReturns struct {
+		result1 configv3.TargetHistoryEntry
+		result2 bool
+	}
+	previousTargetReturnsOnCall map[int]struct {
+		result1 configv3.TargetHistoryEntry
+		result2 bool
+	}
+	RecordCassetteStub        func() string
+	recordCassetteMutex       sync.RWMutex
+	recordCassetteArgsForCall []struct {
+	}
+	recordCassetteReturns struct {
+		result1 string
+	}
+	recordCassetteReturnsOnCall map[int]struct {
+		result1 string
+	}
+	RecordTargetStub        func()
+	recordTargetMutex       sync.RWMutex
+	recordTargetArgsForCall []struct {
+	}
 	RefreshTokenStub        func() string
 	refreshTokenMutex       sync.RWMutex
 	refreshTokenArgsForCall []struct {
@@ -331,11 +467,26 @@ type FakeConfig struct {
 	refreshTokenReturnsOnCall map[int]struct {
 		result1 string
 	}
+	RemoveFoundationStub        func(string)
+	removeFoundationMutex       sync.RWMutex
+	removeFoundationArgsForCall []struct {
+		arg1 string
+	}
 	RemovePluginStub        func(string)
 	removePluginMutex       sync.RWMutex
 	removePluginArgsForCall []struct {
 		arg1 string
 	}
+	ReplayCassetteStub        func() string
+	replayCassetteMutex       sync.RWMutex
+	replayCassetteArgsForCall []struct {
+	}
+	replayCassetteReturns struct {
+		result1 string
+	}
+	replayCassetteReturnsOnCall map[int]struct {
+		result1 string
+	}
 	RequestRetryCountStub        func() int
 	requestRetryCountMutex       sync.RWMutex
 	requestRetryCountArgsForCall []struct {
@@ -381,6 +532,21 @@ type FakeConfig struct {
 	setColorEnabledArgsForCall []struct {
 		arg1 string
 	}
+	SetEnvOrganizationGUIDStub        func(string)
+	setEnvOrganizationGUIDMutex       sync.RWMutex
+	setEnvOrganizationGUIDArgsForCall []struct {
+		arg1 string
+	}
+	SetEnvSpaceGUIDStub        func(string)
+	setEnvSpaceGUIDMutex       sync.RWMutex
+	setEnvSpaceGUIDArgsForCall []struct {
+		arg1 string
+	}
+	SetHTTPCacheTTLStub        func(time.Duration)
+	setHTTPCacheTTLMutex       sync.RWMutex
+	setHTTPCacheTTLArgsForCall []struct {
+		arg1 time.Duration
+	}
 	SetKubernetesAuthInfoStub        func(string)
 	setKubernetesAuthInfoMutex       sync.RWMutex
 	setKubernetesAuthInfoArgsForCall []struct {
@@ -402,6 +568,11 @@ type FakeConfig struct {
 		arg1 string
 		arg2 string
 	}
+	SetPageConcurrencyStub        func(int)
+	setPageConcurrencyMutex       sync.RWMutex
+	setPageConcurrencyArgsForCall []struct {
+		arg1 int
+	}
 	SetRefreshTokenStub        func(string)
 	setRefreshTokenMutex       sync.RWMutex
 	setRefreshTokenArgsForCall []struct {
@@ -431,6 +602,11 @@ type FakeConfig struct {
 	setTraceArgsForCall []struct {
 		arg1 string
 	}
+	SetTraceHARStub        func(string)
+	setTraceHARMutex       sync.RWMutex
+	setTraceHARArgsForCall []struct {
+		arg1 string
+	}
 	SetUAAClientCredentialsStub        func(string, string)
 	setUAAClientCredentialsMutex       sync.RWMutex
 	setUAAClientCredentialsArgsForCall []struct {
@@ -477,6 +653,50 @@ type FakeConfig struct {
 	startupTimeoutReturnsOnCall map[int]struct {
 		result1 time.Duration
 	}
+	SwitchFoundationStub        func(string) error
+	switchFoundationMutex       sync.RWMutex
+	switchFoundationArgsForCall []struct {
+		arg1 string
+	}
+	switchFoundationReturns struct {
+		result1 error
+	}
+	switchFoundationReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SwitchToTargetStub        func(configv3.TargetHistoryEntry) bool
+	switchToTargetMutex       sync.RWMutex
+	switchToTargetArgsForCall []struct {
+		arg1 configv3.TargetHistoryEntry
+	}
+	switchToTargetReturns struct {
+		result1 bool
+	}
+	switchToTargetReturnsOnCall map[int]struct {
+		result1 bool
+	}
+	TLSCertificatesStub        func() (util.TLSCertificates, error)
+	tLSCertificatesMutex       sync.RWMutex
+	tLSCertificatesArgsForCall []struct {
+	}
+	tLSCertificatesReturns struct {
+		result1 util.TLSCertificates
+		result2 error
+	}
+	tLSCertificatesReturnsOnCall map[int]struct {
+		result1 util.TLSCertificates
+		result2 error
+	}
+	TableOptionsStub        func() configv3.TableOptions
+	tableOptionsMutex       sync.RWMutex
+	tableOptionsArgsForCall []struct {
+	}
+	tableOptionsReturns struct {
+		result1 configv3.TableOptions
+	}
+	tableOptionsReturnsOnCall map[int]struct {
+		result1 configv3.TableOptions
+	}
 	TargetStub        func() string
 	targetMutex       sync.RWMutex
 	targetArgsForCall []struct {
@@ -487,6 +707,26 @@ type FakeConfig struct {
 	targetReturnsOnCall map[int]struct {
 		result1 string
 	}
+	TargetFailoverURLsStub        func() []string
+	targetFailoverURLsMutex       sync.RWMutex
+	targetFailoverURLsArgsForCall []struct {
+	}
+	targetFailoverURLsReturns struct {
+		result1 []string
+	}
+	targetFailoverURLsReturnsOnCall map[int]struct {
+		result1 []string
+	}
+	TargetHistoryStub        func() []configv3.TargetHistoryEntry
+	targetHistoryMutex       sync.RWMutex
+	targetHistoryArgsForCall []struct {
+	}
+	targetHistoryReturns struct {
+		result1 []configv3.TargetHistoryEntry
+	}
+	targetHistoryReturnsOnCall map[int]struct {
+		result1 []configv3.TargetHistoryEntry
+	}
 	TargetedOrganizationStub        func() configv3.Organization
 	targetedOrganizationMutex       sync.RWMutex
 	targetedOrganizationArgsForCall []struct {
@@ -527,6 +767,26 @@ type FakeConfig struct {
 	terminalWidthReturnsOnCall map[int]struct {
 		result1 int
 	}
+	TimingsStub        func() bool
+	timingsMutex       sync.RWMutex
+	timingsArgsForCall []struct {
+	}
+	timingsReturns struct {
+		result1 bool
+	}
+	timingsReturnsOnCall map[int]struct {
+		result1 bool
+	}
+	TraceHARStub        func() string
+	traceHARMutex       sync.RWMutex
+	traceHARArgsForCall []struct {
+	}
+	traceHARReturns struct {
+		result1 string
+	}
+	traceHARReturnsOnCall map[int]struct {
+		result1 string
+	}
 	UAADisableKeepAlivesStub        func() bool
 	uAADisableKeepAlivesMutex       sync.RWMutex
 	uAADisableKeepAlivesArgsForCall []struct {
@@ -589,6 +849,16 @@ type FakeConfig struct {
 	unsetUserInformationMutex       sync.RWMutex
 	unsetUserInformationArgsForCall []struct {
 	}
+	UsesEncryptedTokenStoreStub        func() bool
+	usesEncryptedTokenStoreMutex       sync.RWMutex
+	usesEncryptedTokenStoreArgsForCall []struct {
+	}
+	usesEncryptedTokenStoreReturns struct {
+		result1 bool
+	}
+	usesEncryptedTokenStoreReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	V7SetSpaceInformationStub        func(string, string)
 	v7SetSpaceInformationMutex       sync.RWMutex
 	v7SetSpaceInformationArgsForCall []struct {
@@ -735,6 +1005,89 @@ func (fake *FakeConfig) AccessTokenReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeConfig) ActiveFoundation() string {
+	fake.activeFoundationMutex.Lock()
+	ret, specificReturn := fake.activeFoundationReturnsOnCall[len(fake.activeFoundationArgsForCall)]
+	fake.activeFoundationArgsForCall = append(fake.activeFoundationArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ActiveFoundation", []interface{}{})
+	fake.activeFoundationMutex.Unlock()
+	if fake.ActiveFoundationStub != nil {
+		return fake.ActiveFoundationStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.activeFoundationReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) ActiveFoundationCallCount() int {
+	fake.activeFoundationMutex.RLock()
+	defer fake.activeFoundationMutex.RUnlock()
+	return len(fake.activeFoundationArgsForCall)
+}
+
+func (fake *FakeConfig) ActiveFoundationCalls(stub func() string) {
+	fake.activeFoundationMutex.Lock()
+	defer fake.activeFoundationMutex.Unlock()
+	fake.ActiveFoundationStub = stub
+}
+
+func (fake *FakeConfig) ActiveFoundationReturns(result1 string) {
+	fake.activeFoundationMutex.Lock()
+	defer fake.activeFoundationMutex.Unlock()
+	fake.ActiveFoundationStub = nil
+	fake.activeFoundationReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) ActiveFoundationReturnsOnCall(i int, result1 string) {
+	fake.activeFoundationMutex.Lock()
+	defer fake.activeFoundationMutex.Unlock()
+	fake.ActiveFoundationStub = nil
+	if fake.activeFoundationReturnsOnCall == nil {
+		fake.activeFoundationReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.activeFoundationReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) AddFoundation(arg1 string) {
+	fake.addFoundationMutex.Lock()
+	fake.addFoundationArgsForCall = append(fake.addFoundationArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("AddFoundation", []interface{}{arg1})
+	fake.addFoundationMutex.Unlock()
+	if fake.AddFoundationStub != nil {
+		fake.AddFoundationStub(arg1)
+	}
+}
+
+func (fake *FakeConfig) AddFoundationCallCount() int {
+	fake.addFoundationMutex.RLock()
+	defer fake.addFoundationMutex.RUnlock()
+	return len(fake.addFoundationArgsForCall)
+}
+
+func (fake *FakeConfig) AddFoundationCalls(stub func(string)) {
+	fake.addFoundationMutex.Lock()
+	defer fake.addFoundationMutex.Unlock()
+	fake.AddFoundationStub = stub
+}
+
+func (fake *FakeConfig) AddFoundationArgsForCall(i int) string {
+	fake.addFoundationMutex.RLock()
+	defer fake.addFoundationMutex.RUnlock()
+	argsForCall := fake.addFoundationArgsForCall[i]
+	return argsForCall.arg1
+}
+
 func (fake *FakeConfig) AddPlugin(arg1 configv3.Plugin) {
 	fake.addPluginMutex.Lock()
 	fake.addPluginArgsForCall = append(fake.addPluginArgsForCall, struct {
@@ -1058,6 +1411,58 @@ func (fake *FakeConfig) CFUsernameReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeConfig) CircuitBreakerFile() string {
+	fake.circuitBreakerFileMutex.Lock()
+	ret, specificReturn := fake.circuitBreakerFileReturnsOnCall[len(fake.circuitBreakerFileArgsForCall)]
+	fake.circuitBreakerFileArgsForCall = append(fake.circuitBreakerFileArgsForCall, struct {
+	}{})
+	fake.recordInvocation("CircuitBreakerFile", []interface{}{})
+	fake.circuitBreakerFileMutex.Unlock()
+	if fake.CircuitBreakerFileStub != nil {
+		return fake.CircuitBreakerFileStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.circuitBreakerFileReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) CircuitBreakerFileCallCount() int {
+	fake.circuitBreakerFileMutex.RLock()
+	defer fake.circuitBreakerFileMutex.RUnlock()
+	return len(fake.circuitBreakerFileArgsForCall)
+}
+
+func (fake *FakeConfig) CircuitBreakerFileCalls(stub func() string) {
+	fake.circuitBreakerFileMutex.Lock()
+	defer fake.circuitBreakerFileMutex.Unlock()
+	fake.CircuitBreakerFileStub = stub
+}
+
+func (fake *FakeConfig) CircuitBreakerFileReturns(result1 string) {
+	fake.circuitBreakerFileMutex.Lock()
+	defer fake.circuitBreakerFileMutex.Unlock()
+	fake.CircuitBreakerFileStub = nil
+	fake.circuitBreakerFileReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) CircuitBreakerFileReturnsOnCall(i int, result1 string) {
+	fake.circuitBreakerFileMutex.Lock()
+	defer fake.circuitBreakerFileMutex.Unlock()
+	fake.CircuitBreakerFileStub = nil
+	if fake.circuitBreakerFileReturnsOnCall == nil {
+		fake.circuitBreakerFileReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.circuitBreakerFileReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeConfig) ColorEnabled() configv3.ColorSetting {
 	fake.colorEnabledMutex.Lock()
 	ret, specificReturn := fake.colorEnabledReturnsOnCall[len(fake.colorEnabledArgsForCall)]
@@ -1324,6 +1729,110 @@ func (fake *FakeConfig) DockerPasswordReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeConfig) EnableEncryptedTokenStore() error {
+	fake.enableEncryptedTokenStoreMutex.Lock()
+	ret, specificReturn := fake.enableEncryptedTokenStoreReturnsOnCall[len(fake.enableEncryptedTokenStoreArgsForCall)]
+	fake.enableEncryptedTokenStoreArgsForCall = append(fake.enableEncryptedTokenStoreArgsForCall, struct {
+	}{})
+	fake.recordInvocation("EnableEncryptedTokenStore", []interface{}{})
+	fake.enableEncryptedTokenStoreMutex.Unlock()
+	if fake.EnableEncryptedTokenStoreStub != nil {
+		return fake.EnableEncryptedTokenStoreStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.enableEncryptedTokenStoreReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) EnableEncryptedTokenStoreCallCount() int {
+	fake.enableEncryptedTokenStoreMutex.RLock()
+	defer fake.enableEncryptedTokenStoreMutex.RUnlock()
+	return len(fake.enableEncryptedTokenStoreArgsForCall)
+}
+
+func (fake *FakeConfig) EnableEncryptedTokenStoreCalls(stub func() error) {
+	fake.enableEncryptedTokenStoreMutex.Lock()
+	defer fake.enableEncryptedTokenStoreMutex.Unlock()
+	fake.EnableEncryptedTokenStoreStub = stub
+}
+
+func (fake *FakeConfig) EnableEncryptedTokenStoreReturns(result1 error) {
+	fake.enableEncryptedTokenStoreMutex.Lock()
+	defer fake.enableEncryptedTokenStoreMutex.Unlock()
+	fake.EnableEncryptedTokenStoreStub = nil
+	fake.enableEncryptedTokenStoreReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeConfig) EnableEncryptedTokenStoreReturnsOnCall(i int, result1 error) {
+	fake.enableEncryptedTokenStoreMutex.Lock()
+	defer fake.enableEncryptedTokenStoreMutex.Unlock()
+	fake.EnableEncryptedTokenStoreStub = nil
+	if fake.enableEncryptedTokenStoreReturnsOnCall == nil {
+		fake.enableEncryptedTokenStoreReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.enableEncryptedTokenStoreReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeConfig) EnvTarget() configv3.EnvTarget {
+	fake.envTargetMutex.Lock()
+	ret, specificReturn := fake.envTargetReturnsOnCall[len(fake.envTargetArgsForCall)]
+	fake.envTargetArgsForCall = append(fake.envTargetArgsForCall, struct {
+	}{})
+	fake.recordInvocation("EnvTarget", []interface{}{})
+	fake.envTargetMutex.Unlock()
+	if fake.EnvTargetStub != nil {
+		return fake.EnvTargetStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.envTargetReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) EnvTargetCallCount() int {
+	fake.envTargetMutex.RLock()
+	defer fake.envTargetMutex.RUnlock()
+	return len(fake.envTargetArgsForCall)
+}
+
+func (fake *FakeConfig) EnvTargetCalls(stub func() configv3.EnvTarget) {
+	fake.envTargetMutex.Lock()
+	defer fake.envTargetMutex.Unlock()
+	fake.EnvTargetStub = stub
+}
+
+func (fake *FakeConfig) EnvTargetReturns(result1 configv3.EnvTarget) {
+	fake.envTargetMutex.Lock()
+	defer fake.envTargetMutex.Unlock()
+	fake.EnvTargetStub = nil
+	fake.envTargetReturns = struct {
+		result1 configv3.EnvTarget
+	}{result1}
+}
+
+func (fake *FakeConfig) EnvTargetReturnsOnCall(i int, result1 configv3.EnvTarget) {
+	fake.envTargetMutex.Lock()
+	defer fake.envTargetMutex.Unlock()
+	fake.EnvTargetStub = nil
+	if fake.envTargetReturnsOnCall == nil {
+		fake.envTargetReturnsOnCall = make(map[int]struct {
+			result1 configv3.EnvTarget
+		})
+	}
+	fake.envTargetReturnsOnCall[i] = struct {
+		result1 configv3.EnvTarget
+	}{result1}
+}
+
 func (fake *FakeConfig) Experimental() bool {
 	fake.experimentalMutex.Lock()
 	ret, specificReturn := fake.experimentalReturnsOnCall[len(fake.experimentalArgsForCall)]
@@ -1376,59 +1885,234 @@ func (fake *FakeConfig) ExperimentalReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
-func (fake *FakeConfig) GetPlugin(arg1 string) (configv3.Plugin, bool) {
-	fake.getPluginMutex.Lock()
-	ret, specificReturn := fake.getPluginReturnsOnCall[len(fake.getPluginArgsForCall)]
-	fake.getPluginArgsForCall = append(fake.getPluginArgsForCall, struct {
-		arg1 string
+func (fake *FakeConfig) ForFoundation(arg1 configv3.Foundation) *configv3.Config {
+	fake.forFoundationMutex.Lock()
+	ret, specificReturn := fake.forFoundationReturnsOnCall[len(fake.forFoundationArgsForCall)]
+	fake.forFoundationArgsForCall = append(fake.forFoundationArgsForCall, struct {
+		arg1 configv3.Foundation
 	}{arg1})
-	fake.recordInvocation("GetPlugin", []interface{}{arg1})
-	fake.getPluginMutex.Unlock()
-	if fake.GetPluginStub != nil {
-		return fake.GetPluginStub(arg1)
+	fake.recordInvocation("ForFoundation", []interface{}{arg1})
+	fake.forFoundationMutex.Unlock()
+	if fake.ForFoundationStub != nil {
+		return fake.ForFoundationStub(arg1)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2
+		return ret.result1
 	}
-	fakeReturns := fake.getPluginReturns
-	return fakeReturns.result1, fakeReturns.result2
+	fakeReturns := fake.forFoundationReturns
+	return fakeReturns.result1
 }
 
-func (fake *FakeConfig) GetPluginCallCount() int {
-	fake.getPluginMutex.RLock()
-	defer fake.getPluginMutex.RUnlock()
-	return len(fake.getPluginArgsForCall)
+func (fake *FakeConfig) ForFoundationCallCount() int {
+	fake.forFoundationMutex.RLock()
+	defer fake.forFoundationMutex.RUnlock()
+	return len(fake.forFoundationArgsForCall)
 }
 
-func (fake *FakeConfig) GetPluginCalls(stub func(string) (configv3.Plugin, bool)) {
-	fake.getPluginMutex.Lock()
-	defer fake.getPluginMutex.Unlock()
-	fake.GetPluginStub = stub
+func (fake *FakeConfig) ForFoundationCalls(stub func(configv3.Foundation) *configv3.Config) {
+	fake.forFoundationMutex.Lock()
+	defer fake.forFoundationMutex.Unlock()
+	fake.ForFoundationStub = stub
 }
 
-func (fake *FakeConfig) GetPluginArgsForCall(i int) string {
-	fake.getPluginMutex.RLock()
-	defer fake.getPluginMutex.RUnlock()
-	argsForCall := fake.getPluginArgsForCall[i]
+func (fake *FakeConfig) ForFoundationArgsForCall(i int) configv3.Foundation {
+	fake.forFoundationMutex.RLock()
+	defer fake.forFoundationMutex.RUnlock()
+	argsForCall := fake.forFoundationArgsForCall[i]
 	return argsForCall.arg1
 }
 
-func (fake *FakeConfig) GetPluginReturns(result1 configv3.Plugin, result2 bool) {
-	fake.getPluginMutex.Lock()
-	defer fake.getPluginMutex.Unlock()
-	fake.GetPluginStub = nil
-	fake.getPluginReturns = struct {
-		result1 configv3.Plugin
-		result2 bool
-	}{result1, result2}
+func (fake *FakeConfig) ForFoundationReturns(result1 *configv3.Config) {
+	fake.forFoundationMutex.Lock()
+	defer fake.forFoundationMutex.Unlock()
+	fake.ForFoundationStub = nil
+	fake.forFoundationReturns = struct {
+		result1 *configv3.Config
+	}{result1}
 }
 
-func (fake *FakeConfig) GetPluginReturnsOnCall(i int, result1 configv3.Plugin, result2 bool) {
-	fake.getPluginMutex.Lock()
-	defer fake.getPluginMutex.Unlock()
-	fake.GetPluginStub = nil
-	if fake.getPluginReturnsOnCall == nil {
-		fake.getPluginReturnsOnCall = make(map[int]struct {
+func (fake *FakeConfig) ForFoundationReturnsOnCall(i int, result1 *configv3.Config) {
+	fake.forFoundationMutex.Lock()
+	defer fake.forFoundationMutex.Unlock()
+	fake.ForFoundationStub = nil
+	if fake.forFoundationReturnsOnCall == nil {
+		fake.forFoundationReturnsOnCall = make(map[int]struct {
+			result1 *configv3.Config
+		})
+	}
+	fake.forFoundationReturnsOnCall[i] = struct {
+		result1 *configv3.Config
+	}{result1}
+}
+
+func (fake *FakeConfig) Foundations() []configv3.Foundation {
+	fake.foundationsMutex.Lock()
+	ret, specificReturn := fake.foundationsReturnsOnCall[len(fake.foundationsArgsForCall)]
+	fake.foundationsArgsForCall = append(fake.foundationsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Foundations", []interface{}{})
+	fake.foundationsMutex.Unlock()
+	if fake.FoundationsStub != nil {
+		return fake.FoundationsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.foundationsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) FoundationsCallCount() int {
+	fake.foundationsMutex.RLock()
+	defer fake.foundationsMutex.RUnlock()
+	return len(fake.foundationsArgsForCall)
+}
+
+func (fake *FakeConfig) FoundationsCalls(stub func() []configv3.Foundation) {
+	fake.foundationsMutex.Lock()
+	defer fake.foundationsMutex.Unlock()
+	fake.FoundationsStub = stub
+}
+
+func (fake *FakeConfig) FoundationsReturns(result1 []configv3.Foundation) {
+	fake.foundationsMutex.Lock()
+	defer fake.foundationsMutex.Unlock()
+	fake.FoundationsStub = nil
+	fake.foundationsReturns = struct {
+		result1 []configv3.Foundation
+	}{result1}
+}
+
+func (fake *FakeConfig) FoundationsReturnsOnCall(i int, result1 []configv3.Foundation) {
+	fake.foundationsMutex.Lock()
+	defer fake.foundationsMutex.Unlock()
+	fake.FoundationsStub = nil
+	if fake.foundationsReturnsOnCall == nil {
+		fake.foundationsReturnsOnCall = make(map[int]struct {
+			result1 []configv3.Foundation
+		})
+	}
+	fake.foundationsReturnsOnCall[i] = struct {
+		result1 []configv3.Foundation
+	}{result1}
+}
+
+func (fake *FakeConfig) GetFoundation(arg1 string) (configv3.Foundation, bool) {
+	fake.getFoundationMutex.Lock()
+	ret, specificReturn := fake.getFoundationReturnsOnCall[len(fake.getFoundationArgsForCall)]
+	fake.getFoundationArgsForCall = append(fake.getFoundationArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetFoundation", []interface{}{arg1})
+	fake.getFoundationMutex.Unlock()
+	if fake.GetFoundationStub != nil {
+		return fake.GetFoundationStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.getFoundationReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeConfig) GetFoundationCallCount() int {
+	fake.getFoundationMutex.RLock()
+	defer fake.getFoundationMutex.RUnlock()
+	return len(fake.getFoundationArgsForCall)
+}
+
+func (fake *FakeConfig) GetFoundationCalls(stub func(string) (configv3.Foundation, bool)) {
+	fake.getFoundationMutex.Lock()
+	defer fake.getFoundationMutex.Unlock()
+	fake.GetFoundationStub = stub
+}
+
+func (fake *FakeConfig) GetFoundationArgsForCall(i int) string {
+	fake.getFoundationMutex.RLock()
+	defer fake.getFoundationMutex.RUnlock()
+	argsForCall := fake.getFoundationArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeConfig) GetFoundationReturns(result1 configv3.Foundation, result2 bool) {
+	fake.getFoundationMutex.Lock()
+	defer fake.getFoundationMutex.Unlock()
+	fake.GetFoundationStub = nil
+	fake.getFoundationReturns = struct {
+		result1 configv3.Foundation
+		result2 bool
+	}{result1, result2}
+}
+
+func (fake *FakeConfig) GetFoundationReturnsOnCall(i int, result1 configv3.Foundation, result2 bool) {
+	fake.getFoundationMutex.Lock()
+	defer fake.getFoundationMutex.Unlock()
+	fake.GetFoundationStub = nil
+	if fake.getFoundationReturnsOnCall == nil {
+		fake.getFoundationReturnsOnCall = make(map[int]struct {
+			result1 configv3.Foundation
+			result2 bool
+		})
+	}
+	fake.getFoundationReturnsOnCall[i] = struct {
+		result1 configv3.Foundation
+		result2 bool
+	}{result1, result2}
+}
+
+func (fake *FakeConfig) GetPlugin(arg1 string) (configv3.Plugin, bool) {
+	fake.getPluginMutex.Lock()
+	ret, specificReturn := fake.getPluginReturnsOnCall[len(fake.getPluginArgsForCall)]
+	fake.getPluginArgsForCall = append(fake.getPluginArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetPlugin", []interface{}{arg1})
+	fake.getPluginMutex.Unlock()
+	if fake.GetPluginStub != nil {
+		return fake.GetPluginStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.getPluginReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeConfig) GetPluginCallCount() int {
+	fake.getPluginMutex.RLock()
+	defer fake.getPluginMutex.RUnlock()
+	return len(fake.getPluginArgsForCall)
+}
+
+func (fake *FakeConfig) GetPluginCalls(stub func(string) (configv3.Plugin, bool)) {
+	fake.getPluginMutex.Lock()
+	defer fake.getPluginMutex.Unlock()
+	fake.GetPluginStub = stub
+}
+
+func (fake *FakeConfig) GetPluginArgsForCall(i int) string {
+	fake.getPluginMutex.RLock()
+	defer fake.getPluginMutex.RUnlock()
+	argsForCall := fake.getPluginArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeConfig) GetPluginReturns(result1 configv3.Plugin, result2 bool) {
+	fake.getPluginMutex.Lock()
+	defer fake.getPluginMutex.Unlock()
+	fake.GetPluginStub = nil
+	fake.getPluginReturns = struct {
+		result1 configv3.Plugin
+		result2 bool
+	}{result1, result2}
+}
+
+func (fake *FakeConfig) GetPluginReturnsOnCall(i int, result1 configv3.Plugin, result2 bool) {
+	fake.getPluginMutex.Lock()
+	defer fake.getPluginMutex.Unlock()
+	fake.GetPluginStub = nil
+	if fake.getPluginReturnsOnCall == nil {
+		fake.getPluginReturnsOnCall = make(map[int]struct {
 			result1 configv3.Plugin
 			result2 bool
 		})
@@ -1502,6 +2186,110 @@ func (fake *FakeConfig) GetPluginCaseInsensitiveReturnsOnCall(i int, result1 con
 	}{result1, result2}
 }
 
+func (fake *FakeConfig) HTTPCacheDirectory() string {
+	fake.hTTPCacheDirectoryMutex.Lock()
+	ret, specificReturn := fake.hTTPCacheDirectoryReturnsOnCall[len(fake.hTTPCacheDirectoryArgsForCall)]
+	fake.hTTPCacheDirectoryArgsForCall = append(fake.hTTPCacheDirectoryArgsForCall, struct {
+	}{})
+	fake.recordInvocation("HTTPCacheDirectory", []interface{}{})
+	fake.hTTPCacheDirectoryMutex.Unlock()
+	if fake.HTTPCacheDirectoryStub != nil {
+		return fake.HTTPCacheDirectoryStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.hTTPCacheDirectoryReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) HTTPCacheDirectoryCallCount() int {
+	fake.hTTPCacheDirectoryMutex.RLock()
+	defer fake.hTTPCacheDirectoryMutex.RUnlock()
+	return len(fake.hTTPCacheDirectoryArgsForCall)
+}
+
+func (fake *FakeConfig) HTTPCacheDirectoryCalls(stub func() string) {
+	fake.hTTPCacheDirectoryMutex.Lock()
+	defer fake.hTTPCacheDirectoryMutex.Unlock()
+	fake.HTTPCacheDirectoryStub = stub
+}
+
+func (fake *FakeConfig) HTTPCacheDirectoryReturns(result1 string) {
+	fake.hTTPCacheDirectoryMutex.Lock()
+	defer fake.hTTPCacheDirectoryMutex.Unlock()
+	fake.HTTPCacheDirectoryStub = nil
+	fake.hTTPCacheDirectoryReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) HTTPCacheDirectoryReturnsOnCall(i int, result1 string) {
+	fake.hTTPCacheDirectoryMutex.Lock()
+	defer fake.hTTPCacheDirectoryMutex.Unlock()
+	fake.HTTPCacheDirectoryStub = nil
+	if fake.hTTPCacheDirectoryReturnsOnCall == nil {
+		fake.hTTPCacheDirectoryReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.hTTPCacheDirectoryReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) HTTPCacheTTL() time.Duration {
+	fake.hTTPCacheTTLMutex.Lock()
+	ret, specificReturn := fake.hTTPCacheTTLReturnsOnCall[len(fake.hTTPCacheTTLArgsForCall)]
+	fake.hTTPCacheTTLArgsForCall = append(fake.hTTPCacheTTLArgsForCall, struct {
+	}{})
+	fake.recordInvocation("HTTPCacheTTL", []interface{}{})
+	fake.hTTPCacheTTLMutex.Unlock()
+	if fake.HTTPCacheTTLStub != nil {
+		return fake.HTTPCacheTTLStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.hTTPCacheTTLReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) HTTPCacheTTLCallCount() int {
+	fake.hTTPCacheTTLMutex.RLock()
+	defer fake.hTTPCacheTTLMutex.RUnlock()
+	return len(fake.hTTPCacheTTLArgsForCall)
+}
+
+func (fake *FakeConfig) HTTPCacheTTLCalls(stub func() time.Duration) {
+	fake.hTTPCacheTTLMutex.Lock()
+	defer fake.hTTPCacheTTLMutex.Unlock()
+	fake.HTTPCacheTTLStub = stub
+}
+
+func (fake *FakeConfig) HTTPCacheTTLReturns(result1 time.Duration) {
+	fake.hTTPCacheTTLMutex.Lock()
+	defer fake.hTTPCacheTTLMutex.Unlock()
+	fake.HTTPCacheTTLStub = nil
+	fake.hTTPCacheTTLReturns = struct {
+		result1 time.Duration
+	}{result1}
+}
+
+func (fake *FakeConfig) HTTPCacheTTLReturnsOnCall(i int, result1 time.Duration) {
+	fake.hTTPCacheTTLMutex.Lock()
+	defer fake.hTTPCacheTTLMutex.Unlock()
+	fake.HTTPCacheTTLStub = nil
+	if fake.hTTPCacheTTLReturnsOnCall == nil {
+		fake.hTTPCacheTTLReturnsOnCall = make(map[int]struct {
+			result1 time.Duration
+		})
+	}
+	fake.hTTPCacheTTLReturnsOnCall[i] = struct {
+		result1 time.Duration
+	}{result1}
+}
+
 func (fake *FakeConfig) HasTargetedOrganization() bool {
 	fake.hasTargetedOrganizationMutex.Lock()
 	ret, specificReturn := fake.hasTargetedOrganizationReturnsOnCall[len(fake.hasTargetedOrganizationArgsForCall)]
@@ -2022,6 +2810,58 @@ func (fake *FakeConfig) OverallPollingTimeoutReturnsOnCall(i int, result1 time.D
 	}{result1}
 }
 
+func (fake *FakeConfig) PageConcurrency() int {
+	fake.pageConcurrencyMutex.Lock()
+	ret, specificReturn := fake.pageConcurrencyReturnsOnCall[len(fake.pageConcurrencyArgsForCall)]
+	fake.pageConcurrencyArgsForCall = append(fake.pageConcurrencyArgsForCall, struct {
+	}{})
+	fake.recordInvocation("PageConcurrency", []interface{}{})
+	fake.pageConcurrencyMutex.Unlock()
+	if fake.PageConcurrencyStub != nil {
+		return fake.PageConcurrencyStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.pageConcurrencyReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) PageConcurrencyCallCount() int {
+	fake.pageConcurrencyMutex.RLock()
+	defer fake.pageConcurrencyMutex.RUnlock()
+	return len(fake.pageConcurrencyArgsForCall)
+}
+
+func (fake *FakeConfig) PageConcurrencyCalls(stub func() int) {
+	fake.pageConcurrencyMutex.Lock()
+	defer fake.pageConcurrencyMutex.Unlock()
+	fake.PageConcurrencyStub = stub
+}
+
+func (fake *FakeConfig) PageConcurrencyReturns(result1 int) {
+	fake.pageConcurrencyMutex.Lock()
+	defer fake.pageConcurrencyMutex.Unlock()
+	fake.PageConcurrencyStub = nil
+	fake.pageConcurrencyReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeConfig) PageConcurrencyReturnsOnCall(i int, result1 int) {
+	fake.pageConcurrencyMutex.Lock()
+	defer fake.pageConcurrencyMutex.Unlock()
+	fake.PageConcurrencyStub = nil
+	if fake.pageConcurrencyReturnsOnCall == nil {
+		fake.pageConcurrencyReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.pageConcurrencyReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
 func (fake *FakeConfig) PluginHome() string {
 	fake.pluginHomeMutex.Lock()
 	ret, specificReturn := fake.pluginHomeReturnsOnCall[len(fake.pluginHomeArgsForCall)]
@@ -2230,6 +3070,136 @@ func (fake *FakeConfig) PollingIntervalReturnsOnCall(i int, result1 time.Duratio
 	}{result1}
 }
 
+func (fake *FakeConfig) PreviousTarget() (configv3.TargetHistoryEntry, bool) {
+	fake.previousTargetMutex.Lock()
+	ret, specificReturn := fake.previousTargetReturnsOnCall[len(fake.previousTargetArgsForCall)]
+	fake.previousTargetArgsForCall = append(fake.previousTargetArgsForCall, struct {
+	}{})
+	fake.recordInvocation("PreviousTarget", []interface{}{})
+	fake.previousTargetMutex.Unlock()
+	if fake.PreviousTargetStub != nil {
+		return fake.PreviousTargetStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.previousTargetReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeConfig) PreviousTargetCallCount() int {
+	fake.previousTargetMutex.RLock()
+	defer fake.previousTargetMutex.RUnlock()
+	return len(fake.previousTargetArgsForCall)
+}
+
+func (fake *FakeConfig) PreviousTargetCalls(stub func() (configv3.TargetHistoryEntry, bool)) {
+	fake.previousTargetMutex.Lock()
+	defer fake.previousTargetMutex.Unlock()
+	fake.PreviousTargetStub = stub
+}
+
+func (fake *FakeConfig) PreviousTargetReturns(result1 configv3.TargetHistoryEntry, result2 bool) {
+	fake.previousTargetMutex.Lock()
+	defer fake.previousTargetMutex.Unlock()
+	fake.PreviousTargetStub = nil
+	fake.previousTargetReturns = struct {
+		result1 configv3.TargetHistoryEntry
+		result2 bool
+	}{result1, result2}
+}
+
+func (fake *FakeConfig) PreviousTargetReturnsOnCall(i int, result1 configv3.TargetHistoryEntry, result2 bool) {
+	fake.previousTargetMutex.Lock()
+	defer fake.previousTargetMutex.Unlock()
+	fake.PreviousTargetStub = nil
+	if fake.previousTargetReturnsOnCall == nil {
+		fake.previousTargetReturnsOnCall = make(map[int]struct {
+			result1 configv3.TargetHistoryEntry
+			result2 bool
+		})
+	}
+	fake.previousTargetReturnsOnCall[i] = struct {
+		result1 configv3.TargetHistoryEntry
+		result2 bool
+	}{result1, result2}
+}
+
+func (fake *FakeConfig) RecordCassette() string {
+	fake.recordCassetteMutex.Lock()
+	ret, specificReturn := fake.recordCassetteReturnsOnCall[len(fake.recordCassetteArgsForCall)]
+	fake.recordCassetteArgsForCall = append(fake.recordCassetteArgsForCall, struct {
+	}{})
+	fake.recordInvocation("RecordCassette", []interface{}{})
+	fake.recordCassetteMutex.Unlock()
+	if fake.RecordCassetteStub != nil {
+		return fake.RecordCassetteStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.recordCassetteReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) RecordCassetteCallCount() int {
+	fake.recordCassetteMutex.RLock()
+	defer fake.recordCassetteMutex.RUnlock()
+	return len(fake.recordCassetteArgsForCall)
+}
+
+func (fake *FakeConfig) RecordCassetteCalls(stub func() string) {
+	fake.recordCassetteMutex.Lock()
+	defer fake.recordCassetteMutex.Unlock()
+	fake.RecordCassetteStub = stub
+}
+
+func (fake *FakeConfig) RecordCassetteReturns(result1 string) {
+	fake.recordCassetteMutex.Lock()
+	defer fake.recordCassetteMutex.Unlock()
+	fake.RecordCassetteStub = nil
+	fake.recordCassetteReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) RecordCassetteReturnsOnCall(i int, result1 string) {
+	fake.recordCassetteMutex.Lock()
+	defer fake.recordCassetteMutex.Unlock()
+	fake.RecordCassetteStub = nil
+	if fake.recordCassetteReturnsOnCall == nil {
+		fake.recordCassetteReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.recordCassetteReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) RecordTarget() {
+	fake.recordTargetMutex.Lock()
+	fake.recordTargetArgsForCall = append(fake.recordTargetArgsForCall, struct {
+	}{})
+	fake.recordInvocation("RecordTarget", []interface{}{})
+	fake.recordTargetMutex.Unlock()
+	if fake.RecordTargetStub != nil {
+		fake.RecordTargetStub()
+	}
+}
+
+func (fake *FakeConfig) RecordTargetCallCount() int {
+	fake.recordTargetMutex.RLock()
+	defer fake.recordTargetMutex.RUnlock()
+	return len(fake.recordTargetArgsForCall)
+}
+
+func (fake *FakeConfig) RecordTargetCalls(stub func()) {
+	fake.recordTargetMutex.Lock()
+	defer fake.recordTargetMutex.Unlock()
+	fake.RecordTargetStub = stub
+}
+
 func (fake *FakeConfig) RefreshToken() string {
 	fake.refreshTokenMutex.Lock()
 	ret, specificReturn := fake.refreshTokenReturnsOnCall[len(fake.refreshTokenArgsForCall)]
@@ -2282,6 +3252,37 @@ func (fake *FakeConfig) RefreshTokenReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeConfig) RemoveFoundation(arg1 string) {
+	fake.removeFoundationMutex.Lock()
+	fake.removeFoundationArgsForCall = append(fake.removeFoundationArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("RemoveFoundation", []interface{}{arg1})
+	fake.removeFoundationMutex.Unlock()
+	if fake.RemoveFoundationStub != nil {
+		fake.RemoveFoundationStub(arg1)
+	}
+}
+
+func (fake *FakeConfig) RemoveFoundationCallCount() int {
+	fake.removeFoundationMutex.RLock()
+	defer fake.removeFoundationMutex.RUnlock()
+	return len(fake.removeFoundationArgsForCall)
+}
+
+func (fake *FakeConfig) RemoveFoundationCalls(stub func(string)) {
+	fake.removeFoundationMutex.Lock()
+	defer fake.removeFoundationMutex.Unlock()
+	fake.RemoveFoundationStub = stub
+}
+
+func (fake *FakeConfig) RemoveFoundationArgsForCall(i int) string {
+	fake.removeFoundationMutex.RLock()
+	defer fake.removeFoundationMutex.RUnlock()
+	argsForCall := fake.removeFoundationArgsForCall[i]
+	return argsForCall.arg1
+}
+
 func (fake *FakeConfig) RemovePlugin(arg1 string) {
 	fake.removePluginMutex.Lock()
 	fake.removePluginArgsForCall = append(fake.removePluginArgsForCall, struct {
@@ -2313,6 +3314,58 @@ func (fake *FakeConfig) RemovePluginArgsForCall(i int) string {
 	return argsForCall.arg1
 }
 
+func (fake *FakeConfig) ReplayCassette() string {
+	fake.replayCassetteMutex.Lock()
+	ret, specificReturn := fake.replayCassetteReturnsOnCall[len(fake.replayCassetteArgsForCall)]
+	fake.replayCassetteArgsForCall = append(fake.replayCassetteArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ReplayCassette", []interface{}{})
+	fake.replayCassetteMutex.Unlock()
+	if fake.ReplayCassetteStub != nil {
+		return fake.ReplayCassetteStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.replayCassetteReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) ReplayCassetteCallCount() int {
+	fake.replayCassetteMutex.RLock()
+	defer fake.replayCassetteMutex.RUnlock()
+	return len(fake.replayCassetteArgsForCall)
+}
+
+func (fake *FakeConfig) ReplayCassetteCalls(stub func() string) {
+	fake.replayCassetteMutex.Lock()
+	defer fake.replayCassetteMutex.Unlock()
+	fake.ReplayCassetteStub = stub
+}
+
+func (fake *FakeConfig) ReplayCassetteReturns(result1 string) {
+	fake.replayCassetteMutex.Lock()
+	defer fake.replayCassetteMutex.Unlock()
+	fake.ReplayCassetteStub = nil
+	fake.replayCassetteReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) ReplayCassetteReturnsOnCall(i int, result1 string) {
+	fake.replayCassetteMutex.Lock()
+	defer fake.replayCassetteMutex.Unlock()
+	fake.ReplayCassetteStub = nil
+	if fake.replayCassetteReturnsOnCall == nil {
+		fake.replayCassetteReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.replayCassetteReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeConfig) RequestRetryCount() int {
 	fake.requestRetryCountMutex.Lock()
 	ret, specificReturn := fake.requestRetryCountReturnsOnCall[len(fake.requestRetryCountArgsForCall)]
@@ -2524,41 +3577,134 @@ func (fake *FakeConfig) SetAsyncTimeoutCalls(stub func(int)) {
 	fake.SetAsyncTimeoutStub = stub
 }
 
-func (fake *FakeConfig) SetAsyncTimeoutArgsForCall(i int) int {
-	fake.setAsyncTimeoutMutex.RLock()
-	defer fake.setAsyncTimeoutMutex.RUnlock()
-	argsForCall := fake.setAsyncTimeoutArgsForCall[i]
+func (fake *FakeConfig) SetAsyncTimeoutArgsForCall(i int) int {
+	fake.setAsyncTimeoutMutex.RLock()
+	defer fake.setAsyncTimeoutMutex.RUnlock()
+	argsForCall := fake.setAsyncTimeoutArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeConfig) SetColorEnabled(arg1 string) {
+	fake.setColorEnabledMutex.Lock()
+	fake.setColorEnabledArgsForCall = append(fake.setColorEnabledArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("SetColorEnabled", []interface{}{arg1})
+	fake.setColorEnabledMutex.Unlock()
+	if fake.SetColorEnabledStub != nil {
+		fake.SetColorEnabledStub(arg1)
+	}
+}
+
+func (fake *FakeConfig) SetColorEnabledCallCount() int {
+	fake.setColorEnabledMutex.RLock()
+	defer fake.setColorEnabledMutex.RUnlock()
+	return len(fake.setColorEnabledArgsForCall)
+}
+
+func (fake *FakeConfig) SetColorEnabledCalls(stub func(string)) {
+	fake.setColorEnabledMutex.Lock()
+	defer fake.setColorEnabledMutex.Unlock()
+	fake.SetColorEnabledStub = stub
+}
+
+func (fake *FakeConfig) SetColorEnabledArgsForCall(i int) string {
+	fake.setColorEnabledMutex.RLock()
+	defer fake.setColorEnabledMutex.RUnlock()
+	argsForCall := fake.setColorEnabledArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeConfig) SetEnvOrganizationGUID(arg1 string) {
+	fake.setEnvOrganizationGUIDMutex.Lock()
+	fake.setEnvOrganizationGUIDArgsForCall = append(fake.setEnvOrganizationGUIDArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("SetEnvOrganizationGUID", []interface{}{arg1})
+	fake.setEnvOrganizationGUIDMutex.Unlock()
+	if fake.SetEnvOrganizationGUIDStub != nil {
+		fake.SetEnvOrganizationGUIDStub(arg1)
+	}
+}
+
+func (fake *FakeConfig) SetEnvOrganizationGUIDCallCount() int {
+	fake.setEnvOrganizationGUIDMutex.RLock()
+	defer fake.setEnvOrganizationGUIDMutex.RUnlock()
+	return len(fake.setEnvOrganizationGUIDArgsForCall)
+}
+
+func (fake *FakeConfig) SetEnvOrganizationGUIDCalls(stub func(string)) {
+	fake.setEnvOrganizationGUIDMutex.Lock()
+	defer fake.setEnvOrganizationGUIDMutex.Unlock()
+	fake.SetEnvOrganizationGUIDStub = stub
+}
+
+func (fake *FakeConfig) SetEnvOrganizationGUIDArgsForCall(i int) string {
+	fake.setEnvOrganizationGUIDMutex.RLock()
+	defer fake.setEnvOrganizationGUIDMutex.RUnlock()
+	argsForCall := fake.setEnvOrganizationGUIDArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeConfig) SetEnvSpaceGUID(arg1 string) {
+	fake.setEnvSpaceGUIDMutex.Lock()
+	fake.setEnvSpaceGUIDArgsForCall = append(fake.setEnvSpaceGUIDArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("SetEnvSpaceGUID", []interface{}{arg1})
+	fake.setEnvSpaceGUIDMutex.Unlock()
+	if fake.SetEnvSpaceGUIDStub != nil {
+		fake.SetEnvSpaceGUIDStub(arg1)
+	}
+}
+
+func (fake *FakeConfig) SetEnvSpaceGUIDCallCount() int {
+	fake.setEnvSpaceGUIDMutex.RLock()
+	defer fake.setEnvSpaceGUIDMutex.RUnlock()
+	return len(fake.setEnvSpaceGUIDArgsForCall)
+}
+
+func (fake *FakeConfig) SetEnvSpaceGUIDCalls(stub func(string)) {
+	fake.setEnvSpaceGUIDMutex.Lock()
+	defer fake.setEnvSpaceGUIDMutex.Unlock()
+	fake.SetEnvSpaceGUIDStub = stub
+}
+
+func (fake *FakeConfig) SetEnvSpaceGUIDArgsForCall(i int) string {
+	fake.setEnvSpaceGUIDMutex.RLock()
+	defer fake.setEnvSpaceGUIDMutex.RUnlock()
+	argsForCall := fake.setEnvSpaceGUIDArgsForCall[i]
 	return argsForCall.arg1
 }
 
-func (fake *FakeConfig) SetColorEnabled(arg1 string) {
-	fake.setColorEnabledMutex.Lock()
-	fake.setColorEnabledArgsForCall = append(fake.setColorEnabledArgsForCall, struct {
-		arg1 string
+func (fake *FakeConfig) SetHTTPCacheTTL(arg1 time.Duration) {
+	fake.setHTTPCacheTTLMutex.Lock()
+	fake.setHTTPCacheTTLArgsForCall = append(fake.setHTTPCacheTTLArgsForCall, struct {
+		arg1 time.Duration
 	}{arg1})
-	fake.recordInvocation("SetColorEnabled", []interface{}{arg1})
-	fake.setColorEnabledMutex.Unlock()
-	if fake.SetColorEnabledStub != nil {
-		fake.SetColorEnabledStub(arg1)
+	fake.recordInvocation("SetHTTPCacheTTL", []interface{}{arg1})
+	fake.setHTTPCacheTTLMutex.Unlock()
+	if fake.SetHTTPCacheTTLStub != nil {
+		fake.SetHTTPCacheTTLStub(arg1)
 	}
 }
 
-func (fake *FakeConfig) SetColorEnabledCallCount() int {
-	fake.setColorEnabledMutex.RLock()
-	defer fake.setColorEnabledMutex.RUnlock()
-	return len(fake.setColorEnabledArgsForCall)
+func (fake *FakeConfig) SetHTTPCacheTTLCallCount() int {
+	fake.setHTTPCacheTTLMutex.RLock()
+	defer fake.setHTTPCacheTTLMutex.RUnlock()
+	return len(fake.setHTTPCacheTTLArgsForCall)
 }
 
-func (fake *FakeConfig) SetColorEnabledCalls(stub func(string)) {
-	fake.setColorEnabledMutex.Lock()
-	defer fake.setColorEnabledMutex.Unlock()
-	fake.SetColorEnabledStub = stub
+func (fake *FakeConfig) SetHTTPCacheTTLCalls(stub func(time.Duration)) {
+	fake.setHTTPCacheTTLMutex.Lock()
+	defer fake.setHTTPCacheTTLMutex.Unlock()
+	fake.SetHTTPCacheTTLStub = stub
 }
 
-func (fake *FakeConfig) SetColorEnabledArgsForCall(i int) string {
-	fake.setColorEnabledMutex.RLock()
-	defer fake.setColorEnabledMutex.RUnlock()
-	argsForCall := fake.setColorEnabledArgsForCall[i]
+func (fake *FakeConfig) SetHTTPCacheTTLArgsForCall(i int) time.Duration {
+	fake.setHTTPCacheTTLMutex.RLock()
+	defer fake.setHTTPCacheTTLMutex.RUnlock()
+	argsForCall := fake.setHTTPCacheTTLArgsForCall[i]
 	return argsForCall.arg1
 }
 
@@ -2687,6 +3833,37 @@ func (fake *FakeConfig) SetOrganizationInformationArgsForCall(i int) (string, st
 	return argsForCall.arg1, argsForCall.arg2
 }
 
+func (fake *FakeConfig) SetPageConcurrency(arg1 int) {
+	fake.setPageConcurrencyMutex.Lock()
+	fake.setPageConcurrencyArgsForCall = append(fake.setPageConcurrencyArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("SetPageConcurrency", []interface{}{arg1})
+	fake.setPageConcurrencyMutex.Unlock()
+	if fake.SetPageConcurrencyStub != nil {
+		fake.SetPageConcurrencyStub(arg1)
+	}
+}
+
+func (fake *FakeConfig) SetPageConcurrencyCallCount() int {
+	fake.setPageConcurrencyMutex.RLock()
+	defer fake.setPageConcurrencyMutex.RUnlock()
+	return len(fake.setPageConcurrencyArgsForCall)
+}
+
+func (fake *FakeConfig) SetPageConcurrencyCalls(stub func(int)) {
+	fake.setPageConcurrencyMutex.Lock()
+	defer fake.setPageConcurrencyMutex.Unlock()
+	fake.SetPageConcurrencyStub = stub
+}
+
+func (fake *FakeConfig) SetPageConcurrencyArgsForCall(i int) int {
+	fake.setPageConcurrencyMutex.RLock()
+	defer fake.setPageConcurrencyMutex.RUnlock()
+	argsForCall := fake.setPageConcurrencyArgsForCall[i]
+	return argsForCall.arg1
+}
+
 func (fake *FakeConfig) SetRefreshToken(arg1 string) {
 	fake.setRefreshTokenMutex.Lock()
 	fake.setRefreshTokenArgsForCall = append(fake.setRefreshTokenArgsForCall, struct {
@@ -2846,6 +4023,37 @@ func (fake *FakeConfig) SetTraceArgsForCall(i int) string {
 	return argsForCall.arg1
 }
 
+func (fake *FakeConfig) SetTraceHAR(arg1 string) {
+	fake.setTraceHARMutex.Lock()
+	fake.setTraceHARArgsForCall = append(fake.setTraceHARArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("SetTraceHAR", []interface{}{arg1})
+	fake.setTraceHARMutex.Unlock()
+	if fake.SetTraceHARStub != nil {
+		fake.SetTraceHARStub(arg1)
+	}
+}
+
+func (fake *FakeConfig) SetTraceHARCallCount() int {
+	fake.setTraceHARMutex.RLock()
+	defer fake.setTraceHARMutex.RUnlock()
+	return len(fake.setTraceHARArgsForCall)
+}
+
+func (fake *FakeConfig) SetTraceHARCalls(stub func(string)) {
+	fake.setTraceHARMutex.Lock()
+	defer fake.setTraceHARMutex.Unlock()
+	fake.SetTraceHARStub = stub
+}
+
+func (fake *FakeConfig) SetTraceHARArgsForCall(i int) string {
+	fake.setTraceHARMutex.RLock()
+	defer fake.setTraceHARMutex.RUnlock()
+	argsForCall := fake.setTraceHARArgsForCall[i]
+	return argsForCall.arg1
+}
+
 func (fake *FakeConfig) SetUAAClientCredentials(arg1 string, arg2 string) {
 	fake.setUAAClientCredentialsMutex.Lock()
 	fake.setUAAClientCredentialsArgsForCall = append(fake.setUAAClientCredentialsArgsForCall, struct {
@@ -3096,6 +4304,233 @@ func (fake *FakeConfig) StartupTimeoutReturnsOnCall(i int, result1 time.Duration
 	}{result1}
 }
 
+func (fake *FakeConfig) SwitchFoundation(arg1 string) error {
+	fake.switchFoundationMutex.Lock()
+	ret, specificReturn := fake.switchFoundationReturnsOnCall[len(fake.switchFoundationArgsForCall)]
+	fake.switchFoundationArgsForCall = append(fake.switchFoundationArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("SwitchFoundation", []interface{}{arg1})
+	fake.switchFoundationMutex.Unlock()
+	if fake.SwitchFoundationStub != nil {
+		return fake.SwitchFoundationStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.switchFoundationReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) SwitchFoundationCallCount() int {
+	fake.switchFoundationMutex.RLock()
+	defer fake.switchFoundationMutex.RUnlock()
+	return len(fake.switchFoundationArgsForCall)
+}
+
+func (fake *FakeConfig) SwitchFoundationCalls(stub func(string) error) {
+	fake.switchFoundationMutex.Lock()
+	defer fake.switchFoundationMutex.Unlock()
+	fake.SwitchFoundationStub = stub
+}
+
+func (fake *FakeConfig) SwitchFoundationArgsForCall(i int) string {
+	fake.switchFoundationMutex.RLock()
+	defer fake.switchFoundationMutex.RUnlock()
+	argsForCall := fake.switchFoundationArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeConfig) SwitchFoundationReturns(result1 error) {
+	fake.switchFoundationMutex.Lock()
+	defer fake.switchFoundationMutex.Unlock()
+	fake.SwitchFoundationStub = nil
+	fake.switchFoundationReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeConfig) SwitchFoundationReturnsOnCall(i int, result1 error) {
+	fake.switchFoundationMutex.Lock()
+	defer fake.switchFoundationMutex.Unlock()
+	fake.SwitchFoundationStub = nil
+	if fake.switchFoundationReturnsOnCall == nil {
+		fake.switchFoundationReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.switchFoundationReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeConfig) SwitchToTarget(arg1 configv3.TargetHistoryEntry) bool {
+	fake.switchToTargetMutex.Lock()
+	ret, specificReturn := fake.switchToTargetReturnsOnCall[len(fake.switchToTargetArgsForCall)]
+	fake.switchToTargetArgsForCall = append(fake.switchToTargetArgsForCall, struct {
+		arg1 configv3.TargetHistoryEntry
+	}{arg1})
+	fake.recordInvocation("SwitchToTarget", []interface{}{arg1})
+	fake.switchToTargetMutex.Unlock()
+	if fake.SwitchToTargetStub != nil {
+		return fake.SwitchToTargetStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.switchToTargetReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) SwitchToTargetCallCount() int {
+	fake.switchToTargetMutex.RLock()
+	defer fake.switchToTargetMutex.RUnlock()
+	return len(fake.switchToTargetArgsForCall)
+}
+
+func (fake *FakeConfig) SwitchToTargetCalls(stub func(configv3.TargetHistoryEntry) bool) {
+	fake.switchToTargetMutex.Lock()
+	defer fake.switchToTargetMutex.Unlock()
+	fake.SwitchToTargetStub = stub
+}
+
+func (fake *FakeConfig) SwitchToTargetArgsForCall(i int) configv3.TargetHistoryEntry {
+	fake.switchToTargetMutex.RLock()
+	defer fake.switchToTargetMutex.RUnlock()
+	argsForCall := fake.switchToTargetArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeConfig) SwitchToTargetReturns(result1 bool) {
+	fake.switchToTargetMutex.Lock()
+	defer fake.switchToTargetMutex.Unlock()
+	fake.SwitchToTargetStub = nil
+	fake.switchToTargetReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeConfig) SwitchToTargetReturnsOnCall(i int, result1 bool) {
+	fake.switchToTargetMutex.Lock()
+	defer fake.switchToTargetMutex.Unlock()
+	fake.SwitchToTargetStub = nil
+	if fake.switchToTargetReturnsOnCall == nil {
+		fake.switchToTargetReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.switchToTargetReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeConfig) TLSCertificates() (util.TLSCertificates, error) {
+	fake.tLSCertificatesMutex.Lock()
+	ret, specificReturn := fake.tLSCertificatesReturnsOnCall[len(fake.tLSCertificatesArgsForCall)]
+	fake.tLSCertificatesArgsForCall = append(fake.tLSCertificatesArgsForCall, struct {
+	}{})
+	fake.recordInvocation("TLSCertificates", []interface{}{})
+	fake.tLSCertificatesMutex.Unlock()
+	if fake.TLSCertificatesStub != nil {
+		return fake.TLSCertificatesStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.tLSCertificatesReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeConfig) TLSCertificatesCallCount() int {
+	fake.tLSCertificatesMutex.RLock()
+	defer fake.tLSCertificatesMutex.RUnlock()
+	return len(fake.tLSCertificatesArgsForCall)
+}
+
+func (fake *FakeConfig) TLSCertificatesCalls(stub func() (util.TLSCertificates, error)) {
+	fake.tLSCertificatesMutex.Lock()
+	defer fake.tLSCertificatesMutex.Unlock()
+	fake.TLSCertificatesStub = stub
+}
+
+func (fake *FakeConfig) TLSCertificatesReturns(result1 util.TLSCertificates, result2 error) {
+	fake.tLSCertificatesMutex.Lock()
+	defer fake.tLSCertificatesMutex.Unlock()
+	fake.TLSCertificatesStub = nil
+	fake.tLSCertificatesReturns = struct {
+		result1 util.TLSCertificates
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeConfig) TLSCertificatesReturnsOnCall(i int, result1 util.TLSCertificates, result2 error) {
+	fake.tLSCertificatesMutex.Lock()
+	defer fake.tLSCertificatesMutex.Unlock()
+	fake.TLSCertificatesStub = nil
+	if fake.tLSCertificatesReturnsOnCall == nil {
+		fake.tLSCertificatesReturnsOnCall = make(map[int]struct {
+			result1 util.TLSCertificates
+			result2 error
+		})
+	}
+	fake.tLSCertificatesReturnsOnCall[i] = struct {
+		result1 util.TLSCertificates
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeConfig) TableOptions() configv3.TableOptions {
+	fake.tableOptionsMutex.Lock()
+	ret, specificReturn := fake.tableOptionsReturnsOnCall[len(fake.tableOptionsArgsForCall)]
+	fake.tableOptionsArgsForCall = append(fake.tableOptionsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("TableOptions", []interface{}{})
+	fake.tableOptionsMutex.Unlock()
+	if fake.TableOptionsStub != nil {
+		return fake.TableOptionsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.tableOptionsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) TableOptionsCallCount() int {
+	fake.tableOptionsMutex.RLock()
+	defer fake.tableOptionsMutex.RUnlock()
+	return len(fake.tableOptionsArgsForCall)
+}
+
+func (fake *FakeConfig) TableOptionsCalls(stub func() configv3.TableOptions) {
+	fake.tableOptionsMutex.Lock()
+	defer fake.tableOptionsMutex.Unlock()
+	fake.TableOptionsStub = stub
+}
+
+func (fake *FakeConfig) TableOptionsReturns(result1 configv3.TableOptions) {
+	fake.tableOptionsMutex.Lock()
+	defer fake.tableOptionsMutex.Unlock()
+	fake.TableOptionsStub = nil
+	fake.tableOptionsReturns = struct {
+		result1 configv3.TableOptions
+	}{result1}
+}
+
+func (fake *FakeConfig) TableOptionsReturnsOnCall(i int, result1 configv3.TableOptions) {
+	fake.tableOptionsMutex.Lock()
+	defer fake.tableOptionsMutex.Unlock()
+	fake.TableOptionsStub = nil
+	if fake.tableOptionsReturnsOnCall == nil {
+		fake.tableOptionsReturnsOnCall = make(map[int]struct {
+			result1 configv3.TableOptions
+		})
+	}
+	fake.tableOptionsReturnsOnCall[i] = struct {
+		result1 configv3.TableOptions
+	}{result1}
+}
+
 func (fake *FakeConfig) Target() string {
 	fake.targetMutex.Lock()
 	ret, specificReturn := fake.targetReturnsOnCall[len(fake.targetArgsForCall)]
@@ -3148,6 +4583,110 @@ func (fake *FakeConfig) TargetReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeConfig) TargetFailoverURLs() []string {
+	fake.targetFailoverURLsMutex.Lock()
+	ret, specificReturn := fake.targetFailoverURLsReturnsOnCall[len(fake.targetFailoverURLsArgsForCall)]
+	fake.targetFailoverURLsArgsForCall = append(fake.targetFailoverURLsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("TargetFailoverURLs", []interface{}{})
+	fake.targetFailoverURLsMutex.Unlock()
+	if fake.TargetFailoverURLsStub != nil {
+		return fake.TargetFailoverURLsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.targetFailoverURLsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) TargetFailoverURLsCallCount() int {
+	fake.targetFailoverURLsMutex.RLock()
+	defer fake.targetFailoverURLsMutex.RUnlock()
+	return len(fake.targetFailoverURLsArgsForCall)
+}
+
+func (fake *FakeConfig) TargetFailoverURLsCalls(stub func() []string) {
+	fake.targetFailoverURLsMutex.Lock()
+	defer fake.targetFailoverURLsMutex.Unlock()
+	fake.TargetFailoverURLsStub = stub
+}
+
+func (fake *FakeConfig) TargetFailoverURLsReturns(result1 []string) {
+	fake.targetFailoverURLsMutex.Lock()
+	defer fake.targetFailoverURLsMutex.Unlock()
+	fake.TargetFailoverURLsStub = nil
+	fake.targetFailoverURLsReturns = struct {
+		result1 []string
+	}{result1}
+}
+
+func (fake *FakeConfig) TargetFailoverURLsReturnsOnCall(i int, result1 []string) {
+	fake.targetFailoverURLsMutex.Lock()
+	defer fake.targetFailoverURLsMutex.Unlock()
+	fake.TargetFailoverURLsStub = nil
+	if fake.targetFailoverURLsReturnsOnCall == nil {
+		fake.targetFailoverURLsReturnsOnCall = make(map[int]struct {
+			result1 []string
+		})
+	}
+	fake.targetFailoverURLsReturnsOnCall[i] = struct {
+		result1 []string
+	}{result1}
+}
+
+func (fake *FakeConfig) TargetHistory() []configv3.TargetHistoryEntry {
+	fake.targetHistoryMutex.Lock()
+	ret, specificReturn := fake.targetHistoryReturnsOnCall[len(fake.targetHistoryArgsForCall)]
+	fake.targetHistoryArgsForCall = append(fake.targetHistoryArgsForCall, struct {
+	}{})
+	fake.recordInvocation("TargetHistory", []interface{}{})
+	fake.targetHistoryMutex.Unlock()
+	if fake.TargetHistoryStub != nil {
+		return fake.TargetHistoryStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.targetHistoryReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) TargetHistoryCallCount() int {
+	fake.targetHistoryMutex.RLock()
+	defer fake.targetHistoryMutex.RUnlock()
+	return len(fake.targetHistoryArgsForCall)
+}
+
+func (fake *FakeConfig) TargetHistoryCalls(stub func() []configv3.TargetHistoryEntry) {
+	fake.targetHistoryMutex.Lock()
+	defer fake.targetHistoryMutex.Unlock()
+	fake.TargetHistoryStub = stub
+}
+
+func (fake *FakeConfig) TargetHistoryReturns(result1 []configv3.TargetHistoryEntry) {
+	fake.targetHistoryMutex.Lock()
+	defer fake.targetHistoryMutex.Unlock()
+	fake.TargetHistoryStub = nil
+	fake.targetHistoryReturns = struct {
+		result1 []configv3.TargetHistoryEntry
+	}{result1}
+}
+
+func (fake *FakeConfig) TargetHistoryReturnsOnCall(i int, result1 []configv3.TargetHistoryEntry) {
+	fake.targetHistoryMutex.Lock()
+	defer fake.targetHistoryMutex.Unlock()
+	fake.TargetHistoryStub = nil
+	if fake.targetHistoryReturnsOnCall == nil {
+		fake.targetHistoryReturnsOnCall = make(map[int]struct {
+			result1 []configv3.TargetHistoryEntry
+		})
+	}
+	fake.targetHistoryReturnsOnCall[i] = struct {
+		result1 []configv3.TargetHistoryEntry
+	}{result1}
+}
+
 func (fake *FakeConfig) TargetedOrganization() configv3.Organization {
 	fake.targetedOrganizationMutex.Lock()
 	ret, specificReturn := fake.targetedOrganizationReturnsOnCall[len(fake.targetedOrganizationArgsForCall)]
@@ -3356,6 +4895,110 @@ func (fake *FakeConfig) TerminalWidthReturnsOnCall(i int, result1 int) {
 	}{result1}
 }
 
+func (fake *FakeConfig) Timings() bool {
+	fake.timingsMutex.Lock()
+	ret, specificReturn := fake.timingsReturnsOnCall[len(fake.timingsArgsForCall)]
+	fake.timingsArgsForCall = append(fake.timingsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Timings", []interface{}{})
+	fake.timingsMutex.Unlock()
+	if fake.TimingsStub != nil {
+		return fake.TimingsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.timingsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) TimingsCallCount() int {
+	fake.timingsMutex.RLock()
+	defer fake.timingsMutex.RUnlock()
+	return len(fake.timingsArgsForCall)
+}
+
+func (fake *FakeConfig) TimingsCalls(stub func() bool) {
+	fake.timingsMutex.Lock()
+	defer fake.timingsMutex.Unlock()
+	fake.TimingsStub = stub
+}
+
+func (fake *FakeConfig) TimingsReturns(result1 bool) {
+	fake.timingsMutex.Lock()
+	defer fake.timingsMutex.Unlock()
+	fake.TimingsStub = nil
+	fake.timingsReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeConfig) TimingsReturnsOnCall(i int, result1 bool) {
+	fake.timingsMutex.Lock()
+	defer fake.timingsMutex.Unlock()
+	fake.TimingsStub = nil
+	if fake.timingsReturnsOnCall == nil {
+		fake.timingsReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.timingsReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeConfig) TraceHAR() string {
+	fake.traceHARMutex.Lock()
+	ret, specificReturn := fake.traceHARReturnsOnCall[len(fake.traceHARArgsForCall)]
+	fake.traceHARArgsForCall = append(fake.traceHARArgsForCall, struct {
+	}{})
+	fake.recordInvocation("TraceHAR", []interface{}{})
+	fake.traceHARMutex.Unlock()
+	if fake.TraceHARStub != nil {
+		return fake.TraceHARStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.traceHARReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) TraceHARCallCount() int {
+	fake.traceHARMutex.RLock()
+	defer fake.traceHARMutex.RUnlock()
+	return len(fake.traceHARArgsForCall)
+}
+
+func (fake *FakeConfig) TraceHARCalls(stub func() string) {
+	fake.traceHARMutex.Lock()
+	defer fake.traceHARMutex.Unlock()
+	fake.TraceHARStub = stub
+}
+
+func (fake *FakeConfig) TraceHARReturns(result1 string) {
+	fake.traceHARMutex.Lock()
+	defer fake.traceHARMutex.Unlock()
+	fake.TraceHARStub = nil
+	fake.traceHARReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) TraceHARReturnsOnCall(i int, result1 string) {
+	fake.traceHARMutex.Lock()
+	defer fake.traceHARMutex.Unlock()
+	fake.TraceHARStub = nil
+	if fake.traceHARReturnsOnCall == nil {
+		fake.traceHARReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.traceHARReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeConfig) UAADisableKeepAlives() bool {
 	fake.uAADisableKeepAlivesMutex.Lock()
 	ret, specificReturn := fake.uAADisableKeepAlivesReturnsOnCall[len(fake.uAADisableKeepAlivesArgsForCall)]
@@ -3685,6 +5328,58 @@ func (fake *FakeConfig) UnsetUserInformationCalls(stub func()) {
 	fake.UnsetUserInformationStub = stub
 }
 
+func (fake *FakeConfig) UsesEncryptedTokenStore() bool {
+	fake.usesEncryptedTokenStoreMutex.Lock()
+	ret, specificReturn := fake.usesEncryptedTokenStoreReturnsOnCall[len(fake.usesEncryptedTokenStoreArgsForCall)]
+	fake.usesEncryptedTokenStoreArgsForCall = append(fake.usesEncryptedTokenStoreArgsForCall, struct {
+	}{})
+	fake.recordInvocation("UsesEncryptedTokenStore", []interface{}{})
+	fake.usesEncryptedTokenStoreMutex.Unlock()
+	if fake.UsesEncryptedTokenStoreStub != nil {
+		return fake.UsesEncryptedTokenStoreStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.usesEncryptedTokenStoreReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) UsesEncryptedTokenStoreCallCount() int {
+	fake.usesEncryptedTokenStoreMutex.RLock()
+	defer fake.usesEncryptedTokenStoreMutex.RUnlock()
+	return len(fake.usesEncryptedTokenStoreArgsForCall)
+}
+
+func (fake *FakeConfig) UsesEncryptedTokenStoreCalls(stub func() bool) {
+	fake.usesEncryptedTokenStoreMutex.Lock()
+	defer fake.usesEncryptedTokenStoreMutex.Unlock()
+	fake.UsesEncryptedTokenStoreStub = stub
+}
+
+func (fake *FakeConfig) UsesEncryptedTokenStoreReturns(result1 bool) {
+	fake.usesEncryptedTokenStoreMutex.Lock()
+	defer fake.usesEncryptedTokenStoreMutex.Unlock()
+	fake.UsesEncryptedTokenStoreStub = nil
+	fake.usesEncryptedTokenStoreReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeConfig) UsesEncryptedTokenStoreReturnsOnCall(i int, result1 bool) {
+	fake.usesEncryptedTokenStoreMutex.Lock()
+	defer fake.usesEncryptedTokenStoreMutex.Unlock()
+	fake.UsesEncryptedTokenStoreStub = nil
+	if fake.usesEncryptedTokenStoreReturnsOnCall == nil {
+		fake.usesEncryptedTokenStoreReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.usesEncryptedTokenStoreReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeConfig) V7SetSpaceInformation(arg1 string, arg2 string) {
 	fake.v7SetSpaceInformationMutex.Lock()
 	fake.v7SetSpaceInformationArgsForCall = append(fake.v7SetSpaceInformationArgsForCall, struct {
@@ -3883,6 +5578,10 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.aPIVersionMutex.RUnlock()
 	fake.accessTokenMutex.RLock()
 	defer fake.accessTokenMutex.RUnlock()
+	fake.activeFoundationMutex.RLock()
+	defer fake.activeFoundationMutex.RUnlock()
+	fake.addFoundationMutex.RLock()
+	defer fake.addFoundationMutex.RUnlock()
 	fake.addPluginMutex.RLock()
 	defer fake.addPluginMutex.RUnlock()
 	fake.addPluginRepositoryMutex.RLock()
@@ -3897,6 +5596,8 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.cFPasswordMutex.RUnlock()
 	fake.cFUsernameMutex.RLock()
 	defer fake.cFUsernameMutex.RUnlock()
+	fake.circuitBreakerFileMutex.RLock()
+	defer fake.circuitBreakerFileMutex.RUnlock()
 	fake.colorEnabledMutex.RLock()
 	defer fake.colorEnabledMutex.RUnlock()
 	fake.currentUserMutex.RLock()
@@ -3907,12 +5608,26 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.dialTimeoutMutex.RUnlock()
 	fake.dockerPasswordMutex.RLock()
 	defer fake.dockerPasswordMutex.RUnlock()
+	fake.enableEncryptedTokenStoreMutex.RLock()
+	defer fake.enableEncryptedTokenStoreMutex.RUnlock()
+	fake.envTargetMutex.RLock()
+	defer fake.envTargetMutex.RUnlock()
 	fake.experimentalMutex.RLock()
 	defer fake.experimentalMutex.RUnlock()
+	fake.forFoundationMutex.RLock()
+	defer fake.forFoundationMutex.RUnlock()
+	fake.foundationsMutex.RLock()
+	defer fake.foundationsMutex.RUnlock()
+	fake.getFoundationMutex.RLock()
+	defer fake.getFoundationMutex.RUnlock()
 	fake.getPluginMutex.RLock()
 	defer fake.getPluginMutex.RUnlock()
 	fake.getPluginCaseInsensitiveMutex.RLock()
 	defer fake.getPluginCaseInsensitiveMutex.RUnlock()
+	fake.hTTPCacheDirectoryMutex.RLock()
+	defer fake.hTTPCacheDirectoryMutex.RUnlock()
+	fake.hTTPCacheTTLMutex.RLock()
+	defer fake.hTTPCacheTTLMutex.RUnlock()
 	fake.hasTargetedOrganizationMutex.RLock()
 	defer fake.hasTargetedOrganizationMutex.RUnlock()
 	fake.hasTargetedSpaceMutex.RLock()
@@ -3933,6 +5648,8 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.networkPolicyV1EndpointMutex.RUnlock()
 	fake.overallPollingTimeoutMutex.RLock()
 	defer fake.overallPollingTimeoutMutex.RUnlock()
+	fake.pageConcurrencyMutex.RLock()
+	defer fake.pageConcurrencyMutex.RUnlock()
 	fake.pluginHomeMutex.RLock()
 	defer fake.pluginHomeMutex.RUnlock()
 	fake.pluginRepositoriesMutex.RLock()
@@ -3941,10 +5658,20 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.pluginsMutex.RUnlock()
 	fake.pollingIntervalMutex.RLock()
 	defer fake.pollingIntervalMutex.RUnlock()
+	fake.previousTargetMutex.RLock()
+	defer fake.previousTargetMutex.RUnlock()
+	fake.recordCassetteMutex.RLock()
+	defer fake.recordCassetteMutex.RUnlock()
+	fake.recordTargetMutex.RLock()
+	defer fake.recordTargetMutex.RUnlock()
 	fake.refreshTokenMutex.RLock()
 	defer fake.refreshTokenMutex.RUnlock()
+	fake.removeFoundationMutex.RLock()
+	defer fake.removeFoundationMutex.RUnlock()
 	fake.removePluginMutex.RLock()
 	defer fake.removePluginMutex.RUnlock()
+	fake.replayCassetteMutex.RLock()
+	defer fake.replayCassetteMutex.RUnlock()
 	fake.requestRetryCountMutex.RLock()
 	defer fake.requestRetryCountMutex.RUnlock()
 	fake.routingEndpointMutex.RLock()
@@ -3957,6 +5684,12 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.setAsyncTimeoutMutex.RUnlock()
 	fake.setColorEnabledMutex.RLock()
 	defer fake.setColorEnabledMutex.RUnlock()
+	fake.setEnvOrganizationGUIDMutex.RLock()
+	defer fake.setEnvOrganizationGUIDMutex.RUnlock()
+	fake.setEnvSpaceGUIDMutex.RLock()
+	defer fake.setEnvSpaceGUIDMutex.RUnlock()
+	fake.setHTTPCacheTTLMutex.RLock()
+	defer fake.setHTTPCacheTTLMutex.RUnlock()
 	fake.setKubernetesAuthInfoMutex.RLock()
 	defer fake.setKubernetesAuthInfoMutex.RUnlock()
 	fake.setLocaleMutex.RLock()
@@ -3965,6 +5698,8 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.setMinCLIVersionMutex.RUnlock()
 	fake.setOrganizationInformationMutex.RLock()
 	defer fake.setOrganizationInformationMutex.RUnlock()
+	fake.setPageConcurrencyMutex.RLock()
+	defer fake.setPageConcurrencyMutex.RUnlock()
 	fake.setRefreshTokenMutex.RLock()
 	defer fake.setRefreshTokenMutex.RUnlock()
 	fake.setSpaceInformationMutex.RLock()
@@ -3975,6 +5710,8 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.setTokenInformationMutex.RUnlock()
 	fake.setTraceMutex.RLock()
 	defer fake.setTraceMutex.RUnlock()
+	fake.setTraceHARMutex.RLock()
+	defer fake.setTraceHARMutex.RUnlock()
 	fake.setUAAClientCredentialsMutex.RLock()
 	defer fake.setUAAClientCredentialsMutex.RUnlock()
 	fake.setUAAEndpointMutex.RLock()
@@ -3987,8 +5724,20 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.stagingTimeoutMutex.RUnlock()
 	fake.startupTimeoutMutex.RLock()
 	defer fake.startupTimeoutMutex.RUnlock()
+	fake.switchFoundationMutex.RLock()
+	defer fake.switchFoundationMutex.RUnlock()
+	fake.switchToTargetMutex.RLock()
+	defer fake.switchToTargetMutex.RUnlock()
+	fake.tLSCertificatesMutex.RLock()
+	defer fake.tLSCertificatesMutex.RUnlock()
+	fake.tableOptionsMutex.RLock()
+	defer fake.tableOptionsMutex.RUnlock()
 	fake.targetMutex.RLock()
 	defer fake.targetMutex.RUnlock()
+	fake.targetFailoverURLsMutex.RLock()
+	defer fake.targetFailoverURLsMutex.RUnlock()
+	fake.targetHistoryMutex.RLock()
+	defer fake.targetHistoryMutex.RUnlock()
 	fake.targetedOrganizationMutex.RLock()
 	defer fake.targetedOrganizationMutex.RUnlock()
 	fake.targetedOrganizationNameMutex.RLock()
@@ -3997,6 +5746,10 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.targetedSpaceMutex.RUnlock()
 	fake.terminalWidthMutex.RLock()
 	defer fake.terminalWidthMutex.RUnlock()
+	fake.timingsMutex.RLock()
+	defer fake.timingsMutex.RUnlock()
+	fake.traceHARMutex.RLock()
+	defer fake.traceHARMutex.RUnlock()
 	fake.uAADisableKeepAlivesMutex.RLock()
 	defer fake.uAADisableKeepAlivesMutex.RUnlock()
 	fake.uAAEndpointMutex.RLock()
@@ -4013,6 +5766,8 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.unsetSpaceInformationMutex.RUnlock()
 	fake.unsetUserInformationMutex.RLock()
 	defer fake.unsetUserInformationMutex.RUnlock()
+	fake.usesEncryptedTokenStoreMutex.RLock()
+	defer fake.usesEncryptedTokenStoreMutex.RUnlock()
 	fake.v7SetSpaceInformationMutex.RLock()
 	defer fake.v7SetSpaceInformationMutex.RUnlock()
 	fake.verboseMutex.RLock()
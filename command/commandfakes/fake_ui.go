@@ -226,6 +226,17 @@ type FakeUI struct {
 	displayWarningsArgsForCall []struct {
 		arg1 []string
 	}
+	DisplayYAMLStub        func(interface{}) error
+	displayYAMLMutex       sync.RWMutex
+	displayYAMLArgsForCall []struct {
+		arg1 interface{}
+	}
+	displayYAMLReturns struct {
+		result1 error
+	}
+	displayYAMLReturnsOnCall map[int]struct {
+		result1 error
+	}
 	GetErrStub        func() io.Writer
 	getErrMutex       sync.RWMutex
 	getErrArgsForCall []struct {
@@ -267,6 +278,17 @@ type FakeUI struct {
 	requestLoggerFileWriterReturnsOnCall map[int]struct {
 		result1 *ui.RequestLoggerFileWriter
 	}
+	RequestLoggerHARWriterStub        func(string) *ui.RequestLoggerHARWriter
+	requestLoggerHARWriterMutex       sync.RWMutex
+	requestLoggerHARWriterArgsForCall []struct {
+		arg1 string
+	}
+	requestLoggerHARWriterReturns struct {
+		result1 *ui.RequestLoggerHARWriter
+	}
+	requestLoggerHARWriterReturnsOnCall map[int]struct {
+		result1 *ui.RequestLoggerHARWriter
+	}
 	RequestLoggerTerminalDisplayStub        func() *ui.RequestLoggerTerminalDisplay
 	requestLoggerTerminalDisplayMutex       sync.RWMutex
 	requestLoggerTerminalDisplayArgsForCall []struct {
@@ -277,6 +299,26 @@ type FakeUI struct {
 	requestLoggerTerminalDisplayReturnsOnCall map[int]struct {
 		result1 *ui.RequestLoggerTerminalDisplay
 	}
+	RequestTimingsStub        func() *ui.RequestTimings
+	requestTimingsMutex       sync.RWMutex
+	requestTimingsArgsForCall []struct {
+	}
+	requestTimingsReturns struct {
+		result1 *ui.RequestTimings
+	}
+	requestTimingsReturnsOnCall map[int]struct {
+		result1 *ui.RequestTimings
+	}
+	ReserveOutForDataStub        func() io.Writer
+	reserveOutForDataMutex       sync.RWMutex
+	reserveOutForDataArgsForCall []struct {
+	}
+	reserveOutForDataReturns struct {
+		result1 io.Writer
+	}
+	reserveOutForDataReturnsOnCall map[int]struct {
+		result1 io.Writer
+	}
 	TranslateTextStub        func(string, ...map[string]interface{}) string
 	translateTextMutex       sync.RWMutex
 	translateTextArgsForCall []struct {
@@ -1462,6 +1504,66 @@ func (fake *FakeUI) DisplayWarningsArgsForCall(i int) []string {
 	return argsForCall.arg1
 }
 
+func (fake *FakeUI) DisplayYAML(arg1 interface{}) error {
+	fake.displayYAMLMutex.Lock()
+	ret, specificReturn := fake.displayYAMLReturnsOnCall[len(fake.displayYAMLArgsForCall)]
+	fake.displayYAMLArgsForCall = append(fake.displayYAMLArgsForCall, struct {
+		arg1 interface{}
+	}{arg1})
+	fake.recordInvocation("DisplayYAML", []interface{}{arg1})
+	fake.displayYAMLMutex.Unlock()
+	if fake.DisplayYAMLStub != nil {
+		return fake.DisplayYAMLStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.displayYAMLReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeUI) DisplayYAMLCallCount() int {
+	fake.displayYAMLMutex.RLock()
+	defer fake.displayYAMLMutex.RUnlock()
+	return len(fake.displayYAMLArgsForCall)
+}
+
+func (fake *FakeUI) DisplayYAMLCalls(stub func(interface{}) error) {
+	fake.displayYAMLMutex.Lock()
+	defer fake.displayYAMLMutex.Unlock()
+	fake.DisplayYAMLStub = stub
+}
+
+func (fake *FakeUI) DisplayYAMLArgsForCall(i int) interface{} {
+	fake.displayYAMLMutex.RLock()
+	defer fake.displayYAMLMutex.RUnlock()
+	argsForCall := fake.displayYAMLArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeUI) DisplayYAMLReturns(result1 error) {
+	fake.displayYAMLMutex.Lock()
+	defer fake.displayYAMLMutex.Unlock()
+	fake.DisplayYAMLStub = nil
+	fake.displayYAMLReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUI) DisplayYAMLReturnsOnCall(i int, result1 error) {
+	fake.displayYAMLMutex.Lock()
+	defer fake.displayYAMLMutex.Unlock()
+	fake.DisplayYAMLStub = nil
+	if fake.displayYAMLReturnsOnCall == nil {
+		fake.displayYAMLReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.displayYAMLReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeUI) GetErr() io.Writer {
 	fake.getErrMutex.Lock()
 	ret, specificReturn := fake.getErrReturnsOnCall[len(fake.getErrArgsForCall)]
@@ -1687,6 +1789,66 @@ func (fake *FakeUI) RequestLoggerFileWriterReturnsOnCall(i int, result1 *ui.Requ
 	}{result1}
 }
 
+func (fake *FakeUI) RequestLoggerHARWriter(arg1 string) *ui.RequestLoggerHARWriter {
+	fake.requestLoggerHARWriterMutex.Lock()
+	ret, specificReturn := fake.requestLoggerHARWriterReturnsOnCall[len(fake.requestLoggerHARWriterArgsForCall)]
+	fake.requestLoggerHARWriterArgsForCall = append(fake.requestLoggerHARWriterArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("RequestLoggerHARWriter", []interface{}{arg1})
+	fake.requestLoggerHARWriterMutex.Unlock()
+	if fake.RequestLoggerHARWriterStub != nil {
+		return fake.RequestLoggerHARWriterStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.requestLoggerHARWriterReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeUI) RequestLoggerHARWriterCallCount() int {
+	fake.requestLoggerHARWriterMutex.RLock()
+	defer fake.requestLoggerHARWriterMutex.RUnlock()
+	return len(fake.requestLoggerHARWriterArgsForCall)
+}
+
+func (fake *FakeUI) RequestLoggerHARWriterCalls(stub func(string) *ui.RequestLoggerHARWriter) {
+	fake.requestLoggerHARWriterMutex.Lock()
+	defer fake.requestLoggerHARWriterMutex.Unlock()
+	fake.RequestLoggerHARWriterStub = stub
+}
+
+func (fake *FakeUI) RequestLoggerHARWriterArgsForCall(i int) string {
+	fake.requestLoggerHARWriterMutex.RLock()
+	defer fake.requestLoggerHARWriterMutex.RUnlock()
+	argsForCall := fake.requestLoggerHARWriterArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeUI) RequestLoggerHARWriterReturns(result1 *ui.RequestLoggerHARWriter) {
+	fake.requestLoggerHARWriterMutex.Lock()
+	defer fake.requestLoggerHARWriterMutex.Unlock()
+	fake.RequestLoggerHARWriterStub = nil
+	fake.requestLoggerHARWriterReturns = struct {
+		result1 *ui.RequestLoggerHARWriter
+	}{result1}
+}
+
+func (fake *FakeUI) RequestLoggerHARWriterReturnsOnCall(i int, result1 *ui.RequestLoggerHARWriter) {
+	fake.requestLoggerHARWriterMutex.Lock()
+	defer fake.requestLoggerHARWriterMutex.Unlock()
+	fake.RequestLoggerHARWriterStub = nil
+	if fake.requestLoggerHARWriterReturnsOnCall == nil {
+		fake.requestLoggerHARWriterReturnsOnCall = make(map[int]struct {
+			result1 *ui.RequestLoggerHARWriter
+		})
+	}
+	fake.requestLoggerHARWriterReturnsOnCall[i] = struct {
+		result1 *ui.RequestLoggerHARWriter
+	}{result1}
+}
+
 func (fake *FakeUI) RequestLoggerTerminalDisplay() *ui.RequestLoggerTerminalDisplay {
 	fake.requestLoggerTerminalDisplayMutex.Lock()
 	ret, specificReturn := fake.requestLoggerTerminalDisplayReturnsOnCall[len(fake.requestLoggerTerminalDisplayArgsForCall)]
@@ -1740,6 +1902,110 @@ func (fake *FakeUI) RequestLoggerTerminalDisplayReturnsOnCall(i int, result1 *ui
 	}{result1}
 }
 
+func (fake *FakeUI) RequestTimings() *ui.RequestTimings {
+	fake.requestTimingsMutex.Lock()
+	ret, specificReturn := fake.requestTimingsReturnsOnCall[len(fake.requestTimingsArgsForCall)]
+	fake.requestTimingsArgsForCall = append(fake.requestTimingsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("RequestTimings", []interface{}{})
+	fake.requestTimingsMutex.Unlock()
+	if fake.RequestTimingsStub != nil {
+		return fake.RequestTimingsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.requestTimingsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeUI) RequestTimingsCallCount() int {
+	fake.requestTimingsMutex.RLock()
+	defer fake.requestTimingsMutex.RUnlock()
+	return len(fake.requestTimingsArgsForCall)
+}
+
+func (fake *FakeUI) RequestTimingsCalls(stub func() *ui.RequestTimings) {
+	fake.requestTimingsMutex.Lock()
+	defer fake.requestTimingsMutex.Unlock()
+	fake.RequestTimingsStub = stub
+}
+
+func (fake *FakeUI) RequestTimingsReturns(result1 *ui.RequestTimings) {
+	fake.requestTimingsMutex.Lock()
+	defer fake.requestTimingsMutex.Unlock()
+	fake.RequestTimingsStub = nil
+	fake.requestTimingsReturns = struct {
+		result1 *ui.RequestTimings
+	}{result1}
+}
+
+func (fake *FakeUI) RequestTimingsReturnsOnCall(i int, result1 *ui.RequestTimings) {
+	fake.requestTimingsMutex.Lock()
+	defer fake.requestTimingsMutex.Unlock()
+	fake.RequestTimingsStub = nil
+	if fake.requestTimingsReturnsOnCall == nil {
+		fake.requestTimingsReturnsOnCall = make(map[int]struct {
+			result1 *ui.RequestTimings
+		})
+	}
+	fake.requestTimingsReturnsOnCall[i] = struct {
+		result1 *ui.RequestTimings
+	}{result1}
+}
+
+func (fake *FakeUI) ReserveOutForData() io.Writer {
+	fake.reserveOutForDataMutex.Lock()
+	ret, specificReturn := fake.reserveOutForDataReturnsOnCall[len(fake.reserveOutForDataArgsForCall)]
+	fake.reserveOutForDataArgsForCall = append(fake.reserveOutForDataArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ReserveOutForData", []interface{}{})
+	fake.reserveOutForDataMutex.Unlock()
+	if fake.ReserveOutForDataStub != nil {
+		return fake.ReserveOutForDataStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.reserveOutForDataReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeUI) ReserveOutForDataCallCount() int {
+	fake.reserveOutForDataMutex.RLock()
+	defer fake.reserveOutForDataMutex.RUnlock()
+	return len(fake.reserveOutForDataArgsForCall)
+}
+
+func (fake *FakeUI) ReserveOutForDataCalls(stub func() io.Writer) {
+	fake.reserveOutForDataMutex.Lock()
+	defer fake.reserveOutForDataMutex.Unlock()
+	fake.ReserveOutForDataStub = stub
+}
+
+func (fake *FakeUI) ReserveOutForDataReturns(result1 io.Writer) {
+	fake.reserveOutForDataMutex.Lock()
+	defer fake.reserveOutForDataMutex.Unlock()
+	fake.ReserveOutForDataStub = nil
+	fake.reserveOutForDataReturns = struct {
+		result1 io.Writer
+	}{result1}
+}
+
+func (fake *FakeUI) ReserveOutForDataReturnsOnCall(i int, result1 io.Writer) {
+	fake.reserveOutForDataMutex.Lock()
+	defer fake.reserveOutForDataMutex.Unlock()
+	fake.ReserveOutForDataStub = nil
+	if fake.reserveOutForDataReturnsOnCall == nil {
+		fake.reserveOutForDataReturnsOnCall = make(map[int]struct {
+			result1 io.Writer
+		})
+	}
+	fake.reserveOutForDataReturnsOnCall[i] = struct {
+		result1 io.Writer
+	}{result1}
+}
+
 func (fake *FakeUI) TranslateText(arg1 string, arg2 ...map[string]interface{}) string {
 	fake.translateTextMutex.Lock()
 	ret, specificReturn := fake.translateTextReturnsOnCall[len(fake.translateTextArgsForCall)]
@@ -1975,6 +2241,8 @@ func (fake *FakeUI) Invocations() map[string][][]interface{} {
 	defer fake.displayWarningMutex.RUnlock()
 	fake.displayWarningsMutex.RLock()
 	defer fake.displayWarningsMutex.RUnlock()
+	fake.displayYAMLMutex.RLock()
+	defer fake.displayYAMLMutex.RUnlock()
 	fake.getErrMutex.RLock()
 	defer fake.getErrMutex.RUnlock()
 	fake.getInMutex.RLock()
@@ -1983,8 +2251,14 @@ func (fake *FakeUI) Invocations() map[string][][]interface{} {
 	defer fake.getOutMutex.RUnlock()
 	fake.requestLoggerFileWriterMutex.RLock()
 	defer fake.requestLoggerFileWriterMutex.RUnlock()
+	fake.requestLoggerHARWriterMutex.RLock()
+	defer fake.requestLoggerHARWriterMutex.RUnlock()
 	fake.requestLoggerTerminalDisplayMutex.RLock()
 	defer fake.requestLoggerTerminalDisplayMutex.RUnlock()
+	fake.requestTimingsMutex.RLock()
+	defer fake.requestTimingsMutex.RUnlock()
+	fake.reserveOutForDataMutex.RLock()
+	defer fake.reserveOutForDataMutex.RUnlock()
 	fake.translateTextMutex.RLock()
 	defer fake.translateTextMutex.RUnlock()
 	fake.userFriendlyDateMutex.RLock()
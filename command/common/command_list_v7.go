@@ -3,6 +3,7 @@ package common
 import (
 	"reflect"
 
+	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/plugin"
 	v7 "code.cloudfoundry.org/cli/command/v7"
 )
@@ -11,17 +12,27 @@ var Commands commandList
 var ShouldFallbackToLegacy = false
 
 type commandList struct {
-	VerboseOrVersion bool `short:"v" long:"version" description:"verbose and version flag"`
+	VerboseOrVersion bool               `short:"v" long:"version" description:"verbose and version flag"`
+	Foundation       string             `long:"foundation" description:"Use the named foundation for this command only"`
+	Columns          flag.TableColumns  `long:"columns" description:"Comma separated list of table columns to display, in order"`
+	SortBy           string             `long:"sort-by" description:"Sort table rows by the given column"`
+	Filter           []flag.TableFilter `long:"filter" description:"Only display table rows where COLUMN matches VALUE, '*' matches any characters (repeatable)"`
+	NoHeaders        bool               `long:"no-headers" description:"Do not display the table header row"`
+	Wide             bool               `long:"wide" description:"Display GUIDs, timestamps and labels in tables that support them"`
+	Timings          bool               `long:"timings" description:"Display the duration of every API request made by the command when it finishes"`
 
 	V3Push v7.PushCommand `command:"v3-push" description:"Push a new app or sync changes to an existing app" hidden:"true"`
 
 	API                                v7.APICommand                                `command:"api" description:"Set or view target api url"`
+	AddFoundation                      v7.AddFoundationCommand                      `command:"add-foundation" description:"Save the current API target and session as a named foundation"`
 	AddNetworkPolicy                   v7.AddNetworkPolicyCommand                   `command:"add-network-policy" description:"Create policy to allow direct network traffic from one app to another"`
 	AddPluginRepo                      plugin.AddPluginRepoCommand                  `command:"add-plugin-repo" description:"Add a new plugin repository"`
 	AllowSpaceSSH                      v7.AllowSpaceSSHCommand                      `command:"allow-space-ssh" description:"Allow SSH access for the space"`
 	App                                v7.AppCommand                                `command:"app" description:"Display health and status for an app"`
+	AppUsageEvents                     v7.AppUsageEventsCommand                     `command:"app-usage-events" description:"List app usage events, or summarize the app usage of every space"`
 	ApplyManifest                      v7.ApplyManifestCommand                      `command:"apply-manifest" description:"Apply manifest properties to a space"`
 	Apps                               v7.AppsCommand                               `command:"apps" alias:"a" description:"List all apps in the target space"`
+	AuditEvents                        v7.AuditEventsCommand                        `command:"audit-events" description:"List audit events, filtered by org, space, target, type, actor and time"`
 	Auth                               v7.AuthCommand                               `command:"auth" description:"Authenticate non-interactively"`
 	BindRouteService                   v7.BindRouteServiceCommand                   `command:"bind-route-service" alias:"brs" description:"Bind a service instance to an HTTP route"`
 	BindRunningSecurityGroup           v7.BindRunningSecurityGroupCommand           `command:"bind-running-security-group" description:"Bind a security group to the list of security groups to be used for running applications"`
@@ -68,6 +79,7 @@ type commandList struct {
 	DeleteSpace                        v7.DeleteSpaceCommand                        `command:"delete-space" description:"Delete a space"`
 	DeleteSpaceQuota                   v7.DeleteSpaceQuotaCommand                   `command:"delete-space-quota" description:"Delete a space quota"`
 	DeleteUser                         v7.DeleteUserCommand                         `command:"delete-user" description:"Delete a user"`
+	DiffSpace                          v7.DiffSpaceCommand                          `command:"diff-space" description:"Compare a space across foundations and report drift"`
 	DisableFeatureFlag                 v7.DisableFeatureFlagCommand                 `command:"disable-feature-flag" description:"Prevent use of a feature"`
 	DisableOrgIsolation                v7.DisableOrgIsolationCommand                `command:"disable-org-isolation" description:"Revoke an organization's entitlement to an isolation segment"`
 	DisableSSH                         v7.DisableSSHCommand                         `command:"disable-ssh" description:"Disable ssh for the application"`
@@ -80,10 +92,13 @@ type commandList struct {
 	EnableOrgIsolation                 v7.EnableOrgIsolationCommand                 `command:"enable-org-isolation" description:"Entitle an organization to an isolation segment"`
 	EnableSSH                          v7.EnableSSHCommand                          `command:"enable-ssh" description:"Enable ssh for the application"`
 	EnableServiceAccess                v7.EnableServiceAccessCommand                `command:"enable-service-access" description:"Enable access to a service offering or service plan for one or all orgs"`
+	EncryptTokens                      v7.EncryptTokensCommand                      `command:"encrypt-tokens" description:"Move tokens from the config file into an encrypted token store"`
 	Env                                v7.EnvCommand                                `command:"env" alias:"e" description:"Show all env variables for an app"`
 	Events                             v7.EventsCommand                             `command:"events" description:"Show recent app events"`
+	ExportInventory                    v7.ExportInventoryCommand                    `command:"export-inventory" description:"Export every visible org, space, app, route, service instance and user role as CSV files"`
 	FeatureFlag                        v7.FeatureFlagCommand                        `command:"feature-flag" description:"Retrieve an individual feature flag with status"`
 	FeatureFlags                       v7.FeatureFlagsCommand                       `command:"feature-flags" description:"Retrieve list of feature flags with status"`
+	Foundations                        v7.FoundationsCommand                        `command:"foundations" description:"List all saved foundations"`
 	GetHealthCheck                     v7.GetHealthCheckCommand                     `command:"get-health-check" description:"Show the type of health check performed on an app"`
 	Help                               HelpCommand                                  `command:"help" alias:"h" description:"Show help"`
 	InstallPlugin                      InstallPluginCommand                         `command:"install-plugin" description:"Install CLI plugin"`
@@ -108,6 +123,7 @@ type commandList struct {
 	PurgeServiceInstance               v7.PurgeServiceInstanceCommand               `command:"purge-service-instance" description:"Recursively remove a service instance and child objects from Cloud Foundry database without making requests to a service broker"`
 	PurgeServiceOffering               v7.PurgeServiceOfferingCommand               `command:"purge-service-offering" description:"Recursively remove a service offering and child objects from Cloud Foundry database without making requests to a service broker"`
 	Push                               v7.PushCommand                               `command:"push" alias:"p" description:"Push a new app or sync changes to an existing app"`
+	RemoveFoundation                   v7.RemoveFoundationCommand                   `command:"remove-foundation" description:"Remove a saved foundation"`
 	RemoveNetworkPolicy                v7.RemoveNetworkPolicyCommand                `command:"remove-network-policy" description:"Remove network traffic policy of an app"`
 	RemovePluginRepo                   plugin.RemovePluginRepoCommand               `command:"remove-plugin-repo" description:"Remove a plugin repository"`
 	Rename                             v7.RenameCommand                             `command:"rename" description:"Rename an app"`
@@ -142,6 +158,7 @@ type commandList struct {
 	ServiceBrokers                     v7.ServiceBrokersCommand                     `command:"service-brokers" description:"List service brokers"`
 	ServiceKey                         v7.ServiceKeyCommand                         `command:"service-key" description:"Show service key info"`
 	ServiceKeys                        v7.ServiceKeysCommand                        `command:"service-keys" alias:"sk" description:"List keys for a service instance"`
+	ServiceUsageEvents                 v7.ServiceUsageEventsCommand                 `command:"service-usage-events" description:"List service usage events, or summarize the service usage of every space"`
 	Services                           v7.ServicesCommand                           `command:"services" alias:"s" description:"List all service instances in the target space"`
 	SetDroplet                         v7.SetDropletCommand                         `command:"set-droplet" description:"Set the droplet used to run an app"`
 	SetEnv                             v7.SetEnvCommand                             `command:"set-env" alias:"se" description:"Set an env variable for an app"`
@@ -170,6 +187,7 @@ type commandList struct {
 	StagingSecurityGroups              v7.StagingSecurityGroupsCommand              `command:"staging-security-groups" description:"List security groups globally configured for staging applications"`
 	Start                              v7.StartCommand                              `command:"start" alias:"st" description:"Start an app"`
 	Stop                               v7.StopCommand                               `command:"stop" alias:"sp" description:"Stop an app"`
+	SwitchFoundation                   v7.SwitchFoundationCommand                   `command:"switch-foundation" description:"Switch the active foundation"`
 	Target                             v7.TargetCommand                             `command:"target" alias:"t" description:"Set or view the targeted org or space"`
 	Tasks                              v7.TasksCommand                              `command:"tasks" description:"List tasks of an app"`
 	TerminateTask                      v7.TerminateTaskCommand                      `command:"terminate-task" description:"Terminate a running task of an app"`
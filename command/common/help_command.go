@@ -320,9 +320,14 @@ func (cmd HelpCommand) environmentalVariablesTableData() [][]string {
 		{"CF_COLOR=false", cmd.UI.TranslateText("Do not colorize output")},
 		{"CF_DIAL_TIMEOUT=6", cmd.UI.TranslateText("Max wait time to establish a connection, including name resolution, in seconds")},
 		{"CF_HOME=path/to/dir/", cmd.UI.TranslateText("Override path to default config directory")},
+		{"CF_HTTP_CACHE_TTL=10m", cmd.UI.TranslateText("Cache rarely changing API responses, such as stacks and buildpacks, for this long, 0 turns the cache off")},
+		{"CF_PAGE_CONCURRENCY=4", cmd.UI.TranslateText("Number of pages of a list fetched at the same time, 1 fetches one page at a time")},
 		{"CF_PLUGIN_HOME=path/to/dir/", cmd.UI.TranslateText("Override path to default plugin config directory")},
+		{"CF_RECORD_CASSETTE=path/to/cassette.json", cmd.UI.TranslateText("Record API requests and responses in a cassette file, with credentials redacted")},
+		{"CF_REPLAY_CASSETTE=path/to/cassette.json", cmd.UI.TranslateText("Answer API requests from a recorded cassette file instead of the API")},
 		{"CF_TRACE=true", cmd.UI.TranslateText("Print API request diagnostics to stdout")},
 		{"CF_TRACE=path/to/trace.log", cmd.UI.TranslateText("Append API request diagnostics to a log file")},
+		{"CF_TRACE_HAR=path/to/trace.har", cmd.UI.TranslateText("Record API requests and responses in an HTTP Archive (HAR) file, with credentials redacted")},
 		{"all_proxy=proxy.example.com:8080", cmd.UI.TranslateText("Specify a proxy server to enable proxying for all requests")},
 		{"https_proxy=proxy.example.com:8080", cmd.UI.TranslateText("Enable proxying for HTTP requests")},
 	}
@@ -330,7 +335,14 @@ func (cmd HelpCommand) environmentalVariablesTableData() [][]string {
 
 func (cmd HelpCommand) globalOptionsTableData() [][]string {
 	return [][]string{
+		{"--columns COLUMNS", cmd.UI.TranslateText("Comma separated list of table columns to display, in order")},
+		{"--filter COLUMN=VALUE", cmd.UI.TranslateText("Only display table rows where COLUMN matches VALUE, '*' matches any characters")},
+		{"--foundation NAME", cmd.UI.TranslateText("Use the named foundation for this command only")},
 		{"--help, -h", cmd.UI.TranslateText("Show help")},
+		{"--no-headers", cmd.UI.TranslateText("Do not display the table header row")},
+		{"--sort-by COLUMN", cmd.UI.TranslateText("Sort table rows by the given column")},
+		{"--timings", cmd.UI.TranslateText("Display the duration of every API request made by the command when it finishes")},
+		{"--wide", cmd.UI.TranslateText("Display GUIDs, timestamps and labels in tables that support them")},
 		{"-v", cmd.UI.TranslateText("Print API request diagnostics to stdout")},
 	}
 }
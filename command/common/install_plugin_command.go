@@ -76,6 +76,10 @@ func (cmd *InstallPluginCommand) Setup(config command.Config, ui command.UI) err
 }
 
 func (cmd InstallPluginCommand) Execute([]string) (err error) {
+	if cmd.Config.UsesEncryptedTokenStore() {
+		return translatableerror.TokenStorePluginUnsupportedError{PluginName: string(cmd.OptionalArgs.PluginNameOrLocation)}
+	}
+
 	log.WithField("PluginHome", cmd.Config.PluginHome()).Info("making plugin dir")
 
 	var tempPluginDir string
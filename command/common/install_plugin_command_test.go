@@ -62,6 +62,19 @@ var _ = Describe("install-plugin command", func() {
 		executeErr = cmd.Execute(nil)
 	})
 
+	When("tokens are kept in the encrypted token store", func() {
+		BeforeEach(func() {
+			cmd.OptionalArgs.PluginNameOrLocation = "some-path"
+			fakeConfig.UsesEncryptedTokenStoreReturns(true)
+		})
+
+		It("returns an error without installing the plugin", func() {
+			Expect(executeErr).To(MatchError(translatableerror.TokenStorePluginUnsupportedError{PluginName: "some-path"}))
+			Expect(fakeActor.FileExistsCallCount()).To(Equal(0))
+			Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(0))
+		})
+	})
+
 	Describe("installing from a local file", func() {
 		BeforeEach(func() {
 			cmd.OptionalArgs.PluginNameOrLocation = "some-path"
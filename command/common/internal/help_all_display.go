@@ -6,6 +6,7 @@ var HelpCategoryList = []HelpCategory{
 		CommandList: [][]string{
 			{"help", "version", "login", "logout", "passwd", "target"},
 			{"api", "auth"},
+			{"foundations", "add-foundation", "remove-foundation", "switch-foundation"},
 		},
 	},
 	{
@@ -18,7 +19,7 @@ var HelpCategoryList = []HelpCategory{
 			{"run-task", "tasks", "terminate-task"},
 			{"packages", "create-package"},
 			{"droplets", "set-droplet", "download-droplet"},
-			{"events", "logs"},
+			{"events", "audit-events", "logs"},
 			{"env", "set-env", "unset-env"},
 			{"stacks", "stack"},
 			{"copy-source", "create-app-manifest"},
@@ -42,12 +43,14 @@ var HelpCategoryList = []HelpCategory{
 		CommandList: [][]string{
 			{"orgs", "org"},
 			{"create-org", "delete-org", "rename-org"},
+			{"export-inventory"},
+			{"app-usage-events", "service-usage-events"},
 		},
 	},
 	{
 		CategoryName: "SPACES:",
 		CommandList: [][]string{
-			{"spaces", "space"},
+			{"spaces", "space", "diff-space"},
 			{"create-space", "delete-space", "rename-space", "apply-manifest"},
 			{"allow-space-ssh", "disallow-space-ssh", "space-ssh-allowed"},
 		},
@@ -151,7 +154,7 @@ var HelpCategoryList = []HelpCategory{
 	{
 		CategoryName: "ADVANCED:",
 		CommandList: [][]string{
-			{"curl", "config", "oauth-token", "ssh-code"},
+			{"curl", "config", "encrypt-tokens", "oauth-token", "ssh-code"},
 		},
 	},
 	{
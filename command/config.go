@@ -3,6 +3,7 @@ package command
 import (
 	"time"
 
+	"code.cloudfoundry.org/cli/util"
 	"code.cloudfoundry.org/cli/util/configv3"
 )
 
@@ -11,6 +12,8 @@ import (
 // Config a way of getting basic CF configuration
 type Config interface {
 	AccessToken() string
+	ActiveFoundation() string
+	AddFoundation(name string)
 	AddPlugin(configv3.Plugin)
 	AddPluginRepository(name string, url string)
 	AuthorizationEndpoint() string
@@ -18,17 +21,25 @@ type Config interface {
 	BinaryName() string
 	BinaryVersion() string
 	CFPassword() string
+	CircuitBreakerFile() string
 	CFUsername() string
 	ColorEnabled() configv3.ColorSetting
 	CurrentUser() (configv3.User, error)
 	CurrentUserName() (string, error)
 	DialTimeout() time.Duration
 	DockerPassword() string
+	EnableEncryptedTokenStore() error
+	EnvTarget() configv3.EnvTarget
 	Experimental() bool
+	ForFoundation(foundation configv3.Foundation) *configv3.Config
+	Foundations() []configv3.Foundation
+	GetFoundation(name string) (configv3.Foundation, bool)
 	GetPlugin(pluginName string) (configv3.Plugin, bool)
 	GetPluginCaseInsensitive(pluginName string) (configv3.Plugin, bool)
 	HasTargetedOrganization() bool
 	HasTargetedSpace() bool
+	HTTPCacheDirectory() string
+	HTTPCacheTTL() time.Duration
 	IsTTY() bool
 	Locale() string
 	LogCacheEndpoint() string
@@ -36,26 +47,37 @@ type Config interface {
 	NOAARequestRetryCount() int
 	NetworkPolicyV1Endpoint() string
 	OverallPollingTimeout() time.Duration
+	PageConcurrency() int
 	PluginHome() string
 	PluginRepositories() []configv3.PluginRepository
 	Plugins() []configv3.Plugin
 	PollingInterval() time.Duration
+	PreviousTarget() (configv3.TargetHistoryEntry, bool)
+	RecordCassette() string
+	RecordTarget()
 	RefreshToken() string
+	RemoveFoundation(name string)
 	RemovePlugin(string)
+	ReplayCassette() string
 	RequestRetryCount() int
 	RoutingEndpoint() string
 	SetAsyncTimeout(timeout int)
 	SetAccessToken(token string)
 	SetColorEnabled(enabled string)
+	SetEnvOrganizationGUID(guid string)
+	SetEnvSpaceGUID(guid string)
+	SetHTTPCacheTTL(ttl time.Duration)
 	SetLocale(locale string)
 	SetMinCLIVersion(version string)
 	SetOrganizationInformation(guid string, name string)
+	SetPageConcurrency(concurrency int)
 	SetRefreshToken(token string)
 	SetSpaceInformation(guid string, name string, allowSSH bool)
 	V7SetSpaceInformation(guid string, name string)
 	SetTargetInformation(args configv3.TargetInformationArgs)
 	SetTokenInformation(accessToken string, refreshToken string, sshOAuthClient string)
 	SetTrace(trace string)
+	SetTraceHAR(path string)
 	SetUAAClientCredentials(client string, clientSecret string)
 	SetUAAEndpoint(uaaEndpoint string)
 	SetUAAGrantType(uaaGrantType string)
@@ -63,12 +85,20 @@ type Config interface {
 	SSHOAuthClient() string
 	StagingTimeout() time.Duration
 	StartupTimeout() time.Duration
+	SwitchFoundation(name string) error
+	SwitchToTarget(entry configv3.TargetHistoryEntry) bool
+	TableOptions() configv3.TableOptions
 	// TODO: Rename to APITarget()
 	Target() string
+	TargetHistory() []configv3.TargetHistoryEntry
 	TargetedOrganization() configv3.Organization
 	TargetedOrganizationName() string
 	TargetedSpace() configv3.Space
+	TargetFailoverURLs() []string
 	TerminalWidth() int
+	Timings() bool
+	TLSCertificates() (util.TLSCertificates, error)
+	TraceHAR() string
 	UAADisableKeepAlives() bool
 	UAAEndpoint() string
 	UAAGrantType() string
@@ -77,6 +107,7 @@ type Config interface {
 	UnsetOrganizationAndSpaceInformation()
 	UnsetSpaceInformation()
 	UnsetUserInformation()
+	UsesEncryptedTokenStore() bool
 	Verbose() (bool, []string)
 	WritePluginConfig() error
 	WriteConfig() error
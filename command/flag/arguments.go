@@ -29,6 +29,10 @@ type Feature struct {
 	Feature string `positional-arg-name:"FEATURE_NAME" required:"true" description:"The feature flag name"`
 }
 
+type FoundationName struct {
+	Foundation string `positional-arg-name:"FOUNDATION_NAME" required:"true" description:"The foundation name"`
+}
+
 type ParamsAsJSON struct {
 	JSON string `positional-arg-name:"JSON" required:"true" description:"Parameters as JSON"`
 }
@@ -73,6 +77,10 @@ type Space struct {
 	Space string `positional-arg-name:"SPACE" required:"true" description:"The space"`
 }
 
+type PreviousTarget struct {
+	Previous string `positional-arg-name:"-" description:"Target the previously targeted API, org and space, or with --history the recent target with the given number"`
+}
+
 type Rename struct {
 	OldAppName string `positional-arg-name:"APP_NAME" required:"true" description:"The current app name"`
 	NewAppName string `positional-arg-name:"NEW_APP_NAME" required:"true" description:"The new app name"`
@@ -96,7 +104,8 @@ type Username struct {
 }
 
 type APITarget struct {
-	URL string `positional-arg-name:"URL" description:"API URL to target"`
+	URL          string   `positional-arg-name:"URL" description:"API URL to target"`
+	FailoverURLs []string `positional-arg-name:"FAILOVER_URL" description:"Equivalent API URLs to fail over to, in order, when the API URL is unavailable"`
 }
 
 type Authentication struct {
@@ -408,3 +417,7 @@ type RemoveNetworkPolicyArgsV7 struct {
 	SourceApp string `positional-arg-name:"SOURCE_APP" required:"true" description:"The source app"`
 	DestApp   string `positional-arg-name:"DESTINATION_APP" required:"true" description:"The destination app"`
 }
+
+type ExportInventoryArgs struct {
+	Directory Path `positional-arg-name:"DIRECTORY" required:"true" description:"The directory to write the CSV files to"`
+}
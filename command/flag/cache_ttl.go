@@ -0,0 +1,28 @@
+package flag
+
+import (
+	"time"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+// CacheTTL is a duration such as '10m' or '1h'. A TTL of 0 turns the cache
+// off.
+type CacheTTL struct {
+	Duration time.Duration
+	IsSet    bool
+}
+
+func (t *CacheTTL) UnmarshalFlag(val string) error {
+	duration, err := time.ParseDuration(val)
+	if err != nil || duration < 0 {
+		return &flags.Error{
+			Type:    flags.ErrMarshal,
+			Message: "Cache TTL must be a duration such as 10m or 1h, or 0 to turn the cache off",
+		}
+	}
+
+	t.Duration = duration
+	t.IsSet = true
+	return nil
+}
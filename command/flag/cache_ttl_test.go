@@ -0,0 +1,45 @@
+package flag_test
+
+import (
+	"time"
+
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CacheTTL", func() {
+	var cacheTTL CacheTTL
+
+	BeforeEach(func() {
+		cacheTTL = CacheTTL{}
+	})
+
+	Describe("UnmarshalFlag", func() {
+		DescribeTable("accepts durations",
+			func(input string, expected time.Duration) {
+				Expect(cacheTTL.UnmarshalFlag(input)).To(Succeed())
+				Expect(cacheTTL).To(Equal(CacheTTL{Duration: expected, IsSet: true}))
+			},
+			Entry("minutes", "10m", 10*time.Minute),
+			Entry("hours", "1h", time.Hour),
+			Entry("zero", "0", time.Duration(0)),
+		)
+
+		DescribeTable("rejects other values",
+			func(input string) {
+				err := cacheTTL.UnmarshalFlag(input)
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrMarshal,
+					Message: "Cache TTL must be a duration such as 10m or 1h, or 0 to turn the cache off",
+				}))
+				Expect(cacheTTL.IsSet).To(BeFalse())
+			},
+			Entry("a number without a unit", "10"),
+			Entry("a negative duration", "-1m"),
+			Entry("words", "soon"),
+		)
+	})
+})
@@ -0,0 +1,42 @@
+package flag
+
+import (
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+const (
+	OutputJSON = "json"
+	OutputYAML = "yaml"
+	OutputCSV  = "csv"
+)
+
+// OutputFormat is the structured format a command renders its results in
+// instead of tables. Format is empty when no format was requested.
+type OutputFormat struct {
+	Format string
+}
+
+func (OutputFormat) Complete(prefix string) []flags.Completion {
+	return completions([]string{OutputJSON, OutputYAML, OutputCSV}, prefix, false)
+}
+
+func (o *OutputFormat) UnmarshalFlag(val string) error {
+	valLower := strings.ToLower(val)
+	switch valLower {
+	case OutputJSON, OutputYAML, OutputCSV:
+		o.Format = valLower
+	default:
+		return &flags.Error{
+			Type:    flags.ErrInvalidChoice,
+			Message: `OUTPUT must be "json", "yaml" or "csv"`,
+		}
+	}
+	return nil
+}
+
+// IsSet returns true if a structured output format was requested.
+func (o OutputFormat) IsSet() bool {
+	return o.Format != ""
+}
@@ -0,0 +1,59 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("OutputFormat", func() {
+	var output OutputFormat
+
+	Describe("Complete", func() {
+		DescribeTable("returns list of completions",
+			func(prefix string, matches []flags.Completion) {
+				completions := output.Complete(prefix)
+				Expect(completions).To(Equal(matches))
+			},
+			Entry("returns 'json' when passed 'j'", "j",
+				[]flags.Completion{{Item: "json"}}),
+			Entry("returns 'yaml' when passed 'Y'", "Y",
+				[]flags.Completion{{Item: "yaml"}}),
+			Entry("returns 'csv' when passed 'c'", "c",
+				[]flags.Completion{{Item: "csv"}}),
+			Entry("returns all formats when passed nothing", "",
+				[]flags.Completion{{Item: "json"}, {Item: "yaml"}, {Item: "csv"}}),
+		)
+	})
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			output = OutputFormat{}
+		})
+
+		DescribeTable("downcases and sets the format",
+			func(value string, expectedFormat string) {
+				err := output.UnmarshalFlag(value)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(output.Format).To(Equal(expectedFormat))
+				Expect(output.IsSet()).To(BeTrue())
+			},
+			Entry("sets 'json' when passed 'json'", "json", OutputJSON),
+			Entry("sets 'yaml' when passed 'YAML'", "YAML", OutputYAML),
+			Entry("sets 'csv' when passed 'Csv'", "Csv", OutputCSV),
+		)
+
+		When("passed anything else", func() {
+			It("returns an error", func() {
+				err := output.UnmarshalFlag("xml")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrInvalidChoice,
+					Message: `OUTPUT must be "json", "yaml" or "csv"`,
+				}))
+				Expect(output.IsSet()).To(BeFalse())
+			})
+		})
+	})
+})
@@ -0,0 +1,81 @@
+package flag
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+
+	flags "github.com/jessevdk/go-flags"
+	"k8s.io/client-go/util/jsonpath"
+)
+
+// GoTemplate is a text/template given with '--format'. The template is
+// executed against the same values that '--output' renders, using their Go
+// field names.
+type GoTemplate struct {
+	Template *template.Template
+}
+
+func (t *GoTemplate) UnmarshalFlag(val string) error {
+	tmpl, err := template.New("format").Funcs(template.FuncMap{
+		"join": strings.Join,
+	}).Parse(val)
+	if err != nil {
+		return &flags.Error{
+			Type:    flags.ErrMarshal,
+			Message: fmt.Sprintf("--format is not a valid template: %s", err),
+		}
+	}
+
+	t.Template = tmpl
+	return nil
+}
+
+// IsSet returns true if a template was given.
+func (t GoTemplate) IsSet() bool {
+	return t.Template != nil
+}
+
+// JSONPath is a kubectl style JSONPath expression given with '--jsonpath'.
+// The expression is evaluated against the JSON that '--output json' renders.
+// The surrounding braces and the leading dot may be left out, so '.guid',
+// 'guid' and '{.guid}' are the same expression.
+type JSONPath struct {
+	Path *jsonpath.JSONPath
+	// Expression is the parsed expression in its braced form, e.g. "{.guid}".
+	Expression string
+}
+
+func (j *JSONPath) UnmarshalFlag(val string) error {
+	expression := relaxedJSONPath(val)
+	path := jsonpath.New("jsonpath").AllowMissingKeys(true)
+	err := path.Parse(expression)
+	if err != nil {
+		return &flags.Error{
+			Type:    flags.ErrMarshal,
+			Message: fmt.Sprintf("--jsonpath is not a valid expression: %s", err),
+		}
+	}
+
+	j.Path = path
+	j.Expression = expression
+	return nil
+}
+
+// IsSet returns true if an expression was given.
+func (j JSONPath) IsSet() bool {
+	return j.Path != nil
+}
+
+func relaxedJSONPath(expression string) string {
+	expression = strings.TrimSpace(expression)
+	if strings.Contains(expression, "{") {
+		return expression
+	}
+
+	expression = strings.TrimPrefix(expression, "$")
+	if !strings.HasPrefix(expression, ".") && !strings.HasPrefix(expression, "[") {
+		expression = "." + expression
+	}
+	return "{" + expression + "}"
+}
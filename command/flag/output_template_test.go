@@ -0,0 +1,78 @@
+package flag_test
+
+import (
+	"bytes"
+
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("GoTemplate", func() {
+	var goTemplate GoTemplate
+
+	BeforeEach(func() {
+		goTemplate = GoTemplate{}
+	})
+
+	Describe("UnmarshalFlag", func() {
+		It("parses the template", func() {
+			Expect(goTemplate.UnmarshalFlag(`{{range .}}{{.Name}} {{join .Routes ","}}{{end}}`)).To(Succeed())
+			Expect(goTemplate.IsSet()).To(BeTrue())
+
+			buffer := new(bytes.Buffer)
+			Expect(goTemplate.Template.Execute(buffer, []map[string]interface{}{
+				{"Name": "some-app", "Routes": []string{"a.com", "b.com"}},
+			})).To(Succeed())
+			Expect(buffer.String()).To(Equal("some-app a.com,b.com"))
+		})
+
+		When("the template is invalid", func() {
+			It("returns an error", func() {
+				err := goTemplate.UnmarshalFlag("{{.Name")
+				Expect(err).To(HaveOccurred())
+				Expect(err.(*flags.Error).Type).To(Equal(flags.ErrMarshal))
+				Expect(err.Error()).To(HavePrefix("--format is not a valid template: "))
+				Expect(goTemplate.IsSet()).To(BeFalse())
+			})
+		})
+	})
+})
+
+var _ = Describe("JSONPath", func() {
+	var jsonPath JSONPath
+
+	BeforeEach(func() {
+		jsonPath = JSONPath{}
+	})
+
+	Describe("UnmarshalFlag", func() {
+		DescribeTable("accepts relaxed expressions",
+			func(expression string) {
+				Expect(jsonPath.UnmarshalFlag(expression)).To(Succeed())
+				Expect(jsonPath.IsSet()).To(BeTrue())
+				Expect(jsonPath.Expression).To(Equal("{.guid}"))
+
+				buffer := new(bytes.Buffer)
+				Expect(jsonPath.Path.Execute(buffer, map[string]interface{}{"guid": "some-guid"})).To(Succeed())
+				Expect(buffer.String()).To(Equal("some-guid"))
+			},
+			Entry("with braces", "{.guid}"),
+			Entry("without braces", ".guid"),
+			Entry("without the leading dot", "guid"),
+			Entry("with a leading dollar", "$.guid"),
+		)
+
+		When("the expression is invalid", func() {
+			It("returns an error", func() {
+				err := jsonPath.UnmarshalFlag("{.items[}")
+				Expect(err).To(HaveOccurred())
+				Expect(err.(*flags.Error).Type).To(Equal(flags.ErrMarshal))
+				Expect(err.Error()).To(HavePrefix("--jsonpath is not a valid expression: "))
+				Expect(jsonPath.IsSet()).To(BeFalse())
+			})
+		})
+	})
+})
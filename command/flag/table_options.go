@@ -0,0 +1,51 @@
+package flag
+
+import (
+	"fmt"
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+// TableColumns is the comma separated list of columns given with '--columns'.
+type TableColumns []string
+
+func (c *TableColumns) UnmarshalFlag(val string) error {
+	var columns []string
+	for _, column := range strings.Split(val, ",") {
+		if trimmed := strings.TrimSpace(column); trimmed != "" {
+			columns = append(columns, trimmed)
+		}
+	}
+
+	if len(columns) == 0 {
+		return &flags.Error{
+			Type:    flags.ErrMarshal,
+			Message: "--columns must list at least one column",
+		}
+	}
+
+	*c = columns
+	return nil
+}
+
+// TableFilter is a COLUMN=VALUE pair given with '--filter'.
+type TableFilter struct {
+	Column string
+	Value  string
+}
+
+func (f *TableFilter) UnmarshalFlag(val string) error {
+	column, value, found := strings.Cut(val, "=")
+	column = strings.TrimSpace(column)
+	if !found || column == "" {
+		return &flags.Error{
+			Type:    flags.ErrMarshal,
+			Message: fmt.Sprintf("--filter must be given as COLUMN=VALUE, got '%s'", val),
+		}
+	}
+
+	f.Column = column
+	f.Value = strings.TrimSpace(value)
+	return nil
+}
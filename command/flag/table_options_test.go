@@ -0,0 +1,69 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TableColumns", func() {
+	var columns TableColumns
+
+	BeforeEach(func() {
+		columns = TableColumns{}
+	})
+
+	Describe("UnmarshalFlag", func() {
+		It("splits and trims the columns", func() {
+			Expect(columns.UnmarshalFlag(" name, state,,routes ")).To(Succeed())
+			Expect(columns).To(Equal(TableColumns{"name", "state", "routes"}))
+		})
+
+		When("no column is given", func() {
+			It("returns an error", func() {
+				err := columns.UnmarshalFlag(" , ")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrMarshal,
+					Message: "--columns must list at least one column",
+				}))
+			})
+		})
+	})
+})
+
+var _ = Describe("TableFilter", func() {
+	var filter TableFilter
+
+	BeforeEach(func() {
+		filter = TableFilter{}
+	})
+
+	Describe("UnmarshalFlag", func() {
+		It("splits on the first equals sign", func() {
+			Expect(filter.UnmarshalFlag("routes=a.com/path?x=y")).To(Succeed())
+			Expect(filter).To(Equal(TableFilter{Column: "routes", Value: "a.com/path?x=y"}))
+		})
+
+		It("allows an empty value", func() {
+			Expect(filter.UnmarshalFlag("routes=")).To(Succeed())
+			Expect(filter).To(Equal(TableFilter{Column: "routes", Value: ""}))
+		})
+
+		When("there is no equals sign", func() {
+			It("returns an error", func() {
+				err := filter.UnmarshalFlag("state")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrMarshal,
+					Message: "--filter must be given as COLUMN=VALUE, got 'state'",
+				}))
+			})
+		})
+
+		When("the column is empty", func() {
+			It("returns an error", func() {
+				Expect(filter.UnmarshalFlag("=started")).To(HaveOccurred())
+			})
+		})
+	})
+})
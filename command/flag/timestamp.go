@@ -0,0 +1,37 @@
+package flag
+
+import (
+	"time"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+// Timestamp is a point in time given as an RFC 3339 timestamp such as
+// '2021-03-04T05:06:07Z', a date such as '2021-03-04' (midnight local time),
+// or a duration such as '2h' meaning that long ago.
+type Timestamp struct {
+	Time  time.Time
+	IsSet bool
+}
+
+func (t *Timestamp) UnmarshalFlag(val string) error {
+	if timestamp, err := time.Parse(time.RFC3339, val); err == nil {
+		t.Time, t.IsSet = timestamp, true
+		return nil
+	}
+
+	if date, err := time.ParseInLocation("2006-01-02", val, time.Local); err == nil {
+		t.Time, t.IsSet = date, true
+		return nil
+	}
+
+	if duration, err := time.ParseDuration(val); err == nil && duration >= 0 {
+		t.Time, t.IsSet = time.Now().Add(-duration), true
+		return nil
+	}
+
+	return &flags.Error{
+		Type:    flags.ErrMarshal,
+		Message: "Timestamp must be like 2021-03-04T05:06:07Z, a date like 2021-03-04, or a duration ago like 2h",
+	}
+}
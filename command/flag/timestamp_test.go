@@ -0,0 +1,52 @@
+package flag_test
+
+import (
+	"time"
+
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Timestamp", func() {
+	var timestamp Timestamp
+
+	BeforeEach(func() {
+		timestamp = Timestamp{}
+	})
+
+	Describe("UnmarshalFlag", func() {
+		It("accepts RFC 3339 timestamps", func() {
+			Expect(timestamp.UnmarshalFlag("2021-03-04T05:06:07+01:00")).To(Succeed())
+			Expect(timestamp.IsSet).To(BeTrue())
+			Expect(timestamp.Time.Equal(time.Date(2021, 3, 4, 4, 6, 7, 0, time.UTC))).To(BeTrue())
+		})
+
+		It("accepts dates as midnight local time", func() {
+			Expect(timestamp.UnmarshalFlag("2021-03-04")).To(Succeed())
+			Expect(timestamp).To(Equal(Timestamp{Time: time.Date(2021, 3, 4, 0, 0, 0, 0, time.Local), IsSet: true}))
+		})
+
+		It("accepts durations as that long ago", func() {
+			Expect(timestamp.UnmarshalFlag("2h")).To(Succeed())
+			Expect(timestamp.IsSet).To(BeTrue())
+			Expect(timestamp.Time).To(BeTemporally("~", time.Now().Add(-2*time.Hour), time.Minute))
+		})
+
+		DescribeTable("rejects other values",
+			func(input string) {
+				err := timestamp.UnmarshalFlag(input)
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrMarshal,
+					Message: "Timestamp must be like 2021-03-04T05:06:07Z, a date like 2021-03-04, or a duration ago like 2h",
+				}))
+				Expect(timestamp.IsSet).To(BeFalse())
+			},
+			Entry("a negative duration", "-1h"),
+			Entry("a timestamp without a time zone", "2021-03-04T05:06:07"),
+			Entry("words", "yesterday"),
+		)
+	})
+})
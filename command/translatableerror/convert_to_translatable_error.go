@@ -48,8 +48,16 @@ func ConvertToTranslatableError(err error) error {
 		return EmptyDirectoryError(e)
 	case actionerror.EmptyBuildpackDirectoryError:
 		return EmptyBuildpackDirectoryError(e)
+	case actionerror.EnvAPINotTargetedError:
+		return EnvAPINotTargetedError(e)
+	case actionerror.EnvOrganizationNotFoundError:
+		return EnvOrganizationNotFoundError(e)
+	case actionerror.EnvSpaceNotFoundError:
+		return EnvSpaceNotFoundError(e)
 	case actionerror.FileChangedError:
 		return FileChangedError(e)
+	case actionerror.FoundationNotFoundError:
+		return FoundationNotFoundError(e)
 	case actionerror.GettingPluginRepositoryError:
 		return GettingPluginRepositoryError(e)
 	case actionerror.HostnameWithTCPDomainError:
@@ -58,6 +66,8 @@ func ConvertToTranslatableError(err error) error {
 		return HTTPHealthCheckInvalidError{}
 	case actionerror.InvalidBuildpacksError:
 		return InvalidBuildpacksError{}
+	case actionerror.InvalidFoundationsFileError:
+		return InvalidFoundationsFileError(e)
 	case actionerror.InvalidHTTPRouteSettings:
 		return PortNotAllowedWithHTTPDomainError(e)
 	case actionerror.InvalidRouteError:
@@ -149,6 +159,8 @@ func ConvertToTranslatableError(err error) error {
 		return TCPRouteOptionsNotProvidedError{}
 	case actionerror.TriggerLegacyPushError:
 		return TriggerLegacyPushError{DomainHostRelated: e.DomainHostRelated}
+	case actionerror.UnsavedFoundationError:
+		return UnsavedFoundationError(e)
 	case actionerror.UploadFailedError:
 		return UploadFailedError{Err: ConvertToTranslatableError(e.Err)}
 	case actionerror.CommandLineOptionsAndManifestConflictError:
@@ -183,6 +195,8 @@ func ConvertToTranslatableError(err error) error {
 		return JobFailedNoErrorError{JobGUID: e.JobGUID}
 	case ccerror.MultiError:
 		return MultiError{Messages: e.Details()}
+	case ccerror.RateLimitExceededError:
+		return RateLimitExceededError{ResetAt: e.ResetAt}
 	case ccerror.UnprocessableEntityError:
 		if strings.Contains(e.Message, "Task must have a droplet. Specify droplet or assign current droplet to app.") {
 			return RunTaskError{Message: "App is not staged."}
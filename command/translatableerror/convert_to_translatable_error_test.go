@@ -96,10 +96,30 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			actionerror.FileChangedError{Filename: "some-filename"},
 			FileChangedError{Filename: "some-filename"}),
 
+		Entry("actionerror.FoundationNotFoundError -> FoundationNotFoundError",
+			actionerror.FoundationNotFoundError{Name: "dc2"},
+			FoundationNotFoundError{Name: "dc2"}),
+
+		Entry("actionerror.InvalidFoundationsFileError -> InvalidFoundationsFileError",
+			actionerror.InvalidFoundationsFileError{Path: "foundations.yml", Message: "no foundations listed"},
+			InvalidFoundationsFileError{Path: "foundations.yml", Message: "no foundations listed"}),
+
 		Entry("actionerror.GettingPluginRepositoryError -> GettingPluginRepositoryError",
 			actionerror.GettingPluginRepositoryError{Name: "some-repo", Message: "404"},
 			GettingPluginRepositoryError{Name: "some-repo", Message: "404"}),
 
+		Entry("actionerror.EnvAPINotTargetedError -> EnvAPINotTargetedError",
+			actionerror.EnvAPINotTargetedError{API: "https://api.example.com", BinaryName: "faceman"},
+			EnvAPINotTargetedError{API: "https://api.example.com", BinaryName: "faceman"}),
+
+		Entry("actionerror.EnvOrganizationNotFoundError -> EnvOrganizationNotFoundError",
+			actionerror.EnvOrganizationNotFoundError{Name: "some-org"},
+			EnvOrganizationNotFoundError{Name: "some-org"}),
+
+		Entry("actionerror.EnvSpaceNotFoundError -> EnvSpaceNotFoundError",
+			actionerror.EnvSpaceNotFoundError{Name: "some-space", OrganizationName: "some-org"},
+			EnvSpaceNotFoundError{Name: "some-space", OrganizationName: "some-org"}),
+
 		Entry("actionerror.HostnameWithTCPDomainError -> HostnameWithTCPDomainError",
 			actionerror.HostnameWithTCPDomainError{},
 			HostnameWithTCPDomainError{}),
@@ -287,6 +307,10 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			actionerror.TriggerLegacyPushError{DomainHostRelated: []string{"domain", "host"}},
 			TriggerLegacyPushError{DomainHostRelated: []string{"domain", "host"}}),
 
+		Entry("actionerror.UnsavedFoundationError -> UnsavedFoundationError",
+			actionerror.UnsavedFoundationError{API: "https://api.dc1.com", BinaryName: "faceman"},
+			UnsavedFoundationError{API: "https://api.dc1.com", BinaryName: "faceman"}),
+
 		Entry("actionerror.UploadFailedError -> UploadFailedError",
 			actionerror.UploadFailedError{Err: actionerror.NoDomainsFoundError{}},
 			UploadFailedError{Err: NoDomainsFoundError{}}),
@@ -334,6 +358,10 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			ccerror.JobFailedNoErrorError{JobGUID: "some-job-guid"},
 			JobFailedNoErrorError{JobGUID: "some-job-guid"}),
 
+		Entry("ccerror.RateLimitExceededError -> RateLimitExceededError",
+			ccerror.RateLimitExceededError{Message: "some-message", ResetAt: time.Unix(1700000000, 0)},
+			RateLimitExceededError{ResetAt: time.Unix(1700000000, 0)}),
+
 		Entry("ccerror.MultiError -> MultiError",
 			ccerror.MultiError{ResponseCode: 418, Errors: []ccerror.V3Error{
 				{
@@ -0,0 +1,17 @@
+package translatableerror
+
+type EnvAPINotTargetedError struct {
+	API        string
+	BinaryName string
+}
+
+func (EnvAPINotTargetedError) Error() string {
+	return "API endpoint '{{.API}}' from CF_API is not the API of the foundation selected with '{{.BinaryName}} --foundation'. Unset CF_API or select another foundation."
+}
+
+func (e EnvAPINotTargetedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"API":        e.API,
+		"BinaryName": e.BinaryName,
+	})
+}
@@ -0,0 +1,15 @@
+package translatableerror
+
+type EnvOrganizationNotFoundError struct {
+	Name string
+}
+
+func (EnvOrganizationNotFoundError) Error() string {
+	return "Organization '{{.Name}}' from CF_ORG not found."
+}
+
+func (e EnvOrganizationNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Name": e.Name,
+	})
+}
@@ -0,0 +1,17 @@
+package translatableerror
+
+type EnvSpaceNotFoundError struct {
+	Name             string
+	OrganizationName string
+}
+
+func (EnvSpaceNotFoundError) Error() string {
+	return "Space '{{.Name}}' from CF_SPACE not found in org '{{.OrganizationName}}'."
+}
+
+func (e EnvSpaceNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Name":             e.Name,
+		"OrganizationName": e.OrganizationName,
+	})
+}
@@ -0,0 +1,17 @@
+package translatableerror
+
+type FoundationCredentialsNotSetError struct {
+	Foundation string
+	EnvVar     string
+}
+
+func (FoundationCredentialsNotSetError) Error() string {
+	return "Environment variable {{.EnvVar}} holding credentials for foundation '{{.Foundation}}' is not set."
+}
+
+func (e FoundationCredentialsNotSetError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Foundation": e.Foundation,
+		"EnvVar":     e.EnvVar,
+	})
+}
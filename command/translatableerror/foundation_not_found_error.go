@@ -0,0 +1,15 @@
+package translatableerror
+
+type FoundationNotFoundError struct {
+	Name string
+}
+
+func (FoundationNotFoundError) Error() string {
+	return "Foundation '{{.Name}}' not found."
+}
+
+func (e FoundationNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Name": e.Name,
+	})
+}
@@ -0,0 +1,19 @@
+package translatableerror
+
+import "strings"
+
+// FoundationsFailedError is returned when a command run with
+// --foundations-file failed on some of the foundations.
+type FoundationsFailedError struct {
+	Names []string
+}
+
+func (FoundationsFailedError) Error() string {
+	return "Command failed on foundations: {{.Names}}"
+}
+
+func (e FoundationsFailedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Names": strings.Join(e.Names, ", "),
+	})
+}
@@ -0,0 +1,20 @@
+package translatableerror
+
+// InvalidFoundationsFileError is returned when the file given with
+// --foundations-file cannot be parsed or does not describe the foundations
+// needed by the command.
+type InvalidFoundationsFileError struct {
+	Path    string
+	Message string
+}
+
+func (InvalidFoundationsFileError) Error() string {
+	return "Invalid foundations file '{{.Path}}': {{.Message}}"
+}
+
+func (e InvalidFoundationsFileError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Path":    e.Path,
+		"Message": e.Message,
+	})
+}
@@ -0,0 +1,15 @@
+package translatableerror
+
+type InvalidTLSCertificatesError struct {
+	Message string
+}
+
+func (InvalidTLSCertificatesError) Error() string {
+	return "Invalid TLS certificates: {{.Message}}"
+}
+
+func (e InvalidTLSCertificatesError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Message": e.Message,
+	})
+}
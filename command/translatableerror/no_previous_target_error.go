@@ -0,0 +1,17 @@
+package translatableerror
+
+import "fmt"
+
+type NoPreviousTargetError struct {
+	BinaryName string
+}
+
+func (NoPreviousTargetError) Error() string {
+	return "No previous target, use '{{.Command}}' to target an org and space."
+}
+
+func (e NoPreviousTargetError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Command": fmt.Sprintf("%s target -o ORG -s SPACE", e.BinaryName),
+	})
+}
@@ -0,0 +1,20 @@
+package translatableerror
+
+// PluginTargetOverrideUnsupportedError is returned when a plugin is run with
+// the global '--foundation' flag or with CF_API, CF_ORG or CF_SPACE set.
+// Plugins read the target from the config file, so they would ignore them.
+type PluginTargetOverrideUnsupportedError struct {
+	PluginName string
+	Override   string
+}
+
+func (PluginTargetOverrideUnsupportedError) Error() string {
+	return "Plugin {{.PluginName}} cannot be run with {{.Override}}, because plugins only use the target saved in the config. Use 'cf switch-foundation' or 'cf target' instead."
+}
+
+func (e PluginTargetOverrideUnsupportedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"PluginName": e.PluginName,
+		"Override":   e.Override,
+	})
+}
@@ -0,0 +1,22 @@
+package translatableerror
+
+import "time"
+
+// RateLimitExceededError is returned when the Cloud Controller keeps rejecting
+// requests because the user's rate limit has been reached.
+type RateLimitExceededError struct {
+	ResetAt time.Time
+}
+
+func (e RateLimitExceededError) Error() string {
+	if e.ResetAt.IsZero() {
+		return "Rate limit exceeded. Wait a while before trying again."
+	}
+	return "Rate limit exceeded. The limit resets at {{.ResetAt}}."
+}
+
+func (e RateLimitExceededError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"ResetAt": e.ResetAt.Local().Format(time.RFC1123),
+	})
+}
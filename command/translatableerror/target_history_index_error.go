@@ -0,0 +1,21 @@
+package translatableerror
+
+import "fmt"
+
+// TargetHistoryIndexError is returned when the index given with
+// 'cf target --history' is not the number of a recent target.
+type TargetHistoryIndexError struct {
+	Index      int
+	BinaryName string
+}
+
+func (TargetHistoryIndexError) Error() string {
+	return "There is no recent target {{.Index}}, use '{{.Command}}' to list the recent targets."
+}
+
+func (e TargetHistoryIndexError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Index":   e.Index,
+		"Command": fmt.Sprintf("%s target --history", e.BinaryName),
+	})
+}
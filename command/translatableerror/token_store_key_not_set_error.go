@@ -0,0 +1,17 @@
+package translatableerror
+
+type TokenStoreKeyNotSetError struct {
+	PassphraseEnvVar string
+	KeyFileEnvVar    string
+}
+
+func (TokenStoreKeyNotSetError) Error() string {
+	return "The encrypted token store is locked. Set {{.PassphraseEnvVar}} or {{.KeyFileEnvVar}} to unlock it."
+}
+
+func (e TokenStoreKeyNotSetError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"PassphraseEnvVar": e.PassphraseEnvVar,
+		"KeyFileEnvVar":    e.KeyFileEnvVar,
+	})
+}
@@ -0,0 +1,17 @@
+package translatableerror
+
+// TokenStorePluginUnsupportedError is returned when a plugin is installed or
+// run while tokens are kept in the encrypted token store.
+type TokenStorePluginUnsupportedError struct {
+	PluginName string
+}
+
+func (TokenStorePluginUnsupportedError) Error() string {
+	return "Plugin {{.PluginName}} cannot be used while tokens are kept in the encrypted token store, because plugins cannot read the store."
+}
+
+func (e TokenStorePluginUnsupportedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"PluginName": e.PluginName,
+	})
+}
@@ -0,0 +1,20 @@
+package translatableerror
+
+import "strings"
+
+// TokenStorePluginsInstalledError is returned when tokens are to be moved
+// into the encrypted token store while plugins are installed. Plugins read
+// the tokens from the config file and cannot unlock the store.
+type TokenStorePluginsInstalledError struct {
+	Plugins []string
+}
+
+func (TokenStorePluginsInstalledError) Error() string {
+	return "Tokens cannot be encrypted while plugins are installed, because plugins cannot read the encrypted token store. Uninstall these plugins first: {{.Plugins}}"
+}
+
+func (e TokenStorePluginsInstalledError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Plugins": strings.Join(e.Plugins, ", "),
+	})
+}
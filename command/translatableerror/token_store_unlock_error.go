@@ -0,0 +1,15 @@
+package translatableerror
+
+type TokenStoreUnlockError struct {
+	Path string
+}
+
+func (TokenStoreUnlockError) Error() string {
+	return "Unable to unlock the encrypted token store {{.Path}}: the passphrase or key file is incorrect or the store is corrupt."
+}
+
+func (e TokenStoreUnlockError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Path": e.Path,
+	})
+}
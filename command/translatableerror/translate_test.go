@@ -53,9 +53,15 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("DownloadPluginHTTPError", DownloadPluginHTTPError{}),
 		Entry("EmptyDirectoryError", EmptyDirectoryError{}),
 		Entry("EmptyBuildpacksError", EmptyBuildpacksError{}),
+		Entry("EnvAPINotTargetedError", EnvAPINotTargetedError{}),
+		Entry("EnvOrganizationNotFoundError", EnvOrganizationNotFoundError{}),
+		Entry("EnvSpaceNotFoundError", EnvSpaceNotFoundError{}),
 		Entry("FetchingPluginInfoFromRepositoriesError", FetchingPluginInfoFromRepositoriesError{}),
 		Entry("FileChangedError", FileChangedError{}),
 		Entry("FileNotFoundError", FileNotFoundError{}),
+		Entry("FoundationCredentialsNotSetError", FoundationCredentialsNotSetError{}),
+		Entry("FoundationNotFoundError", FoundationNotFoundError{}),
+		Entry("FoundationsFailedError", FoundationsFailedError{}),
 		Entry("GettingPluginRepositoryError", GettingPluginRepositoryError{}),
 		Entry("HealthCheckTypeUnsupportedError", HealthCheckTypeUnsupportedError{SupportedTypes: []string{"some-type", "another-type"}}),
 		Entry("HostAndPathNotAllowedWithTCPDomainError", HostAndPathNotAllowedWithTCPDomainError{}),
@@ -63,8 +69,10 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("HTTPHealthCheckInvalidError", HTTPHealthCheckInvalidError{}),
 		Entry("HTTPStatusError", HTTPStatusError{Status: "some status"}),
 		Entry("InvalidChecksumError", InvalidChecksumError{}),
+		Entry("InvalidFoundationsFileError", InvalidFoundationsFileError{}),
 		Entry("InvalidRouteError", InvalidRouteError{}),
 		Entry("InvalidSSLCertError", InvalidSSLCertError{}),
+		Entry("InvalidTLSCertificatesError", InvalidTLSCertificatesError{}),
 		Entry("IsolationSegmentNotFoundError", IsolationSegmentNotFoundError{}),
 		Entry("JobFailedError", JobFailedError{}),
 		Entry("JobTimeoutError", JobTimeoutError{}),
@@ -83,6 +91,7 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("NoMatchingDomainError", NoMatchingDomainError{}),
 		Entry("NoOrganizationTargetedError", NoOrganizationTargetedError{}),
 		Entry("NoPluginRepositoriesError", NoPluginRepositoriesError{}),
+		Entry("NoPreviousTargetError", NoPreviousTargetError{}),
 		Entry("NoSpaceTargetedError", NoSpaceTargetedError{}),
 		Entry("NotLoggedInError", NotLoggedInError{}),
 		Entry("OrgNotFoundError", OrganizationNotFoundError{}),
@@ -98,6 +107,7 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("PluginNotFoundError", PluginNotFoundError{}),
 		Entry("PluginNotFoundInRepositoryError", PluginNotFoundInRepositoryError{}),
 		Entry("PluginNotFoundOnDiskOrInAnyRepositoryError", PluginNotFoundOnDiskOrInAnyRepositoryError{}),
+		Entry("PluginTargetOverrideUnsupportedError", PluginTargetOverrideUnsupportedError{}),
 		Entry("PortNotAllowedWithHTTPDomainError", PortNotAllowedWithHTTPDomainError{}),
 		Entry("ProcessInstanceNotFoundError", ProcessInstanceNotFoundError{ProcessType: "some-process", InstanceIndex: 1}),
 		Entry("ProcessInstanceNotRunningError", ProcessInstanceNotRunningError{ProcessType: "some-process", InstanceIndex: 1}),
@@ -121,8 +131,14 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("StagingFailedNoAppDetectedError", StagingFailedNoAppDetectedError{}),
 		Entry("StagingTimeoutError", StagingTimeoutError{}),
 		Entry("StartupTimeoutError", StartupTimeoutError{}),
+		Entry("TargetHistoryIndexError", TargetHistoryIndexError{}),
 		Entry("ThreeRequiredArgumentsError", ThreeRequiredArgumentsError{}),
+		Entry("TokenStoreKeyNotSetError", TokenStoreKeyNotSetError{}),
+		Entry("TokenStorePluginsInstalledError", TokenStorePluginsInstalledError{}),
+		Entry("TokenStorePluginUnsupportedError", TokenStorePluginUnsupportedError{}),
+		Entry("TokenStoreUnlockError", TokenStoreUnlockError{}),
 		Entry("TriggerLegacyPushError", TriggerLegacyPushError{}),
+		Entry("UnsavedFoundationError", UnsavedFoundationError{}),
 		Entry("UnsupportedURLSchemeError", UnsupportedURLSchemeError{}),
 		Entry("UploadFailedError", UploadFailedError{Err: JobFailedError{}}),
 		Entry("V3APIDoesNotExistError", V3APIDoesNotExistError{}),
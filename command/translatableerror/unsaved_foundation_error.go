@@ -0,0 +1,17 @@
+package translatableerror
+
+type UnsavedFoundationError struct {
+	API        string
+	BinaryName string
+}
+
+func (UnsavedFoundationError) Error() string {
+	return "The target {{.API}} is not saved as a foundation and would be lost. Save it first with '{{.BinaryName}} add-foundation FOUNDATION_NAME'."
+}
+
+func (e UnsavedFoundationError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"API":        e.API,
+		"BinaryName": e.BinaryName,
+	})
+}
@@ -38,11 +38,15 @@ type UI interface {
 	DisplayTextWithFlavor(text string, keys ...map[string]interface{})
 	DisplayWarning(formattedString string, keys ...map[string]interface{})
 	DisplayWarnings(warnings []string)
+	DisplayYAML(yamlData interface{}) error
 	GetErr() io.Writer
 	GetIn() io.Reader
 	GetOut() io.Writer
 	RequestLoggerFileWriter(filePaths []string) *ui.RequestLoggerFileWriter
+	RequestLoggerHARWriter(filePath string) *ui.RequestLoggerHARWriter
 	RequestLoggerTerminalDisplay() *ui.RequestLoggerTerminalDisplay
+	RequestTimings() *ui.RequestTimings
+	ReserveOutForData() io.Writer
 	TranslateText(template string, data ...map[string]interface{}) string
 	UserFriendlyDate(input time.Time) string
 	Writer() io.Writer
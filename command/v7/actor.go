@@ -98,6 +98,9 @@ type Actor interface {
 	GetApplicationRoutes(appGUID string) ([]resources.Route, v7action.Warnings, error)
 	GetApplicationTasks(appName string, sortOrder v7action.SortOrder) ([]resources.Task, v7action.Warnings, error)
 	GetApplicationsByNamesAndSpace(appNames []string, spaceGUID string) ([]resources.Application, v7action.Warnings, error)
+	GetAppUsageEvents(filter v7action.UsageEventFilter) ([]v7action.AppUsageEvent, v7action.Warnings, error)
+	GetAppUsageSummary(filter v7action.UsageEventFilter) ([]v7action.AppUsageSummary, v7action.Warnings, error)
+	GetAuditEvents(filter v7action.AuditEventFilter) ([]v7action.AuditEvent, v7action.Warnings, error)
 	GetBuildpackLabels(buildpackName string, buildpackStack string) (map[string]types.NullString, v7action.Warnings, error)
 	GetBuildpacks(labelSelector string) ([]resources.Buildpack, v7action.Warnings, error)
 	GetCurrentUser() (configv3.User, error)
@@ -116,6 +119,7 @@ type Actor interface {
 	GetIsolationSegmentsByOrganization(orgName string) ([]resources.IsolationSegment, v7action.Warnings, error)
 	GetIsolationSegmentByName(isoSegmentName string) (resources.IsolationSegment, v7action.Warnings, error)
 	GetIsolationSegmentSummaries() ([]v7action.IsolationSegmentSummary, v7action.Warnings, error)
+	GetInventory() (v7action.Inventory, v7action.Warnings, error)
 	GetLatestActiveDeploymentForApp(appGUID string) (resources.Deployment, v7action.Warnings, error)
 	GetLoginPrompts() (map[string]coreconfig.AuthPrompt, error)
 	GetNewestReadyPackageForApplication(app resources.Application) (resources.Package, v7action.Warnings, error)
@@ -165,6 +169,8 @@ type Actor interface {
 	GetServiceOfferingLabels(serviceOfferingName, serviceBrokerName string) (map[string]types.NullString, v7action.Warnings, error)
 	GetServicePlanLabels(servicePlanName, serviceOfferingName, serviceBrokerName string) (map[string]types.NullString, v7action.Warnings, error)
 	GetServicePlanByNameOfferingAndBroker(servicePlanName, serviceOfferingName, serviceBrokerName string) (resources.ServicePlan, v7action.Warnings, error)
+	GetServiceUsageEvents(filter v7action.UsageEventFilter) ([]v7action.ServiceUsageEvent, v7action.Warnings, error)
+	GetServiceUsageSummary(filter v7action.UsageEventFilter) ([]v7action.ServiceUsageSummary, v7action.Warnings, error)
 	GetSpaceByNameAndOrganization(spaceName string, orgGUID string) (resources.Space, v7action.Warnings, error)
 	GetSpaceFeature(spaceName string, orgGUID string, feature string) (bool, v7action.Warnings, error)
 	GetSpaceLabels(spaceName string, orgGUID string) (map[string]types.NullString, v7action.Warnings, error)
@@ -0,0 +1,46 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+)
+
+type AddFoundationCommand struct {
+	UI     command.UI
+	Config command.Config
+
+	RequiredArgs    flag.FoundationName `positional-args:"yes"`
+	usage           interface{}         `usage:"CF_NAME add-foundation FOUNDATION_NAME\n\nEXAMPLES:\n   CF_NAME add-foundation dc1\n   CF_NAME add-foundation dc2 && CF_NAME switch-foundation dc2 && CF_NAME login -a https://api.dc2.example.com"`
+	relatedCommands interface{}         `related_commands:"api, foundations, login, remove-foundation, switch-foundation"`
+}
+
+func (cmd *AddFoundationCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+
+	return nil
+}
+
+func (cmd AddFoundationCommand) Execute(args []string) error {
+	name := cmd.RequiredArgs.Foundation
+
+	if cmd.Config.Target() == "" {
+		return translatableerror.NoAPISetError{BinaryName: cmd.Config.BinaryName()}
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Adding foundation {{.Foundation}} for API endpoint {{.Endpoint}}...", map[string]interface{}{
+		"Foundation": name,
+		"Endpoint":   cmd.Config.Target(),
+	})
+
+	if _, found := cmd.Config.GetFoundation(name); found {
+		cmd.UI.DisplayWarning("Foundation {{.Foundation}} already exists and will be replaced.", map[string]interface{}{
+			"Foundation": name,
+		})
+	}
+
+	cmd.Config.AddFoundation(name)
+	cmd.UI.DisplayOK()
+	return nil
+}
@@ -0,0 +1,72 @@
+package v7_test
+
+import (
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("add-foundation command", func() {
+	var (
+		cmd        AddFoundationCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeConfig.BinaryNameReturns("faceman")
+		fakeConfig.TargetReturns("https://api.dc1.com")
+		cmd = AddFoundationCommand{
+			UI:           testUI,
+			Config:       fakeConfig,
+			RequiredArgs: flag.FoundationName{Foundation: "dc1"},
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("saves the current session as the foundation", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+		Expect(testUI.Out).To(Say(`Adding foundation dc1 for API endpoint https://api.dc1.com\.\.\.`))
+		Expect(testUI.Out).To(Say("OK"))
+
+		Expect(fakeConfig.AddFoundationCallCount()).To(Equal(1))
+		Expect(fakeConfig.AddFoundationArgsForCall(0)).To(Equal("dc1"))
+	})
+
+	When("the foundation already exists", func() {
+		BeforeEach(func() {
+			fakeConfig.GetFoundationReturns(configv3.Foundation{Name: "dc1"}, true)
+		})
+
+		It("warns and replaces it with the current session", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Err).To(Say("Foundation dc1 already exists and will be replaced."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(fakeConfig.AddFoundationCallCount()).To(Equal(1))
+			Expect(fakeConfig.AddFoundationArgsForCall(0)).To(Equal("dc1"))
+		})
+	})
+
+	When("no API endpoint is targeted", func() {
+		BeforeEach(func() {
+			fakeConfig.TargetReturns("")
+		})
+
+		It("returns a NoAPISetError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NoAPISetError{BinaryName: "faceman"}))
+			Expect(fakeConfig.AddFoundationCallCount()).To(Equal(0))
+		})
+	})
+})
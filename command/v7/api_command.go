@@ -15,18 +15,24 @@ import (
 type APICommand struct {
 	BaseCommand
 
-	OptionalArgs      flag.APITarget `positional-args:"yes"`
-	SkipSSLValidation bool           `long:"skip-ssl-validation" description:"Skip verification of the API endpoint. Not recommended!"`
-	Unset             bool           `long:"unset" description:"Remove all api endpoint targeting"`
-	usage             interface{}    `usage:"CF_NAME api [URL]"`
-	relatedCommands   interface{}    `related_commands:"auth, login, target"`
+	OptionalArgs      flag.APITarget              `positional-args:"yes"`
+	CACert            flag.PathWithExistenceCheck `long:"ca-cert" description:"Path to a PEM encoded CA certificate to trust for this API endpoint"`
+	ClientCert        flag.PathWithExistenceCheck `long:"client-cert" description:"Path to a PEM encoded client certificate to present to this API endpoint"`
+	ClientKey         flag.PathWithExistenceCheck `long:"client-key" description:"Path to the PEM encoded private key for the client certificate. Only the path is saved, so the file must stay in place"`
+	SkipSSLValidation bool                        `long:"skip-ssl-validation" description:"Skip verification of the API endpoint. Not recommended!"`
+	Unset             bool                        `long:"unset" description:"Remove all api endpoint targeting"`
+	usage             interface{}                 `usage:"CF_NAME api [URL [FAILOVER_URL...]]"`
+	relatedCommands   interface{}                 `related_commands:"auth, login, target"`
 }
 
 func (cmd *APICommand) Setup(config command.Config, ui command.UI) error {
 	cmd.UI = ui
 	cmd.Config = config
 
-	ccClient := shared.NewWrappedCloudControllerClient(config, ui)
+	ccClient, err := shared.NewWrappedCloudControllerClient(config, ui)
+	if err != nil {
+		return err
+	}
 	cmd.Actor = v7action.NewActor(ccClient, config, nil, nil, nil, clock.NewClock())
 	return nil
 }
@@ -51,16 +57,29 @@ func (cmd *APICommand) clearTarget() error {
 }
 
 func (cmd *APICommand) setAPI() error {
+	certs, err := shared.ReadTLSCertificates(string(cmd.CACert), string(cmd.ClientCert), string(cmd.ClientKey))
+	if err != nil {
+		return err
+	}
+
 	cmd.UI.DisplayTextWithFlavor("Setting API endpoint to {{.Endpoint}}...", map[string]interface{}{
 		"Endpoint": cmd.OptionalArgs.URL,
 	})
 
 	apiURL := cmd.processURL(cmd.OptionalArgs.URL)
 
-	_, err := cmd.Actor.SetTarget(v7action.TargetSettings{
-		URL:               apiURL,
-		SkipSSLValidation: cmd.SkipSSLValidation,
-		DialTimeout:       cmd.Config.DialTimeout(),
+	var failoverURLs []string
+	for _, failoverURL := range cmd.OptionalArgs.FailoverURLs {
+		failoverURLs = append(failoverURLs, cmd.processURL(failoverURL))
+	}
+
+	_, err = cmd.Actor.SetTarget(v7action.TargetSettings{
+		URL:                apiURL,
+		FailoverURLs:       failoverURLs,
+		SkipSSLValidation:  cmd.SkipSSLValidation,
+		TLSCertificates:    certs,
+		DialTimeout:        cmd.Config.DialTimeout(),
+		CircuitBreakerFile: cmd.Config.CircuitBreakerFile(),
 	})
 	if err != nil {
 		return err
@@ -93,10 +112,17 @@ func (cmd *APICommand) viewTarget() error {
 }
 
 func (cmd *APICommand) displayTarget() error {
-	cmd.UI.DisplayKeyValueTable("", [][]string{
+	table := [][]string{
 		{cmd.UI.TranslateText("API endpoint:"), cmd.Config.Target()},
 		{cmd.UI.TranslateText("API version:"), cmd.Config.APIVersion()},
-	}, 3)
+	}
+	if failoverURLs := cmd.Config.TargetFailoverURLs(); len(failoverURLs) > 0 {
+		table = append(table, []string{cmd.UI.TranslateText("failover endpoints:"), strings.Join(failoverURLs, ", ")})
+	}
+	if foundation := cmd.Config.ActiveFoundation(); foundation != "" {
+		table = append([][]string{{cmd.UI.TranslateText("foundation:"), foundation}}, table...)
+	}
+	cmd.UI.DisplayKeyValueTable("", table, 3)
 
 	user, err := cmd.Config.CurrentUser()
 	if user.Name == "" {
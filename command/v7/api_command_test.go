@@ -5,6 +5,7 @@ import (
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v7"
 	"code.cloudfoundry.org/cli/command/v7/v7fakes"
 	"code.cloudfoundry.org/cli/util/configv3"
@@ -112,6 +113,47 @@ API version:    100.200.300`,
 			})
 		})
 
+		When("failover endpoints are provided", func() {
+			BeforeEach(func() {
+				cmd.OptionalArgs.FailoverURLs = []string{"api-b.foo.com", "http://api-c.foo.com"}
+				fakeConfig.CircuitBreakerFileReturns("some-circuit-breaker-file")
+				fakeConfig.TargetFailoverURLsReturns([]string{"https://api-b.foo.com", "http://api-c.foo.com"})
+			})
+
+			It("sets the target with the failover endpoints, defaulting them to TLS", func() {
+				Expect(err).ToNot(HaveOccurred())
+
+				settings := fakeActor.SetTargetArgsForCall(0)
+				Expect(settings.URL).To(Equal(CCAPI))
+				Expect(settings.FailoverURLs).To(Equal([]string{"https://api-b.foo.com", "http://api-c.foo.com"}))
+				Expect(settings.CircuitBreakerFile).To(Equal("some-circuit-breaker-file"))
+			})
+
+			It("displays the failover endpoints", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`OK
+
+API endpoint:         some-api-target
+API version:          100.200.300
+failover endpoints:   https://api-b.foo.com, http://api-c.foo.com`,
+				))
+			})
+		})
+
+		When("--client-cert is passed without --client-key", func() {
+			BeforeEach(func() {
+				cmd.ClientCert = "some-client-cert"
+			})
+
+			It("returns a RequiredFlagsError without setting the target", func() {
+				Expect(err).To(MatchError(translatableerror.RequiredFlagsError{
+					Arg1: "--client-cert",
+					Arg2: "--client-key",
+				}))
+				Expect(fakeActor.SetTargetCallCount()).To(Equal(0))
+			})
+		})
+
 		When("when the endpoint is TLS but the certificate is unverified", func() {
 			BeforeEach(func() {
 				fakeActor.SetTargetReturns(nil, ccerror.UnverifiedServerError{URL: CCAPI})
@@ -1,26 +1,56 @@
 package v7
 
 import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/util/ui"
 )
 
 type AppCommand struct {
 	BaseCommand
 
-	RequiredArgs    flag.AppName `positional-args:"yes"`
-	GUID            bool         `long:"guid" description:"Retrieve and display the given app's guid.  All other health and status output for the app is suppressed."`
-	usage           interface{}  `usage:"CF_NAME app APP_NAME [--guid]"`
-	relatedCommands interface{}  `related_commands:"apps, events, logs, map-route, unmap-route, push"`
+	RequiredArgs    flag.AppName                `positional-args:"yes"`
+	FoundationsFile flag.PathWithExistenceCheck `long:"foundations-file" description:"Run against every foundation listed in the given YAML file"`
+	GUID            bool                        `long:"guid" description:"Retrieve and display the given app's guid.  All other health and status output for the app is suppressed. Alias for --jsonpath '{.guid}'."`
+	Output          flag.OutputFormat           `long:"output" description:"Display the app as json, yaml or csv"`
+	Format          flag.GoTemplate             `long:"format" description:"Display the app with the given Go template"`
+	JSONPath        flag.JSONPath               `long:"jsonpath" description:"Display the fields of the app selected by the given JSONPath expression"`
+	usage           interface{}                 `usage:"CF_NAME app APP_NAME [--guid] [--foundations-file PATH] [--output json|yaml|csv] [--format TEMPLATE] [--jsonpath EXPRESSION]\n\nEXAMPLES:\n   CF_NAME app my-app\n   CF_NAME app my-app --output yaml\n   CF_NAME app my-app --jsonpath '{.droplet_guid}'"`
+	relatedCommands interface{}                 `related_commands:"apps, events, logs, map-route, unmap-route, push"`
+}
+
+func (cmd *AppCommand) Setup(config command.Config, ui command.UI) error {
+	if cmd.FoundationsFile != "" {
+		cmd.SetupForFoundations(config, ui)
+		return nil
+	}
+	return cmd.BaseCommand.Setup(config, ui)
 }
 
 func (cmd AppCommand) Execute(args []string) error {
+	if err := cmd.output().validate(); err != nil {
+		return err
+	}
+
+	if cmd.FoundationsFile != "" {
+		// '--guid' is the only structured output that is displayed per foundation.
+		if cmd.output().IsSet() && !cmd.GUID {
+			return translatableerror.ArgumentCombinationError{Args: []string{cmd.output().flagName(), "--foundations-file"}}
+		}
+		return cmd.executeOnFoundations()
+	}
+
 	err := cmd.SharedActor.CheckTarget(true, true)
 	if err != nil {
 		return err
 	}
 
-	if cmd.GUID {
+	if cmd.output().guidOnly() {
 		return cmd.displayAppGUID()
 	}
 
@@ -29,13 +59,15 @@ func (cmd AppCommand) Execute(args []string) error {
 		return err
 	}
 
-	cmd.UI.DisplayTextWithFlavor("Showing health and status for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
-		"AppName":   cmd.RequiredArgs.AppName,
-		"OrgName":   cmd.Config.TargetedOrganization().Name,
-		"SpaceName": cmd.Config.TargetedSpace().Name,
-		"Username":  user.Name,
-	})
-	cmd.UI.DisplayNewline()
+	if !cmd.output().IsSet() {
+		cmd.UI.DisplayTextWithFlavor("Showing health and status for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+			"AppName":   cmd.RequiredArgs.AppName,
+			"OrgName":   cmd.Config.TargetedOrganization().Name,
+			"SpaceName": cmd.Config.TargetedSpace().Name,
+			"Username":  user.Name,
+		})
+		cmd.UI.DisplayNewline()
+	}
 
 	appSummaryDisplayer := shared.NewAppSummaryDisplayer(cmd.UI)
 	summary, warnings, err := cmd.Actor.GetDetailedAppSummary(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID, false)
@@ -44,6 +76,10 @@ func (cmd AppCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.output().IsSet() {
+		return cmd.output().display(cmd.UI, appOutput(summary))
+	}
+
 	appSummaryDisplayer.AppDisplay(summary, false)
 	return nil
 }
@@ -58,3 +94,80 @@ func (cmd AppCommand) displayAppGUID() error {
 	cmd.UI.DisplayText(app.GUID)
 	return nil
 }
+
+func (cmd AppCommand) executeOnFoundations() error {
+	if cmd.GUID {
+		return cmd.displayAppGUIDOnFoundations()
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Showing health and status for app {{.AppName}} from foundations in {{.FoundationsFile}}...", map[string]interface{}{
+		"AppName":         cmd.RequiredArgs.AppName,
+		"FoundationsFile": cmd.FoundationsFile,
+	})
+
+	var mutex sync.Mutex
+	summaries := map[string]v7action.DetailedApplicationSummary{}
+	results, err := cmd.FoundationsRunner.RunOnFoundations(string(cmd.FoundationsFile), true, true, func(target FoundationTarget) (v7action.Warnings, error) {
+		summary, warnings, err := target.Actor.GetDetailedAppSummary(cmd.RequiredArgs.AppName, target.Space.GUID, false)
+		if err != nil {
+			return warnings, err
+		}
+		mutex.Lock()
+		defer mutex.Unlock()
+		summaries[target.Name] = summary
+		return warnings, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	appSummaryDisplayer := shared.NewAppSummaryDisplayer(cmd.UI)
+	for _, result := range results {
+		summary, found := summaries[result.Name]
+		if !found {
+			continue
+		}
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayKeyValueTable("", [][]string{
+			{cmd.UI.TranslateText("foundation:"), result.Name},
+		}, 3)
+		appSummaryDisplayer.AppDisplay(summary, false)
+	}
+
+	return displayFoundationResults(cmd.UI, results)
+}
+
+func (cmd AppCommand) displayAppGUIDOnFoundations() error {
+	var mutex sync.Mutex
+	guids := map[string]string{}
+	results, err := cmd.FoundationsRunner.RunOnFoundations(string(cmd.FoundationsFile), true, true, func(target FoundationTarget) (v7action.Warnings, error) {
+		app, warnings, err := target.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, target.Space.GUID)
+		if err != nil {
+			return warnings, err
+		}
+		mutex.Lock()
+		defer mutex.Unlock()
+		guids[target.Name] = app.GUID
+		return warnings, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	table := [][]string{{cmd.UI.TranslateText("foundation"), cmd.UI.TranslateText("guid")}}
+	for _, result := range results {
+		if guid, found := guids[result.Name]; found {
+			table = append(table, []string{result.Name, guid})
+		}
+	}
+
+	if len(table) > 1 {
+		cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+	}
+
+	return displayFoundationResults(cmd.UI, results)
+}
+
+func (cmd AppCommand) output() structuredOutput {
+	return structuredOutput{output: cmd.Output, format: cmd.Format, jsonPath: cmd.JSONPath, guid: cmd.GUID}
+}
@@ -1,6 +1,7 @@
 package v7_test
 
 import (
+	"encoding/json"
 	"errors"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
@@ -8,6 +9,7 @@ import (
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/command/commandfakes"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	v7 "code.cloudfoundry.org/cli/command/v7"
 	"code.cloudfoundry.org/cli/command/v7/v7fakes"
 	"code.cloudfoundry.org/cli/resources"
@@ -111,7 +113,7 @@ var _ = Describe("app Command", func() {
 			It("displays the application guid and all warnings", func() {
 				Expect(executeErr).ToNot(HaveOccurred())
 
-				Expect(testUI.Out).To(Say("some-guid"))
+				Expect(string(testUI.Out.(*Buffer).Contents())).To(Equal("some-guid\n"))
 				Expect(testUI.Err).To(Say("warning-1"))
 				Expect(testUI.Err).To(Say("warning-2"))
 
@@ -119,6 +121,9 @@ var _ = Describe("app Command", func() {
 				appName, spaceGUID := fakeActor.GetApplicationByNameAndSpaceArgsForCall(0)
 				Expect(appName).To(Equal("some-app"))
 				Expect(spaceGUID).To(Equal("some-space-guid"))
+
+				Expect(fakeActor.GetCurrentUserCallCount()).To(Equal(0))
+				Expect(fakeActor.GetDetailedAppSummaryCallCount()).To(Equal(0))
 			})
 		})
 
@@ -158,6 +163,40 @@ var _ = Describe("app Command", func() {
 				})
 			})
 		})
+
+		When("the --jsonpath flag is also set", func() {
+			BeforeEach(func() {
+				Expect(cmd.JSONPath.UnmarshalFlag("name")).To(Succeed())
+			})
+
+			It("returns an argument combination error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+					Args: []string{"--jsonpath", "--guid"},
+				}))
+				Expect(fakeActor.GetDetailedAppSummaryCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	When("the --jsonpath flag selects only the guid", func() {
+		BeforeEach(func() {
+			Expect(cmd.JSONPath.UnmarshalFlag("guid")).To(Succeed())
+			fakeActor.GetApplicationByNameAndSpaceReturns(
+				resources.Application{GUID: "some-guid"},
+				v7action.Warnings{"warning-1"},
+				nil)
+		})
+
+		It("only looks up the application", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(string(testUI.Out.(*Buffer).Contents())).To(Equal("some-guid\n"))
+			Expect(testUI.Err).To(Say("warning-1"))
+
+			Expect(fakeActor.GetApplicationByNameAndSpaceCallCount()).To(Equal(1))
+			Expect(fakeActor.GetCurrentUserCallCount()).To(Equal(0))
+			Expect(fakeActor.GetDetailedAppSummaryCallCount()).To(Equal(0))
+		})
 	})
 
 	When("the --guid is not passed", func() {
@@ -238,4 +277,156 @@ var _ = Describe("app Command", func() {
 			})
 		})
 	})
+
+	When("the --foundations-file flag is set", func() {
+		var (
+			fakeFoundationsRunner *v7fakes.FakeFoundationsRunner
+			dc2Actor              *v7fakes.FakeActor
+		)
+
+		BeforeEach(func() {
+			cmd.FoundationsFile = "foundations.yml"
+			fakeFoundationsRunner = new(v7fakes.FakeFoundationsRunner)
+			cmd.FoundationsRunner = fakeFoundationsRunner
+
+			dc2Actor = new(v7fakes.FakeActor)
+
+			runOnFoundationTargets(fakeFoundationsRunner,
+				v7.FoundationTarget{Name: "dc1", Actor: fakeActor, Space: resources.Space{GUID: "dc1-space-guid"}},
+				v7.FoundationTarget{Name: "dc2", Actor: dc2Actor, Space: resources.Space{GUID: "dc2-space-guid"}},
+			)
+		})
+
+		It("requires an org and a space in every foundation", func() {
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+			_, targetOrg, targetSpace, _ := fakeFoundationsRunner.RunOnFoundationsArgsForCall(0)
+			Expect(targetOrg).To(BeTrue())
+			Expect(targetSpace).To(BeTrue())
+		})
+
+		When("the app exists in every foundation", func() {
+			BeforeEach(func() {
+				fakeActor.GetDetailedAppSummaryReturns(v7action.DetailedApplicationSummary{
+					ApplicationSummary: v7action.ApplicationSummary{
+						Application: resources.Application{Name: "some-app", State: constant.ApplicationStarted},
+					},
+				}, v7action.Warnings{"dc1-warning"}, nil)
+				dc2Actor.GetDetailedAppSummaryReturns(v7action.DetailedApplicationSummary{
+					ApplicationSummary: v7action.ApplicationSummary{
+						Application: resources.Application{Name: "some-app", State: constant.ApplicationStopped},
+					},
+				}, nil, nil)
+			})
+
+			It("displays the application summary of every foundation in file order", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				appName, spaceGUID, _ := dc2Actor.GetDetailedAppSummaryArgsForCall(0)
+				Expect(appName).To(Equal("some-app"))
+				Expect(spaceGUID).To(Equal("dc2-space-guid"))
+
+				Expect(testUI.Out).To(Say(`Showing health and status for app some-app from foundations in foundations.yml\.\.\.`))
+				Expect(testUI.Out).To(Say(`foundation:\s+dc1`))
+				Expect(testUI.Out).To(Say(`requested state:\s+started`))
+				Expect(testUI.Out).To(Say(`foundation:\s+dc2`))
+				Expect(testUI.Out).To(Say(`requested state:\s+stopped`))
+				Expect(testUI.Err).To(Say("dc1: dc1-warning"))
+			})
+		})
+
+		When("the app cannot be found in a foundation", func() {
+			BeforeEach(func() {
+				dc2Actor.GetDetailedAppSummaryReturns(v7action.DetailedApplicationSummary{}, nil, errors.New("dc2 is down"))
+			})
+
+			It("displays the error for that foundation and fails", func() {
+				Expect(testUI.Out).To(Say(`foundation:\s+dc1`))
+				Expect(testUI.Out).ToNot(Say(`foundation:\s+dc2`))
+				Expect(testUI.Err).To(Say("dc2: dc2 is down"))
+				Expect(executeErr).To(MatchError(translatableerror.FoundationsFailedError{Names: []string{"dc2"}}))
+			})
+		})
+
+		When("the --guid flag is provided", func() {
+			BeforeEach(func() {
+				cmd.GUID = true
+				fakeActor.GetApplicationByNameAndSpaceReturns(resources.Application{GUID: "dc1-app-guid"}, nil, nil)
+				dc2Actor.GetApplicationByNameAndSpaceReturns(resources.Application{GUID: "dc2-app-guid"}, nil, nil)
+			})
+
+			It("displays the application guid of every foundation", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeActor.GetDetailedAppSummaryCallCount()).To(Equal(0))
+
+				Expect(testUI.Out).To(Say(`foundation\s+guid`))
+				Expect(testUI.Out).To(Say(`dc1\s+dc1-app-guid`))
+				Expect(testUI.Out).To(Say(`dc2\s+dc2-app-guid`))
+			})
+		})
+	})
+
+	When("the --output flag is set", func() {
+		BeforeEach(func() {
+			cmd.Output = flag.OutputFormat{Format: flag.OutputJSON}
+			fakeActor.GetDetailedAppSummaryReturns(v7action.DetailedApplicationSummary{
+				ApplicationSummary: v7action.ApplicationSummary{
+					Application: resources.Application{
+						Name:          "some-app",
+						GUID:          "some-app-guid",
+						State:         constant.ApplicationStopped,
+						LifecycleType: constant.AppLifecycleTypeBuildpack,
+					},
+				},
+				CurrentDroplet: resources.Droplet{
+					GUID:       "some-droplet-guid",
+					Stack:      "cflinuxfs4",
+					CreatedAt:  "2021-03-10T23:11:26Z",
+					Buildpacks: []resources.DropletBuildpack{{Name: "ruby_buildpack"}},
+				},
+			}, v7action.Warnings{"warning-1"}, nil)
+		})
+
+		It("displays the app as JSON without the header", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			var app map[string]interface{}
+			Expect(json.Unmarshal(testUI.Out.(*Buffer).Contents(), &app)).To(Succeed())
+			Expect(app).To(Equal(map[string]interface{}{
+				"name":          "some-app",
+				"guid":          "some-app-guid",
+				"state":         "stopped",
+				"processes":     []interface{}{},
+				"routes":        []interface{}{},
+				"last_uploaded": "2021-03-10T23:11:26Z",
+				"droplet_guid":  "some-droplet-guid",
+				"stack":         "cflinuxfs4",
+				"buildpacks":    []interface{}{"ruby_buildpack"},
+			}))
+			Expect(testUI.Err).To(Say("warning-1"))
+		})
+
+		When("the --jsonpath flag is set instead", func() {
+			BeforeEach(func() {
+				cmd.Output = flag.OutputFormat{}
+				Expect(cmd.JSONPath.UnmarshalFlag("droplet_guid")).To(Succeed())
+			})
+
+			It("displays the selected field", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(string(testUI.Out.(*Buffer).Contents())).To(Equal("some-droplet-guid\n"))
+			})
+		})
+
+		When("the --guid flag is also set", func() {
+			BeforeEach(func() {
+				cmd.GUID = true
+			})
+
+			It("returns an argument combination error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+					Args: []string{"--output", "--guid"},
+				}))
+			})
+		})
+	})
 })
@@ -0,0 +1,224 @@
+package v7_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("app-usage-events Command", func() {
+	var (
+		cmd             AppUsageEventsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		executeErr      error
+		eventTime       time.Time
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		cmd = AppUsageEventsCommand{
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				Actor:       fakeActor,
+				SharedActor: fakeSharedActor,
+			},
+		}
+
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+
+		eventTime = time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC)
+		fakeActor.GetAppUsageEventsReturns([]v7action.AppUsageEvent{
+			{
+				GUID:                  "event-1",
+				CreatedAt:             eventTime,
+				State:                 "STARTED",
+				AppName:               "some-app",
+				ProcessType:           "web",
+				ProcessGUID:           "process-guid",
+				InstanceCount:         3,
+				MemoryInMBPerInstance: 512,
+				SpaceName:             "some-space",
+			},
+			{
+				GUID:                  "event-2",
+				CreatedAt:             eventTime,
+				State:                 "TASK_STARTED",
+				AppName:               "some-app",
+				TaskGUID:              "task-guid",
+				TaskName:              "migrate",
+				InstanceCount:         1,
+				MemoryInMBPerInstance: 1024,
+				SpaceName:             "some-space",
+			},
+		}, v7action.Warnings{"events-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: "faceman"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: "faceman"}))
+			Expect(fakeActor.GetAppUsageEventsCallCount()).To(Equal(0))
+		})
+	})
+
+	It("displays the events", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(testUI.Out).To(Say(`Getting app usage events as steve\.\.\.`))
+		Expect(testUI.Out).To(Say(`guid\s+time\s+state\s+app\s+process or task\s+instances\s+memory\s+space`))
+		Expect(testUI.Out).To(Say(`event-1\s+%s\s+STARTED\s+some-app\s+web\s+3\s+512M\s+some-space`, eventTime.Local().Format("2006-01-02T15:04:05.00-0700")))
+		Expect(testUI.Out).To(Say(`event-2\s+\S+\s+TASK_STARTED\s+some-app\s+migrate\s+1\s+1024M\s+some-space`))
+		Expect(testUI.Err).To(Say("events-warning"))
+	})
+
+	When("--until is earlier than --since", func() {
+		BeforeEach(func() {
+			cmd.Since = flag.Timestamp{Time: eventTime, IsSet: true}
+			cmd.Until = flag.Timestamp{Time: eventTime.Add(-time.Hour), IsSet: true}
+		})
+
+		It("returns an incorrect usage error without getting events", func() {
+			Expect(executeErr).To(MatchError(translatableerror.IncorrectUsageError{Message: "--until must not be earlier than --since"}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+			Expect(fakeActor.GetAppUsageEventsCallCount()).To(Equal(0))
+		})
+	})
+
+	When("a cursor and a time window are given", func() {
+		BeforeEach(func() {
+			cmd.AfterGUID = "some-event-guid"
+			cmd.Since = flag.Timestamp{Time: eventTime.Add(-time.Hour), IsSet: true}
+			cmd.Until = flag.Timestamp{Time: eventTime.Add(time.Hour), IsSet: true}
+		})
+
+		It("passes them to the actor", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeActor.GetAppUsageEventsArgsForCall(0)).To(Equal(v7action.UsageEventFilter{
+				AfterGUID: "some-event-guid",
+				Since:     eventTime.Add(-time.Hour),
+				Until:     eventTime.Add(time.Hour),
+			}))
+		})
+	})
+
+	When("getting the events fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetAppUsageEventsReturns(nil, v7action.Warnings{"events-warning"}, errors.New("some-error"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("some-error"))
+			Expect(testUI.Err).To(Say("events-warning"))
+		})
+	})
+
+	When("the --output flag is set", func() {
+		BeforeEach(func() {
+			cmd.Output = flag.OutputFormat{Format: flag.OutputJSON}
+			fakeActor.GetAppUsageEventsReturns([]v7action.AppUsageEvent{
+				{GUID: "event-1", CreatedAt: eventTime, State: "STARTED", InstanceCount: 3},
+			}, nil, nil)
+		})
+
+		It("displays the events as JSON without the header", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).ToNot(Say("Getting app usage events"))
+			Expect(string(testUI.Out.(*Buffer).Contents())).To(MatchJSON(`[
+				{
+					"guid": "event-1",
+					"time": "2021-03-04T05:06:07Z",
+					"state": "STARTED",
+					"previous_state": "",
+					"app_guid": "",
+					"app_name": "",
+					"process_guid": "",
+					"process_type": "",
+					"task_guid": "",
+					"task_name": "",
+					"instance_count": 3,
+					"previous_instance_count": 0,
+					"memory_in_mb_per_instance": 0,
+					"previous_memory_in_mb_per_instance": 0,
+					"space_guid": "",
+					"space_name": "",
+					"organization_guid": ""
+				}
+			]`))
+		})
+	})
+
+	When("the --summarize flag is set", func() {
+		BeforeEach(func() {
+			cmd.Summarize = true
+			cmd.Since = flag.Timestamp{Time: eventTime, IsSet: true}
+			fakeActor.GetAppUsageSummaryReturns([]v7action.AppUsageSummary{
+				{
+					OrganizationGUID: "org-guid",
+					OrganizationName: "some-org",
+					SpaceGUID:        "space-guid",
+					SpaceName:        "some-space",
+					InstanceHours:    12,
+					MemoryGBHours:    4.5,
+				},
+			}, v7action.Warnings{"summary-warning"}, nil)
+		})
+
+		It("displays the usage of every space instead of the events", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeActor.GetAppUsageEventsCallCount()).To(Equal(0))
+			Expect(fakeActor.GetAppUsageSummaryArgsForCall(0)).To(Equal(v7action.UsageEventFilter{Since: eventTime}))
+
+			Expect(testUI.Out).To(Say(`Summarizing app usage as steve\.\.\.`))
+			Expect(testUI.Out).To(Say(`org\s+space\s+instance-hours\s+memory GB-hours`))
+			Expect(testUI.Out).To(Say(`some-org\s+some-space\s+12\.00\s+4\.50`))
+			Expect(testUI.Err).To(Say("summary-warning"))
+		})
+
+		When("the --output flag is set", func() {
+			BeforeEach(func() {
+				cmd.Output = flag.OutputFormat{Format: flag.OutputJSON}
+			})
+
+			It("displays the summary as JSON", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(string(testUI.Out.(*Buffer).Contents())).To(MatchJSON(`[
+					{
+						"organization_guid": "org-guid",
+						"organization_name": "some-org",
+						"space_guid": "space-guid",
+						"space_name": "some-space",
+						"instance_hours": 12,
+						"memory_gb_hours": 4.5
+					}
+				]`))
+			})
+		})
+	})
+})
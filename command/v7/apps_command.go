@@ -2,7 +2,12 @@ package v7
 
 import (
 	"strings"
+	"sync"
 
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/resources"
 	"code.cloudfoundry.org/cli/util/ui"
 )
@@ -10,13 +15,39 @@ import (
 type AppsCommand struct {
 	BaseCommand
 
-	usage           interface{} `usage:"CF_NAME apps [--labels SELECTOR]\n\nEXAMPLES:\n   CF_NAME apps\n   CF_NAME apps --labels 'environment in (production,staging),tier in (backend)'\n   CF_NAME apps --labels 'env=dev,!chargeback-code,tier in (backend,worker)'"`
+	usage           interface{} `usage:"CF_NAME apps [--labels SELECTOR] [--foundations-file PATH] [--output json|yaml|csv] [--format TEMPLATE] [--jsonpath EXPRESSION]\n\nEXAMPLES:\n   CF_NAME apps\n   CF_NAME apps --labels 'environment in (production,staging),tier in (backend)'\n   CF_NAME apps --labels 'env=dev,!chargeback-code,tier in (backend,worker)'\n   CF_NAME apps --foundations-file foundations.yml\n   CF_NAME apps --output json\n   CF_NAME apps --jsonpath '{[*].name}'"`
 	relatedCommands interface{} `related_commands:"events, logs, map-route, push, scale, start, stop, restart"`
 
-	Labels string `long:"labels" description:"Selector to filter apps by labels"`
+	FoundationsFile flag.PathWithExistenceCheck `long:"foundations-file" description:"Run against every foundation listed in the given YAML file"`
+	Labels          string                      `long:"labels" description:"Selector to filter apps by labels"`
+	Output          flag.OutputFormat           `long:"output" description:"Display the apps as json, yaml or csv"`
+	Format          flag.GoTemplate             `long:"format" description:"Display the apps with the given Go template"`
+	JSONPath        flag.JSONPath               `long:"jsonpath" description:"Display the fields of the apps selected by the given JSONPath expression"`
+}
+
+func (cmd *AppsCommand) Setup(config command.Config, ui command.UI) error {
+	if cmd.FoundationsFile != "" {
+		cmd.SetupForFoundations(config, ui)
+		return nil
+	}
+	return cmd.BaseCommand.Setup(config, ui)
 }
 
 func (cmd AppsCommand) Execute(args []string) error {
+	if err := cmd.output().validate(); err != nil {
+		return err
+	}
+
+	if cmd.FoundationsFile != "" && cmd.output().IsSet() {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--foundations-file", cmd.output().flagName()},
+		}
+	}
+
+	if cmd.FoundationsFile != "" {
+		return cmd.executeOnFoundations()
+	}
+
 	err := cmd.SharedActor.CheckTarget(true, true)
 	if err != nil {
 		return err
@@ -27,12 +58,14 @@ func (cmd AppsCommand) Execute(args []string) error {
 		return err
 	}
 
-	cmd.UI.DisplayTextWithFlavor("Getting apps in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
-		"OrgName":   cmd.Config.TargetedOrganization().Name,
-		"SpaceName": cmd.Config.TargetedSpace().Name,
-		"Username":  user.Name,
-	})
-	cmd.UI.DisplayNewline()
+	if !cmd.output().IsSet() {
+		cmd.UI.DisplayTextWithFlavor("Getting apps in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+			"OrgName":   cmd.Config.TargetedOrganization().Name,
+			"SpaceName": cmd.Config.TargetedSpace().Name,
+			"Username":  user.Name,
+		})
+		cmd.UI.DisplayNewline()
+	}
 
 	summaries, warnings, err := cmd.Actor.GetAppSummariesForSpace(cmd.Config.TargetedSpace().GUID, cmd.Labels)
 	cmd.UI.DisplayWarnings(warnings)
@@ -40,27 +73,22 @@ func (cmd AppsCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.output().IsSet() {
+		output := []AppSummaryOutput{}
+		for _, summary := range summaries {
+			output = append(output, appSummaryOutput(summary))
+		}
+		return cmd.output().display(cmd.UI, output)
+	}
+
 	if len(summaries) == 0 {
 		cmd.UI.DisplayText("No apps found")
 		return nil
 	}
 
-	table := [][]string{
-		{
-			cmd.UI.TranslateText("name"),
-			cmd.UI.TranslateText("requested state"),
-			cmd.UI.TranslateText("processes"),
-			cmd.UI.TranslateText("routes"),
-		},
-	}
-
+	table := [][]string{cmd.tableHeader()}
 	for _, summary := range summaries {
-		table = append(table, []string{
-			summary.Name,
-			cmd.UI.TranslateText(strings.ToLower(string(summary.State))),
-			summary.ProcessSummaries.String(),
-			getURLs(summary.Routes),
-		})
+		table = append(table, cmd.tableRow(summary))
 	}
 
 	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
@@ -68,6 +96,69 @@ func (cmd AppsCommand) Execute(args []string) error {
 	return nil
 }
 
+func (cmd AppsCommand) executeOnFoundations() error {
+	cmd.UI.DisplayTextWithFlavor("Getting apps from foundations in {{.FoundationsFile}}...", map[string]interface{}{
+		"FoundationsFile": cmd.FoundationsFile,
+	})
+	cmd.UI.DisplayNewline()
+
+	var mutex sync.Mutex
+	summaries := map[string][]v7action.ApplicationSummary{}
+	results, err := cmd.FoundationsRunner.RunOnFoundations(string(cmd.FoundationsFile), true, true, func(target FoundationTarget) (v7action.Warnings, error) {
+		foundationSummaries, warnings, err := target.Actor.GetAppSummariesForSpace(target.Space.GUID, cmd.Labels)
+		mutex.Lock()
+		defer mutex.Unlock()
+		summaries[target.Name] = foundationSummaries
+		return warnings, err
+	})
+	if err != nil {
+		return err
+	}
+
+	table := [][]string{cmd.tableHeader()}
+	var foundations []string
+	for _, result := range results {
+		for _, summary := range summaries[result.Name] {
+			table = append(table, cmd.tableRow(summary))
+			foundations = append(foundations, result.Name)
+		}
+	}
+
+	if len(foundations) == 0 {
+		cmd.UI.DisplayText("No apps found")
+	} else {
+		cmd.UI.DisplayTableWithHeader("", withFoundationColumn(cmd.UI, table, foundations), ui.DefaultTableSpacePadding)
+	}
+
+	return displayFoundationResults(cmd.UI, results)
+}
+
+func (cmd AppsCommand) tableHeader() []string {
+	header := []string{
+		cmd.UI.TranslateText("name"),
+		cmd.UI.TranslateText("requested state"),
+		cmd.UI.TranslateText("processes"),
+		cmd.UI.TranslateText("routes"),
+	}
+	if cmd.Config.TableOptions().Wide {
+		header = append(header, wideTableHeader(cmd.UI)...)
+	}
+	return header
+}
+
+func (cmd AppsCommand) tableRow(summary v7action.ApplicationSummary) []string {
+	row := []string{
+		summary.Name,
+		cmd.UI.TranslateText(strings.ToLower(string(summary.State))),
+		summary.ProcessSummaries.String(),
+		getURLs(summary.Routes),
+	}
+	if cmd.Config.TableOptions().Wide {
+		row = append(row, wideTableRow(summary.GUID, summary.CreatedAt, summary.UpdatedAt, summary.Metadata)...)
+	}
+	return row
+}
+
 func getURLs(routes []resources.Route) string {
 	var routeURLs []string
 	for _, route := range routes {
@@ -76,3 +167,7 @@ func getURLs(routes []resources.Route) string {
 
 	return strings.Join(routeURLs, ", ")
 }
+
+func (cmd AppsCommand) output() structuredOutput {
+	return structuredOutput{output: cmd.Output, format: cmd.Format, jsonPath: cmd.JSONPath}
+}
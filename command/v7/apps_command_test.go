@@ -1,6 +1,7 @@
 package v7_test
 
 import (
+	"encoding/json"
 	"errors"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
@@ -8,9 +9,12 @@ import (
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	v7 "code.cloudfoundry.org/cli/command/v7"
 	"code.cloudfoundry.org/cli/command/v7/v7fakes"
 	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
 	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/ui"
 	. "github.com/onsi/ginkgo"
@@ -147,9 +151,16 @@ var _ = Describe("apps Command", func() {
 				appSummaries := []v7action.ApplicationSummary{
 					{
 						Application: resources.Application{
-							GUID:  "app-guid-1",
-							Name:  "some-app-1",
-							State: constant.ApplicationStarted,
+							GUID:      "app-guid-1",
+							Name:      "some-app-1",
+							State:     constant.ApplicationStarted,
+							CreatedAt: "2021-01-01T00:00:00Z",
+							UpdatedAt: "2021-01-02T00:00:00Z",
+							Metadata: &resources.Metadata{Labels: map[string]types.NullString{
+								"tier":    types.NewNullString("web"),
+								"env":     types.NewNullString("prod"),
+								"removed": types.NewNullString(),
+							}},
 						},
 						ProcessSummaries: []v7action.ProcessSummary{
 							{
@@ -247,6 +258,20 @@ var _ = Describe("apps Command", func() {
 				Expect(spaceGUID).To(Equal("some-space-guid"))
 				Expect(labels).To(Equal(""))
 			})
+
+			When("the --wide flag is set", func() {
+				BeforeEach(func() {
+					fakeConfig.TableOptionsReturns(configv3.TableOptions{Wide: true})
+				})
+
+				It("adds the guid, timestamps and labels columns", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(testUI.Out).To(Say(`name\s+requested state\s+processes\s+routes\s+guid\s+created\s+updated\s+labels`))
+					Expect(testUI.Out).To(Say(`some-app-1\s+started\s+.+\s+app-guid-1\s+2021-01-01T00:00:00Z\s+2021-01-02T00:00:00Z\s+env=prod, tier=web`))
+					Expect(testUI.Out).To(Say(`some-app-2\s+stopped\s+web:0/2\s+some-app-2.some-domain\s+app-guid-2\s*\n`))
+				})
+			})
 		})
 
 		When("app does not have processes", func() {
@@ -294,6 +319,197 @@ var _ = Describe("apps Command", func() {
 
 		})
 	})
+	When("the --foundations-file flag is set", func() {
+		var (
+			fakeFoundationsRunner *v7fakes.FakeFoundationsRunner
+			dc1Actor              *v7fakes.FakeActor
+			dc2Actor              *v7fakes.FakeActor
+		)
+
+		BeforeEach(func() {
+			cmd.FoundationsFile = "foundations.yml"
+			fakeFoundationsRunner = new(v7fakes.FakeFoundationsRunner)
+			cmd.FoundationsRunner = fakeFoundationsRunner
+
+			dc1Actor = new(v7fakes.FakeActor)
+			dc1Actor.GetAppSummariesForSpaceReturns([]v7action.ApplicationSummary{
+				{Application: resources.Application{Name: "app-1", State: constant.ApplicationStarted}},
+			}, v7action.Warnings{"dc1-warning"}, nil)
+			dc2Actor = new(v7fakes.FakeActor)
+			dc2Actor.GetAppSummariesForSpaceReturns(nil, nil, errors.New("dc2 is down"))
+
+			runOnFoundationTargets(fakeFoundationsRunner,
+				v7.FoundationTarget{Name: "dc1", Actor: dc1Actor, Space: resources.Space{GUID: "dc1-space-guid"}},
+				v7.FoundationTarget{Name: "dc2", Actor: dc2Actor, Space: resources.Space{GUID: "dc2-space-guid"}},
+			)
+		})
+
+		It("lists the apps of every foundation with a foundation column", func() {
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+			foundationsFile, targetOrg, targetSpace, _ := fakeFoundationsRunner.RunOnFoundationsArgsForCall(0)
+			Expect(foundationsFile).To(Equal("foundations.yml"))
+			Expect(targetOrg).To(BeTrue())
+			Expect(targetSpace).To(BeTrue())
+
+			spaceGUID, _ := dc1Actor.GetAppSummariesForSpaceArgsForCall(0)
+			Expect(spaceGUID).To(Equal("dc1-space-guid"))
+
+			Expect(testUI.Out).To(Say("Getting apps from foundations in foundations.yml..."))
+			Expect(testUI.Out).To(Say(`foundation\s+name\s+requested state\s+processes\s+routes`))
+			Expect(testUI.Out).To(Say(`dc1\s+app-1\s+started`))
+		})
+
+		It("displays the warnings and errors of each foundation and fails", func() {
+			Expect(testUI.Err).To(Say("dc1: dc1-warning"))
+			Expect(testUI.Err).To(Say("dc2: dc2 is down"))
+			Expect(executeErr).To(MatchError(translatableerror.FoundationsFailedError{Names: []string{"dc2"}}))
+		})
+	})
+
+	When("the --output flag is set", func() {
+		BeforeEach(func() {
+			cmd.Output = flag.OutputFormat{Format: flag.OutputJSON}
+			fakeActor.GetAppSummariesForSpaceReturns([]v7action.ApplicationSummary{
+				{
+					Application: resources.Application{
+						Name:  "some-app",
+						GUID:  "some-app-guid",
+						State: constant.ApplicationStarted,
+					},
+					ProcessSummaries: v7action.ProcessSummaries{
+						{
+							Process: resources.Process{Type: "web", MemoryInMB: types.NullUint64{Value: 64, IsSet: true}},
+							InstanceDetails: []v7action.ProcessInstance{
+								{State: constant.ProcessInstanceRunning},
+							},
+						},
+					},
+					Routes: []resources.Route{{URL: "some-app.example.com"}},
+				},
+			}, v7action.Warnings{"warning-1"}, nil)
+		})
+
+		It("displays the apps as JSON and the warnings on stderr", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			var apps []map[string]interface{}
+			Expect(json.Unmarshal(testUI.Out.(*Buffer).Contents(), &apps)).To(Succeed())
+			Expect(apps).To(Equal([]map[string]interface{}{
+				{
+					"name":  "some-app",
+					"guid":  "some-app-guid",
+					"state": "started",
+					"processes": []interface{}{
+						map[string]interface{}{
+							"type":              "web",
+							"instances":         float64(1),
+							"running_instances": float64(1),
+							"memory_in_mb":      float64(64),
+							"disk_in_mb":        float64(0),
+							"sidecars":          []interface{}{},
+						},
+					},
+					"routes": []interface{}{"some-app.example.com"},
+				},
+			}))
+			Expect(testUI.Err).To(Say("warning-1"))
+		})
+
+		When("the format is yaml", func() {
+			BeforeEach(func() {
+				cmd.Output = flag.OutputFormat{Format: flag.OutputYAML}
+			})
+
+			It("displays the apps as YAML", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`- guid: some-app-guid\n`))
+				Expect(testUI.Out).To(Say(`  name: some-app\n`))
+				Expect(testUI.Out).To(Say(`  routes:\n  - some-app.example.com\n`))
+				Expect(testUI.Out).To(Say(`  state: started\n`))
+			})
+		})
+
+		When("the format is csv", func() {
+			BeforeEach(func() {
+				cmd.Output = flag.OutputFormat{Format: flag.OutputCSV}
+			})
+
+			It("displays the apps as CSV", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(string(testUI.Out.(*Buffer).Contents())).To(Equal(
+					"name,guid,state,processes,routes\n" +
+						`some-app,some-app-guid,started,"[{""type"":""web"",""instances"":1,""running_instances"":1,""memory_in_mb"":64,""disk_in_mb"":0,""sidecars"":[]}]",some-app.example.com` + "\n",
+				))
+			})
+		})
+
+		When("there are no apps", func() {
+			BeforeEach(func() {
+				fakeActor.GetAppSummariesForSpaceReturns(nil, nil, nil)
+			})
+
+			It("displays an empty list", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(string(testUI.Out.(*Buffer).Contents())).To(Equal("[]\n\n"))
+			})
+		})
+
+		When("the --foundations-file flag is also set", func() {
+			BeforeEach(func() {
+				cmd.FoundationsFile = "foundations.yml"
+			})
+
+			It("returns an argument combination error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+					Args: []string{"--foundations-file", "--output"},
+				}))
+			})
+		})
+	})
+
+	When("the --format flag is set", func() {
+		BeforeEach(func() {
+			Expect(cmd.Format.UnmarshalFlag(`{{range .}}{{println .Name .State}}{{end}}`)).To(Succeed())
+			fakeActor.GetAppSummariesForSpaceReturns([]v7action.ApplicationSummary{
+				{Application: resources.Application{Name: "app-1", State: constant.ApplicationStarted}},
+				{Application: resources.Application{Name: "app-2", State: constant.ApplicationStopped}},
+			}, v7action.Warnings{"warning-1"}, nil)
+		})
+
+		It("displays the apps with the template", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(string(testUI.Out.(*Buffer).Contents())).To(Equal("app-1 started\napp-2 stopped\n"))
+			Expect(testUI.Err).To(Say("warning-1"))
+		})
+
+		When("the --output flag is also set", func() {
+			BeforeEach(func() {
+				cmd.Output = flag.OutputFormat{Format: flag.OutputJSON}
+			})
+
+			It("returns an argument combination error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+					Args: []string{"--output", "--format"},
+				}))
+			})
+		})
+	})
+
+	When("the --jsonpath flag is set", func() {
+		BeforeEach(func() {
+			Expect(cmd.JSONPath.UnmarshalFlag(`{[*].guid}`)).To(Succeed())
+			fakeActor.GetAppSummariesForSpaceReturns([]v7action.ApplicationSummary{
+				{Application: resources.Application{Name: "app-1", GUID: "app-guid-1"}},
+				{Application: resources.Application{Name: "app-2", GUID: "app-guid-2"}},
+			}, nil, nil)
+		})
+
+		It("displays the selected fields of the JSON output", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(string(testUI.Out.(*Buffer).Contents())).To(Equal("app-guid-1 app-guid-2\n"))
+		})
+	})
+
 	Context("when a labels flag is set", func() {
 		BeforeEach(func() {
 			cmd.Labels = "fish=moose"
@@ -0,0 +1,148 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+type AuditEventsCommand struct {
+	BaseCommand
+
+	usage           interface{}       `usage:"CF_NAME audit-events [-o ORG] [-s SPACE] [--target-guid GUID] [--target-name NAME] [--type TYPE]... [--actor ACTOR] [--since TIME] [--until TIME] [--output json|yaml|csv]\n\nEXAMPLES:\n   CF_NAME audit-events --type audit.app.update --since 2h\n   CF_NAME audit-events -o my-org -s my-space --target-name my-app --since 2021-03-04 --until 2021-03-05\n   CF_NAME audit-events --actor admin --since 2021-03-04T05:00:00Z --output json"`
+	relatedCommands interface{}       `related_commands:"app-usage-events, curl, events, service-usage-events"`
+	Org             string            `short:"o" description:"Only show events in this org"`
+	Space           string            `short:"s" description:"Only show events in this space of the org given with -o, or of the targeted org"`
+	TargetGUID      string            `long:"target-guid" description:"Only show events about the resource with this GUID"`
+	TargetName      string            `long:"target-name" description:"Only show events about resources with this name"`
+	Types           []string          `long:"type" description:"Only show events of this type, such as audit.app.update. Can be given several times"`
+	ActorName       string            `long:"actor" description:"Only show events caused by the user or client with this name or GUID"`
+	Since           flag.Timestamp    `long:"since" description:"Only show events at or after this time, such as 2021-03-04T05:06:07Z, 2021-03-04 or 2h (ago)"`
+	Until           flag.Timestamp    `long:"until" description:"Only show events at or before this time, such as 2021-03-04T05:06:07Z, 2021-03-04 or 2h (ago)"`
+	Output          flag.OutputFormat `long:"output" description:"Display the events as json, yaml or csv"`
+	Format          flag.GoTemplate   `long:"format" description:"Display the events with the given Go template"`
+	JSONPath        flag.JSONPath     `long:"jsonpath" description:"Display the fields of the events selected by the given JSONPath expression"`
+}
+
+func (cmd AuditEventsCommand) Execute(args []string) error {
+	if err := cmd.output().validate(); err != nil {
+		return err
+	}
+	if err := validateTimeRange(cmd.Since, cmd.Until); err != nil {
+		return err
+	}
+
+	err := cmd.SharedActor.CheckTarget(cmd.Space != "" && cmd.Org == "", false)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	filter, err := cmd.filter()
+	if err != nil {
+		return err
+	}
+
+	if !cmd.output().IsSet() {
+		cmd.UI.DisplayTextWithFlavor("Getting audit events as {{.CurrentUser}}...", map[string]interface{}{
+			"CurrentUser": user.Name,
+		})
+		cmd.UI.DisplayNewline()
+	}
+
+	events, warnings, err := cmd.Actor.GetAuditEvents(filter)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if cmd.output().IsSet() {
+		output := []AuditEventOutput{}
+		for _, event := range events {
+			output = append(output, auditEventOutput(event))
+		}
+		return cmd.output().display(cmd.UI, output)
+	}
+
+	if len(events) == 0 {
+		cmd.UI.DisplayText("No audit events found.")
+		return nil
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("time"),
+			cmd.UI.TranslateText("event"),
+			cmd.UI.TranslateText("actor"),
+			cmd.UI.TranslateText("target type"),
+			cmd.UI.TranslateText("target"),
+			cmd.UI.TranslateText("description"),
+		},
+	}
+	for _, event := range events {
+		table = append(table, []string{
+			event.Time.Local().Format("2006-01-02T15:04:05.00-0700"),
+			event.Type,
+			event.ActorName,
+			event.TargetType,
+			event.TargetName,
+			event.Description,
+		})
+	}
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+
+	return nil
+}
+
+// filter returns the filter selected by the flags, looking up the GUIDs of
+// the org and space.
+func (cmd AuditEventsCommand) filter() (v7action.AuditEventFilter, error) {
+	filter := v7action.AuditEventFilter{
+		TargetGUID: cmd.TargetGUID,
+		TargetName: cmd.TargetName,
+		Types:      cmd.Types,
+		Actor:      cmd.ActorName,
+		Since:      cmd.Since.Time,
+		Until:      cmd.Until.Time,
+	}
+
+	orgGUID := cmd.Config.TargetedOrganization().GUID
+	if cmd.Org != "" {
+		org, warnings, err := cmd.Actor.GetOrganizationByName(cmd.Org)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return filter, err
+		}
+		orgGUID = org.GUID
+		filter.OrganizationGUID = org.GUID
+	}
+
+	if cmd.Space != "" {
+		space, warnings, err := cmd.Actor.GetSpaceByNameAndOrganization(cmd.Space, orgGUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return filter, err
+		}
+		filter.SpaceGUID = space.GUID
+	}
+
+	return filter, nil
+}
+
+// validateTimeRange returns an error if --until is earlier than --since, as
+// no events could be found.
+func validateTimeRange(since flag.Timestamp, until flag.Timestamp) error {
+	if since.IsSet && until.IsSet && until.Time.Before(since.Time) {
+		return translatableerror.IncorrectUsageError{Message: "--until must not be earlier than --since"}
+	}
+	return nil
+}
+
+func (cmd AuditEventsCommand) output() structuredOutput {
+	return structuredOutput{output: cmd.Output, format: cmd.Format, jsonPath: cmd.JSONPath}
+}
@@ -0,0 +1,242 @@
+package v7_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("audit-events Command", func() {
+	var (
+		cmd             AuditEventsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		executeErr      error
+		eventTime       time.Time
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		cmd = AuditEventsCommand{
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				Actor:       fakeActor,
+				SharedActor: fakeSharedActor,
+			},
+		}
+
+		fakeConfig.BinaryNameReturns("faceman")
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "targeted-org", GUID: "targeted-org-guid"})
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+
+		eventTime = time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC)
+		fakeActor.GetAuditEventsReturns([]v7action.AuditEvent{
+			{
+				GUID:             "event-guid",
+				Time:             eventTime,
+				Type:             "audit.app.update",
+				ActorGUID:        "user-guid",
+				ActorType:        "user",
+				ActorName:        "admin",
+				TargetGUID:       "app-guid",
+				TargetType:       "app",
+				TargetName:       "some-app",
+				SpaceGUID:        "space-guid",
+				OrganizationGUID: "org-guid",
+				Description:      "instances: 3",
+				Data:             map[string]interface{}{"request": map[string]interface{}{"instances": float64(3)}},
+			},
+		}, v7action.Warnings{"events-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: "faceman"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: "faceman"}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+			Expect(fakeActor.GetAuditEventsCallCount()).To(Equal(0))
+		})
+	})
+
+	It("displays the events", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(testUI.Out).To(Say(`Getting audit events as steve\.\.\.`))
+		Expect(testUI.Out).To(Say(`time\s+event\s+actor\s+target type\s+target\s+description`))
+		Expect(testUI.Out).To(Say(`%s\s+audit\.app\.update\s+admin\s+app\s+some-app\s+instances: 3`, eventTime.Local().Format("2006-01-02T15:04:05.00-0700")))
+		Expect(testUI.Err).To(Say("events-warning"))
+
+		Expect(fakeActor.GetAuditEventsArgsForCall(0)).To(Equal(v7action.AuditEventFilter{}))
+	})
+
+	When("filters are given", func() {
+		BeforeEach(func() {
+			cmd.TargetGUID = "app-guid"
+			cmd.TargetName = "some-app"
+			cmd.Types = []string{"audit.app.update", "audit.app.start"}
+			cmd.ActorName = "admin"
+			cmd.Since = flag.Timestamp{Time: eventTime.Add(-time.Hour), IsSet: true}
+			cmd.Until = flag.Timestamp{Time: eventTime.Add(time.Hour), IsSet: true}
+		})
+
+		It("passes them to the actor", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeActor.GetAuditEventsArgsForCall(0)).To(Equal(v7action.AuditEventFilter{
+				TargetGUID: "app-guid",
+				TargetName: "some-app",
+				Types:      []string{"audit.app.update", "audit.app.start"},
+				Actor:      "admin",
+				Since:      eventTime.Add(-time.Hour),
+				Until:      eventTime.Add(time.Hour),
+			}))
+		})
+	})
+
+	When("--until is earlier than --since", func() {
+		BeforeEach(func() {
+			cmd.Since = flag.Timestamp{Time: eventTime, IsSet: true}
+			cmd.Until = flag.Timestamp{Time: eventTime.Add(-time.Hour), IsSet: true}
+		})
+
+		It("returns an incorrect usage error without getting events", func() {
+			Expect(executeErr).To(MatchError(translatableerror.IncorrectUsageError{Message: "--until must not be earlier than --since"}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+			Expect(fakeActor.GetAuditEventsCallCount()).To(Equal(0))
+		})
+	})
+
+	When("an org and space are given", func() {
+		BeforeEach(func() {
+			cmd.Org = "some-org"
+			cmd.Space = "some-space"
+			fakeActor.GetOrganizationByNameReturns(resources.Organization{GUID: "some-org-guid"}, v7action.Warnings{"org-warning"}, nil)
+			fakeActor.GetSpaceByNameAndOrganizationReturns(resources.Space{GUID: "some-space-guid"}, v7action.Warnings{"space-warning"}, nil)
+		})
+
+		It("filters the events by the org and the space in that org", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Err).To(Say("org-warning"))
+			Expect(testUI.Err).To(Say("space-warning"))
+
+			Expect(fakeActor.GetOrganizationByNameArgsForCall(0)).To(Equal("some-org"))
+			spaceName, orgGUID := fakeActor.GetSpaceByNameAndOrganizationArgsForCall(0)
+			Expect(spaceName).To(Equal("some-space"))
+			Expect(orgGUID).To(Equal("some-org-guid"))
+
+			Expect(fakeActor.GetAuditEventsArgsForCall(0)).To(Equal(v7action.AuditEventFilter{
+				OrganizationGUID: "some-org-guid",
+				SpaceGUID:        "some-space-guid",
+			}))
+		})
+
+		When("the org does not exist", func() {
+			BeforeEach(func() {
+				fakeActor.GetOrganizationByNameReturns(resources.Organization{}, nil, actionerror.OrganizationNotFoundError{Name: "some-org"})
+			})
+
+			It("returns the error without getting events", func() {
+				Expect(executeErr).To(MatchError(actionerror.OrganizationNotFoundError{Name: "some-org"}))
+				Expect(fakeActor.GetAuditEventsCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	When("only a space is given", func() {
+		BeforeEach(func() {
+			cmd.Space = "some-space"
+			fakeActor.GetSpaceByNameAndOrganizationReturns(resources.Space{GUID: "some-space-guid"}, nil, nil)
+		})
+
+		It("looks up the space in the targeted org", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			checkTargetedOrg, _ := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+
+			_, orgGUID := fakeActor.GetSpaceByNameAndOrganizationArgsForCall(0)
+			Expect(orgGUID).To(Equal("targeted-org-guid"))
+			Expect(fakeActor.GetAuditEventsArgsForCall(0).OrganizationGUID).To(BeEmpty())
+			Expect(fakeActor.GetAuditEventsArgsForCall(0).SpaceGUID).To(Equal("some-space-guid"))
+		})
+	})
+
+	When("there are no events", func() {
+		BeforeEach(func() {
+			fakeActor.GetAuditEventsReturns(nil, nil, nil)
+		})
+
+		It("says so", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("No audit events found."))
+		})
+	})
+
+	When("getting the events fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetAuditEventsReturns(nil, v7action.Warnings{"events-warning"}, errors.New("some-error"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("some-error"))
+			Expect(testUI.Err).To(Say("events-warning"))
+		})
+	})
+
+	When("the --output flag is set", func() {
+		BeforeEach(func() {
+			cmd.Output = flag.OutputFormat{Format: flag.OutputJSON}
+		})
+
+		It("displays the events as JSON without the header", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).ToNot(Say("Getting audit events"))
+			Expect(string(testUI.Out.(*Buffer).Contents())).To(MatchJSON(`[
+				{
+					"guid": "event-guid",
+					"time": "2021-03-04T05:06:07Z",
+					"type": "audit.app.update",
+					"actor_guid": "user-guid",
+					"actor_type": "user",
+					"actor_name": "admin",
+					"target_guid": "app-guid",
+					"target_type": "app",
+					"target_name": "some-app",
+					"space_guid": "space-guid",
+					"organization_guid": "org-guid",
+					"data": {"request": {"instances": 3}}
+				}
+			]`))
+			Expect(testUI.Err).To(Say("events-warning"))
+		})
+	})
+})
@@ -16,6 +16,10 @@ type BaseCommand struct {
 	SharedActor command.SharedActor
 	Actor       Actor
 
+	// FoundationsRunner is only set up for commands run with
+	// --foundations-file.
+	FoundationsRunner FoundationsRunner
+
 	cloudControllerClient *ccv3.Client
 	uaaClient             *uaa.Client
 }
@@ -34,9 +38,23 @@ func (cmd *BaseCommand) Setup(config command.Config, ui command.UI) error {
 	cmd.uaaClient = uaaClient
 
 	cmd.Actor = v7action.NewActor(ccClient, config, sharedActor, uaaClient, routingClient, clock.NewClock())
+
+	if envTarget := config.EnvTarget(); envTarget.IsSet() {
+		ui.DisplayWarning("Using {{.Target}} from the environment.", map[string]interface{}{
+			"Target": envTarget.String(),
+		})
+	}
 	return nil
 }
 
+// SetupForFoundations sets up a command that runs against the foundations in
+// a --foundations-file. It does not connect to the targeted API.
+func (cmd *BaseCommand) SetupForFoundations(config command.Config, ui command.UI) {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.FoundationsRunner = ActualFoundationsRunner{Config: config, UI: ui}
+}
+
 func (cmd *BaseCommand) GetClients() (*ccv3.Client, *uaa.Client) {
 	return cmd.cloudControllerClient, cmd.uaaClient
 }
@@ -3,6 +3,7 @@ package v7
 import (
 	"strconv"
 
+	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/resources"
 	"code.cloudfoundry.org/cli/util/ui"
 )
@@ -10,12 +11,19 @@ import (
 type BuildpacksCommand struct {
 	BaseCommand
 
-	usage           interface{} `usage:"CF_NAME buildpacks [--labels SELECTOR]\n\nEXAMPLES:\n   CF_NAME buildpacks\n   CF_NAME buildpacks --labels 'environment in (production,staging),tier in (backend)'\n   CF_NAME buildpacks --labels 'env=dev,!chargeback-code,tier in (backend,worker)'"`
-	relatedCommands interface{} `related_commands:"create-buildpack, delete-buildpack, rename-buildpack, update-buildpack"`
-	Labels          string      `long:"labels" description:"Selector to filter buildpacks by labels"`
+	usage           interface{}       `usage:"CF_NAME buildpacks [--labels SELECTOR] [--output json|yaml|csv] [--format TEMPLATE] [--jsonpath EXPRESSION]\n\nEXAMPLES:\n   CF_NAME buildpacks\n   CF_NAME buildpacks --labels 'environment in (production,staging),tier in (backend)'\n   CF_NAME buildpacks --labels 'env=dev,!chargeback-code,tier in (backend,worker)'\n   CF_NAME buildpacks --output json"`
+	relatedCommands interface{}       `related_commands:"create-buildpack, delete-buildpack, rename-buildpack, update-buildpack"`
+	Labels          string            `long:"labels" description:"Selector to filter buildpacks by labels"`
+	Output          flag.OutputFormat `long:"output" description:"Display the buildpacks as json, yaml or csv"`
+	Format          flag.GoTemplate   `long:"format" description:"Display the buildpacks with the given Go template"`
+	JSONPath        flag.JSONPath     `long:"jsonpath" description:"Display the fields of the buildpacks selected by the given JSONPath expression"`
 }
 
 func (cmd BuildpacksCommand) Execute(args []string) error {
+	if err := cmd.output().validate(); err != nil {
+		return err
+	}
+
 	err := cmd.SharedActor.CheckTarget(false, false)
 	if err != nil {
 		return err
@@ -26,10 +34,12 @@ func (cmd BuildpacksCommand) Execute(args []string) error {
 		return err
 	}
 
-	cmd.UI.DisplayTextWithFlavor("Getting buildpacks as {{.Username}}...", map[string]interface{}{
-		"Username": user.Name,
-	})
-	cmd.UI.DisplayNewline()
+	if !cmd.output().IsSet() {
+		cmd.UI.DisplayTextWithFlavor("Getting buildpacks as {{.Username}}...", map[string]interface{}{
+			"Username": user.Name,
+		})
+		cmd.UI.DisplayNewline()
+	}
 
 	buildpacks, warnings, err := cmd.Actor.GetBuildpacks(cmd.Labels)
 	cmd.UI.DisplayWarnings(warnings)
@@ -37,6 +47,14 @@ func (cmd BuildpacksCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.output().IsSet() {
+		output := []BuildpackOutput{}
+		for _, buildpack := range buildpacks {
+			output = append(output, buildpackOutput(buildpack))
+		}
+		return cmd.output().display(cmd.UI, output)
+	}
+
 	if len(buildpacks) == 0 {
 		cmd.UI.DisplayTextWithFlavor("No buildpacks found")
 	} else {
@@ -64,3 +82,7 @@ func (cmd BuildpacksCommand) displayTable(buildpacks []resources.Buildpack) {
 		cmd.UI.DisplayTableWithHeader("", keyValueTable, ui.DefaultTableSpacePadding)
 	}
 }
+
+func (cmd BuildpacksCommand) output() structuredOutput {
+	return structuredOutput{output: cmd.Output, format: cmd.Format, jsonPath: cmd.JSONPath}
+}
@@ -1,6 +1,7 @@
 package v7_test
 
 import (
+	"encoding/json"
 	"errors"
 
 	"code.cloudfoundry.org/cli/resources"
@@ -9,6 +10,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
 	. "code.cloudfoundry.org/cli/command/v7"
 	"code.cloudfoundry.org/cli/command/v7/v7fakes"
 	"code.cloudfoundry.org/cli/util/configv3"
@@ -152,4 +154,42 @@ var _ = Describe("buildpacks Command", func() {
 			})
 		})
 	})
+
+	When("the --output flag is set", func() {
+		BeforeEach(func() {
+			cmd.Output = flag.OutputFormat{Format: flag.OutputJSON}
+			fakeActor.GetBuildpacksReturns([]resources.Buildpack{
+				{
+					Name:     "buildpack-1",
+					GUID:     "buildpack-guid-1",
+					Position: types.NullInt{Value: 1, IsSet: true},
+					Enabled:  types.NullBool{Value: true, IsSet: true},
+					Locked:   types.NullBool{Value: false, IsSet: true},
+					Stack:    "cflinuxfs4",
+					Filename: "buildpack-1.zip",
+					State:    "READY",
+				},
+			}, v7action.Warnings{"warning-1"}, nil)
+		})
+
+		It("displays the buildpacks as JSON without the header", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			var buildpacks []map[string]interface{}
+			Expect(json.Unmarshal(testUI.Out.(*Buffer).Contents(), &buildpacks)).To(Succeed())
+			Expect(buildpacks).To(Equal([]map[string]interface{}{
+				{
+					"name":     "buildpack-1",
+					"guid":     "buildpack-guid-1",
+					"position": float64(1),
+					"stack":    "cflinuxfs4",
+					"enabled":  true,
+					"locked":   false,
+					"filename": "buildpack-1.zip",
+					"state":    "READY",
+				},
+			}))
+			Expect(testUI.Err).To(Say("warning-1"))
+		})
+	})
 })
@@ -7,13 +7,16 @@ import (
 )
 
 type ConfigCommand struct {
-	UI           command.UI
-	Config       command.Config
-	AsyncTimeout flag.Timeout      `long:"async-timeout" description:"Timeout in minutes for async HTTP requests"`
-	Color        flag.Color        `long:"color" description:"Enable or disable color in CLI output"`
-	Locale       flag.Locale       `long:"locale" description:"Set default locale. If LOCALE is 'CLEAR', previous locale is deleted."`
-	Trace        flag.PathWithBool `long:"trace" description:"Trace HTTP requests by default. If a file path is provided then output will write to the file provided. If the file does not exist it will be created."`
-	usage        interface{}       `usage:"CF_NAME config [--async-timeout TIMEOUT_IN_MINUTES] [--trace (true | false | path/to/file)] [--color (true | false)] [--locale (LOCALE | CLEAR)]"`
+	UI              command.UI
+	Config          command.Config
+	AsyncTimeout    flag.Timeout         `long:"async-timeout" description:"Timeout in minutes for async HTTP requests"`
+	Color           flag.Color           `long:"color" description:"Enable or disable color in CLI output"`
+	HTTPCacheTTL    flag.CacheTTL        `long:"http-cache-ttl" description:"Cache rarely changing API responses, such as stacks, buildpacks and service offerings, for this long, such as 10m. 0 turns the cache off."`
+	Locale          flag.Locale          `long:"locale" description:"Set default locale. If LOCALE is 'CLEAR', previous locale is deleted."`
+	PageConcurrency flag.PositiveInteger `long:"page-concurrency" description:"Number of pages of a list fetched at the same time"`
+	Trace           flag.PathWithBool    `long:"trace" description:"Trace HTTP requests by default. If a file path is provided then output will write to the file provided. If the file does not exist it will be created."`
+	TraceHAR        flag.PathWithBool    `long:"trace-har" description:"Record HTTP requests by default in an HTTP Archive (HAR) file at the path provided, or 'false' to stop. If the file does not exist it will be created."`
+	usage           interface{}          `usage:"CF_NAME config [--async-timeout TIMEOUT_IN_MINUTES] [--trace (true | false | path/to/file)] [--color (true | false)] [--locale (LOCALE | CLEAR)] [--page-concurrency PAGES] [--http-cache-ttl DURATION] [--trace-har (path/to/file.har | false)]"`
 }
 
 func (cmd *ConfigCommand) Setup(config command.Config, ui command.UI) error {
@@ -24,7 +27,7 @@ func (cmd *ConfigCommand) Setup(config command.Config, ui command.UI) error {
 }
 
 func (cmd ConfigCommand) Execute(args []string) error {
-	if !cmd.Color.IsSet && cmd.Trace == "" && cmd.Locale.Locale == "" && !cmd.AsyncTimeout.IsSet {
+	if !cmd.Color.IsSet && cmd.Trace == "" && cmd.Locale.Locale == "" && !cmd.AsyncTimeout.IsSet && cmd.PageConcurrency.Value == 0 && !cmd.HTTPCacheTTL.IsSet && cmd.TraceHAR == "" {
 		return translatableerror.IncorrectUsageError{Message: "at least one flag must be provided"}
 	}
 
@@ -38,14 +41,26 @@ func (cmd ConfigCommand) Execute(args []string) error {
 		cmd.Config.SetColorEnabled(cmd.Color.Value)
 	}
 
+	if cmd.HTTPCacheTTL.IsSet {
+		cmd.Config.SetHTTPCacheTTL(cmd.HTTPCacheTTL.Duration)
+	}
+
 	if cmd.Locale.Locale != "" {
 		cmd.Config.SetLocale(cmd.Locale.Locale)
 	}
 
+	if cmd.PageConcurrency.Value != 0 {
+		cmd.Config.SetPageConcurrency(int(cmd.PageConcurrency.Value))
+	}
+
 	if cmd.Trace != "" {
 		cmd.Config.SetTrace(string(cmd.Trace))
 	}
 
+	if cmd.TraceHAR != "" {
+		cmd.Config.SetTraceHAR(string(cmd.TraceHAR))
+	}
+
 	cmd.UI.DisplayOK()
 	return nil
 }
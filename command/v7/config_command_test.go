@@ -1,6 +1,8 @@
 package v7_test
 
 import (
+	"time"
+
 	"code.cloudfoundry.org/cli/command/commandfakes"
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
@@ -56,6 +58,42 @@ var _ = Describe("ConfigCommand", func() {
 		})
 	})
 
+	When("using the http cache ttl flag", func() {
+		BeforeEach(func() {
+			cmd.HTTPCacheTTL = flag.CacheTTL{Duration: 10 * time.Minute, IsSet: true}
+		})
+
+		It("successfully updates the config", func() {
+			Expect(executeErr).To(Not(HaveOccurred()))
+			Expect(fakeConfig.SetHTTPCacheTTLCallCount()).To(Equal(1))
+			Expect(fakeConfig.SetHTTPCacheTTLArgsForCall(0)).To(Equal(10 * time.Minute))
+		})
+	})
+
+	When("using the trace har flag", func() {
+		BeforeEach(func() {
+			cmd.TraceHAR = "/some/path/trace.har"
+		})
+
+		It("successfully updates the config", func() {
+			Expect(executeErr).To(Not(HaveOccurred()))
+			Expect(fakeConfig.SetTraceHARCallCount()).To(Equal(1))
+			Expect(fakeConfig.SetTraceHARArgsForCall(0)).To(Equal("/some/path/trace.har"))
+		})
+	})
+
+	When("using the page concurrency flag", func() {
+		BeforeEach(func() {
+			cmd.PageConcurrency = flag.PositiveInteger{Value: 8}
+		})
+
+		It("successfully updates the config", func() {
+			Expect(executeErr).To(Not(HaveOccurred()))
+			Expect(fakeConfig.SetPageConcurrencyCallCount()).To(Equal(1))
+			Expect(fakeConfig.SetPageConcurrencyArgsForCall(0)).To(Equal(8))
+		})
+	})
+
 	When("using the color flag", func() {
 		BeforeEach(func() {
 			cmd.Color = flag.Color{IsSet: true, Value: "true"}
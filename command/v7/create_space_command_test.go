@@ -4,6 +4,8 @@ import (
 	"errors"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction/sharedactionfakes"
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/command/commandfakes"
@@ -366,4 +368,39 @@ var _ = Describe("create-space Command", func() {
 			Expect(testUI.Out).To(Say(`TIP: Use 'cf target -o "%s" -s "%s"' to target new space`, "some-org-name", spaceName))
 		})
 	})
+
+	When("the org is targeted with CF_ORG", func() {
+		var (
+			config             *configv3.Config
+			fakeTargetResolver *sharedactionfakes.FakeTargetResolver
+		)
+
+		BeforeEach(func() {
+			config = &configv3.Config{
+				ConfigFile: configv3.JSONConfig{
+					AccessToken:          "some-access-token",
+					TargetedOrganization: configv3.Organization{Name: "saved-org", GUID: "saved-org-guid"},
+				},
+				ENV: configv3.EnvOverride{CFOrg: "env-org"},
+			}
+			fakeTargetResolver = new(sharedactionfakes.FakeTargetResolver)
+			fakeTargetResolver.ResolveOrganizationGUIDReturns("env-org-guid", nil)
+			fakeActor.CreateSpaceReturns(resources.Space{Name: spaceName, GUID: spaceGUID}, nil, nil)
+		})
+
+		It("creates the space in the org named in CF_ORG", func() {
+			sharedActor := sharedaction.NewActor(config)
+			sharedActor.TargetResolver = fakeTargetResolver
+			cmd.BaseCommand.Config = config
+			cmd.BaseCommand.SharedActor = sharedActor
+
+			Expect(cmd.Execute(nil)).To(Succeed())
+
+			Expect(fakeTargetResolver.ResolveOrganizationGUIDArgsForCall(0)).To(Equal("env-org"))
+			Expect(fakeActor.CreateSpaceCallCount()).To(Equal(2))
+			_, createdInOrgGUID := fakeActor.CreateSpaceArgsForCall(1)
+			Expect(createdInOrgGUID).To(Equal("env-org-guid"))
+			Expect(testUI.Out).To(Say("Creating space some-space in org env-org as some-user-name..."))
+		})
+	})
 })
@@ -0,0 +1,354 @@
+package v7
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"code.cloudfoundry.org/bytefmt"
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+const missingDriftValue = "(missing)"
+
+type DiffSpaceCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.OrgSpace               `positional-args:"yes"`
+	FoundationsFile flag.PathWithExistenceCheck `long:"foundations-file" required:"true" description:"YAML file listing the foundations to compare"`
+	Output          flag.OutputFormat           `long:"output" description:"Display the report as json, yaml or csv"`
+	Format          flag.GoTemplate             `long:"format" description:"Display the report with the given Go template"`
+	JSONPath        flag.JSONPath               `long:"jsonpath" description:"Display the fields of the report selected by the given JSONPath expression"`
+	usage           interface{}                 `usage:"CF_NAME diff-space ORG SPACE --foundations-file PATH [--output json|yaml|csv] [--format TEMPLATE] [--jsonpath EXPRESSION]\n\nEXAMPLES:\n   CF_NAME diff-space my-org production --foundations-file foundations.yml\n   CF_NAME diff-space my-org production --foundations-file foundations.yml --output json"`
+	relatedCommands interface{}                 `related_commands:"apps, network-policies, routes, security-groups, services, space"`
+}
+
+// SpaceDrift is a property of a resource in a space whose value is not the
+// same on every foundation. DriftedFoundations lists the foundations that
+// differ from the value most foundations agree on; it is empty when no value
+// is shared by a majority.
+type SpaceDrift struct {
+	Resource           string            `json:"resource"`
+	Property           string            `json:"property"`
+	Values             map[string]string `json:"values"`
+	DriftedFoundations []string          `json:"drifted_foundations"`
+}
+
+type spaceDriftReport struct {
+	Organization       string       `json:"org"`
+	Space              string       `json:"space"`
+	Foundations        []string     `json:"foundations"`
+	Drifts             []SpaceDrift `json:"drifts"`
+	DriftedFoundations []string     `json:"drifted_foundations"`
+}
+
+type driftKey struct {
+	resource string
+	property string
+}
+
+func (cmd *DiffSpaceCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.SetupForFoundations(config, ui)
+	return nil
+}
+
+func (cmd DiffSpaceCommand) Execute(args []string) error {
+	if err := cmd.output().validate(); err != nil {
+		return err
+	}
+
+	entries, err := cmd.FoundationsRunner.ReadFoundations(string(cmd.FoundationsFile))
+	if err != nil {
+		return err
+	}
+	if len(entries) < 2 {
+		return actionerror.InvalidFoundationsFileError{
+			Path:    string(cmd.FoundationsFile),
+			Message: "at least two foundations are needed to compare a space",
+		}
+	}
+
+	fingerprintKey := make([]byte, sha256.Size)
+	if _, err := rand.Read(fingerprintKey); err != nil {
+		return err
+	}
+
+	if !cmd.output().IsSet() {
+		cmd.UI.DisplayTextWithFlavor("Comparing org {{.OrgName}} / space {{.SpaceName}} across foundations in {{.FoundationsFile}}...", map[string]interface{}{
+			"OrgName":         cmd.RequiredArgs.Organization,
+			"SpaceName":       cmd.RequiredArgs.Space,
+			"FoundationsFile": cmd.FoundationsFile,
+		})
+		cmd.UI.DisplayNewline()
+	}
+
+	var mutex sync.Mutex
+	spaceValues := map[string]map[driftKey]string{}
+	results, err := cmd.FoundationsRunner.RunOnFoundations(string(cmd.FoundationsFile), false, false, func(target FoundationTarget) (v7action.Warnings, error) {
+		values, warnings, err := cmd.getSpaceValues(target, fingerprintKey)
+		mutex.Lock()
+		defer mutex.Unlock()
+		spaceValues[target.Name] = values
+		return warnings, err
+	})
+	if err != nil {
+		return err
+	}
+
+	var foundations []string
+	for _, result := range results {
+		if result.Err == nil {
+			foundations = append(foundations, result.Name)
+		}
+	}
+
+	if len(foundations) > 1 {
+		report := cmd.driftReport(foundations, spaceValues)
+		switch {
+		case cmd.Output.Format == flag.OutputCSV:
+			// A CSV row per drift, as the report itself would be a single row.
+			err = cmd.output().display(cmd.UI, report.Drifts)
+		case cmd.output().IsSet():
+			err = cmd.output().display(cmd.UI, report)
+		default:
+			cmd.displayReport(report)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return displayFoundationResults(cmd.UI, results)
+}
+
+// getSpaceValues returns every compared property of the space on one
+// foundation, keyed by resource and property. The values of environment
+// variables are replaced by fingerprints made with the given key.
+func (cmd DiffSpaceCommand) getSpaceValues(target FoundationTarget, fingerprintKey []byte) (map[driftKey]string, v7action.Warnings, error) {
+	var allWarnings v7action.Warnings
+	values := map[driftKey]string{}
+
+	org, warnings, err := target.Actor.GetOrganizationByName(cmd.RequiredArgs.Organization)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	space, warnings, err := target.Actor.GetSpaceByNameAndOrganization(cmd.RequiredArgs.Space, org.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	appSummaries, warnings, err := target.Actor.GetAppSummariesForSpace(space.GUID, "")
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	for _, appSummary := range appSummaries {
+		resource := "app " + appSummary.Name
+		summary, warnings, err := target.Actor.GetDetailedAppSummary(appSummary.Name, space.GUID, false)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		values[driftKey{resource, "stack"}] = summary.CurrentDroplet.Stack
+		var buildpacks []string
+		for _, buildpack := range summary.CurrentDroplet.Buildpacks {
+			buildpacks = append(buildpacks, buildpack.Name)
+		}
+		values[driftKey{resource, "buildpacks"}] = strings.Join(buildpacks, ", ")
+		values[driftKey{resource, "droplet checksum"}] = summary.CurrentDroplet.Checksum.Value
+
+		for _, process := range summary.ProcessSummaries {
+			if process.MemoryInMB.IsSet {
+				values[driftKey{resource, process.Type + " memory"}] = bytefmt.ByteSize(process.MemoryInMB.Value * bytefmt.MEGABYTE)
+			}
+			if process.Instances.IsSet {
+				values[driftKey{resource, process.Type + " instances"}] = strconv.Itoa(process.Instances.Value)
+			}
+		}
+
+		envVars, warnings, err := target.Actor.GetEnvironmentVariablesByApplicationNameAndSpace(appSummary.Name, space.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+		for name, value := range envVars.EnvironmentVariables {
+			values[driftKey{resource, "env " + name}] = envVarFingerprint(fingerprintKey, value)
+		}
+	}
+
+	routes, warnings, err := target.Actor.GetRoutesBySpace(space.GUID, "")
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+	routeSummaries, warnings, err := target.Actor.GetRouteSummaries(routes)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+	for _, routeSummary := range routeSummaries {
+		appNames := append([]string{}, routeSummary.AppNames...)
+		sort.Strings(appNames)
+		values[driftKey{"route " + routeSummary.URL, "apps"}] = strings.Join(appNames, ", ")
+	}
+
+	serviceInstances, warnings, err := target.Actor.GetServiceInstancesForSpace(space.GUID, true)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+	for _, serviceInstance := range serviceInstances {
+		values[driftKey{"service instance " + serviceInstance.Name, "plan"}] = serviceOfferingName(serviceInstance) + " / " + serviceInstance.ServicePlanName
+	}
+
+	if target.NetworkingActor != nil {
+		policies, networkingWarnings, err := target.NetworkingActor.NetworkPoliciesBySpace(space.GUID)
+		allWarnings = append(allWarnings, networkingWarnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+		for _, policy := range policies {
+			resource := fmt.Sprintf("network policy %s -> %s/%s/%s", policy.SourceName, policy.DestinationOrgName, policy.DestinationSpaceName, policy.DestinationName)
+			property := fmt.Sprintf("%s %d-%d", policy.Protocol, policy.StartPort, policy.EndPort)
+			values[driftKey{resource, property}] = "allowed"
+		}
+	} else {
+		allWarnings = append(allWarnings, "Network policies were not compared because the network policy API is not available.")
+	}
+
+	spaceSummary, warnings, err := target.Actor.GetSpaceSummaryByNameAndOrganization(space.Name, org.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+	for _, securityGroup := range spaceSummary.RunningSecurityGroups {
+		values[driftKey{"security group " + securityGroup.Name, "running"}] = "bound"
+	}
+	for _, securityGroup := range spaceSummary.StagingSecurityGroups {
+		values[driftKey{"security group " + securityGroup.Name, "staging"}] = "bound"
+	}
+
+	return values, allWarnings, nil
+}
+
+// envVarFingerprint hides the value of an environment variable while still
+// allowing values to be compared. The key is random for every run, so the
+// fingerprints cannot be used to guess the values or be compared with the
+// fingerprints of another run.
+func envVarFingerprint(key []byte, value interface{}) string {
+	mac := hmac.New(sha256.New, key)
+	_, _ = mac.Write([]byte(fmt.Sprint(value)))
+	return fmt.Sprintf("hmac:%x", mac.Sum(nil)[:8])
+}
+
+func (cmd DiffSpaceCommand) driftReport(foundations []string, spaceValues map[string]map[driftKey]string) spaceDriftReport {
+	keys := map[driftKey]bool{}
+	for _, foundation := range foundations {
+		for key := range spaceValues[foundation] {
+			keys[key] = true
+		}
+	}
+
+	sortedKeys := make([]driftKey, 0, len(keys))
+	for key := range keys {
+		sortedKeys = append(sortedKeys, key)
+	}
+	sort.Slice(sortedKeys, func(i, j int) bool {
+		if sortedKeys[i].resource != sortedKeys[j].resource {
+			return sortedKeys[i].resource < sortedKeys[j].resource
+		}
+		return sortedKeys[i].property < sortedKeys[j].property
+	})
+
+	report := spaceDriftReport{
+		Organization:       cmd.RequiredArgs.Organization,
+		Space:              cmd.RequiredArgs.Space,
+		Foundations:        foundations,
+		Drifts:             []SpaceDrift{},
+		DriftedFoundations: []string{},
+	}
+	drifted := map[string]bool{}
+	for _, key := range sortedKeys {
+		values := map[string]string{}
+		counts := map[string]int{}
+		for _, foundation := range foundations {
+			value, found := spaceValues[foundation][key]
+			if !found {
+				value = missingDriftValue
+			}
+			values[foundation] = value
+			counts[value]++
+		}
+		if len(counts) == 1 {
+			continue
+		}
+
+		drift := SpaceDrift{Resource: key.resource, Property: key.property, Values: values, DriftedFoundations: []string{}}
+		for value, count := range counts {
+			if count*2 <= len(foundations) {
+				continue
+			}
+			for _, foundation := range foundations {
+				if values[foundation] != value {
+					drift.DriftedFoundations = append(drift.DriftedFoundations, foundation)
+					drifted[foundation] = true
+				}
+			}
+		}
+		report.Drifts = append(report.Drifts, drift)
+	}
+
+	for _, foundation := range foundations {
+		if drifted[foundation] {
+			report.DriftedFoundations = append(report.DriftedFoundations, foundation)
+		}
+	}
+	return report
+}
+
+func (cmd DiffSpaceCommand) displayReport(report spaceDriftReport) {
+	if len(report.Drifts) == 0 {
+		cmd.UI.DisplayText("No drift found.")
+		return
+	}
+
+	header := []string{cmd.UI.TranslateText("resource"), cmd.UI.TranslateText("property")}
+	header = append(header, report.Foundations...)
+	table := [][]string{header}
+	for _, drift := range report.Drifts {
+		row := []string{drift.Resource, drift.Property}
+		for _, foundation := range report.Foundations {
+			row = append(row, drift.Values[foundation])
+		}
+		table = append(table, row)
+	}
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+	cmd.UI.DisplayNewline()
+
+	cmd.UI.DisplayText("Found {{.Count}} differences.", map[string]interface{}{
+		"Count": len(report.Drifts),
+	})
+	if len(report.DriftedFoundations) > 0 {
+		cmd.UI.DisplayText("Drifted from the other foundations: {{.Foundations}}", map[string]interface{}{
+			"Foundations": strings.Join(report.DriftedFoundations, ", "),
+		})
+	}
+}
+
+func (cmd DiffSpaceCommand) output() structuredOutput {
+	return structuredOutput{output: cmd.Output, format: cmd.Format, jsonPath: cmd.JSONPath}
+}
@@ -0,0 +1,244 @@
+package v7_test
+
+import (
+	"encoding/json"
+	"errors"
+	"regexp"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/cfnetworkingaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("diff-space Command", func() {
+	var (
+		cmd                   DiffSpaceCommand
+		testUI                *ui.UI
+		fakeConfig            *commandfakes.FakeConfig
+		fakeFoundationsRunner *v7fakes.FakeFoundationsRunner
+		actors                map[string]*v7fakes.FakeActor
+		targets               []FoundationTarget
+		executeErr            error
+	)
+
+	newTarget := func(name string, memoryInMB uint64, envValue string, plan string) FoundationTarget {
+		actor := new(v7fakes.FakeActor)
+		actor.GetOrganizationByNameReturns(resources.Organization{GUID: name + "-org-guid"}, nil, nil)
+		actor.GetSpaceByNameAndOrganizationReturns(resources.Space{GUID: name + "-space-guid", Name: "some-space"}, nil, nil)
+		actor.GetAppSummariesForSpaceReturns([]v7action.ApplicationSummary{
+			{Application: resources.Application{Name: "some-app"}},
+		}, v7action.Warnings{name + "-warning"}, nil)
+		actor.GetDetailedAppSummaryReturns(v7action.DetailedApplicationSummary{
+			ApplicationSummary: v7action.ApplicationSummary{
+				Application: resources.Application{Name: "some-app"},
+				ProcessSummaries: v7action.ProcessSummaries{
+					{Process: resources.Process{
+						Type:       "web",
+						MemoryInMB: types.NullUint64{Value: memoryInMB, IsSet: true},
+						Instances:  types.NullInt{Value: 2, IsSet: true},
+					}},
+				},
+			},
+			CurrentDroplet: resources.Droplet{Stack: "cflinuxfs4"},
+		}, nil, nil)
+		actor.GetEnvironmentVariablesByApplicationNameAndSpaceReturns(v7action.EnvironmentVariableGroups{
+			EnvironmentVariables: map[string]interface{}{"SECRET": envValue},
+		}, nil, nil)
+		actor.GetServiceInstancesForSpaceReturns([]v7action.ServiceInstance{
+			{Name: "some-db", ServiceOfferingName: "postgres", ServicePlanName: plan},
+		}, nil, nil)
+		actor.GetSpaceSummaryByNameAndOrganizationReturns(v7action.SpaceSummary{
+			RunningSecurityGroups: []resources.SecurityGroup{{Name: "public"}},
+		}, nil, nil)
+		actors[name] = actor
+
+		networkingActor := new(v7fakes.FakeNetworkPoliciesActor)
+		networkingActor.NetworkPoliciesBySpaceReturns([]cfnetworkingaction.Policy{
+			{SourceName: "some-app", DestinationName: "backend", DestinationOrgName: "some-org", DestinationSpaceName: "some-space", Protocol: "tcp", StartPort: 8080, EndPort: 8080},
+		}, nil, nil)
+
+		return FoundationTarget{Name: name, Actor: actor, NetworkingActor: networkingActor}
+	}
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeFoundationsRunner = new(v7fakes.FakeFoundationsRunner)
+
+		cmd = DiffSpaceCommand{
+			BaseCommand: BaseCommand{
+				UI:                testUI,
+				Config:            fakeConfig,
+				FoundationsRunner: fakeFoundationsRunner,
+			},
+			RequiredArgs:    flag.OrgSpace{Organization: "some-org", Space: "some-space"},
+			FoundationsFile: "foundations.yml",
+		}
+
+		actors = map[string]*v7fakes.FakeActor{}
+		targets = []FoundationTarget{
+			newTarget("dc1", 1024, "secret-1", "small"),
+			newTarget("dc2", 512, "secret-2", "large"),
+			newTarget("dc3", 1024, "secret-1", "small"),
+		}
+	})
+
+	JustBeforeEach(func() {
+		runOnFoundationTargets(fakeFoundationsRunner, targets...)
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("looks up the org and space given as arguments on every foundation", func() {
+		_, targetOrg, targetSpace, _ := fakeFoundationsRunner.RunOnFoundationsArgsForCall(0)
+		Expect(targetOrg).To(BeFalse())
+		Expect(targetSpace).To(BeFalse())
+
+		Expect(actors["dc2"].GetOrganizationByNameArgsForCall(0)).To(Equal("some-org"))
+		spaceName, orgGUID := actors["dc2"].GetSpaceByNameAndOrganizationArgsForCall(0)
+		Expect(spaceName).To(Equal("some-space"))
+		Expect(orgGUID).To(Equal("dc2-org-guid"))
+
+		spaceGUID, _ := actors["dc2"].GetAppSummariesForSpaceArgsForCall(0)
+		Expect(spaceGUID).To(Equal("dc2-space-guid"))
+	})
+
+	It("displays only the properties that differ and the foundation that drifted", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(testUI.Out).To(Say(`Comparing org some-org / space some-space across foundations in foundations.yml\.\.\.`))
+		Expect(testUI.Out).To(Say(`resource\s+property\s+dc1\s+dc2\s+dc3`))
+		Expect(testUI.Out).To(Say(`app some-app\s+env SECRET\s+hmac:\w+\s+hmac:\w+\s+hmac:\w+`))
+		Expect(testUI.Out).To(Say(`app some-app\s+web memory\s+1G\s+512M\s+1G`))
+		Expect(testUI.Out).To(Say(`service instance some-db\s+plan\s+postgres / small\s+postgres / large\s+postgres / small`))
+		Expect(testUI.Out).To(Say(`Found 3 differences\.`))
+		Expect(testUI.Out).To(Say(`Drifted from the other foundations: dc2`))
+
+		output := string(testUI.Out.(*Buffer).Contents())
+		Expect(output).ToNot(ContainSubstring("secret-1"))
+		Expect(output).ToNot(ContainSubstring("web instances"))
+		Expect(output).ToNot(ContainSubstring("security group"))
+		Expect(testUI.Err).To(Say("dc1: dc1-warning"))
+	})
+
+	When("the spaces are the same", func() {
+		BeforeEach(func() {
+			targets = []FoundationTarget{
+				newTarget("dc1", 1024, "secret-1", "small"),
+				newTarget("dc2", 1024, "secret-1", "small"),
+			}
+		})
+
+		It("reports that there is no drift", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("No drift found."))
+		})
+	})
+
+	When("a foundation has no network policy API", func() {
+		BeforeEach(func() {
+			targets[1].NetworkingActor = nil
+		})
+
+		It("warns that network policies were not compared", func() {
+			Expect(testUI.Err).To(Say("dc2: Network policies were not compared because the network policy API is not available."))
+		})
+	})
+
+	It("fingerprints the values of environment variables with a key that changes between runs", func() {
+		secretFingerprint := func() string {
+			matches := regexp.MustCompile(`env SECRET\s+(hmac:\w+)`).FindStringSubmatch(string(testUI.Out.(*Buffer).Contents()))
+			Expect(matches).To(HaveLen(2))
+			return matches[1]
+		}
+		firstFingerprint := secretFingerprint()
+
+		testUI.Out = NewBuffer()
+		runOnFoundationTargets(fakeFoundationsRunner, targets...)
+		Expect(cmd.Execute(nil)).To(Succeed())
+		Expect(secretFingerprint()).ToNot(Equal(firstFingerprint))
+	})
+
+	When("the --output flag is set to json", func() {
+		BeforeEach(func() {
+			cmd.Output = flag.OutputFormat{Format: flag.OutputJSON}
+		})
+
+		It("displays the report as JSON", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).ToNot(Say("Comparing"))
+
+			var report map[string]interface{}
+			Expect(json.Unmarshal(testUI.Out.(*Buffer).Contents(), &report)).To(Succeed())
+			Expect(report["org"]).To(Equal("some-org"))
+			Expect(report["drifted_foundations"]).To(Equal([]interface{}{"dc2"}))
+			Expect(report["drifts"]).To(ContainElement(map[string]interface{}{
+				"resource":            "app some-app",
+				"property":            "web memory",
+				"values":              map[string]interface{}{"dc1": "1G", "dc2": "512M", "dc3": "1G"},
+				"drifted_foundations": []interface{}{"dc2"},
+			}))
+		})
+	})
+
+	When("the --output flag is set to csv", func() {
+		BeforeEach(func() {
+			cmd.Output = flag.OutputFormat{Format: flag.OutputCSV}
+		})
+
+		It("displays a row per drift", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`resource,property,values,drifted_foundations\n`))
+			Expect(testUI.Out).To(Say(`app some-app,env SECRET,dc1=hmac:\w+; dc2=hmac:\w+; dc3=hmac:\w+,dc2\n`))
+			Expect(testUI.Out).To(Say(`app some-app,web memory,dc1=1G; dc2=512M; dc3=1G,dc2\n`))
+		})
+	})
+
+	When("the --jsonpath flag is set", func() {
+		BeforeEach(func() {
+			Expect(cmd.JSONPath.UnmarshalFlag("org")).To(Succeed())
+		})
+
+		It("displays the selected fields of the report", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(string(testUI.Out.(*Buffer).Contents())).To(Equal("some-org\n"))
+		})
+	})
+
+	When("a foundation fails", func() {
+		BeforeEach(func() {
+			actors["dc3"].GetSpaceByNameAndOrganizationReturns(resources.Space{}, nil, errors.New("space not found"))
+		})
+
+		It("compares the remaining foundations and fails", func() {
+			Expect(testUI.Out).To(Say(`resource\s+property\s+dc1\s+dc2\n`))
+			Expect(testUI.Err).To(Say("dc3: space not found"))
+			Expect(executeErr).To(MatchError(translatableerror.FoundationsFailedError{Names: []string{"dc3"}}))
+		})
+	})
+
+	When("the foundations file lists a single foundation", func() {
+		BeforeEach(func() {
+			targets = targets[:1]
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.InvalidFoundationsFileError{
+				Path:    "foundations.yml",
+				Message: "at least two foundations are needed to compare a space",
+			}))
+			Expect(fakeFoundationsRunner.ReadFoundationsArgsForCall(0)).To(Equal("foundations.yml"))
+			Expect(fakeFoundationsRunner.RunOnFoundationsCallCount()).To(Equal(0))
+		})
+	})
+})
@@ -0,0 +1,63 @@
+package v7
+
+import (
+	"sort"
+
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/configv3"
+)
+
+type EncryptTokensCommand struct {
+	UI     command.UI
+	Config command.Config
+
+	usage           interface{} `usage:"CF_NAME encrypt-tokens\n\n   Moves the access token, refresh token and client secret of every foundation from the config file into an encrypted token store.\n   The store is keyed by the passphrase in CF_TOKEN_STORE_PASSPHRASE or by the key file named in CF_TOKEN_STORE_KEY_FILE,\n   which must be set for every later command. Plugins cannot read the store, so they must be uninstalled first.\n\nEXAMPLES:\n   CF_TOKEN_STORE_KEY_FILE=~/.cf-token-key CF_NAME encrypt-tokens"`
+	relatedCommands interface{} `related_commands:"config, login, oauth-token"`
+}
+
+func (cmd *EncryptTokensCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+
+	return nil
+}
+
+func (cmd EncryptTokensCommand) Execute(args []string) error {
+	if cmd.Config.UsesEncryptedTokenStore() {
+		cmd.UI.DisplayText("Tokens are already kept in the encrypted token store.")
+		cmd.UI.DisplayOK()
+		return nil
+	}
+
+	if plugins := cmd.Config.Plugins(); len(plugins) > 0 {
+		var names []string
+		for _, plugin := range plugins {
+			names = append(names, plugin.Name)
+		}
+		sort.Strings(names)
+		return translatableerror.TokenStorePluginsInstalledError{Plugins: names}
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Moving tokens from {{.ConfigFile}} to the encrypted token store {{.TokenStore}}...", map[string]interface{}{
+		"ConfigFile": configv3.ConfigFilePath(),
+		"TokenStore": configv3.TokenStoreFilePath(),
+	})
+
+	err := cmd.Config.EnableEncryptedTokenStore()
+	if err != nil {
+		return err
+	}
+
+	err = cmd.Config.WriteConfig()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayText("TIP: Set {{.PassphraseEnvVar}} or {{.KeyFileEnvVar}} for every command to unlock the tokens.", map[string]interface{}{
+		"PassphraseEnvVar": configv3.TokenStorePassphraseEnvVar,
+		"KeyFileEnvVar":    configv3.TokenStoreKeyFileEnvVar,
+	})
+	return nil
+}
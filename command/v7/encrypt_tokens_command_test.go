@@ -0,0 +1,95 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("encrypt-tokens Command", func() {
+	var (
+		cmd        EncryptTokensCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+
+		cmd = EncryptTokensCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("moves the tokens into the encrypted token store and writes the config", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(testUI.Out).To(Say(`Moving tokens from .*config\.json to the encrypted token store .*tokens\.json\.enc\.\.\.`))
+		Expect(testUI.Out).To(Say("OK"))
+		Expect(testUI.Out).To(Say("TIP: Set CF_TOKEN_STORE_PASSPHRASE or CF_TOKEN_STORE_KEY_FILE for every command to unlock the tokens."))
+
+		Expect(fakeConfig.EnableEncryptedTokenStoreCallCount()).To(Equal(1))
+		Expect(fakeConfig.WriteConfigCallCount()).To(Equal(1))
+	})
+
+	When("no passphrase or key file is set", func() {
+		BeforeEach(func() {
+			fakeConfig.EnableEncryptedTokenStoreReturns(translatableerror.TokenStoreKeyNotSetError{})
+		})
+
+		It("returns the error without writing the config", func() {
+			Expect(executeErr).To(MatchError(translatableerror.TokenStoreKeyNotSetError{}))
+			Expect(fakeConfig.WriteConfigCallCount()).To(Equal(0))
+		})
+	})
+
+	When("plugins are installed", func() {
+		BeforeEach(func() {
+			fakeConfig.PluginsReturns([]configv3.Plugin{{Name: "some-plugin"}, {Name: "another-plugin"}})
+		})
+
+		It("returns an error without moving the tokens", func() {
+			Expect(executeErr).To(MatchError(translatableerror.TokenStorePluginsInstalledError{
+				Plugins: []string{"another-plugin", "some-plugin"},
+			}))
+			Expect(fakeConfig.EnableEncryptedTokenStoreCallCount()).To(Equal(0))
+			Expect(fakeConfig.WriteConfigCallCount()).To(Equal(0))
+		})
+	})
+
+	When("writing the config fails", func() {
+		BeforeEach(func() {
+			fakeConfig.WriteConfigReturns(errors.New("disk full"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("disk full"))
+		})
+	})
+
+	When("the tokens are already encrypted", func() {
+		BeforeEach(func() {
+			fakeConfig.UsesEncryptedTokenStoreReturns(true)
+		})
+
+		It("does nothing", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Tokens are already kept in the encrypted token store."))
+			Expect(fakeConfig.EnableEncryptedTokenStoreCallCount()).To(Equal(0))
+		})
+	})
+})
@@ -0,0 +1,244 @@
+package v7
+
+import (
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/util/csvexport"
+)
+
+// InventoryOrgRow is a row of orgs.csv written by 'cf export-inventory'.
+type InventoryOrgRow struct {
+	Name   string `json:"name"`
+	GUID   string `json:"guid"`
+	Labels string `json:"labels"`
+}
+
+// InventorySpaceRow is a row of spaces.csv written by 'cf export-inventory'.
+type InventorySpaceRow struct {
+	Org    string `json:"org"`
+	Name   string `json:"name"`
+	GUID   string `json:"guid"`
+	Labels string `json:"labels"`
+}
+
+// InventoryAppRow is a row of apps.csv written by 'cf export-inventory'.
+type InventoryAppRow struct {
+	Org       string `json:"org"`
+	Space     string `json:"space"`
+	Name      string `json:"name"`
+	GUID      string `json:"guid"`
+	State     string `json:"state"`
+	Stack     string `json:"stack"`
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at"`
+	Labels    string `json:"labels"`
+}
+
+// InventoryRouteRow is a row of routes.csv written by 'cf export-inventory'.
+// Port is 0 for HTTP routes.
+type InventoryRouteRow struct {
+	Org       string `json:"org"`
+	Space     string `json:"space"`
+	URL       string `json:"url"`
+	GUID      string `json:"guid"`
+	Host      string `json:"host"`
+	Path      string `json:"path"`
+	Port      int    `json:"port"`
+	Protocol  string `json:"protocol"`
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+// InventoryServiceInstanceRow is a row of service_instances.csv written by
+// 'cf export-inventory'.
+type InventoryServiceInstanceRow struct {
+	Org       string `json:"org"`
+	Space     string `json:"space"`
+	Name      string `json:"name"`
+	GUID      string `json:"guid"`
+	Type      string `json:"type"`
+	Offering  string `json:"offering"`
+	Plan      string `json:"plan"`
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+// InventoryUserRow is a row of users.csv written by 'cf export-inventory'.
+// There is one row per role, Space is empty for org roles.
+type InventoryUserRow struct {
+	Username string `json:"username"`
+	Origin   string `json:"origin"`
+	UserGUID string `json:"user_guid"`
+	Role     string `json:"role"`
+	Org      string `json:"org"`
+	Space    string `json:"space"`
+}
+
+type ExportInventoryCommand struct {
+	BaseCommand
+
+	RequiredArgs    flag.ExportInventoryArgs `positional-args:"yes"`
+	usage           interface{}              `usage:"CF_NAME export-inventory DIRECTORY\n\n   Writes orgs.csv, spaces.csv, apps.csv, routes.csv, service_instances.csv and users.csv to DIRECTORY, creating it if needed.\n\nEXAMPLES:\n   CF_NAME export-inventory ./inventory"`
+	relatedCommands interface{}              `related_commands:"apps, orgs, routes, services, spaces"`
+}
+
+func (cmd ExportInventoryCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	directory := string(cmd.RequiredArgs.Directory)
+	cmd.UI.DisplayTextWithFlavor("Exporting inventory to {{.Directory}} as {{.CurrentUser}}...", map[string]interface{}{
+		"Directory":   directory,
+		"CurrentUser": user.Name,
+	})
+
+	inventory, warnings, err := cmd.Actor.GetInventory()
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(directory, 0755)
+	if err != nil {
+		return err
+	}
+
+	files := []struct {
+		name string
+		rows interface{}
+	}{
+		{"orgs.csv", inventoryOrgRows(inventory)},
+		{"spaces.csv", inventorySpaceRows(inventory)},
+		{"apps.csv", inventoryAppRows(inventory)},
+		{"routes.csv", inventoryRouteRows(inventory)},
+		{"service_instances.csv", inventoryServiceInstanceRows(inventory)},
+		{"users.csv", inventoryUserRows(inventory)},
+	}
+	for _, file := range files {
+		err = writeCSVFile(filepath.Join(directory, file.name), file.rows)
+		if err != nil {
+			return err
+		}
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+func writeCSVFile(path string, rows interface{}) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	err = csvexport.Write(file, rows)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+func inventoryOrgRows(inventory v7action.Inventory) []InventoryOrgRow {
+	rows := []InventoryOrgRow{}
+	for _, org := range inventory.Organizations {
+		rows = append(rows, InventoryOrgRow{
+			Name:   org.Name,
+			GUID:   org.GUID,
+			Labels: labelsList(org.Metadata),
+		})
+	}
+	return rows
+}
+
+func inventorySpaceRows(inventory v7action.Inventory) []InventorySpaceRow {
+	rows := []InventorySpaceRow{}
+	for _, space := range inventory.Spaces {
+		rows = append(rows, InventorySpaceRow{
+			Org:    space.OrganizationName,
+			Name:   space.Name,
+			GUID:   space.GUID,
+			Labels: labelsList(space.Metadata),
+		})
+	}
+	return rows
+}
+
+func inventoryAppRows(inventory v7action.Inventory) []InventoryAppRow {
+	rows := []InventoryAppRow{}
+	for _, app := range inventory.Applications {
+		rows = append(rows, InventoryAppRow{
+			Org:       app.OrganizationName,
+			Space:     app.SpaceName,
+			Name:      app.Name,
+			GUID:      app.GUID,
+			State:     string(app.State),
+			Stack:     app.StackName,
+			CreatedAt: app.CreatedAt,
+			UpdatedAt: app.UpdatedAt,
+			Labels:    labelsList(app.Metadata),
+		})
+	}
+	return rows
+}
+
+func inventoryRouteRows(inventory v7action.Inventory) []InventoryRouteRow {
+	rows := []InventoryRouteRow{}
+	for _, route := range inventory.Routes {
+		rows = append(rows, InventoryRouteRow{
+			Org:       route.OrganizationName,
+			Space:     route.SpaceName,
+			URL:       route.URL,
+			GUID:      route.GUID,
+			Host:      route.Host,
+			Path:      route.Path,
+			Port:      route.Port,
+			Protocol:  route.Protocol,
+			CreatedAt: route.CreatedAt,
+			UpdatedAt: route.UpdatedAt,
+		})
+	}
+	return rows
+}
+
+func inventoryServiceInstanceRows(inventory v7action.Inventory) []InventoryServiceInstanceRow {
+	rows := []InventoryServiceInstanceRow{}
+	for _, instance := range inventory.ServiceInstances {
+		rows = append(rows, InventoryServiceInstanceRow{
+			Org:       instance.OrganizationName,
+			Space:     instance.SpaceName,
+			Name:      instance.Name,
+			GUID:      instance.GUID,
+			Type:      string(instance.Type),
+			Offering:  instance.ServiceOfferingName,
+			Plan:      instance.ServicePlanName,
+			CreatedAt: instance.CreatedAt,
+			UpdatedAt: instance.UpdatedAt,
+		})
+	}
+	return rows
+}
+
+func inventoryUserRows(inventory v7action.Inventory) []InventoryUserRow {
+	rows := []InventoryUserRow{}
+	for _, role := range inventory.Roles {
+		rows = append(rows, InventoryUserRow{
+			Username: role.Username,
+			Origin:   role.Origin,
+			UserGUID: role.UserGUID,
+			Role:     string(role.Type),
+			Org:      role.OrganizationName,
+			Space:    role.SpaceName,
+		})
+	}
+	return rows
+}
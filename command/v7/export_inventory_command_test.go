@@ -0,0 +1,166 @@
+package v7_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("export-inventory Command", func() {
+	var (
+		cmd             ExportInventoryCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		directory       string
+		tempDir         string
+		executeErr      error
+	)
+
+	readCSV := func(name string) string {
+		contents, err := os.ReadFile(filepath.Join(directory, name))
+		Expect(err).NotTo(HaveOccurred())
+		return string(contents)
+	}
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		var err error
+		tempDir, err = os.MkdirTemp("", "export-inventory")
+		Expect(err).NotTo(HaveOccurred())
+		directory = filepath.Join(tempDir, "inventory")
+
+		cmd = ExportInventoryCommand{
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				SharedActor: fakeSharedActor,
+				Actor:       fakeActor,
+			},
+			RequiredArgs: flag.ExportInventoryArgs{Directory: flag.Path(directory)},
+		}
+
+		fakeConfig.BinaryNameReturns("faceman")
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+		fakeActor.GetInventoryReturns(v7action.Inventory{
+			Organizations: []resources.Organization{{
+				GUID:     "org-guid",
+				Name:     "some-org",
+				Metadata: &resources.Metadata{Labels: map[string]types.NullString{"env": types.NewNullString("prod")}},
+			}},
+			Spaces: []v7action.InventorySpace{
+				{Space: resources.Space{GUID: "space-guid", Name: "some-space"}, OrganizationName: "some-org"},
+			},
+			Applications: []v7action.InventoryApplication{{
+				Application:      resources.Application{GUID: "app-guid", Name: "some-app", State: constant.ApplicationStarted, StackName: "cflinuxfs4"},
+				OrganizationName: "some-org",
+				SpaceName:        "some-space",
+			}},
+			Routes: []v7action.InventoryRoute{{
+				Route:            resources.Route{GUID: "route-guid", URL: "some-host.example.com/path", Host: "some-host", Path: "/path", Protocol: "http"},
+				OrganizationName: "some-org",
+				SpaceName:        "some-space",
+			}},
+			ServiceInstances: []v7action.InventoryServiceInstance{{
+				ServiceInstance:     resources.ServiceInstance{GUID: "instance-guid", Name: "some-db", Type: resources.ManagedServiceInstance},
+				OrganizationName:    "some-org",
+				SpaceName:           "some-space",
+				ServiceOfferingName: "postgres",
+				ServicePlanName:     "small",
+			}},
+			Roles: []v7action.InventoryRole{
+				{
+					Role:             resources.Role{Type: constant.OrgManagerRole, UserGUID: "user-guid", Username: "admin", Origin: "uaa"},
+					OrganizationName: "some-org",
+				},
+				{
+					Role:             resources.Role{Type: constant.SpaceDeveloperRole, UserGUID: "user-guid", Username: "admin", Origin: "uaa"},
+					OrganizationName: "some-org",
+					SpaceName:        "some-space",
+				},
+			},
+		}, v7action.Warnings{"inventory-warning"}, nil)
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tempDir)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: "faceman"})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: "faceman"}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	It("writes one CSV file per resource type", func() {
+		Expect(executeErr).NotTo(HaveOccurred())
+
+		Expect(testUI.Out).To(Say(`Exporting inventory to .*inventory as some-user\.\.\.`))
+		Expect(testUI.Out).To(Say("OK"))
+		Expect(testUI.Err).To(Say("inventory-warning"))
+
+		Expect(readCSV("orgs.csv")).To(Equal("name,guid,labels\nsome-org,org-guid,env=prod\n"))
+		Expect(readCSV("spaces.csv")).To(Equal("org,name,guid,labels\nsome-org,some-space,space-guid,\n"))
+		Expect(readCSV("apps.csv")).To(Equal(
+			"org,space,name,guid,state,stack,created_at,updated_at,labels\n" +
+				"some-org,some-space,some-app,app-guid,STARTED,cflinuxfs4,,,\n",
+		))
+		Expect(readCSV("routes.csv")).To(Equal(
+			"org,space,url,guid,host,path,port,protocol,created_at,updated_at\n" +
+				"some-org,some-space,some-host.example.com/path,route-guid,some-host,/path,0,http,,\n",
+		))
+		Expect(readCSV("service_instances.csv")).To(Equal(
+			"org,space,name,guid,type,offering,plan,created_at,updated_at\n" +
+				"some-org,some-space,some-db,instance-guid,managed,postgres,small,,\n",
+		))
+		Expect(readCSV("users.csv")).To(Equal(
+			"username,origin,user_guid,role,org,space\n" +
+				"admin,uaa,user-guid,organization_manager,some-org,\n" +
+				"admin,uaa,user-guid,space_developer,some-org,some-space\n",
+		))
+	})
+
+	When("getting the inventory fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetInventoryReturns(v7action.Inventory{}, v7action.Warnings{"inventory-warning"}, errors.New("inventory-error"))
+		})
+
+		It("returns the error and does not write any files", func() {
+			Expect(executeErr).To(MatchError("inventory-error"))
+			Expect(testUI.Err).To(Say("inventory-warning"))
+			Expect(directory).NotTo(BeADirectory())
+		})
+	})
+})
@@ -0,0 +1,52 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+type FoundationsCommand struct {
+	UI     command.UI
+	Config command.Config
+
+	usage           interface{} `usage:"CF_NAME foundations"`
+	relatedCommands interface{} `related_commands:"add-foundation, remove-foundation, switch-foundation"`
+}
+
+func (cmd *FoundationsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+
+	return nil
+}
+
+func (cmd FoundationsCommand) Execute(args []string) error {
+	cmd.UI.DisplayText("Getting foundations...")
+	cmd.UI.DisplayNewline()
+
+	foundations := cmd.Config.Foundations()
+	if len(foundations) == 0 {
+		cmd.UI.DisplayText("No foundations found.")
+		return nil
+	}
+
+	table := [][]string{
+		{"", cmd.UI.TranslateText("name"), cmd.UI.TranslateText("api endpoint"), cmd.UI.TranslateText("org"), cmd.UI.TranslateText("space")},
+	}
+	for _, foundation := range foundations {
+		var active string
+		if foundation.Name == cmd.Config.ActiveFoundation() {
+			active = "*"
+		}
+		table = append(table, []string{
+			active,
+			foundation.Name,
+			foundation.Target,
+			foundation.TargetedOrganization.Name,
+			foundation.TargetedSpace.Name,
+		})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+	return nil
+}
@@ -0,0 +1,67 @@
+package v7_test
+
+import (
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("foundations command", func() {
+	var (
+		cmd        FoundationsCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		cmd = FoundationsCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("there are no foundations", func() {
+		It("displays that no foundations were found", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Getting foundations..."))
+			Expect(testUI.Out).To(Say("No foundations found."))
+		})
+	})
+
+	When("there are foundations", func() {
+		BeforeEach(func() {
+			fakeConfig.FoundationsReturns([]configv3.Foundation{
+				{
+					Name:                 "dc1",
+					Target:               "https://api.dc1.com",
+					TargetedOrganization: configv3.Organization{Name: "org-1"},
+					TargetedSpace:        configv3.Space{Name: "space-1"},
+				},
+				{
+					Name:   "dc2",
+					Target: "https://api.dc2.com",
+				},
+			})
+			fakeConfig.ActiveFoundationReturns("dc2")
+		})
+
+		It("displays the foundations and marks the active one", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Getting foundations..."))
+			Expect(testUI.Out).To(Say(`name\s+api endpoint\s+org\s+space`))
+			Expect(testUI.Out).To(Say(`dc1\s+https://api.dc1.com\s+org-1\s+space-1`))
+			Expect(testUI.Out).To(Say(`\*\s+dc2\s+https://api.dc2.com`))
+		})
+	})
+})
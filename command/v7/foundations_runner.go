@@ -0,0 +1,289 @@
+package v7
+
+import (
+	"fmt"
+	"os"
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/cfnetworkingaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/uaa/constant"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/clock"
+)
+
+// FoundationTarget is one foundation from a foundations file that a command
+// runs against. The actor is logged in and, when requested, the org and space
+// named in the file have been looked up and targeted in the foundation's
+// in-memory config. NetworkingActor is nil when the foundation has no network
+// policy endpoint.
+type FoundationTarget struct {
+	Name            string
+	Actor           Actor
+	NetworkingActor NetworkPoliciesActor
+	Config          command.Config
+	Organization    resources.Organization
+	Space           resources.Space
+	VarsFiles       []string
+}
+
+// FoundationResult holds the warnings and error from running a command
+// against one foundation. Skipped is set when the foundation was never run
+// because an earlier one failed.
+type FoundationResult struct {
+	Name     string
+	Warnings v7action.Warnings
+	Err      error
+	Skipped  bool
+}
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . FoundationsRunner
+
+type FoundationsRunner interface {
+	ReadFoundations(foundationsFile string) ([]configv3.FoundationsFileEntry, error)
+	RunOnFoundations(foundationsFile string, targetOrg bool, targetSpace bool, run func(FoundationTarget) (v7action.Warnings, error)) ([]FoundationResult, error)
+	RunOnFoundationsSequentially(foundationsFile string, targetOrg bool, targetSpace bool, run func(FoundationTarget) (v7action.Warnings, error)) ([]FoundationResult, error)
+}
+
+// ActualFoundationsRunner targets, logs in to and runs a function against
+// every foundation in a foundations file. It never changes the targeted
+// foundation in the config.
+type ActualFoundationsRunner struct {
+	Config command.Config
+	UI     command.UI
+}
+
+// ReadFoundations returns the foundations listed in the file without
+// connecting to any of them.
+func (r ActualFoundationsRunner) ReadFoundations(foundationsFile string) ([]configv3.FoundationsFileEntry, error) {
+	return configv3.ReadFoundationsFile(foundationsFile)
+}
+
+// RunOnFoundations returns one result per foundation in the order of the
+// file. It only returns an error if the file cannot be read.
+func (r ActualFoundationsRunner) RunOnFoundations(foundationsFile string, targetOrg bool, targetSpace bool, run func(FoundationTarget) (v7action.Warnings, error)) ([]FoundationResult, error) {
+	entries, err := configv3.ReadFoundationsFile(foundationsFile)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]FoundationResult, len(entries))
+	var wg sync.WaitGroup
+	for i, entry := range entries {
+		wg.Add(1)
+		go func(i int, entry configv3.FoundationsFileEntry) {
+			defer wg.Done()
+			warnings, err := r.runOnFoundation(foundationsFile, entry, targetOrg, targetSpace, run)
+			results[i] = FoundationResult{Name: entry.Name, Warnings: warnings, Err: err}
+		}(i, entry)
+	}
+	wg.Wait()
+
+	return results, nil
+}
+
+// RunOnFoundationsSequentially runs against one foundation at a time in the
+// order of the file and stops at the first foundation that fails. The
+// remaining foundations are returned as skipped.
+func (r ActualFoundationsRunner) RunOnFoundationsSequentially(foundationsFile string, targetOrg bool, targetSpace bool, run func(FoundationTarget) (v7action.Warnings, error)) ([]FoundationResult, error) {
+	entries, err := configv3.ReadFoundationsFile(foundationsFile)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]FoundationResult, len(entries))
+	failed := false
+	for i, entry := range entries {
+		if failed {
+			results[i] = FoundationResult{Name: entry.Name, Skipped: true}
+			continue
+		}
+		warnings, err := r.runOnFoundation(foundationsFile, entry, targetOrg, targetSpace, run)
+		results[i] = FoundationResult{Name: entry.Name, Warnings: warnings, Err: err}
+		failed = err != nil
+	}
+
+	return results, nil
+}
+
+func (r ActualFoundationsRunner) runOnFoundation(foundationsFile string, entry configv3.FoundationsFileEntry, targetOrg bool, targetSpace bool, run func(FoundationTarget) (v7action.Warnings, error)) (v7action.Warnings, error) {
+	if (targetOrg || targetSpace) && entry.Org == "" {
+		return nil, actionerror.InvalidFoundationsFileError{
+			Path:    foundationsFile,
+			Message: fmt.Sprintf("foundation '%s' needs an org for this command", entry.Name),
+		}
+	}
+	if targetSpace && entry.Space == "" {
+		return nil, actionerror.InvalidFoundationsFileError{
+			Path:    foundationsFile,
+			Message: fmt.Sprintf("foundation '%s' needs a space for this command", entry.Name),
+		}
+	}
+
+	credentials, grantType, err := foundationCredentials(entry)
+	if err != nil {
+		return nil, err
+	}
+
+	certs, err := shared.ReadTLSCertificates(entry.CACert, entry.ClientCert, entry.ClientKey)
+	if err != nil {
+		return nil, err
+	}
+
+	config := r.Config.ForFoundation(configv3.Foundation{Name: entry.Name})
+
+	var allWarnings v7action.Warnings
+	ccClient, err := shared.NewWrappedCloudControllerClient(config, r.UI)
+	if err != nil {
+		return nil, err
+	}
+	targetActor := v7action.NewActor(ccClient, config, nil, nil, nil, clock.NewClock())
+	warnings, err := targetActor.SetTarget(v7action.TargetSettings{
+		URL:               entry.API,
+		SkipSSLValidation: entry.SkipSSLValidation,
+		TLSCertificates:   certs,
+		DialTimeout:       config.DialTimeout(),
+	})
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	ccClient, uaaClient, routingClient, err := shared.GetNewClientsAndConnectToCF(config, r.UI, "")
+	if err != nil {
+		return allWarnings, err
+	}
+	actor := v7action.NewActor(ccClient, config, sharedaction.NewActor(config), uaaClient, routingClient, clock.NewClock())
+
+	err = actor.Authenticate(credentials, entry.Origin, grantType)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	target := FoundationTarget{Name: entry.Name, Actor: actor, Config: config, VarsFiles: entry.VarsFiles}
+	if networkingClient, networkingErr := shared.NewNetworkingClient(config.NetworkPolicyV1Endpoint(), config, uaaClient, r.UI); networkingErr == nil {
+		target.NetworkingActor = cfnetworkingaction.NewActor(networkingClient, ccClient)
+	}
+	if entry.Org != "" && (targetOrg || targetSpace) {
+		target.Organization, warnings, err = actor.GetOrganizationByName(entry.Org)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+		config.SetOrganizationInformation(target.Organization.GUID, target.Organization.Name)
+	}
+	if targetSpace {
+		target.Space, warnings, err = actor.GetSpaceByNameAndOrganization(entry.Space, target.Organization.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+		config.V7SetSpaceInformation(target.Space.GUID, target.Space.Name)
+	}
+
+	warnings, err = run(target)
+	allWarnings = append(allWarnings, warnings...)
+	return allWarnings, err
+}
+
+func foundationCredentials(entry configv3.FoundationsFileEntry) (map[string]string, constant.GrantType, error) {
+	getenv := func(name string) (string, error) {
+		value := os.Getenv(name)
+		if value == "" {
+			return "", translatableerror.FoundationCredentialsNotSetError{Foundation: entry.Name, EnvVar: name}
+		}
+		return value, nil
+	}
+
+	if entry.ClientIDEnv != "" {
+		clientID, err := getenv(entry.ClientIDEnv)
+		if err != nil {
+			return nil, "", err
+		}
+		clientSecret, err := getenv(entry.ClientSecretEnv)
+		if err != nil {
+			return nil, "", err
+		}
+		return map[string]string{
+			"client_id":     clientID,
+			"client_secret": clientSecret,
+		}, constant.GrantTypeClientCredentials, nil
+	}
+
+	username, err := getenv(entry.UsernameEnv)
+	if err != nil {
+		return nil, "", err
+	}
+	password, err := getenv(entry.PasswordEnv)
+	if err != nil {
+		return nil, "", err
+	}
+	return map[string]string{
+		"username": username,
+		"password": password,
+	}, constant.GrantTypePassword, nil
+}
+
+// displayFoundationResults shows the warnings and errors of every foundation,
+// prefixed with the foundation name, and returns a FoundationsFailedError if
+// any foundation failed.
+func displayFoundationResults(ui command.UI, results []FoundationResult) error {
+	var failed []string
+	for _, result := range results {
+		for _, warning := range result.Warnings {
+			ui.DisplayWarning("{{.Foundation}}: {{.Warning}}", map[string]interface{}{
+				"Foundation": result.Name,
+				"Warning":    warning,
+			})
+		}
+	}
+
+	for _, result := range results {
+		if result.Err == nil {
+			continue
+		}
+		failed = append(failed, result.Name)
+		ui.DisplayWarning("{{.Foundation}}: {{.Error}}", map[string]interface{}{
+			"Foundation": result.Name,
+			"Error":      translatedErrorMessage(ui, result.Err),
+		})
+	}
+
+	if len(failed) > 0 {
+		return translatableerror.FoundationsFailedError{Names: failed}
+	}
+	return nil
+}
+
+// withFoundationColumn prepends a foundation column to a table. foundations
+// holds the foundation of every row after the header.
+func withFoundationColumn(ui command.UI, table [][]string, foundations []string) [][]string {
+	withColumn := [][]string{append([]string{ui.TranslateText("foundation")}, table[0]...)}
+	for i, row := range table[1:] {
+		withColumn = append(withColumn, append([]string{foundations[i]}, row...))
+	}
+	return withColumn
+}
+
+func translatedErrorMessage(ui command.UI, err error) string {
+	err = translatableerror.ConvertToTranslatableError(err)
+	translatableErr, ok := err.(translatableerror.TranslatableError)
+	if !ok {
+		return err.Error()
+	}
+
+	return translatableErr.Translate(func(template string, values ...interface{}) string {
+		if len(values) > 0 {
+			if templateValues, ok := values[0].(map[string]interface{}); ok {
+				return ui.TranslateText(template, templateValues)
+			}
+		}
+		return ui.TranslateText(template)
+	})
+}
@@ -17,8 +17,11 @@ import (
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
 	"code.cloudfoundry.org/cli/cf/errors"
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/util"
+	"code.cloudfoundry.org/cli/util/configv3"
 )
 
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . ActorReloader
@@ -46,21 +49,27 @@ type LoginCommand struct {
 	Config        command.Config
 	ActorReloader ActorReloader
 
-	APIEndpoint       string      `short:"a" description:"API endpoint (e.g. https://api.example.com)"`
-	Organization      string      `short:"o" description:"Org"`
-	Password          string      `short:"p" description:"Password"`
-	Space             string      `short:"s" description:"Space"`
-	SkipSSLValidation bool        `long:"skip-ssl-validation" description:"Skip verification of the API endpoint. Not recommended!"`
-	SSO               bool        `long:"sso" description:"Prompt for a one-time passcode to login"`
-	SSOPasscode       string      `long:"sso-passcode" description:"One-time passcode"`
-	Username          string      `short:"u" description:"Username"`
-	Origin            string      `long:"origin" description:"Indicates the identity provider to be used for login"`
-	usage             interface{} `usage:"CF_NAME login [-a API_URL] [-u USERNAME] [-p PASSWORD] [-o ORG] [-s SPACE] [--sso | --sso-passcode PASSCODE] [--origin ORIGIN]\n\nWARNING:\n   Providing your password as a command line option is highly discouraged\n   Your password may be visible to others and may be recorded in your shell history\n\nEXAMPLES:\n   CF_NAME login (omit username and password to login interactively -- CF_NAME will prompt for both)\n   CF_NAME login -u name@example.com -p pa55woRD (specify username and password as arguments)\n   CF_NAME login -u name@example.com -p \"my password\" (use quotes for passwords with a space)\n   CF_NAME login -u name@example.com -p \"\\\"password\\\"\" (escape quotes if used in password)\n   CF_NAME login --sso (CF_NAME will provide a url to obtain a one-time passcode to login)\n   CF_NAME login --origin ldap"`
-	relatedCommands   interface{} `related_commands:"api, auth, target"`
+	APIEndpoint       string                      `short:"a" description:"API endpoint (e.g. https://api.example.com)"`
+	CACert            flag.PathWithExistenceCheck `long:"ca-cert" description:"Path to a PEM encoded CA certificate to trust for the API endpoint"`
+	ClientCert        flag.PathWithExistenceCheck `long:"client-cert" description:"Path to a PEM encoded client certificate to present to the API endpoint"`
+	ClientKey         flag.PathWithExistenceCheck `long:"client-key" description:"Path to the PEM encoded private key for the client certificate. Only the path is saved, so the file must stay in place"`
+	Organization      string                      `short:"o" description:"Org"`
+	Password          string                      `short:"p" description:"Password"`
+	Space             string                      `short:"s" description:"Space"`
+	SkipSSLValidation bool                        `long:"skip-ssl-validation" description:"Skip verification of the API endpoint. Not recommended!"`
+	SSO               bool                        `long:"sso" description:"Prompt for a one-time passcode to login"`
+	SSOPasscode       string                      `long:"sso-passcode" description:"One-time passcode"`
+	Username          string                      `short:"u" description:"Username"`
+	Origin            string                      `long:"origin" description:"Indicates the identity provider to be used for login"`
+	usage             interface{}                 `usage:"CF_NAME login [-a API_URL] [-u USERNAME] [-p PASSWORD] [-o ORG] [-s SPACE] [--sso | --sso-passcode PASSCODE] [--origin ORIGIN]\n\nWARNING:\n   Providing your password as a command line option is highly discouraged\n   Your password may be visible to others and may be recorded in your shell history\n\nEXAMPLES:\n   CF_NAME login (omit username and password to login interactively -- CF_NAME will prompt for both)\n   CF_NAME login -u name@example.com -p pa55woRD (specify username and password as arguments)\n   CF_NAME login -u name@example.com -p \"my password\" (use quotes for passwords with a space)\n   CF_NAME login -u name@example.com -p \"\\\"password\\\"\" (escape quotes if used in password)\n   CF_NAME login --sso (CF_NAME will provide a url to obtain a one-time passcode to login)\n   CF_NAME login --origin ldap"`
+	relatedCommands   interface{}                 `related_commands:"api, auth, target"`
 }
 
 func (cmd *LoginCommand) Setup(config command.Config, ui command.UI) error {
-	ccClient := shared.NewWrappedCloudControllerClient(config, ui)
+	ccClient, err := shared.NewWrappedCloudControllerClient(config, ui)
+	if err != nil {
+		return err
+	}
 	cmd.Actor = v7action.NewActor(ccClient, config, nil, nil, nil, clock.NewClock())
 	cmd.ActorReloader = ActualActorReloader{}
 
@@ -213,11 +222,24 @@ func (cmd *LoginCommand) determineAPIEndpoint() (v7action.TargetSettings, error)
 	endpoint := cmd.APIEndpoint
 	skipSSLValidation := cmd.SkipSSLValidation
 
+	certs, err := shared.ReadTLSCertificates(string(cmd.CACert), string(cmd.ClientCert), string(cmd.ClientKey))
+	if err != nil {
+		return v7action.TargetSettings{}, err
+	}
+
 	configTarget := cmd.Config.Target()
 
+	var failoverURLs []string
 	if endpoint == "" && configTarget != "" {
 		endpoint = configTarget
+		failoverURLs = cmd.Config.TargetFailoverURLs()
 		skipSSLValidation = cmd.Config.SkipSSLValidation() || cmd.SkipSSLValidation
+		if certs == (util.TLSCertificates{}) {
+			certs, err = cmd.Config.TLSCertificates()
+			if err != nil {
+				return v7action.TargetSettings{}, translatableerror.InvalidTLSCertificatesError{Message: err.Error()}
+			}
+		}
 	}
 
 	if len(endpoint) > 0 {
@@ -241,7 +263,18 @@ func (cmd *LoginCommand) determineAPIEndpoint() (v7action.TargetSettings, error)
 		parsedURL.Scheme = "https"
 	}
 
-	return v7action.TargetSettings{URL: parsedURL.String(), SkipSSLValidation: skipSSLValidation}, nil
+	// Logging in to the targeted API again keeps its failover endpoints.
+	if failoverURLs == nil && configTarget != "" && configv3.IsSameAPI(parsedURL.String(), configTarget) {
+		failoverURLs = cmd.Config.TargetFailoverURLs()
+	}
+
+	return v7action.TargetSettings{
+		URL:                parsedURL.String(),
+		FailoverURLs:       failoverURLs,
+		SkipSSLValidation:  skipSSLValidation,
+		TLSCertificates:    certs,
+		CircuitBreakerFile: cmd.Config.CircuitBreakerFile(),
+	}, nil
 }
 
 func (cmd *LoginCommand) targetAPI(settings v7action.TargetSettings) error {
@@ -407,6 +440,10 @@ func (cmd *LoginCommand) showStatus() {
 		},
 	}
 
+	if foundation := cmd.Config.ActiveFoundation(); foundation != "" {
+		tableContent = append([][]string{{cmd.UI.TranslateText("foundation:"), foundation}}, tableContent...)
+	}
+
 	user, err := cmd.Actor.GetCurrentUser()
 	if user.Name == "" || err != nil {
 		cmd.UI.DisplayKeyValueTable("", tableContent, 3)
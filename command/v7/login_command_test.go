@@ -16,6 +16,7 @@ import (
 	. "code.cloudfoundry.org/cli/command/v7"
 	"code.cloudfoundry.org/cli/command/v7/v7fakes"
 	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/util"
 	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/ui"
 	. "github.com/onsi/ginkgo"
@@ -215,6 +216,20 @@ var _ = Describe("login Command", func() {
 				})
 			})
 
+			When("the user specifies --client-key without --client-cert", func() {
+				BeforeEach(func() {
+					cmd.ClientKey = "some-client-key"
+				})
+
+				It("returns a RequiredFlagsError without targeting the API", func() {
+					Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{
+						Arg1: "--client-cert",
+						Arg2: "--client-key",
+					}))
+					Expect(fakeActor.SetTargetCallCount()).To(Equal(0))
+				})
+			})
+
 			When("targeting the API fails", func() {
 				BeforeEach(func() {
 					fakeActor.SetTargetReturns(
@@ -228,6 +243,36 @@ var _ = Describe("login Command", func() {
 					Expect(testUI.Err).To(Say("some-warning-2"))
 				})
 			})
+
+			When("the config has failover endpoints", func() {
+				BeforeEach(func() {
+					fakeConfig.TargetFailoverURLsReturns([]string{"https://api-b.example.com"})
+				})
+
+				When("the endpoint is the targeted API", func() {
+					BeforeEach(func() {
+						fakeConfig.TargetReturns("https://api.example.com/")
+					})
+
+					It("keeps the failover endpoints", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						targetSettings := fakeActor.SetTargetArgsForCall(0)
+						Expect(targetSettings.FailoverURLs).To(Equal([]string{"https://api-b.example.com"}))
+					})
+				})
+
+				When("the endpoint is another API", func() {
+					BeforeEach(func() {
+						fakeConfig.TargetReturns("https://api.other.com")
+					})
+
+					It("does not use the failover endpoints", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						targetSettings := fakeActor.SetTargetArgsForCall(0)
+						Expect(targetSettings.FailoverURLs).To(BeEmpty())
+					})
+				})
+			})
 		})
 
 		When("user does not provide the api endpoint using the -a flag", func() {
@@ -267,6 +312,45 @@ var _ = Describe("login Command", func() {
 					Expect(testUI.Err).ToNot(Say("is less than the minimum supported API version"))
 				})
 
+				When("the config has failover endpoints for the API endpoint", func() {
+					BeforeEach(func() {
+						fakeConfig.TargetFailoverURLsReturns([]string{"https://api-b.fake.com"})
+						fakeConfig.CircuitBreakerFileReturns("some-circuit-breaker-file")
+					})
+
+					It("sets the target with the failover endpoints from the config", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						targetSettings := fakeActor.SetTargetArgsForCall(0)
+						Expect(targetSettings.FailoverURLs).To(Equal([]string{"https://api-b.fake.com"}))
+						Expect(targetSettings.CircuitBreakerFile).To(Equal("some-circuit-breaker-file"))
+					})
+				})
+
+				When("the config has TLS certificates for the API endpoint", func() {
+					BeforeEach(func() {
+						fakeConfig.TLSCertificatesReturns(util.TLSCertificates{CACert: "some-ca-cert"}, nil)
+					})
+
+					It("sets the target with the certificates from the config", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						targetSettings := fakeActor.SetTargetArgsForCall(0)
+						Expect(targetSettings.TLSCertificates).To(Equal(util.TLSCertificates{CACert: "some-ca-cert"}))
+					})
+				})
+
+				When("the client key file saved for the API endpoint cannot be read", func() {
+					BeforeEach(func() {
+						fakeConfig.TLSCertificatesReturns(util.TLSCertificates{}, errors.New("client key file some-key-file could not be read"))
+					})
+
+					It("returns an invalid TLS certificates error", func() {
+						Expect(executeErr).To(MatchError(translatableerror.InvalidTLSCertificatesError{
+							Message: "client key file some-key-file could not be read",
+						}))
+						Expect(fakeActor.SetTargetCallCount()).To(Equal(0))
+					})
+				})
+
 				When("the config has SkipSSLValidation false and the --skip-ssl-validation flag is passed", func() {
 					BeforeEach(func() {
 						fakeConfig.SkipSSLValidationReturns(false)
@@ -3,6 +3,8 @@ package v7
 import (
 	"os"
 	"os/signal"
+	"sort"
+	"sync"
 	"time"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
@@ -16,15 +18,21 @@ import (
 type LogsCommand struct {
 	BaseCommand
 
-	RequiredArgs    flag.AppName `positional-args:"yes"`
-	Recent          bool         `long:"recent" description:"Dump recent logs instead of tailing"`
-	usage           interface{}  `usage:"CF_NAME logs APP_NAME"`
-	relatedCommands interface{}  `related_commands:"app, apps, ssh"`
+	RequiredArgs    flag.AppName                `positional-args:"yes"`
+	FoundationsFile flag.PathWithExistenceCheck `long:"foundations-file" description:"Show the logs of the app on every foundation listed in the given YAML file"`
+	Recent          bool                        `long:"recent" description:"Dump recent logs instead of tailing"`
+	usage           interface{}                 `usage:"CF_NAME logs APP_NAME [--recent] [--foundations-file PATH]"`
+	relatedCommands interface{}                 `related_commands:"app, apps, ssh"`
 
 	LogCacheClient sharedaction.LogCacheClient
 }
 
 func (cmd *LogsCommand) Setup(config command.Config, ui command.UI) error {
+	if cmd.FoundationsFile != "" {
+		cmd.SetupForFoundations(config, ui)
+		return nil
+	}
+
 	err := cmd.BaseCommand.Setup(config, ui)
 	if err != nil {
 		return err
@@ -35,6 +43,10 @@ func (cmd *LogsCommand) Setup(config command.Config, ui command.UI) error {
 }
 
 func (cmd LogsCommand) Execute(args []string) error {
+	if cmd.FoundationsFile != "" {
+		return cmd.executeOnFoundations()
+	}
+
 	err := cmd.SharedActor.CheckTarget(true, true)
 	if err != nil {
 		return err
@@ -168,3 +180,191 @@ func (cmd LogsCommand) streamLogs() error {
 
 	return nil
 }
+
+// foundationLogMergeInterval is how long logs from several foundations are
+// collected before they are displayed ordered by timestamp.
+const foundationLogMergeInterval = 2 * time.Second
+
+type foundationLogMessage struct {
+	sharedaction.LogMessage
+	foundation string
+}
+
+func (message foundationLogMessage) Foundation() string {
+	return message.foundation
+}
+
+func (cmd LogsCommand) executeOnFoundations() error {
+	cmd.UI.DisplayTextWithFlavor("Retrieving logs for app {{.AppName}} from foundations in {{.FoundationsFile}}...", map[string]interface{}{
+		"AppName":         cmd.RequiredArgs.AppName,
+		"FoundationsFile": cmd.FoundationsFile,
+	})
+	cmd.UI.DisplayNewline()
+
+	if cmd.Recent {
+		return cmd.displayRecentLogsFromFoundations()
+	}
+
+	messages := make(chan foundationLogMessage)
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	var (
+		results []FoundationResult
+		err     error
+	)
+	go func() {
+		defer close(done)
+		results, err = cmd.FoundationsRunner.RunOnFoundations(string(cmd.FoundationsFile), true, true, func(target FoundationTarget) (v7action.Warnings, error) {
+			return cmd.streamFoundationLogs(target, messages, stop)
+		})
+	}()
+
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	defer signal.Stop(interrupt)
+
+	ticker := time.NewTicker(foundationLogMergeInterval)
+	defer ticker.Stop()
+
+	// The first interrupt stops streaming from every foundation and waits for
+	// the streams to close. A second one stops waiting for them.
+	var pending []foundationLogMessage
+	interrupted := false
+	for streaming := true; streaming; {
+		select {
+		case message := <-messages:
+			pending = append(pending, message)
+		case <-ticker.C:
+			cmd.displayFoundationLogMessages(pending)
+			pending = nil
+		case <-interrupt:
+			if interrupted {
+				cmd.displayFoundationLogMessages(pending)
+				return nil
+			}
+			close(stop)
+			interrupted = true
+		case <-done:
+			streaming = false
+		}
+	}
+	cmd.displayFoundationLogMessages(pending)
+
+	if err != nil {
+		return err
+	}
+	return displayFoundationResults(cmd.UI, results)
+}
+
+func (cmd LogsCommand) displayRecentLogsFromFoundations() error {
+	var (
+		mutex    sync.Mutex
+		messages []foundationLogMessage
+	)
+	results, err := cmd.FoundationsRunner.RunOnFoundations(string(cmd.FoundationsFile), true, true, func(target FoundationTarget) (v7action.Warnings, error) {
+		client, err := logcache.NewClient(target.Config.LogCacheEndpoint(), target.Config, cmd.UI, v7action.NewDefaultKubernetesConfigGetter())
+		if err != nil {
+			return nil, err
+		}
+
+		recentMessages, warnings, err := target.Actor.GetRecentLogsForApplicationByNameAndSpace(cmd.RequiredArgs.AppName, target.Space.GUID, client)
+		mutex.Lock()
+		defer mutex.Unlock()
+		for _, message := range recentMessages {
+			messages = append(messages, foundationLogMessage{LogMessage: message, foundation: target.Name})
+		}
+		return warnings, err
+	})
+	if err != nil {
+		return err
+	}
+
+	cmd.displayFoundationLogMessages(messages)
+	return displayFoundationResults(cmd.UI, results)
+}
+
+// streamFoundationLogs sends the logs of the app on one foundation to
+// messages until the stream ends or stop is closed. The foundation's token is
+// refreshed while the logs are streamed.
+func (cmd LogsCommand) streamFoundationLogs(target FoundationTarget, messages chan<- foundationLogMessage, stop chan struct{}) (v7action.Warnings, error) {
+	client, err := logcache.NewClient(target.Config.LogCacheEndpoint(), target.Config, cmd.UI, v7action.NewDefaultKubernetesConfigGetter())
+	if err != nil {
+		return nil, err
+	}
+
+	stopRefreshing := make(chan struct{})
+	stoppedRefreshing := make(chan struct{})
+	tokenRefreshErrors, err := target.Actor.ScheduleTokenRefresh(time.After, stopRefreshing, stoppedRefreshing)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		close(stopRefreshing)
+		for {
+			select {
+			case <-tokenRefreshErrors:
+			case <-stoppedRefreshing:
+				return
+			}
+		}
+	}()
+
+	appMessages, logErrs, stopStreaming, warnings, err := target.Actor.GetStreamingLogsForApplicationByNameAndSpace(
+		cmd.RequiredArgs.AppName,
+		target.Space.GUID,
+		client,
+	)
+	if err != nil {
+		return warnings, err
+	}
+	defer stopStreaming()
+
+	for appMessages != nil || logErrs != nil {
+		select {
+		case message, ok := <-appMessages:
+			if !ok {
+				appMessages = nil
+				continue
+			}
+			messages <- foundationLogMessage{LogMessage: message, foundation: target.Name}
+		case logErr, ok := <-logErrs:
+			if !ok {
+				logErrs = nil
+				continue
+			}
+			cmd.handleFoundationLogErr(target.Name, logErr)
+		case refreshErr := <-tokenRefreshErrors:
+			cmd.UI.DisplayWarning("{{.Foundation}}: {{.Error}}", map[string]interface{}{
+				"Foundation": target.Name,
+				"Error":      translatedErrorMessage(cmd.UI, refreshErr),
+			})
+		case <-stop:
+			return warnings, nil
+		}
+	}
+
+	return warnings, nil
+}
+
+func (cmd LogsCommand) handleFoundationLogErr(foundation string, logErr error) {
+	switch logErr.(type) {
+	case actionerror.LogCacheTimeoutError:
+		cmd.UI.DisplayWarning("{{.Foundation}}: timeout connecting to log server, no log will be shown", map[string]interface{}{
+			"Foundation": foundation,
+		})
+	default:
+		cmd.UI.DisplayWarning("{{.Foundation}}: Failed to retrieve logs from Log Cache: {{.Error}}", map[string]interface{}{
+			"Foundation": foundation,
+			"Error":      logErr,
+		})
+	}
+}
+
+func (cmd LogsCommand) displayFoundationLogMessages(messages []foundationLogMessage) {
+	sort.SliceStable(messages, func(i, j int) bool {
+		return messages[i].Timestamp().Before(messages[j].Timestamp())
+	})
+	for _, message := range messages {
+		cmd.UI.DisplayLogMessage(message, true)
+	}
+}
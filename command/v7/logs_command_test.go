@@ -10,8 +10,10 @@ import (
 	"code.cloudfoundry.org/cli/actor/sharedaction/sharedactionfakes"
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v7"
 	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/resources"
 	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/ui"
 	. "github.com/onsi/ginkgo"
@@ -333,4 +335,143 @@ var _ = Describe("logs command", func() {
 			})
 		})
 	})
+
+	When("the --foundations-file flag is provided", func() {
+		var (
+			fakeFoundationsRunner *v7fakes.FakeFoundationsRunner
+			dc1Actor              *v7fakes.FakeActor
+			dc2Actor              *v7fakes.FakeActor
+		)
+
+		logMessage := func(message string, seconds int64) sharedaction.LogMessage {
+			return *sharedaction.NewLogMessage(message, "OUT", time.Unix(seconds, 0), "APP/PROC/WEB", "0")
+		}
+
+		streamingLogs := func(messages ...sharedaction.LogMessage) (<-chan sharedaction.LogMessage, <-chan error) {
+			messageStream := make(chan sharedaction.LogMessage, len(messages))
+			for _, message := range messages {
+				messageStream <- message
+			}
+			close(messageStream)
+
+			errStream := make(chan error, 1)
+			errStream <- errors.New("connection reset")
+			close(errStream)
+			return messageStream, errStream
+		}
+
+		BeforeEach(func() {
+			fakeFoundationsRunner = new(v7fakes.FakeFoundationsRunner)
+			cmd.FoundationsRunner = fakeFoundationsRunner
+			cmd.FoundationsFile = "foundations.yml"
+
+			dc1Actor = new(v7fakes.FakeActor)
+			dc2Actor = new(v7fakes.FakeActor)
+			for _, actor := range []*v7fakes.FakeActor{dc1Actor, dc2Actor} {
+				actor.ScheduleTokenRefreshStub = func(
+					after func(time.Duration) <-chan time.Time,
+					stop chan struct{}, stoppedRefreshing chan struct{}) (<-chan error, error) {
+					go func() {
+						<-stop
+						close(stoppedRefreshing)
+					}()
+					return make(chan error), nil
+				}
+			}
+
+			runOnFoundationTargets(fakeFoundationsRunner,
+				FoundationTarget{Name: "dc1", Actor: dc1Actor, Config: fakeConfig, Space: resources.Space{GUID: "dc1-space-guid"}},
+				FoundationTarget{Name: "dc2", Actor: dc2Actor, Config: fakeConfig, Space: resources.Space{GUID: "dc2-space-guid"}},
+			)
+		})
+
+		When("the --recent flag is provided", func() {
+			BeforeEach(func() {
+				cmd.Recent = true
+				dc1Actor.GetRecentLogsForApplicationByNameAndSpaceReturns(
+					[]sharedaction.LogMessage{logMessage("dc1 message 1", 1), logMessage("dc1 message 2", 3)},
+					v7action.Warnings{"dc1-warning"},
+					nil)
+				dc2Actor.GetRecentLogsForApplicationByNameAndSpaceReturns(
+					[]sharedaction.LogMessage{logMessage("dc2 message 1", 2)},
+					nil,
+					nil)
+			})
+
+			It("displays the logs of every foundation ordered by timestamp", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say(`Retrieving logs for app some-app from foundations in foundations\.yml\.\.\.`))
+				Expect(testUI.Out).To(Say(`\[dc1\] .* OUT dc1 message 1`))
+				Expect(testUI.Out).To(Say(`\[dc2\] .* OUT dc2 message 1`))
+				Expect(testUI.Out).To(Say(`\[dc1\] .* OUT dc1 message 2`))
+				Expect(testUI.Err).To(Say("dc1: dc1-warning"))
+
+				appName, spaceGUID, _ := dc2Actor.GetRecentLogsForApplicationByNameAndSpaceArgsForCall(0)
+				Expect(appName).To(Equal("some-app"))
+				Expect(spaceGUID).To(Equal("dc2-space-guid"))
+			})
+
+			When("a foundation fails", func() {
+				BeforeEach(func() {
+					dc2Actor.GetRecentLogsForApplicationByNameAndSpaceReturns(nil, nil, errors.New("app not found"))
+				})
+
+				It("displays the logs of the other foundations and returns an error", func() {
+					Expect(testUI.Out).To(Say(`\[dc1\] .* OUT dc1 message 1`))
+					Expect(testUI.Err).To(Say("dc2: app not found"))
+					Expect(executeErr).To(MatchError(translatableerror.FoundationsFailedError{Names: []string{"dc2"}}))
+				})
+			})
+		})
+
+		When("the --recent flag is not provided", func() {
+			BeforeEach(func() {
+				dc1Messages, dc1Errs := streamingLogs(logMessage("dc1 message 1", 1), logMessage("dc1 message 2", 3))
+				dc1Actor.GetStreamingLogsForApplicationByNameAndSpaceReturns(dc1Messages, dc1Errs, func() {}, nil, nil)
+				dc2Messages, dc2Errs := streamingLogs(logMessage("dc2 message 1", 2))
+				dc2Actor.GetStreamingLogsForApplicationByNameAndSpaceReturns(dc2Messages, dc2Errs, func() {}, v7action.Warnings{"dc2-warning"}, nil)
+			})
+
+			It("streams the logs of every foundation merged by timestamp", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say(`\[dc1\] .* OUT dc1 message 1`))
+				Expect(testUI.Out).To(Say(`\[dc2\] .* OUT dc2 message 1`))
+				Expect(testUI.Out).To(Say(`\[dc1\] .* OUT dc1 message 2`))
+
+				Expect(testUI.Err).To(Say("dc1: Failed to retrieve logs from Log Cache: connection reset"))
+				Expect(testUI.Err).To(Say("dc2: Failed to retrieve logs from Log Cache: connection reset"))
+				Expect(testUI.Err).To(Say("dc2: dc2-warning"))
+
+				_, spaceGUID, _ := dc1Actor.GetStreamingLogsForApplicationByNameAndSpaceArgsForCall(0)
+				Expect(spaceGUID).To(Equal("dc1-space-guid"))
+			})
+
+			It("targets the org and space of every foundation instead of checking the target", func() {
+				Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+				_, targetOrg, targetSpace, _ := fakeFoundationsRunner.RunOnFoundationsArgsForCall(0)
+				Expect(targetOrg).To(BeTrue())
+				Expect(targetSpace).To(BeTrue())
+			})
+
+			It("refreshes the token of every foundation", func() {
+				Expect(dc1Actor.ScheduleTokenRefreshCallCount()).To(Equal(1))
+				Expect(dc2Actor.ScheduleTokenRefreshCallCount()).To(Equal(1))
+			})
+
+			When("scheduling the token refresh fails", func() {
+				BeforeEach(func() {
+					dc2Actor.ScheduleTokenRefreshReturns(nil, errors.New("fjords pining"))
+				})
+
+				It("does not stream the logs of that foundation", func() {
+					Expect(dc2Actor.GetStreamingLogsForApplicationByNameAndSpaceCallCount()).To(Equal(0))
+					Expect(testUI.Out).To(Say(`\[dc1\] .* OUT dc1 message 2`))
+					Expect(testUI.Err).To(Say("dc2: fjords pining"))
+					Expect(executeErr).To(MatchError(translatableerror.FoundationsFailedError{Names: []string{"dc2"}}))
+				})
+			})
+		})
+	})
 })
@@ -1,6 +1,7 @@
 package v7
 
 import (
+	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/v7/shared"
 	"code.cloudfoundry.org/cli/resources"
 )
@@ -8,11 +9,18 @@ import (
 type OrgQuotasCommand struct {
 	BaseCommand
 
-	usage           interface{} `usage:"CF_NAME org-quotas"`
-	relatedCommands interface{} `related_commands:"org-quota"`
+	Output          flag.OutputFormat `long:"output" description:"Display the org quotas as json, yaml or csv"`
+	Format          flag.GoTemplate   `long:"format" description:"Display the org quotas with the given Go template"`
+	JSONPath        flag.JSONPath     `long:"jsonpath" description:"Display the fields of the org quotas selected by the given JSONPath expression"`
+	usage           interface{}       `usage:"CF_NAME org-quotas [--output json|yaml|csv] [--format TEMPLATE] [--jsonpath EXPRESSION]"`
+	relatedCommands interface{}       `related_commands:"org-quota"`
 }
 
 func (cmd OrgQuotasCommand) Execute(args []string) error {
+	if err := cmd.output().validate(); err != nil {
+		return err
+	}
+
 	err := cmd.SharedActor.CheckTarget(false, false)
 	if err != nil {
 		return err
@@ -23,10 +31,12 @@ func (cmd OrgQuotasCommand) Execute(args []string) error {
 		return err
 	}
 
-	cmd.UI.DisplayTextWithFlavor("Getting org quotas as {{.Username}}...", map[string]interface{}{
-		"Username": user.Name,
-	})
-	cmd.UI.DisplayNewline()
+	if !cmd.output().IsSet() {
+		cmd.UI.DisplayTextWithFlavor("Getting org quotas as {{.Username}}...", map[string]interface{}{
+			"Username": user.Name,
+		})
+		cmd.UI.DisplayNewline()
+	}
 
 	orgQuotas, warnings, err := cmd.Actor.GetOrganizationQuotas()
 	cmd.UI.DisplayWarnings(warnings)
@@ -34,6 +44,14 @@ func (cmd OrgQuotasCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.output().IsSet() {
+		output := []OrgQuotaOutput{}
+		for _, orgQuota := range orgQuotas {
+			output = append(output, orgQuotaOutput(orgQuota))
+		}
+		return cmd.output().display(cmd.UI, output)
+	}
+
 	var quotas []resources.Quota
 	for _, orgQuota := range orgQuotas {
 		quotas = append(quotas, resources.Quota(orgQuota.Quota))
@@ -44,3 +62,7 @@ func (cmd OrgQuotasCommand) Execute(args []string) error {
 
 	return nil
 }
+
+func (cmd OrgQuotasCommand) output() structuredOutput {
+	return structuredOutput{output: cmd.Output, format: cmd.Format, jsonPath: cmd.JSONPath}
+}
@@ -1,11 +1,13 @@
 package v7_test
 
 import (
+	"encoding/json"
 	"errors"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
 	. "code.cloudfoundry.org/cli/command/v7"
 	"code.cloudfoundry.org/cli/command/v7/v7fakes"
 	"code.cloudfoundry.org/cli/resources"
@@ -174,4 +176,54 @@ var _ = Describe("org-quotas command", func() {
 			Expect(testUI.Out).To(Say("No organization quotas found."))
 		})
 	})
+
+	When("the --output flag is set", func() {
+		BeforeEach(func() {
+			cmd.Output = flag.OutputFormat{Format: flag.OutputJSON}
+			fakeActor.GetOrganizationQuotasReturns([]resources.OrganizationQuota{
+				{
+					Quota: resources.Quota{
+						Name: "quota-1",
+						GUID: "quota-guid-1",
+						Apps: resources.AppLimit{
+							TotalMemory:       &types.NullInt{Value: 2048, IsSet: true},
+							InstanceMemory:    &types.NullInt{IsSet: false},
+							TotalAppInstances: &types.NullInt{Value: 3, IsSet: true},
+							TotalLogVolume:    &types.NullInt{IsSet: false},
+						},
+						Services: resources.ServiceLimit{
+							TotalServiceInstances: &types.NullInt{Value: 4, IsSet: true},
+							PaidServicePlans:      &trueValue,
+						},
+						Routes: resources.RouteLimit{
+							TotalRoutes:        &types.NullInt{Value: 5, IsSet: true},
+							TotalReservedPorts: &types.NullInt{Value: 6, IsSet: true},
+						},
+					},
+				},
+			}, v7action.Warnings{"warning-1"}, nil)
+		})
+
+		It("displays the quotas as JSON with null for unlimited values", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			var quotas []map[string]interface{}
+			Expect(json.Unmarshal(testUI.Out.(*Buffer).Contents(), &quotas)).To(Succeed())
+			Expect(quotas).To(Equal([]map[string]interface{}{
+				{
+					"name":                               "quota-1",
+					"guid":                               "quota-guid-1",
+					"total_memory_in_mb":                 float64(2048),
+					"instance_memory_in_mb":              nil,
+					"total_routes":                       float64(5),
+					"total_service_instances":            float64(4),
+					"paid_service_plans":                 true,
+					"total_app_instances":                float64(3),
+					"total_route_ports":                  float64(6),
+					"log_rate_limit_in_bytes_per_second": nil,
+				},
+			}))
+			Expect(testUI.Err).To(Say("warning-1"))
+		})
+	})
 })
@@ -12,11 +12,18 @@ type OrgUsersCommand struct {
 
 	RequiredArgs    flag.Organization `positional-args:"yes"`
 	AllUsers        bool              `long:"all-users" short:"a" description:"List all users with roles in the org or in spaces within the org"`
-	usage           interface{}       `usage:"CF_NAME org-users ORG"`
+	Output          flag.OutputFormat `long:"output" description:"Display the roles of the users as json, yaml or csv"`
+	Format          flag.GoTemplate   `long:"format" description:"Display the roles of the users with the given Go template"`
+	JSONPath        flag.JSONPath     `long:"jsonpath" description:"Display the fields of the roles of the users selected by the given JSONPath expression"`
+	usage           interface{}       `usage:"CF_NAME org-users ORG [--output json|yaml|csv] [--format TEMPLATE] [--jsonpath EXPRESSION]\n\nEXAMPLES:\n   CF_NAME org-users my-org\n   CF_NAME org-users my-org --all-users --output csv"`
 	relatedCommands interface{}       `related_commands:"orgs, set-org-role"`
 }
 
 func (cmd *OrgUsersCommand) Execute(args []string) error {
+	if err := cmd.output().validate(); err != nil {
+		return err
+	}
+
 	err := cmd.SharedActor.CheckTarget(false, false)
 	if err != nil {
 		return err
@@ -27,11 +34,13 @@ func (cmd *OrgUsersCommand) Execute(args []string) error {
 		return err
 	}
 
-	cmd.UI.DisplayTextWithFlavor("Getting users in org {{.Org}} as {{.CurrentUser}}...", map[string]interface{}{
-		"Org":         cmd.RequiredArgs.Organization,
-		"CurrentUser": user.Name,
-	})
-	cmd.UI.DisplayNewline()
+	if !cmd.output().IsSet() {
+		cmd.UI.DisplayTextWithFlavor("Getting users in org {{.Org}} as {{.CurrentUser}}...", map[string]interface{}{
+			"Org":         cmd.RequiredArgs.Organization,
+			"CurrentUser": user.Name,
+		})
+		cmd.UI.DisplayNewline()
+	}
 
 	org, warnings, err := cmd.Actor.GetOrganizationByName(cmd.RequiredArgs.Organization)
 	cmd.UI.DisplayWarnings(warnings)
@@ -45,11 +54,25 @@ func (cmd *OrgUsersCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.output().IsSet() {
+		return cmd.output().display(cmd.UI, cmd.orgUsersOutput(orgUsersByRoleType))
+	}
+
 	cmd.displayOrgUsers(orgUsersByRoleType)
 
 	return nil
 }
 
+// orgUsersOutput lists the roles that are displayed. With --all-users it also
+// lists the org user role that every user with a role in the org has.
+func (cmd OrgUsersCommand) orgUsersOutput(orgUsersByRoleType map[constant.RoleType][]resources.User) []UserRoleOutput {
+	roleTypes := []constant.RoleType{constant.OrgManagerRole, constant.OrgBillingManagerRole, constant.OrgAuditorRole}
+	if cmd.AllUsers {
+		roleTypes = append([]constant.RoleType{constant.OrgUserRole}, roleTypes...)
+	}
+	return userRolesOutput(orgUsersByRoleType, roleTypes...)
+}
+
 func (cmd OrgUsersCommand) displayOrgUsers(orgUsersByRoleType map[constant.RoleType][]resources.User) {
 	if cmd.AllUsers {
 		cmd.displayRoleGroup(getUniqueUsers(orgUsersByRoleType), "ORG USERS")
@@ -101,3 +124,7 @@ func getUniqueUsers(orgUsersByRoleType map[constant.RoleType][]resources.User) [
 
 	return allUsers
 }
+
+func (cmd OrgUsersCommand) output() structuredOutput {
+	return structuredOutput{output: cmd.Output, format: cmd.Format, jsonPath: cmd.JSONPath}
+}
@@ -7,6 +7,8 @@ import (
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v7"
 	"code.cloudfoundry.org/cli/command/v7/v7fakes"
 	"code.cloudfoundry.org/cli/resources"
@@ -213,6 +215,53 @@ var _ = Describe("org-users Command", func() {
 
 							Expect(fakeActor.GetOrganizationByNameCallCount()).To(Equal(1))
 						})
+
+						When("the --output flag is set to csv", func() {
+							BeforeEach(func() {
+								cmd.Output = flag.OutputFormat{Format: flag.OutputCSV}
+							})
+
+							It("also displays the org user roles", func() {
+								Expect(executeErr).ToNot(HaveOccurred())
+
+								Expect(testUI.Out).To(Say(`role,guid,username,presentation_name,origin\n`))
+								Expect(testUI.Out).To(Say(`organization_user,orgUser-guid,,org-user,uaa\n`))
+								Expect(testUI.Out).To(Say(`organization_manager,abby-user-guid,,abby,ldap\n`))
+							})
+						})
+					})
+
+					When("the --output flag is set to csv", func() {
+						BeforeEach(func() {
+							cmd.Output = flag.OutputFormat{Format: flag.OutputCSV}
+						})
+
+						It("displays a row for every role of every user", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							Expect(testUI.Out).ToNot(Say("Getting users"))
+							Expect(testUI.Out).To(Say(`role,guid,username,presentation_name,origin\n`))
+							Expect(testUI.Out).To(Say(`organization_manager,abby-user-guid,,abby,ldap\n`))
+							Expect(testUI.Out).To(Say(`organization_manager,uaaAdmin-guid,,admin,uaa\n`))
+							Expect(testUI.Out).To(Say(`organization_manager,client-guid,,admin,client\n`))
+							Expect(testUI.Out).To(Say(`organization_billing_manager,uaaAdmin-guid,,admin,uaa\n`))
+							Expect(testUI.Out).To(Say(`organization_billing_manager,billingManager-guid,,billing-manager,uaa\n`))
+							Expect(testUI.Out).To(Say(`organization_auditor,orgAuditor-guid,,org-auditor,uaa\n`))
+							Expect(testUI.Out).ToNot(Say("organization_user"))
+							Expect(testUI.Err).To(Say("get-org-by-name-warning"))
+						})
+					})
+
+					When("the --output and --format flags are both set", func() {
+						BeforeEach(func() {
+							cmd.Output = flag.OutputFormat{Format: flag.OutputJSON}
+							Expect(cmd.Format.UnmarshalFlag("{{.}}")).To(Succeed())
+						})
+
+						It("returns an argument combination error", func() {
+							Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"--output", "--format"}}))
+							Expect(fakeActor.GetOrgUsersByRoleTypeCallCount()).To(Equal(0))
+						})
 					})
 				})
 
@@ -1,6 +1,7 @@
 package v7
 
 import (
+	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/resources"
 	"code.cloudfoundry.org/cli/util/ui"
 )
@@ -8,12 +9,19 @@ import (
 type OrgsCommand struct {
 	BaseCommand
 
-	usage           interface{} `usage:"CF_NAME orgs [--labels SELECTOR]\n\nEXAMPLES:\n   CF_NAME orgs\n   CF_NAME orgs --labels 'environment in (production,staging),tier in (backend)'\n   CF_NAME orgs --labels 'env=dev,!chargeback-code,tier in (backend,worker)'"`
-	relatedCommands interface{} `related_commands:"create-org, org, org-users, set-org-role"`
-	Labels          string      `long:"labels" description:"Selector to filter orgs by labels"`
+	usage           interface{}       `usage:"CF_NAME orgs [--labels SELECTOR] [--output json|yaml|csv] [--format TEMPLATE] [--jsonpath EXPRESSION]\n\nEXAMPLES:\n   CF_NAME orgs\n   CF_NAME orgs --labels 'environment in (production,staging),tier in (backend)'\n   CF_NAME orgs --labels 'env=dev,!chargeback-code,tier in (backend,worker)'\n   CF_NAME orgs --output json"`
+	relatedCommands interface{}       `related_commands:"create-org, org, org-users, set-org-role"`
+	Labels          string            `long:"labels" description:"Selector to filter orgs by labels"`
+	Output          flag.OutputFormat `long:"output" description:"Display the orgs as json, yaml or csv"`
+	Format          flag.GoTemplate   `long:"format" description:"Display the orgs with the given Go template"`
+	JSONPath        flag.JSONPath     `long:"jsonpath" description:"Display the fields of the orgs selected by the given JSONPath expression"`
 }
 
 func (cmd OrgsCommand) Execute(args []string) error {
+	if err := cmd.output().validate(); err != nil {
+		return err
+	}
+
 	err := cmd.SharedActor.CheckTarget(false, false)
 	if err != nil {
 		return err
@@ -24,10 +32,12 @@ func (cmd OrgsCommand) Execute(args []string) error {
 		return err
 	}
 
-	cmd.UI.DisplayTextWithFlavor("Getting orgs as {{.CurrentUser}}...", map[string]interface{}{
-		"CurrentUser": user.Name,
-	})
-	cmd.UI.DisplayNewline()
+	if !cmd.output().IsSet() {
+		cmd.UI.DisplayTextWithFlavor("Getting orgs as {{.CurrentUser}}...", map[string]interface{}{
+			"CurrentUser": user.Name,
+		})
+		cmd.UI.DisplayNewline()
+	}
 
 	orgs, warnings, err := cmd.Actor.GetOrganizations(cmd.Labels)
 	cmd.UI.DisplayWarnings(warnings)
@@ -35,6 +45,14 @@ func (cmd OrgsCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.output().IsSet() {
+		output := []OrgOutput{}
+		for _, org := range orgs {
+			output = append(output, OrgOutput{Name: org.Name, GUID: org.GUID})
+		}
+		return cmd.output().display(cmd.UI, output)
+	}
+
 	if len(orgs) == 0 {
 		cmd.UI.DisplayText("No orgs found.")
 	} else {
@@ -51,3 +69,7 @@ func (cmd OrgsCommand) displayOrgs(orgs []resources.Organization) {
 	}
 	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
 }
+
+func (cmd OrgsCommand) output() structuredOutput {
+	return structuredOutput{output: cmd.Output, format: cmd.Format, jsonPath: cmd.JSONPath}
+}
@@ -6,6 +6,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
 	. "code.cloudfoundry.org/cli/command/v7"
 	"code.cloudfoundry.org/cli/command/v7/v7fakes"
 	"code.cloudfoundry.org/cli/resources"
@@ -161,4 +162,20 @@ var _ = Describe("orgs Command", func() {
 			})
 		})
 	})
+
+	When("the --output flag is set", func() {
+		BeforeEach(func() {
+			cmd.Output = flag.OutputFormat{Format: flag.OutputYAML}
+			fakeActor.GetOrganizationsReturns([]resources.Organization{
+				{Name: "org-1", GUID: "org-guid-1"},
+				{Name: "org-2", GUID: "org-guid-2"},
+			}, v7action.Warnings{"warning-1"}, nil)
+		})
+
+		It("displays the orgs as YAML without the header", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(string(testUI.Out.(*Buffer).Contents())).To(Equal("- guid: org-guid-1\n  name: org-1\n- guid: org-guid-2\n  name: org-2\n"))
+			Expect(testUI.Err).To(Say("warning-1"))
+		})
+	})
 })
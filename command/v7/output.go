@@ -0,0 +1,746 @@
+package v7
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/csvexport"
+)
+
+// The types in this file are the documented schema of the '--output json',
+// '--output yaml' and '--output csv' formats and of the values seen by
+// '--format' and '--jsonpath'. Fields are only ever added to them, so scripts can rely on
+// the existing keys.
+
+// AppSummaryOutput is an app as displayed by 'cf apps --output'.
+type AppSummaryOutput struct {
+	Name      string          `json:"name"`
+	GUID      string          `json:"guid"`
+	State     string          `json:"state"`
+	Processes []ProcessOutput `json:"processes"`
+	Routes    []string        `json:"routes"`
+}
+
+// ProcessOutput is a process of an app. InstanceDetails is only filled in by
+// 'cf app'.
+type ProcessOutput struct {
+	Type             string                  `json:"type"`
+	Instances        int                     `json:"instances"`
+	RunningInstances int                     `json:"running_instances"`
+	MemoryInMB       uint64                  `json:"memory_in_mb"`
+	DiskInMB         uint64                  `json:"disk_in_mb"`
+	Sidecars         []string                `json:"sidecars"`
+	InstanceDetails  []ProcessInstanceOutput `json:"instance_details,omitempty"`
+}
+
+// ProcessInstanceOutput is one instance of a process. Usage and quotas are in
+// bytes and a LogRateLimit of -1 means unlimited.
+type ProcessInstanceOutput struct {
+	Index        int64   `json:"index"`
+	State        string  `json:"state"`
+	Since        string  `json:"since,omitempty"`
+	CPU          float64 `json:"cpu"`
+	MemoryUsage  uint64  `json:"memory_usage"`
+	MemoryQuota  uint64  `json:"memory_quota"`
+	DiskUsage    uint64  `json:"disk_usage"`
+	DiskQuota    uint64  `json:"disk_quota"`
+	LogRate      uint64  `json:"log_rate"`
+	LogRateLimit int64   `json:"log_rate_limit"`
+	Details      string  `json:"details,omitempty"`
+}
+
+// AppOutput is an app as displayed by 'cf app --output'. DockerImage is only
+// set for Docker apps and Buildpacks only for buildpack apps.
+type AppOutput struct {
+	AppSummaryOutput
+	IsolationSegment string   `json:"isolation_segment,omitempty"`
+	LastUploaded     string   `json:"last_uploaded,omitempty"`
+	DropletGUID      string   `json:"droplet_guid,omitempty"`
+	Stack            string   `json:"stack"`
+	DockerImage      string   `json:"docker_image,omitempty"`
+	Buildpacks       []string `json:"buildpacks,omitempty"`
+}
+
+// ServiceInstanceOutput is a service instance as displayed by
+// 'cf services --output'. UpgradeAvailable is null when the broker does not
+// support upgrades.
+type ServiceInstanceOutput struct {
+	Name             string   `json:"name"`
+	Type             string   `json:"type"`
+	Offering         string   `json:"offering"`
+	Plan             string   `json:"plan"`
+	Broker           string   `json:"broker"`
+	BoundApps        []string `json:"bound_apps"`
+	LastOperation    string   `json:"last_operation"`
+	UpgradeAvailable *bool    `json:"upgrade_available"`
+}
+
+// ServiceOutput is a service instance as displayed by 'cf service --output'.
+// The broker, offering and plan fields are empty for user-provided service
+// instances.
+type ServiceOutput struct {
+	Name            string                   `json:"name"`
+	GUID            string                   `json:"guid"`
+	Type            string                   `json:"type"`
+	Broker          string                   `json:"broker,omitempty"`
+	Offering        string                   `json:"offering,omitempty"`
+	Plan            string                   `json:"plan,omitempty"`
+	Tags            []string                 `json:"tags"`
+	OfferingTags    []string                 `json:"offering_tags,omitempty"`
+	Description     string                   `json:"description,omitempty"`
+	Documentation   string                   `json:"documentation,omitempty"`
+	DashboardURL    string                   `json:"dashboard_url,omitempty"`
+	RouteServiceURL string                   `json:"route_service_url,omitempty"`
+	SyslogDrainURL  string                   `json:"syslog_drain_url,omitempty"`
+	LastOperation   *LastOperationOutput     `json:"last_operation"`
+	BoundApps       []ServiceBindingOutput   `json:"bound_apps"`
+	SharedFrom      *ServiceSharedFromOutput `json:"shared_from,omitempty"`
+	SharedWith      []ServiceSharedToOutput  `json:"shared_with,omitempty"`
+	Upgrade         string                   `json:"upgrade"`
+}
+
+// LastOperationOutput is the last operation on a service instance or binding.
+type LastOperationOutput struct {
+	Type        string `json:"type"`
+	State       string `json:"state"`
+	Description string `json:"description"`
+	CreatedAt   string `json:"created_at,omitempty"`
+	UpdatedAt   string `json:"updated_at,omitempty"`
+}
+
+// ServiceBindingOutput is an app bound to a service instance.
+type ServiceBindingOutput struct {
+	App           string              `json:"app"`
+	Name          string              `json:"name"`
+	LastOperation LastOperationOutput `json:"last_operation"`
+}
+
+// ServiceSharedFromOutput is the space a shared service instance belongs to.
+type ServiceSharedFromOutput struct {
+	Org   string `json:"org"`
+	Space string `json:"space"`
+}
+
+// ServiceSharedToOutput is a space a service instance is shared with.
+type ServiceSharedToOutput struct {
+	Org      string `json:"org"`
+	Space    string `json:"space"`
+	Bindings int    `json:"bindings"`
+}
+
+// RouteOutput is a route as displayed by 'cf routes --output'. Port is 0 for
+// HTTP routes.
+type RouteOutput struct {
+	GUID            string   `json:"guid"`
+	URL             string   `json:"url"`
+	Space           string   `json:"space"`
+	Host            string   `json:"host"`
+	Domain          string   `json:"domain"`
+	Port            int      `json:"port"`
+	Path            string   `json:"path"`
+	Protocol        string   `json:"protocol"`
+	AppProtocols    []string `json:"app_protocols"`
+	Apps            []string `json:"apps"`
+	ServiceInstance string   `json:"service_instance"`
+}
+
+// OrgOutput is an org as displayed by 'cf orgs --output'.
+type OrgOutput struct {
+	Name string `json:"name"`
+	GUID string `json:"guid"`
+}
+
+// SpaceOutput is a space as displayed by 'cf spaces --output'.
+type SpaceOutput struct {
+	Name string `json:"name"`
+	GUID string `json:"guid"`
+}
+
+// BuildpackOutput is a buildpack as displayed by 'cf buildpacks --output'.
+type BuildpackOutput struct {
+	Name     string `json:"name"`
+	GUID     string `json:"guid"`
+	Position int    `json:"position"`
+	Stack    string `json:"stack"`
+	Enabled  bool   `json:"enabled"`
+	Locked   bool   `json:"locked"`
+	Filename string `json:"filename"`
+	State    string `json:"state"`
+}
+
+// SecurityGroupOutput is a security group as displayed by
+// 'cf security-groups --output'. Bindings is empty for groups that are not
+// bound to any space.
+type SecurityGroupOutput struct {
+	Name     string                       `json:"name"`
+	Rules    []resources.Rule             `json:"rules"`
+	Bindings []SecurityGroupBindingOutput `json:"bindings"`
+}
+
+// SecurityGroupBindingOutput is a space a security group is bound to.
+type SecurityGroupBindingOutput struct {
+	Org       string `json:"org"`
+	Space     string `json:"space"`
+	Lifecycle string `json:"lifecycle"`
+}
+
+// OrgQuotaOutput is an org quota as displayed by 'cf org-quotas --output'.
+// Limits are null when they are unlimited; memory limits are in megabytes
+// and the log rate limit is in bytes per second.
+type OrgQuotaOutput struct {
+	Name                  string `json:"name"`
+	GUID                  string `json:"guid"`
+	TotalMemoryInMB       *int   `json:"total_memory_in_mb"`
+	InstanceMemoryInMB    *int   `json:"instance_memory_in_mb"`
+	TotalRoutes           *int   `json:"total_routes"`
+	TotalServiceInstances *int   `json:"total_service_instances"`
+	PaidServicePlans      bool   `json:"paid_service_plans"`
+	TotalAppInstances     *int   `json:"total_app_instances"`
+	TotalRoutePorts       *int   `json:"total_route_ports"`
+	LogRateLimitInBPS     *int   `json:"log_rate_limit_in_bytes_per_second"`
+}
+
+// AuditEventOutput is an audit event as displayed by
+// 'cf audit-events --output'. Time is an RFC 3339 timestamp in UTC and Data
+// is the data of the event as recorded by the Cloud Controller.
+type AuditEventOutput struct {
+	GUID             string                 `json:"guid"`
+	Time             string                 `json:"time"`
+	Type             string                 `json:"type"`
+	ActorGUID        string                 `json:"actor_guid"`
+	ActorType        string                 `json:"actor_type"`
+	ActorName        string                 `json:"actor_name"`
+	TargetGUID       string                 `json:"target_guid"`
+	TargetType       string                 `json:"target_type"`
+	TargetName       string                 `json:"target_name"`
+	SpaceGUID        string                 `json:"space_guid"`
+	OrganizationGUID string                 `json:"organization_guid"`
+	Data             map[string]interface{} `json:"data"`
+}
+
+// AppUsageEventOutput is an app usage event as displayed by
+// 'cf app-usage-events --output'. Time is an RFC 3339 timestamp in UTC. The
+// process fields are empty for task events and the task fields for process
+// events.
+type AppUsageEventOutput struct {
+	GUID                          string `json:"guid"`
+	Time                          string `json:"time"`
+	State                         string `json:"state"`
+	PreviousState                 string `json:"previous_state"`
+	AppGUID                       string `json:"app_guid"`
+	AppName                       string `json:"app_name"`
+	ProcessGUID                   string `json:"process_guid"`
+	ProcessType                   string `json:"process_type"`
+	TaskGUID                      string `json:"task_guid"`
+	TaskName                      string `json:"task_name"`
+	InstanceCount                 int    `json:"instance_count"`
+	PreviousInstanceCount         int    `json:"previous_instance_count"`
+	MemoryInMBPerInstance         int    `json:"memory_in_mb_per_instance"`
+	PreviousMemoryInMBPerInstance int    `json:"previous_memory_in_mb_per_instance"`
+	SpaceGUID                     string `json:"space_guid"`
+	SpaceName                     string `json:"space_name"`
+	OrganizationGUID              string `json:"organization_guid"`
+}
+
+// ServiceUsageEventOutput is a service usage event as displayed by
+// 'cf service-usage-events --output'. Time is an RFC 3339 timestamp in UTC.
+type ServiceUsageEventOutput struct {
+	GUID                string `json:"guid"`
+	Time                string `json:"time"`
+	State               string `json:"state"`
+	ServiceInstanceGUID string `json:"service_instance_guid"`
+	ServiceInstanceName string `json:"service_instance_name"`
+	ServiceInstanceType string `json:"service_instance_type"`
+	ServicePlanGUID     string `json:"service_plan_guid"`
+	ServicePlanName     string `json:"service_plan_name"`
+	ServiceOfferingName string `json:"service_offering_name"`
+	ServiceBrokerName   string `json:"service_broker_name"`
+	SpaceGUID           string `json:"space_guid"`
+	SpaceName           string `json:"space_name"`
+	OrganizationGUID    string `json:"organization_guid"`
+}
+
+// AppUsageSummaryOutput is the usage of the apps of a space as displayed by
+// 'cf app-usage-events --summarize --output'.
+type AppUsageSummaryOutput struct {
+	OrganizationGUID string  `json:"organization_guid"`
+	OrganizationName string  `json:"organization_name"`
+	SpaceGUID        string  `json:"space_guid"`
+	SpaceName        string  `json:"space_name"`
+	InstanceHours    float64 `json:"instance_hours"`
+	MemoryGBHours    float64 `json:"memory_gb_hours"`
+}
+
+// ServiceUsageSummaryOutput is the usage of the service instances of a space
+// as displayed by 'cf service-usage-events --summarize --output'.
+type ServiceUsageSummaryOutput struct {
+	OrganizationGUID string  `json:"organization_guid"`
+	OrganizationName string  `json:"organization_name"`
+	SpaceGUID        string  `json:"space_guid"`
+	SpaceName        string  `json:"space_name"`
+	ServiceInstances int     `json:"service_instances"`
+	InstanceHours    float64 `json:"instance_hours"`
+}
+
+// UserRoleOutput is a role of a user as displayed by 'cf org-users --output'
+// and 'cf space-users --output'. A user with several roles is listed once for
+// every role. Origin is "client" for clients.
+type UserRoleOutput struct {
+	Role             string `json:"role"`
+	GUID             string `json:"guid"`
+	Username         string `json:"username"`
+	PresentationName string `json:"presentation_name"`
+	Origin           string `json:"origin"`
+}
+
+// structuredOutput holds the '--output', '--format' and '--jsonpath' flags of
+// a command, and the '--guid' flag of commands that display a single
+// resource, which is an alias for '--jsonpath {.guid}'. At most one of them
+// may be given.
+type structuredOutput struct {
+	output   flag.OutputFormat
+	format   flag.GoTemplate
+	jsonPath flag.JSONPath
+	guid     bool
+}
+
+// IsSet returns true if any structured output was requested.
+func (o structuredOutput) IsSet() bool {
+	return len(o.flagNames()) > 0
+}
+
+func (o structuredOutput) flagNames() []string {
+	var names []string
+	if o.output.IsSet() {
+		names = append(names, "--output")
+	}
+	if o.format.IsSet() {
+		names = append(names, "--format")
+	}
+	if o.jsonPath.IsSet() {
+		names = append(names, "--jsonpath")
+	}
+	if o.guid {
+		names = append(names, "--guid")
+	}
+	return names
+}
+
+// flagName returns the name of the flag that requested structured output.
+func (o structuredOutput) flagName() string {
+	return o.flagNames()[0]
+}
+
+func (o structuredOutput) validate() error {
+	if names := o.flagNames(); len(names) > 1 {
+		return translatableerror.ArgumentCombinationError{Args: names}
+	}
+	return nil
+}
+
+// display renders value as JSON, YAML or CSV, or with the given Go template
+// or JSONPath expression. Templates see the Go field names of value while
+// JSONPath expressions see the keys of its JSON representation.
+func (o structuredOutput) display(ui command.UI, value interface{}) error {
+	switch {
+	case o.format.IsSet():
+		buffer := new(bytes.Buffer)
+		err := o.format.Template.Execute(buffer, value)
+		if err != nil {
+			return err
+		}
+		return displayRaw(ui, buffer.String())
+	case o.jsonPath.IsSet() || o.guid:
+		path := o.jsonPath
+		if o.guid {
+			path = guidJSONPath()
+		}
+
+		rawJSON, err := json.Marshal(value)
+		if err != nil {
+			return err
+		}
+		var data interface{}
+		decoder := json.NewDecoder(bytes.NewReader(rawJSON))
+		decoder.UseNumber()
+		err = decoder.Decode(&data)
+		if err != nil {
+			return err
+		}
+
+		buffer := new(bytes.Buffer)
+		err = path.Path.Execute(buffer, data)
+		if err != nil {
+			return err
+		}
+		return displayRaw(ui, buffer.String())
+	case o.output.Format == flag.OutputYAML:
+		return ui.DisplayYAML(value)
+	case o.output.Format == flag.OutputCSV:
+		return csvexport.Write(ui.GetOut(), value)
+	default:
+		return ui.DisplayJSON("", value)
+	}
+}
+
+// guidOnly returns true if nothing but the GUID was requested, with '--guid'
+// or '--jsonpath {.guid}'.
+func (o structuredOutput) guidOnly() bool {
+	return o.guid || o.jsonPath.Expression == guidJSONPath().Expression
+}
+
+// guidJSONPath returns the expression that '--guid' is an alias for.
+func guidJSONPath() flag.JSONPath {
+	var path flag.JSONPath
+	_ = path.UnmarshalFlag("{.guid}")
+	return path
+}
+
+// displayRaw writes text to stdout as is, without translating it, and ends
+// it with a newline.
+func displayRaw(ui command.UI, text string) error {
+	if !strings.HasSuffix(text, "\n") {
+		text += "\n"
+	}
+	_, err := io.WriteString(ui.GetOut(), text)
+	return err
+}
+
+func userRolesOutput(usersByRoleType map[constant.RoleType][]resources.User, roleTypes ...constant.RoleType) []UserRoleOutput {
+	output := []UserRoleOutput{}
+	for _, roleType := range roleTypes {
+		users := usersByRoleType[roleType]
+		v7action.SortUsers(users)
+		for _, user := range users {
+			output = append(output, UserRoleOutput{
+				Role:             string(roleType),
+				GUID:             user.GUID,
+				Username:         user.Username,
+				PresentationName: user.PresentationName,
+				Origin:           v7action.GetHumanReadableOrigin(user),
+			})
+		}
+	}
+	return output
+}
+
+func appSummaryOutput(summary v7action.ApplicationSummary) AppSummaryOutput {
+	output := AppSummaryOutput{
+		Name:      summary.Name,
+		GUID:      summary.GUID,
+		State:     strings.ToLower(string(summary.State)),
+		Processes: []ProcessOutput{},
+		Routes:    []string{},
+	}
+	for _, processSummary := range summary.ProcessSummaries {
+		output.Processes = append(output.Processes, processOutput(processSummary, false))
+	}
+	for _, route := range summary.Routes {
+		output.Routes = append(output.Routes, route.URL)
+	}
+	return output
+}
+
+func appOutput(summary v7action.DetailedApplicationSummary) AppOutput {
+	output := AppOutput{
+		AppSummaryOutput: appSummaryOutput(summary.ApplicationSummary),
+		LastUploaded:     summary.CurrentDroplet.CreatedAt,
+		DropletGUID:      summary.CurrentDroplet.GUID,
+		Stack:            summary.CurrentDroplet.Stack,
+	}
+	output.IsolationSegment, _ = summary.GetIsolationSegmentName()
+
+	output.Processes = []ProcessOutput{}
+	for _, processSummary := range summary.ProcessSummaries {
+		output.Processes = append(output.Processes, processOutput(processSummary, true))
+	}
+
+	if summary.LifecycleType == constant.AppLifecycleTypeDocker {
+		output.DockerImage = summary.CurrentDroplet.Image
+	} else {
+		output.Buildpacks = []string{}
+		for _, buildpack := range summary.CurrentDroplet.Buildpacks {
+			output.Buildpacks = append(output.Buildpacks, buildpack.Name)
+		}
+	}
+	return output
+}
+
+func processOutput(processSummary v7action.ProcessSummary, withInstances bool) ProcessOutput {
+	output := ProcessOutput{
+		Type:             processSummary.Type,
+		Instances:        processSummary.TotalInstanceCount(),
+		RunningInstances: processSummary.HealthyInstanceCount(),
+		MemoryInMB:       processSummary.MemoryInMB.Value,
+		DiskInMB:         processSummary.DiskInMB.Value,
+		Sidecars:         []string{},
+	}
+	for _, sidecar := range processSummary.Sidecars {
+		output.Sidecars = append(output.Sidecars, sidecar.Name)
+	}
+
+	if withInstances {
+		output.InstanceDetails = []ProcessInstanceOutput{}
+		for _, instance := range processSummary.InstanceDetails {
+			instanceOutput := ProcessInstanceOutput{
+				Index:        instance.Index,
+				State:        strings.ToLower(string(instance.State)),
+				CPU:          instance.CPU,
+				MemoryUsage:  instance.MemoryUsage,
+				MemoryQuota:  instance.MemoryQuota,
+				DiskUsage:    instance.DiskUsage,
+				DiskQuota:    instance.DiskQuota,
+				LogRate:      instance.LogRate,
+				LogRateLimit: instance.LogRateLimit,
+				Details:      instance.Details,
+			}
+			if instance.Uptime > 0 {
+				instanceOutput.Since = instance.StartTime().UTC().Format(time.RFC3339)
+			}
+			output.InstanceDetails = append(output.InstanceDetails, instanceOutput)
+		}
+	}
+	return output
+}
+
+func serviceInstanceOutput(serviceInstance v7action.ServiceInstance) ServiceInstanceOutput {
+	output := ServiceInstanceOutput{
+		Name:          serviceInstance.Name,
+		Type:          string(serviceInstance.Type),
+		Offering:      serviceOfferingName(serviceInstance),
+		Plan:          serviceInstance.ServicePlanName,
+		Broker:        serviceInstance.ServiceBrokerName,
+		BoundApps:     []string{},
+		LastOperation: serviceInstance.LastOperation,
+	}
+	output.BoundApps = append(output.BoundApps, serviceInstance.BoundApps...)
+	if serviceInstance.UpgradeAvailable.IsSet {
+		upgradeAvailable := serviceInstance.UpgradeAvailable.Value
+		output.UpgradeAvailable = &upgradeAvailable
+	}
+	return output
+}
+
+func serviceOutput(details v7action.ServiceInstanceDetails) ServiceOutput {
+	output := ServiceOutput{
+		Name:            details.Name,
+		GUID:            details.GUID,
+		Type:            string(details.Type),
+		Tags:            optionalStrings(details.Tags),
+		DashboardURL:    details.DashboardURL.String(),
+		RouteServiceURL: details.RouteServiceURL.String(),
+		SyslogDrainURL:  details.SyslogDrainURL.String(),
+		BoundApps:       []ServiceBindingOutput{},
+	}
+
+	if details.LastOperation != (resources.LastOperation{}) {
+		output.LastOperation = &LastOperationOutput{
+			Type:        string(details.LastOperation.Type),
+			State:       string(details.LastOperation.State),
+			Description: details.LastOperation.Description,
+			CreatedAt:   details.LastOperation.CreatedAt,
+			UpdatedAt:   details.LastOperation.UpdatedAt,
+		}
+	}
+
+	for _, binding := range details.BoundApps {
+		output.BoundApps = append(output.BoundApps, ServiceBindingOutput{
+			App:  binding.AppName,
+			Name: binding.Name,
+			LastOperation: LastOperationOutput{
+				Type:        string(binding.LastOperation.Type),
+				State:       string(binding.LastOperation.State),
+				Description: binding.LastOperation.Description,
+				CreatedAt:   binding.LastOperation.CreatedAt,
+				UpdatedAt:   binding.LastOperation.UpdatedAt,
+			},
+		})
+	}
+
+	if details.Type == resources.UserProvidedServiceInstance {
+		output.Upgrade = "not_supported"
+		return output
+	}
+
+	output.Broker = details.ServiceBrokerName
+	output.Offering = details.ServiceOffering.Name
+	output.Plan = details.ServicePlan.Name
+	output.OfferingTags = optionalStrings(details.ServiceOffering.Tags)
+	output.Description = details.ServiceOffering.Description
+	output.Documentation = details.ServiceOffering.DocumentationURL
+
+	if details.SharedStatus.IsSharedFromOriginalSpace {
+		output.SharedFrom = &ServiceSharedFromOutput{
+			Org:   details.OrganizationName,
+			Space: details.SpaceName,
+		}
+	}
+	for _, usage := range details.SharedStatus.UsageSummary {
+		output.SharedWith = append(output.SharedWith, ServiceSharedToOutput{
+			Org:      usage.OrganizationName,
+			Space:    usage.SpaceName,
+			Bindings: usage.BoundAppCount,
+		})
+	}
+
+	switch details.UpgradeStatus.State {
+	case v7action.ServiceInstanceUpgradeAvailable:
+		output.Upgrade = "available"
+	case v7action.ServiceInstanceUpgradeNotAvailable:
+		output.Upgrade = "not_available"
+	default:
+		output.Upgrade = "not_supported"
+	}
+	return output
+}
+
+func optionalStrings(value types.OptionalStringSlice) []string {
+	strs := []string{}
+	return append(strs, value.Value...)
+}
+
+func routeOutput(routeSummary v7action.RouteSummary) RouteOutput {
+	output := RouteOutput{
+		GUID:            routeSummary.GUID,
+		URL:             routeSummary.URL,
+		Space:           routeSummary.SpaceName,
+		Host:            routeSummary.Host,
+		Domain:          routeSummary.DomainName,
+		Port:            routeSummary.Port,
+		Path:            routeSummary.Path,
+		Protocol:        routeSummary.Protocol,
+		AppProtocols:    []string{},
+		Apps:            []string{},
+		ServiceInstance: routeSummary.ServiceInstanceName,
+	}
+	output.AppProtocols = append(output.AppProtocols, routeSummary.AppProtocols...)
+	output.Apps = append(output.Apps, routeSummary.AppNames...)
+	return output
+}
+
+func buildpackOutput(buildpack resources.Buildpack) BuildpackOutput {
+	return BuildpackOutput{
+		Name:     buildpack.Name,
+		GUID:     buildpack.GUID,
+		Position: buildpack.Position.Value,
+		Stack:    buildpack.Stack,
+		Enabled:  buildpack.Enabled.Value,
+		Locked:   buildpack.Locked.Value,
+		Filename: buildpack.Filename,
+		State:    buildpack.State,
+	}
+}
+
+func securityGroupOutput(summary v7action.SecurityGroupSummary) SecurityGroupOutput {
+	output := SecurityGroupOutput{
+		Name:     summary.Name,
+		Rules:    []resources.Rule{},
+		Bindings: []SecurityGroupBindingOutput{},
+	}
+	output.Rules = append(output.Rules, summary.Rules...)
+	for _, space := range summary.SecurityGroupSpaces {
+		output.Bindings = append(output.Bindings, SecurityGroupBindingOutput{
+			Org:       space.OrgName,
+			Space:     space.SpaceName,
+			Lifecycle: space.Lifecycle,
+		})
+	}
+	return output
+}
+
+func orgQuotaOutput(quota resources.OrganizationQuota) OrgQuotaOutput {
+	output := OrgQuotaOutput{
+		Name:                  quota.Name,
+		GUID:                  quota.GUID,
+		TotalMemoryInMB:       quotaLimit(quota.Apps.TotalMemory),
+		InstanceMemoryInMB:    quotaLimit(quota.Apps.InstanceMemory),
+		TotalRoutes:           quotaLimit(quota.Routes.TotalRoutes),
+		TotalServiceInstances: quotaLimit(quota.Services.TotalServiceInstances),
+		TotalAppInstances:     quotaLimit(quota.Apps.TotalAppInstances),
+		TotalRoutePorts:       quotaLimit(quota.Routes.TotalReservedPorts),
+		LogRateLimitInBPS:     quotaLimit(quota.Apps.TotalLogVolume),
+	}
+	if quota.Services.PaidServicePlans != nil {
+		output.PaidServicePlans = *quota.Services.PaidServicePlans
+	}
+	return output
+}
+
+func quotaLimit(limit *types.NullInt) *int {
+	if limit == nil || !limit.IsSet {
+		return nil
+	}
+	value := limit.Value
+	return &value
+}
+
+func auditEventOutput(event v7action.AuditEvent) AuditEventOutput {
+	data := event.Data
+	if data == nil {
+		data = map[string]interface{}{}
+	}
+	return AuditEventOutput{
+		GUID:             event.GUID,
+		Time:             event.Time.UTC().Format(time.RFC3339),
+		Type:             event.Type,
+		ActorGUID:        event.ActorGUID,
+		ActorType:        event.ActorType,
+		ActorName:        event.ActorName,
+		TargetGUID:       event.TargetGUID,
+		TargetType:       event.TargetType,
+		TargetName:       event.TargetName,
+		SpaceGUID:        event.SpaceGUID,
+		OrganizationGUID: event.OrganizationGUID,
+		Data:             data,
+	}
+}
+
+func appUsageEventOutput(event v7action.AppUsageEvent) AppUsageEventOutput {
+	return AppUsageEventOutput{
+		GUID:                          event.GUID,
+		Time:                          event.CreatedAt.UTC().Format(time.RFC3339),
+		State:                         event.State,
+		PreviousState:                 event.PreviousState,
+		AppGUID:                       event.AppGUID,
+		AppName:                       event.AppName,
+		ProcessGUID:                   event.ProcessGUID,
+		ProcessType:                   event.ProcessType,
+		TaskGUID:                      event.TaskGUID,
+		TaskName:                      event.TaskName,
+		InstanceCount:                 event.InstanceCount,
+		PreviousInstanceCount:         event.PreviousInstanceCount,
+		MemoryInMBPerInstance:         event.MemoryInMBPerInstance,
+		PreviousMemoryInMBPerInstance: event.PreviousMemoryInMBPerInstance,
+		SpaceGUID:                     event.SpaceGUID,
+		SpaceName:                     event.SpaceName,
+		OrganizationGUID:              event.OrganizationGUID,
+	}
+}
+
+func serviceUsageEventOutput(event v7action.ServiceUsageEvent) ServiceUsageEventOutput {
+	return ServiceUsageEventOutput{
+		GUID:                event.GUID,
+		Time:                event.CreatedAt.UTC().Format(time.RFC3339),
+		State:               event.State,
+		ServiceInstanceGUID: event.ServiceInstanceGUID,
+		ServiceInstanceName: event.ServiceInstanceName,
+		ServiceInstanceType: event.ServiceInstanceType,
+		ServicePlanGUID:     event.ServicePlanGUID,
+		ServicePlanName:     event.ServicePlanName,
+		ServiceOfferingName: event.ServiceOfferingName,
+		ServiceBrokerName:   event.ServiceBrokerName,
+		SpaceGUID:           event.SpaceGUID,
+		SpaceName:           event.SpaceName,
+		OrganizationGUID:    event.OrganizationGUID,
+	}
+}
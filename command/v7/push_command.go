@@ -1,10 +1,12 @@
 package v7
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"os"
 	"strings"
+	"sync"
 
 	"github.com/cloudfoundry/bosh-cli/director/template"
 	log "github.com/sirupsen/logrus"
@@ -26,6 +28,7 @@ import (
 	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/manifestparser"
 	"code.cloudfoundry.org/cli/util/progressbar"
+	"code.cloudfoundry.org/cli/util/ui"
 )
 
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . ProgressBar
@@ -75,6 +78,25 @@ type DiffDisplayer interface {
 	DisplayDiff(rawManifest []byte, diff resources.ManifestDiff) error
 }
 
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . FoundationPushActors
+
+// FoundationPushActors creates the actors used to push to one foundation from
+// a foundations file.
+type FoundationPushActors interface {
+	NewPushActors(target FoundationTarget, ui command.UI) (PushActor, V7ActorForPush, sharedaction.LogCacheClient, error)
+}
+
+type actualFoundationPushActors struct{}
+
+func (actualFoundationPushActors) NewPushActors(target FoundationTarget, ui command.UI) (PushActor, V7ActorForPush, sharedaction.LogCacheClient, error) {
+	logCacheClient, err := logcache.NewClient(target.Config.LogCacheEndpoint(), target.Config, ui, v7action.NewDefaultKubernetesConfigGetter())
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return v7pushaction.NewActor(target.Actor, sharedaction.NewActor(target.Config)), target.Actor, logCacheClient, nil
+}
+
 type PushCommand struct {
 	BaseCommand
 
@@ -86,6 +108,8 @@ type PushCommand struct {
 	DockerUsername          string                              `long:"docker-username" description:"Repository username; used with password from environment variable CF_DOCKER_PASSWORD"`
 	DropletPath             flag.PathWithExistenceCheck         `long:"droplet" description:"Path to a tgz file with a pre-staged app"`
 	HealthCheckHTTPEndpoint string                              `long:"endpoint"  description:"Valid path on the app for an HTTP health check. Only used when specifying --health-check-type=http"`
+	EventStream             bool                                `long:"event-stream" description:"Write push progress to stdout as newline-delimited JSON events and all other output to stderr"`
+	FoundationsFile         flag.PathWithExistenceCheck         `long:"foundations-file" description:"Push to every foundation listed in the given YAML file, one at a time, stopping at the first failure"`
 	HealthCheckType         flag.HealthCheckType                `long:"health-check-type" short:"u" description:"Application health check type. Defaults to 'port'. 'http' requires a valid endpoint, for example, '/health'."`
 	Instances               flag.Instances                      `long:"instances" short:"i" description:"Number of instances"`
 	LogRateLimit            string                              `long:"log-rate-limit" short:"l" description:"Log rate limit per second, in bytes (e.g. 128B, 4K, 1M). -l=-1 represents unlimited"`
@@ -95,6 +119,7 @@ type PushCommand struct {
 	NoRoute                 bool                                `long:"no-route" description:"Do not map a route to this app"`
 	NoStart                 bool                                `long:"no-start" description:"Do not stage and start the app after pushing"`
 	NoWait                  bool                                `long:"no-wait" description:"Exit when the first instance of the web process is healthy"`
+	Parallel                bool                                `long:"parallel" description:"Push to all foundations from --foundations-file at the same time"`
 	AppPath                 flag.PathWithExistenceCheck         `long:"path" short:"p" description:"Path to app directory or to a zip file of the contents of the app directory"`
 	RandomRoute             bool                                `long:"random-route" description:"Create a random route for this app (except when no-route is specified in the manifest)"`
 	Stack                   string                              `long:"stack" short:"s" description:"Stack to use (a stack is a pre-built file system, including an operating system, that can run apps)"`
@@ -104,35 +129,47 @@ type PushCommand struct {
 	Vars                    []template.VarKV                    `long:"var" description:"Variable key value pair for variable substitution, (e.g., name=app1); can specify multiple times"`
 	PathsToVarsFiles        []flag.PathWithExistenceCheck       `long:"vars-file" description:"Path to a variable substitution file for manifest; can specify multiple times"`
 	dockerPassword          interface{}                         `environmentName:"CF_DOCKER_PASSWORD" environmentDescription:"Password used for private docker repository"`
-	usage                   interface{}                         `usage:"CF_NAME push APP_NAME [-b BUILDPACK_NAME]\n   [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start] [--no-wait] [-i NUM_INSTANCES]\n   [-k DISK] [-m MEMORY] [-l LOG_RATE_LIMIT] [-p PATH] [-s STACK] [-t HEALTH_TIMEOUT] [--task TASK]\n   [-u (process | port | http)] [--no-route | --random-route]\n   [--var KEY=VALUE] [--vars-file VARS_FILE_PATH]...\n \n   CF_NAME push APP_NAME --docker-image [REGISTRY_HOST:PORT/]IMAGE[:TAG] [--docker-username USERNAME]\n   [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start] [--no-wait] [-i NUM_INSTANCES]\n   [-k DISK] [-m MEMORY] [-l LOG_RATE_LIMIT] [-p PATH] [-s STACK] [-t HEALTH_TIMEOUT] [--task TASK]\n   [-u (process | port | http)] [--no-route | --random-route ]\n   [--var KEY=VALUE] [--vars-file VARS_FILE_PATH]..."`
+	usage                   interface{}                         `usage:"CF_NAME push APP_NAME [-b BUILDPACK_NAME]\n   [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start] [--no-wait] [-i NUM_INSTANCES]\n   [-k DISK] [-m MEMORY] [-l LOG_RATE_LIMIT] [-p PATH] [-s STACK] [-t HEALTH_TIMEOUT] [--task TASK]\n   [-u (process | port | http)] [--no-route | --random-route]\n   [--var KEY=VALUE] [--vars-file VARS_FILE_PATH]...\n   [--event-stream]\n \n   CF_NAME push APP_NAME --docker-image [REGISTRY_HOST:PORT/]IMAGE[:TAG] [--docker-username USERNAME]\n   [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start] [--no-wait] [-i NUM_INSTANCES]\n   [-k DISK] [-m MEMORY] [-l LOG_RATE_LIMIT] [-p PATH] [-s STACK] [-t HEALTH_TIMEOUT] [--task TASK]\n   [-u (process | port | http)] [--no-route | --random-route ]\n   [--var KEY=VALUE] [--vars-file VARS_FILE_PATH]...\n \n   CF_NAME push APP_NAME --foundations-file FOUNDATIONS_FILE [--parallel]\n   [-f MANIFEST_PATH] [--var KEY=VALUE] [--vars-file VARS_FILE_PATH]..."`
 	envCFStagingTimeout     interface{}                         `environmentName:"CF_STAGING_TIMEOUT" environmentDescription:"Max wait time for staging, in minutes" environmentDefault:"15"`
 	envCFStartupTimeout     interface{}                         `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
 
-	LogCacheClient  sharedaction.LogCacheClient
-	PushActor       PushActor
-	VersionActor    V7ActorForPush
-	ProgressBar     ProgressBar
-	CWD             string
-	ManifestLocator ManifestLocator
-	ManifestParser  ManifestParser
-	DiffDisplayer   DiffDisplayer
+	LogCacheClient       sharedaction.LogCacheClient
+	PushActor            PushActor
+	VersionActor         V7ActorForPush
+	ProgressBar          ProgressBar
+	CWD                  string
+	ManifestLocator      ManifestLocator
+	ManifestParser       ManifestParser
+	DiffDisplayer        DiffDisplayer
+	FoundationPushActors FoundationPushActors
 
 	stopStreamingFunc func()
+	eventWriter       *pushEventWriter
 }
 
 func (cmd *PushCommand) Setup(config command.Config, ui command.UI) error {
-	err := cmd.BaseCommand.Setup(config, ui)
-	if err != nil {
-		return err
-	}
+	if cmd.FoundationsFile != "" {
+		cmd.SetupForFoundations(config, ui)
+		cmd.FoundationPushActors = actualFoundationPushActors{}
+	} else {
+		err := cmd.BaseCommand.Setup(config, ui)
+		if err != nil {
+			return err
+		}
 
-	cmd.ProgressBar = progressbar.NewProgressBar()
-	cmd.VersionActor = cmd.Actor
-	cmd.PushActor = v7pushaction.NewActor(cmd.Actor, sharedaction.NewActor(config))
+		cmd.VersionActor = cmd.Actor
+		cmd.PushActor = v7pushaction.NewActor(cmd.Actor, sharedaction.NewActor(config))
 
-	cmd.LogCacheClient, err = logcache.NewClient(config.LogCacheEndpoint(), config, ui, v7action.NewDefaultKubernetesConfigGetter())
-	if err != nil {
-		return err
+		cmd.LogCacheClient, err = logcache.NewClient(config.LogCacheEndpoint(), config, ui, v7action.NewDefaultKubernetesConfigGetter())
+		if err != nil {
+			return err
+		}
+	}
+
+	if cmd.EventStream {
+		cmd.ProgressBar = progressbar.NewSilentProgressBar()
+	} else {
+		cmd.ProgressBar = progressbar.NewProgressBar()
 	}
 
 	currentDir, err := os.Getwd()
@@ -146,7 +183,31 @@ func (cmd *PushCommand) Setup(config command.Config, ui command.UI) error {
 }
 
 func (cmd PushCommand) Execute(args []string) error {
+	if cmd.FoundationsFile != "" && cmd.EventStream {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--foundations-file", "--event-stream"},
+		}
+	}
+
+	if cmd.FoundationsFile != "" {
+		return cmd.executeOnFoundations()
+	}
+
 	cmd.stopStreamingFunc = nil
+	cmd.eventWriter = nil
+	if !cmd.EventStream {
+		return cmd.execute()
+	}
+
+	cmd.eventWriter = newPushEventWriter(cmd.UI.ReserveOutForData())
+	err := cmd.execute()
+	if err != nil && !cmd.eventWriter.Failed() {
+		cmd.eventWriter.Write(PushStreamEvent{App: cmd.OptionalArgs.AppName, Event: pushFailedEvent, Error: err.Error()})
+	}
+	return err
+}
+
+func (cmd PushCommand) execute() error {
 	err := cmd.SharedActor.CheckTarget(true, true)
 	if err != nil {
 		return err
@@ -167,6 +228,122 @@ func (cmd PushCommand) Execute(args []string) error {
 		return err
 	}
 
+	return cmd.push(user, flagOverrides)
+}
+
+func (cmd PushCommand) executeOnFoundations() error {
+	flagOverrides, err := cmd.GetFlagOverrides()
+	if err != nil {
+		return err
+	}
+
+	err = cmd.ValidateFlags()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Pushing to foundations in {{.FoundationsFile}}...", map[string]interface{}{
+		"FoundationsFile": cmd.FoundationsFile,
+	})
+
+	var results []FoundationResult
+	if cmd.Parallel {
+		results, err = cmd.FoundationsRunner.RunOnFoundations(string(cmd.FoundationsFile), true, true, cmd.pushToFoundationInParallel(flagOverrides))
+	} else {
+		results, err = cmd.FoundationsRunner.RunOnFoundationsSequentially(string(cmd.FoundationsFile), true, true, func(target FoundationTarget) (v7action.Warnings, error) {
+			cmd.UI.DisplayNewline()
+			cmd.UI.DisplayTextWithFlavor("Pushing to foundation {{.Foundation}}...", map[string]interface{}{
+				"Foundation": target.Name,
+			})
+			return nil, cmd.pushToFoundation(target, flagOverrides)
+		})
+	}
+	if err != nil {
+		return err
+	}
+
+	cmd.displayFoundationsSummary(results)
+	return displayFoundationResults(cmd.UI, results)
+}
+
+// pushToFoundationInParallel returns a function that pushes to a foundation
+// while collecting its output, which is displayed in one piece once the push
+// to that foundation is done.
+func (cmd PushCommand) pushToFoundationInParallel(flagOverrides v7pushaction.FlagOverrides) func(FoundationTarget) (v7action.Warnings, error) {
+	var outputMutex sync.Mutex
+
+	return func(target FoundationTarget) (v7action.Warnings, error) {
+		var out, errOut bytes.Buffer
+		foundationUI, err := ui.NewPluginUI(cmd.Config, &out, &errOut)
+		if err != nil {
+			return nil, err
+		}
+		foundationUI.ShareRequestTimings(cmd.UI.RequestTimings())
+
+		foundationCmd := cmd
+		foundationCmd.UI = foundationUI
+		foundationCmd.ProgressBar = progressbar.NewSilentProgressBar()
+		foundationCmd.DiffDisplayer = shared.NewManifestDiffDisplayer(foundationUI)
+		err = foundationCmd.pushToFoundation(target, flagOverrides)
+
+		outputMutex.Lock()
+		defer outputMutex.Unlock()
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayTextWithFlavor("Output of push to foundation {{.Foundation}}:", map[string]interface{}{
+			"Foundation": target.Name,
+		})
+		cmd.UI.GetOut().Write(out.Bytes())
+		cmd.UI.GetErr().Write(errOut.Bytes())
+		return nil, err
+	}
+}
+
+// pushToFoundation pushes to the space targeted in one foundation, adding the
+// foundation's vars files to the ones given with --vars-file.
+func (cmd PushCommand) pushToFoundation(target FoundationTarget, flagOverrides v7pushaction.FlagOverrides) error {
+	pushActor, versionActor, logCacheClient, err := cmd.FoundationPushActors.NewPushActors(target, cmd.UI)
+	if err != nil {
+		return err
+	}
+
+	user, err := target.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.Config = target.Config
+	cmd.Actor = target.Actor
+	cmd.PushActor = pushActor
+	cmd.VersionActor = versionActor
+	cmd.LogCacheClient = logCacheClient
+	cmd.stopStreamingFunc = nil
+
+	var pathsToVarsFiles []string
+	pathsToVarsFiles = append(pathsToVarsFiles, flagOverrides.PathsToVarsFiles...)
+	flagOverrides.PathsToVarsFiles = append(pathsToVarsFiles, target.VarsFiles...)
+
+	return cmd.push(user, flagOverrides)
+}
+
+func (cmd PushCommand) displayFoundationsSummary(results []FoundationResult) {
+	table := [][]string{{cmd.UI.TranslateText("foundation"), cmd.UI.TranslateText("status")}}
+	for _, result := range results {
+		status := "pushed"
+		switch {
+		case result.Skipped:
+			status = "skipped"
+		case result.Err != nil:
+			status = "failed"
+		}
+		table = append(table, []string{result.Name, cmd.UI.TranslateText(status)})
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Push summary:")
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+}
+
+func (cmd PushCommand) push(user configv3.User, flagOverrides v7pushaction.FlagOverrides) error {
 	baseManifest, err := cmd.GetBaseManifest(flagOverrides)
 	if err != nil {
 		return err
@@ -250,8 +427,17 @@ func (cmd PushCommand) Execute(args []string) error {
 
 	for _, plan := range pushPlans {
 		log.WithField("app_name", plan.Application.Name).Info("actualizing")
-		eventStream := cmd.PushActor.Actualize(plan, cmd.ProgressBar)
+		var progressBar v7pushaction.ProgressBar = cmd.ProgressBar
+		if cmd.eventWriter != nil {
+			cmd.eventWriter.Write(PushStreamEvent{App: plan.Application.Name, Event: pushStartedEvent})
+			progressBar = pushEventProgressBar{ProgressBar: cmd.ProgressBar, events: cmd.eventWriter, appName: plan.Application.Name}
+		}
+
+		eventStream := cmd.PushActor.Actualize(plan, progressBar)
 		err := cmd.eventStreamHandler(eventStream)
+		if cmd.eventWriter != nil && err == nil {
+			cmd.eventWriter.Write(PushStreamEvent{App: plan.Application.Name, Event: pushCompleteEvent})
+		}
 
 		if cmd.shouldDisplaySummary(err) {
 			summaryErr := cmd.displayAppSummary(plan)
@@ -466,6 +652,12 @@ func (cmd PushCommand) ValidateFlags() error {
 				"--random-route",
 			},
 		}
+
+	case cmd.Parallel && cmd.FoundationsFile == "":
+		return translatableerror.RequiredFlagsError{
+			Arg1: "--parallel",
+			Arg2: "--foundations-file",
+		}
 	case !cmd.validBuildpacks():
 		return translatableerror.InvalidBuildpacksError{}
 	}
@@ -542,12 +734,18 @@ func (cmd PushCommand) displayAppSummary(plan v7pushaction.PushPlan) error {
 
 func (cmd *PushCommand) eventStreamHandler(eventStream <-chan *v7pushaction.PushEvent) error {
 	for event := range eventStream {
+		if cmd.eventWriter != nil {
+			cmd.eventWriter.PushEvent(event)
+		}
 		cmd.UI.DisplayWarnings(event.Warnings)
 		if event.Err != nil {
 			return event.Err
 		}
 		err := cmd.processEvent(event.Event, event.Plan.Application.Name)
 		if err != nil {
+			if cmd.eventWriter != nil {
+				cmd.eventWriter.Write(PushStreamEvent{App: event.Plan.Application.Name, Event: pushFailedEvent, Error: err.Error()})
+			}
 			return err
 		}
 	}
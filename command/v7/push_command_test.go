@@ -1,8 +1,13 @@
 package v7_test
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
+	"io"
+	"strings"
+	"testing/iotest"
 	"time"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
@@ -12,6 +17,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/v7pushaction"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/commandfakes"
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
@@ -200,6 +206,129 @@ var _ = Describe("push Command", func() {
 				})
 			})
 
+			When("the --event-stream flag is set", func() {
+				var (
+					stdout       *Buffer
+					streamEvents func() []PushStreamEvent
+				)
+
+				BeforeEach(func() {
+					stdout = testUI.Out.(*Buffer)
+					cmd.EventStream = true
+					cmd.NoManifest = true
+					cmd.OptionalArgs.AppName = appName1
+
+					fakeActor.HandleFlagOverridesReturns(
+						manifestparser.Manifest{Applications: []manifestparser.Application{{Name: appName1}}},
+						nil,
+					)
+					fakeActor.CreatePushPlansReturns(
+						[]v7pushaction.PushPlan{{Application: resources.Application{Name: appName1, GUID: "some-app-guid"}}},
+						nil,
+						nil,
+					)
+					fakeProgressBar.NewProgressBarWrapperStub = func(reader io.Reader, _ int64) io.Reader {
+						return reader
+					}
+					fakeActor.ActualizeStub = func(plan v7pushaction.PushPlan, progressBar v7pushaction.ProgressBar) <-chan *v7pushaction.PushEvent {
+						eventStream := make(chan *v7pushaction.PushEvent)
+						go func() {
+							defer close(eventStream)
+							eventStream <- &v7pushaction.PushEvent{Plan: plan, Event: v7pushaction.UploadingApplicationWithArchive}
+							_, _ = io.Copy(io.Discard, progressBar.NewProgressBarWrapper(iotest.OneByteReader(strings.NewReader("0123456789")), 10))
+							eventStream <- &v7pushaction.PushEvent{Plan: plan, Event: v7pushaction.UploadWithArchiveComplete}
+							eventStream <- &v7pushaction.PushEvent{Plan: plan, Warnings: v7pushaction.Warnings{"some-warning"}}
+							eventStream <- &v7pushaction.PushEvent{Plan: plan}
+						}()
+						return eventStream
+					}
+
+					streamEvents = func() []PushStreamEvent {
+						var events []PushStreamEvent
+						decoder := json.NewDecoder(bytes.NewReader(stdout.Contents()))
+						for decoder.More() {
+							var event PushStreamEvent
+							Expect(decoder.Decode(&event)).To(Succeed())
+							Expect(event.Timestamp).ToNot(BeEmpty())
+							event.Timestamp = ""
+							events = append(events, event)
+						}
+						return events
+					}
+				})
+
+				It("writes the push progress as JSON lines to stdout and everything else to stderr", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					events := streamEvents()
+					Expect(events).To(HaveLen(15))
+					Expect(events[0]).To(Equal(PushStreamEvent{App: appName1, Event: "push_started"}))
+					Expect(events[1]).To(Equal(PushStreamEvent{App: appName1, Event: "uploading_application_with_archive"}))
+					Expect(events[2]).To(Equal(PushStreamEvent{App: appName1, Event: "upload_progress", BytesUploaded: 1, TotalBytes: 10}))
+					Expect(events[11]).To(Equal(PushStreamEvent{App: appName1, Event: "upload_progress", BytesUploaded: 10, TotalBytes: 10}))
+					Expect(events[12]).To(Equal(PushStreamEvent{App: appName1, Event: "upload_complete"}))
+					Expect(events[13]).To(Equal(PushStreamEvent{App: appName1, Event: "warnings", Warnings: []string{"some-warning"}}))
+					Expect(events[14]).To(Equal(PushStreamEvent{App: appName1, Event: "push_complete"}))
+
+					Expect(testUI.Err).To(Say(`Pushing app first-app to org some-org / space some-space as some-user\.\.\.`))
+					Expect(testUI.Err).To(Say("Uploading files..."))
+					Expect(testUI.Err).To(Say("some-warning"))
+				})
+
+				When("pushing the app fails", func() {
+					BeforeEach(func() {
+						fakeActor.ActualizeStub = func(plan v7pushaction.PushPlan, _ v7pushaction.ProgressBar) <-chan *v7pushaction.PushEvent {
+							return FillInEvents([]Step{
+								{Plan: plan, Event: v7pushaction.CreatingArchive},
+								{Plan: plan, Error: errors.New("some-push-error"), Warnings: v7pushaction.Warnings{"some-warning"}},
+							})
+						}
+					})
+
+					It("writes a push_failed event with the error", func() {
+						Expect(executeErr).To(MatchError("some-push-error"))
+						Expect(streamEvents()).To(Equal([]PushStreamEvent{
+							{App: appName1, Event: "push_started"},
+							{App: appName1, Event: "creating_archive"},
+							{App: appName1, Event: "push_failed", Warnings: []string{"some-warning"}, Error: "some-push-error"},
+						}))
+					})
+				})
+
+				When("the push fails before any app is pushed", func() {
+					BeforeEach(func() {
+						fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+					})
+
+					It("writes a push_failed event and keeps all other output off stdout", func() {
+						Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+						testUI.DisplayError(executeErr)
+
+						lines := strings.Split(strings.TrimSpace(string(stdout.Contents())), "\n")
+						Expect(lines).To(HaveLen(1))
+						for _, line := range lines {
+							Expect(json.Valid([]byte(line))).To(BeTrue(), line)
+						}
+						Expect(streamEvents()).To(Equal([]PushStreamEvent{
+							{App: appName1, Event: "push_failed", Error: executeErr.Error()},
+						}))
+						Expect(testUI.Err).To(Say("FAILED"))
+					})
+				})
+
+				When("the --foundations-file flag is also set", func() {
+					BeforeEach(func() {
+						cmd.FoundationsFile = "foundations.yml"
+					})
+
+					It("returns an argument combination error", func() {
+						Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+							Args: []string{"--foundations-file", "--event-stream"},
+						}))
+					})
+				})
+			})
+
 			When("invalid flags are passed", func() {
 				BeforeEach(func() {
 					cmd.DockerUsername = "some-docker-username"
@@ -726,6 +855,145 @@ var _ = Describe("push Command", func() {
 				})
 			})
 		})
+
+		When("the --foundations-file flag is set", func() {
+			var (
+				fakeFoundationsRunner    *v7fakes.FakeFoundationsRunner
+				fakeFoundationPushActors *v7fakes.FakeFoundationPushActors
+				pushActors               map[string]*v7fakes.FakePushActor
+				targets                  []FoundationTarget
+			)
+
+			newTarget := func(name string) FoundationTarget {
+				actor := new(v7fakes.FakeActor)
+				actor.GetCurrentUserReturns(configv3.User{Name: name + "-user"}, nil)
+				config := new(commandfakes.FakeConfig)
+				config.TargetedOrganizationReturns(configv3.Organization{Name: name + "-org", GUID: name + "-org-guid"})
+				config.TargetedSpaceReturns(configv3.Space{Name: name + "-space", GUID: name + "-space-guid"})
+
+				pushActor := new(v7fakes.FakePushActor)
+				pushActor.HandleFlagOverridesReturns(manifestparser.Manifest{
+					Applications: []manifestparser.Application{{Name: appName1}},
+				}, nil)
+				pushActor.CreatePushPlansReturns([]v7pushaction.PushPlan{
+					{Application: resources.Application{Name: appName1}},
+				}, nil, nil)
+				pushActor.ActualizeStub = func(v7pushaction.PushPlan, v7pushaction.ProgressBar) <-chan *v7pushaction.PushEvent {
+					return FillInEvents([]Step{})
+				}
+				pushActors[name] = pushActor
+
+				return FoundationTarget{
+					Name:      name,
+					Actor:     actor,
+					Config:    config,
+					VarsFiles: []string{"vars-" + name + ".yml"},
+				}
+			}
+
+			BeforeEach(func() {
+				cmd.FoundationsFile = "foundations.yml"
+				cmd.PathsToVarsFiles = []flag.PathWithExistenceCheck{"vars.yml"}
+				fakeFoundationsRunner = new(v7fakes.FakeFoundationsRunner)
+				cmd.FoundationsRunner = fakeFoundationsRunner
+				fakeFoundationPushActors = new(v7fakes.FakeFoundationPushActors)
+				cmd.FoundationPushActors = fakeFoundationPushActors
+
+				pushActors = map[string]*v7fakes.FakePushActor{}
+				targets = []FoundationTarget{newTarget("dc1"), newTarget("dc2"), newTarget("dc3")}
+				pushActors["dc2"].CreatePushPlansReturns(nil, nil, errors.New("dc2 push failed"))
+
+				fakeFoundationPushActors.NewPushActorsStub = func(target FoundationTarget, _ command.UI) (PushActor, V7ActorForPush, sharedaction.LogCacheClient, error) {
+					return pushActors[target.Name], fakeVersionActor, fakeLogCacheClient, nil
+				}
+
+				fakeManifestLocator.PathReturns("/push/cmd/test/manifest.yml", true, nil)
+
+				fakeFoundationsRunner.RunOnFoundationsSequentiallyStub = func(_ string, _ bool, _ bool, run func(FoundationTarget) (v7action.Warnings, error)) ([]FoundationResult, error) {
+					var results []FoundationResult
+					failed := false
+					for _, target := range targets {
+						if failed {
+							results = append(results, FoundationResult{Name: target.Name, Skipped: true})
+							continue
+						}
+						warnings, err := run(target)
+						results = append(results, FoundationResult{Name: target.Name, Warnings: warnings, Err: err})
+						failed = err != nil
+					}
+					return results, nil
+				}
+			})
+
+			It("pushes to one foundation at a time with the foundation's vars files and stops at the first failure", func() {
+				Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+				Expect(fakeFoundationsRunner.RunOnFoundationsSequentiallyCallCount()).To(Equal(1))
+				_, targetOrg, targetSpace, _ := fakeFoundationsRunner.RunOnFoundationsSequentiallyArgsForCall(0)
+				Expect(targetOrg).To(BeTrue())
+				Expect(targetSpace).To(BeTrue())
+
+				Expect(fakeManifestParser.InterpolateManifestCallCount()).To(Equal(2))
+				_, varsFiles, _ := fakeManifestParser.InterpolateManifestArgsForCall(0)
+				Expect(varsFiles).To(Equal([]string{"vars.yml", "vars-dc1.yml"}))
+				_, varsFiles, _ = fakeManifestParser.InterpolateManifestArgsForCall(1)
+				Expect(varsFiles).To(Equal([]string{"vars.yml", "vars-dc2.yml"}))
+
+				spaceGUID, orgGUID, _, _ := pushActors["dc1"].CreatePushPlansArgsForCall(0)
+				Expect(spaceGUID).To(Equal("dc1-space-guid"))
+				Expect(orgGUID).To(Equal("dc1-org-guid"))
+				Expect(pushActors["dc1"].ActualizeCallCount()).To(Equal(1))
+				Expect(fakeFoundationPushActors.NewPushActorsCallCount()).To(Equal(2))
+
+				Expect(testUI.Out).To(Say(`Pushing to foundations in foundations.yml\.\.\.`))
+				Expect(testUI.Out).To(Say(`Pushing to foundation dc1\.\.\.`))
+				Expect(testUI.Out).To(Say(`Pushing app first-app to org dc1-org / space dc1-space as dc1-user\.\.\.`))
+				Expect(testUI.Out).To(Say(`Pushing to foundation dc2\.\.\.`))
+				Expect(testUI.Out).ToNot(Say(`Pushing to foundation dc3`))
+			})
+
+			It("displays a summary and fails", func() {
+				Expect(testUI.Out).To(Say(`Push summary:`))
+				Expect(testUI.Out).To(Say(`foundation\s+status`))
+				Expect(testUI.Out).To(Say(`dc1\s+pushed`))
+				Expect(testUI.Out).To(Say(`dc2\s+failed`))
+				Expect(testUI.Out).To(Say(`dc3\s+skipped`))
+
+				Expect(testUI.Err).To(Say("dc2: dc2 push failed"))
+				Expect(executeErr).To(MatchError(translatableerror.FoundationsFailedError{Names: []string{"dc2"}}))
+			})
+
+			When("the --parallel flag is set", func() {
+				BeforeEach(func() {
+					cmd.Parallel = true
+					runOnFoundationTargets(fakeFoundationsRunner, targets...)
+				})
+
+				It("pushes to every foundation and displays the output of each foundation in one piece", func() {
+					Expect(fakeFoundationsRunner.RunOnFoundationsCallCount()).To(Equal(1))
+					Expect(fakeFoundationsRunner.RunOnFoundationsSequentiallyCallCount()).To(Equal(0))
+					Expect(fakeFoundationPushActors.NewPushActorsCallCount()).To(Equal(3))
+					Expect(pushActors["dc3"].ActualizeCallCount()).To(Equal(1))
+
+					Expect(testUI.Out).To(Say(`Output of push to foundation dc1:`))
+					Expect(testUI.Out).To(Say(`Pushing app first-app to org dc1-org / space dc1-space as dc1-user\.\.\.`))
+					Expect(testUI.Out).To(Say(`Output of push to foundation dc2:`))
+					Expect(testUI.Out).To(Say(`Output of push to foundation dc3:`))
+					Expect(testUI.Out).To(Say(`Pushing app first-app to org dc3-org / space dc3-space as dc3-user\.\.\.`))
+
+					Expect(testUI.Out).To(Say(`dc1\s+pushed`))
+					Expect(testUI.Out).To(Say(`dc2\s+failed`))
+					Expect(testUI.Out).To(Say(`dc3\s+pushed`))
+					Expect(executeErr).To(MatchError(translatableerror.FoundationsFailedError{Names: []string{"dc2"}}))
+				})
+
+				It("records the requests of every foundation in the request timings of the command", func() {
+					for i := 0; i < fakeFoundationPushActors.NewPushActorsCallCount(); i++ {
+						_, foundationUI := fakeFoundationPushActors.NewPushActorsArgsForCall(i)
+						Expect(foundationUI.RequestTimings()).To(BeIdenticalTo(testUI.RequestTimings()))
+					}
+				})
+			})
+		})
 	})
 
 	Describe("GetDockerPassword", func() {
@@ -1268,5 +1536,11 @@ var _ = Describe("push Command", func() {
 					"--task", "--strategy=rolling",
 				},
 			}),
+
+		Entry("parallel flag is passed without a foundations file",
+			func() {
+				cmd.Parallel = true
+			},
+			translatableerror.RequiredFlagsError{Arg1: "--parallel", Arg2: "--foundations-file"}),
 	)
 })
@@ -0,0 +1,156 @@
+package v7
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v7pushaction"
+)
+
+const (
+	// pushStartedEvent is written before an app is pushed.
+	pushStartedEvent = "push_started"
+	// pushCompleteEvent is written after an app was pushed successfully.
+	pushCompleteEvent = "push_complete"
+	// pushFailedEvent is written when pushing an app failed.
+	pushFailedEvent = "push_failed"
+	// uploadProgressEvent is written while the app bits or droplet upload.
+	uploadProgressEvent = "upload_progress"
+	// warningsEvent carries warnings that are not tied to a push step.
+	warningsEvent = "warnings"
+)
+
+// PushStreamEvent is one line written by 'cf push --event-stream'.
+type PushStreamEvent struct {
+	App           string   `json:"app,omitempty"`
+	Event         string   `json:"event"`
+	Timestamp     string   `json:"timestamp"`
+	Warnings      []string `json:"warnings,omitempty"`
+	Error         string   `json:"error,omitempty"`
+	BytesUploaded int64    `json:"bytes_uploaded,omitempty"`
+	TotalBytes    int64    `json:"total_bytes,omitempty"`
+}
+
+// pushEventWriter writes push progress as newline-delimited JSON so that
+// tools can follow a push without parsing the human readable output. It is
+// safe to use from the goroutine that reports upload progress.
+type pushEventWriter struct {
+	out    io.Writer
+	now    func() time.Time
+	mutex  sync.Mutex
+	failed bool
+}
+
+func newPushEventWriter(out io.Writer) *pushEventWriter {
+	return &pushEventWriter{out: out, now: time.Now}
+}
+
+// PushEvent writes an event received from the push actor. The actor ends
+// every step with an unnamed event carrying the step's warnings and error,
+// which is written as a warnings or push_failed event.
+func (w *pushEventWriter) PushEvent(event *v7pushaction.PushEvent) {
+	streamEvent := PushStreamEvent{
+		App:      event.Plan.Application.Name,
+		Event:    pushEventType(event.Event),
+		Warnings: event.Warnings,
+	}
+
+	switch {
+	case event.Err != nil:
+		streamEvent.Event = pushFailedEvent
+		streamEvent.Error = event.Err.Error()
+	case streamEvent.Event != "":
+	case len(event.Warnings) > 0:
+		streamEvent.Event = warningsEvent
+	default:
+		return
+	}
+	w.Write(streamEvent)
+}
+
+// Write stamps the event with the current time and writes it on its own line.
+func (w *pushEventWriter) Write(event PushStreamEvent) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	if event.Event == pushFailedEvent {
+		w.failed = true
+	}
+
+	event.Timestamp = w.now().UTC().Format(time.RFC3339Nano)
+	line, err := json.Marshal(event)
+	if err != nil {
+		return
+	}
+	_, _ = w.out.Write(append(line, '\n'))
+}
+
+// Failed returns whether a push_failed event was written.
+func (w *pushEventWriter) Failed() bool {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	return w.failed
+}
+
+// pushEventType turns the push actor's event names into snake case, e.g.
+// "uploading application with archive" becomes
+// "uploading_application_with_archive".
+func pushEventType(event v7pushaction.Event) string {
+	return strings.ReplaceAll(strings.ToLower(string(event)), " ", "_")
+}
+
+// pushEventProgressBar reports upload progress as events in addition to
+// tracking it with the wrapped progress bar.
+type pushEventProgressBar struct {
+	ProgressBar
+	events  *pushEventWriter
+	appName string
+}
+
+func (p pushEventProgressBar) NewProgressBarWrapper(reader io.Reader, sizeOfFile int64) io.Reader {
+	wrapped := p.ProgressBar.NewProgressBarWrapper(reader, sizeOfFile)
+	if wrapped == nil {
+		return nil
+	}
+
+	return &uploadProgressReader{
+		reader:  wrapped,
+		total:   sizeOfFile,
+		events:  p.events,
+		appName: p.appName,
+	}
+}
+
+// uploadProgressReader writes an upload progress event for every tenth of
+// the upload that was read.
+type uploadProgressReader struct {
+	reader       io.Reader
+	total        int64
+	read         int64
+	reportedStep int64
+	events       *pushEventWriter
+	appName      string
+}
+
+func (r *uploadProgressReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.read += int64(n)
+
+	if r.total > 0 {
+		step := r.read * 10 / r.total
+		if step > r.reportedStep {
+			r.reportedStep = step
+			r.events.Write(PushStreamEvent{
+				App:           r.appName,
+				Event:         uploadProgressEvent,
+				BytesUploaded: r.read,
+				TotalBytes:    r.total,
+			})
+		}
+	}
+	return n, err
+}
@@ -0,0 +1,42 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+)
+
+type RemoveFoundationCommand struct {
+	UI     command.UI
+	Config command.Config
+
+	RequiredArgs    flag.FoundationName `positional-args:"yes"`
+	usage           interface{}         `usage:"CF_NAME remove-foundation FOUNDATION_NAME"`
+	relatedCommands interface{}         `related_commands:"add-foundation, foundations"`
+}
+
+func (cmd *RemoveFoundationCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+
+	return nil
+}
+
+func (cmd RemoveFoundationCommand) Execute(args []string) error {
+	name := cmd.RequiredArgs.Foundation
+
+	cmd.UI.DisplayTextWithFlavor("Removing foundation {{.Foundation}}...", map[string]interface{}{
+		"Foundation": name,
+	})
+
+	if _, found := cmd.Config.GetFoundation(name); !found {
+		cmd.UI.DisplayWarning("Foundation {{.Foundation}} does not exist.", map[string]interface{}{
+			"Foundation": name,
+		})
+		cmd.UI.DisplayOK()
+		return nil
+	}
+
+	cmd.Config.RemoveFoundation(name)
+	cmd.UI.DisplayOK()
+	return nil
+}
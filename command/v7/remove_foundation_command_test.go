@@ -0,0 +1,59 @@
+package v7_test
+
+import (
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("remove-foundation command", func() {
+	var (
+		cmd        RemoveFoundationCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		cmd = RemoveFoundationCommand{
+			UI:           testUI,
+			Config:       fakeConfig,
+			RequiredArgs: flag.FoundationName{Foundation: "dc1"},
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("the foundation exists", func() {
+		BeforeEach(func() {
+			fakeConfig.GetFoundationReturns(configv3.Foundation{Name: "dc1"}, true)
+		})
+
+		It("removes the foundation", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Removing foundation dc1\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+
+			Expect(fakeConfig.RemoveFoundationCallCount()).To(Equal(1))
+			Expect(fakeConfig.RemoveFoundationArgsForCall(0)).To(Equal("dc1"))
+		})
+	})
+
+	When("the foundation does not exist", func() {
+		It("warns and succeeds", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Err).To(Say("Foundation dc1 does not exist."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(fakeConfig.RemoveFoundationCallCount()).To(Equal(0))
+		})
+	})
+})
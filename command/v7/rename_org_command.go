@@ -40,6 +40,8 @@ func (cmd RenameOrgCommand) Execute(args []string) error {
 		return err
 	}
 
+	// The GUID of an org targeted with CF_ORG is not looked up here: that org
+	// is not saved in the config file, so its name must not be saved either.
 	if org.GUID == cmd.Config.TargetedOrganization().GUID {
 		cmd.Config.SetOrganizationInformation(org.GUID, org.Name)
 	}
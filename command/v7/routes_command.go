@@ -3,8 +3,12 @@ package v7
 import (
 	"strconv"
 	"strings"
+	"sync"
 
 	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/resources"
 	"code.cloudfoundry.org/cli/util/ui"
 )
@@ -12,13 +16,39 @@ import (
 type RoutesCommand struct {
 	BaseCommand
 
-	usage           interface{} `usage:"CF_NAME routes [--org-level]"`
-	relatedCommands interface{} `related_commands:"check-route, create-route, domains, map-route, unmap-route"`
-	Orglevel        bool        `long:"org-level" description:"List all the routes for all spaces of current organization"`
-	Labels          string      `long:"labels" description:"Selector to filter routes by labels"`
+	usage           interface{}                 `usage:"CF_NAME routes [--org-level] [--foundations-file PATH] [--output json|yaml|csv] [--format TEMPLATE] [--jsonpath EXPRESSION]"`
+	relatedCommands interface{}                 `related_commands:"check-route, create-route, domains, map-route, unmap-route"`
+	FoundationsFile flag.PathWithExistenceCheck `long:"foundations-file" description:"Run against every foundation listed in the given YAML file"`
+	Orglevel        bool                        `long:"org-level" description:"List all the routes for all spaces of current organization"`
+	Labels          string                      `long:"labels" description:"Selector to filter routes by labels"`
+	Output          flag.OutputFormat           `long:"output" description:"Display the routes as json, yaml or csv"`
+	Format          flag.GoTemplate             `long:"format" description:"Display the routes with the given Go template"`
+	JSONPath        flag.JSONPath               `long:"jsonpath" description:"Display the fields of the routes selected by the given JSONPath expression"`
+}
+
+func (cmd *RoutesCommand) Setup(config command.Config, ui command.UI) error {
+	if cmd.FoundationsFile != "" {
+		cmd.SetupForFoundations(config, ui)
+		return nil
+	}
+	return cmd.BaseCommand.Setup(config, ui)
 }
 
 func (cmd RoutesCommand) Execute(args []string) error {
+	if err := cmd.output().validate(); err != nil {
+		return err
+	}
+
+	if cmd.FoundationsFile != "" && cmd.output().IsSet() {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--foundations-file", cmd.output().flagName()},
+		}
+	}
+
+	if cmd.FoundationsFile != "" {
+		return cmd.executeOnFoundations()
+	}
+
 	var (
 		routes   []resources.Route
 		warnings v7action.Warnings
@@ -39,17 +69,21 @@ func (cmd RoutesCommand) Execute(args []string) error {
 	targetedSpace := cmd.Config.TargetedSpace()
 
 	if cmd.Orglevel {
-		cmd.UI.DisplayTextWithFlavor("Getting routes for org {{.CurrentOrg}} as {{.CurrentUser}}...\n", map[string]interface{}{
-			"CurrentOrg":  targetedOrg.Name,
-			"CurrentUser": currentUser.Name,
-		})
+		if !cmd.output().IsSet() {
+			cmd.UI.DisplayTextWithFlavor("Getting routes for org {{.CurrentOrg}} as {{.CurrentUser}}...\n", map[string]interface{}{
+				"CurrentOrg":  targetedOrg.Name,
+				"CurrentUser": currentUser.Name,
+			})
+		}
 		routes, warnings, err = cmd.Actor.GetRoutesByOrg(targetedOrg.GUID, cmd.Labels)
 	} else {
-		cmd.UI.DisplayTextWithFlavor("Getting routes for org {{.CurrentOrg}} / space {{.CurrentSpace}} as {{.CurrentUser}}...\n", map[string]interface{}{
-			"CurrentOrg":   targetedOrg.Name,
-			"CurrentSpace": targetedSpace.Name,
-			"CurrentUser":  currentUser.Name,
-		})
+		if !cmd.output().IsSet() {
+			cmd.UI.DisplayTextWithFlavor("Getting routes for org {{.CurrentOrg}} / space {{.CurrentSpace}} as {{.CurrentUser}}...\n", map[string]interface{}{
+				"CurrentOrg":   targetedOrg.Name,
+				"CurrentSpace": targetedSpace.Name,
+				"CurrentUser":  currentUser.Name,
+			})
+		}
 		routes, warnings, err = cmd.Actor.GetRoutesBySpace(targetedSpace.GUID, cmd.Labels)
 	}
 
@@ -64,8 +98,16 @@ func (cmd RoutesCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.output().IsSet() {
+		output := []RouteOutput{}
+		for _, routeSummary := range routeSummaries {
+			output = append(output, routeOutput(routeSummary))
+		}
+		return cmd.output().display(cmd.UI, output)
+	}
+
 	if len(routes) > 0 {
-		cmd.displayRoutesTable(routeSummaries)
+		cmd.UI.DisplayTableWithHeader("", cmd.routesTable(routeSummaries), ui.DefaultTableSpacePadding)
 	} else {
 		cmd.UI.DisplayText("No routes found.")
 	}
@@ -73,7 +115,62 @@ func (cmd RoutesCommand) Execute(args []string) error {
 	return nil
 }
 
-func (cmd RoutesCommand) displayRoutesTable(routeSummaries []v7action.RouteSummary) {
+func (cmd RoutesCommand) executeOnFoundations() error {
+	cmd.UI.DisplayTextWithFlavor("Getting routes from foundations in {{.FoundationsFile}}...\n", map[string]interface{}{
+		"FoundationsFile": cmd.FoundationsFile,
+	})
+
+	var mutex sync.Mutex
+	summaries := map[string][]v7action.RouteSummary{}
+	results, err := cmd.FoundationsRunner.RunOnFoundations(string(cmd.FoundationsFile), true, !cmd.Orglevel, func(target FoundationTarget) (v7action.Warnings, error) {
+		var (
+			routes      []resources.Route
+			allWarnings v7action.Warnings
+			warnings    v7action.Warnings
+			err         error
+		)
+		if cmd.Orglevel {
+			routes, warnings, err = target.Actor.GetRoutesByOrg(target.Organization.GUID, cmd.Labels)
+		} else {
+			routes, warnings, err = target.Actor.GetRoutesBySpace(target.Space.GUID, cmd.Labels)
+		}
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return allWarnings, err
+		}
+
+		routeSummaries, warnings, err := target.Actor.GetRouteSummaries(routes)
+		allWarnings = append(allWarnings, warnings...)
+		mutex.Lock()
+		defer mutex.Unlock()
+		summaries[target.Name] = routeSummaries
+		return allWarnings, err
+	})
+	if err != nil {
+		return err
+	}
+
+	var (
+		routeSummaries []v7action.RouteSummary
+		foundations    []string
+	)
+	for _, result := range results {
+		for _, routeSummary := range summaries[result.Name] {
+			routeSummaries = append(routeSummaries, routeSummary)
+			foundations = append(foundations, result.Name)
+		}
+	}
+
+	if len(foundations) == 0 {
+		cmd.UI.DisplayText("No routes found.")
+	} else {
+		cmd.UI.DisplayTableWithHeader("", withFoundationColumn(cmd.UI, cmd.routesTable(routeSummaries), foundations), ui.DefaultTableSpacePadding)
+	}
+
+	return displayFoundationResults(cmd.UI, results)
+}
+
+func (cmd RoutesCommand) routesTable(routeSummaries []v7action.RouteSummary) [][]string {
 	var routesTable = [][]string{
 		{
 			cmd.UI.TranslateText("space"),
@@ -87,13 +184,17 @@ func (cmd RoutesCommand) displayRoutesTable(routeSummaries []v7action.RouteSumma
 			cmd.UI.TranslateText("service instance"),
 		},
 	}
+	wide := cmd.Config.TableOptions().Wide
+	if wide {
+		routesTable[0] = append(routesTable[0], wideTableHeader(cmd.UI)...)
+	}
 
 	for _, routeSummary := range routeSummaries {
 		port := ""
 		if routeSummary.Port != 0 {
 			port = strconv.Itoa(routeSummary.Port)
 		}
-		routesTable = append(routesTable, []string{
+		row := []string{
 			routeSummary.SpaceName,
 			routeSummary.Host,
 			routeSummary.DomainName,
@@ -103,8 +204,16 @@ func (cmd RoutesCommand) displayRoutesTable(routeSummaries []v7action.RouteSumma
 			strings.Join(routeSummary.AppProtocols, ", "),
 			strings.Join(routeSummary.AppNames, ", "),
 			routeSummary.ServiceInstanceName,
-		})
+		}
+		if wide {
+			row = append(row, wideTableRow(routeSummary.GUID, routeSummary.CreatedAt, routeSummary.UpdatedAt, routeSummary.Metadata)...)
+		}
+		routesTable = append(routesTable, row)
 	}
 
-	cmd.UI.DisplayTableWithHeader("", routesTable, ui.DefaultTableSpacePadding)
+	return routesTable
+}
+
+func (cmd RoutesCommand) output() structuredOutput {
+	return structuredOutput{output: cmd.Output, format: cmd.Format, jsonPath: cmd.JSONPath}
 }
@@ -1,14 +1,18 @@
 package v7_test
 
 import (
+	"encoding/json"
 	"errors"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v7"
 	"code.cloudfoundry.org/cli/command/v7/v7fakes"
 	"code.cloudfoundry.org/cli/resources"
+	"code.cloudfoundry.org/cli/types"
 	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/ui"
 
@@ -196,6 +200,23 @@ var _ = Describe("routes Command", func() {
 					Expect(testUI.Out).To(Say(`space-3\s+tcp\.domain\s+1024\s+app1, app2`))
 					Expect(testUI.Out).To(Say(`space-3\s+domain4\s+1024\s+http1\s+app1, app2`))
 				})
+
+				When("the --wide flag is set", func() {
+					BeforeEach(func() {
+						fakeConfig.TableOptionsReturns(configv3.TableOptions{Wide: true})
+						routeSummaries[1].CreatedAt = "2021-01-01T00:00:00Z"
+						routeSummaries[1].UpdatedAt = "2021-01-02T00:00:00Z"
+						routeSummaries[1].Metadata = &resources.Metadata{Labels: map[string]types.NullString{"env": types.NewNullString("prod")}}
+					})
+
+					It("adds the guid, timestamps and labels columns", func() {
+						Expect(executeErr).NotTo(HaveOccurred())
+
+						Expect(testUI.Out).To(Say(`service instance\s+guid\s+created\s+updated\s+labels`))
+						Expect(testUI.Out).To(Say(`space-1\s+domain1\s+si-1\s+route-guid-1\s*\n`))
+						Expect(testUI.Out).To(Say(`space-2\s+host-3\s+domain2\s+\/path\/2\s+route-guid-2\s+2021-01-01T00:00:00Z\s+2021-01-02T00:00:00Z\s+env=prod\n`))
+					})
+				})
 			})
 
 			When("getting route summaries fails", func() {
@@ -273,4 +294,107 @@ var _ = Describe("routes Command", func() {
 			})
 		})
 	})
+
+	When("the --foundations-file flag is set", func() {
+		var (
+			fakeFoundationsRunner *v7fakes.FakeFoundationsRunner
+			dc2Actor              *v7fakes.FakeActor
+		)
+
+		BeforeEach(func() {
+			cmd.FoundationsFile = "foundations.yml"
+			fakeFoundationsRunner = new(v7fakes.FakeFoundationsRunner)
+			cmd.FoundationsRunner = fakeFoundationsRunner
+
+			fakeActor.GetRoutesBySpaceReturns([]resources.Route{{GUID: "route-guid-1"}}, v7action.Warnings{"dc1-warning"}, nil)
+			fakeActor.GetRouteSummariesReturns([]v7action.RouteSummary{
+				{Route: resources.Route{GUID: "route-guid-1", Host: "host-1"}, DomainName: "domain-1", SpaceName: "space-1"},
+			}, nil, nil)
+
+			dc2Actor = new(v7fakes.FakeActor)
+			dc2Actor.GetRoutesBySpaceReturns(nil, nil, errors.New("dc2 is down"))
+
+			runOnFoundationTargets(fakeFoundationsRunner,
+				FoundationTarget{Name: "dc1", Actor: fakeActor, Organization: resources.Organization{GUID: "dc1-org-guid"}, Space: resources.Space{GUID: "dc1-space-guid"}},
+				FoundationTarget{Name: "dc2", Actor: dc2Actor, Organization: resources.Organization{GUID: "dc2-org-guid"}, Space: resources.Space{GUID: "dc2-space-guid"}},
+			)
+		})
+
+		It("lists the routes of every foundation with a foundation column", func() {
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+			_, targetOrg, targetSpace, _ := fakeFoundationsRunner.RunOnFoundationsArgsForCall(0)
+			Expect(targetOrg).To(BeTrue())
+			Expect(targetSpace).To(BeTrue())
+
+			spaceGUID, _ := fakeActor.GetRoutesBySpaceArgsForCall(0)
+			Expect(spaceGUID).To(Equal("dc1-space-guid"))
+			Expect(dc2Actor.GetRouteSummariesCallCount()).To(Equal(0))
+
+			Expect(testUI.Out).To(Say(`Getting routes from foundations in foundations.yml\.\.\.`))
+			Expect(testUI.Out).To(Say(`foundation\s+` + tableHeaders))
+			Expect(testUI.Out).To(Say(`dc1\s+space-1\s+host-1\s+domain-1`))
+		})
+
+		It("displays the warnings and errors of each foundation and fails", func() {
+			Expect(testUI.Err).To(Say("dc1: dc1-warning"))
+			Expect(testUI.Err).To(Say("dc2: dc2 is down"))
+			Expect(executeErr).To(MatchError(translatableerror.FoundationsFailedError{Names: []string{"dc2"}}))
+		})
+
+		When("--org-level is passed in", func() {
+			BeforeEach(func() {
+				cmd.Orglevel = true
+				fakeActor.GetRoutesByOrgReturns([]resources.Route{{GUID: "route-guid-1"}}, nil, nil)
+			})
+
+			It("lists the routes of the org in every foundation without requiring a space", func() {
+				_, targetOrg, targetSpace, _ := fakeFoundationsRunner.RunOnFoundationsArgsForCall(0)
+				Expect(targetOrg).To(BeTrue())
+				Expect(targetSpace).To(BeFalse())
+
+				orgGUID, _ := fakeActor.GetRoutesByOrgArgsForCall(0)
+				Expect(orgGUID).To(Equal("dc1-org-guid"))
+				orgGUID, _ = dc2Actor.GetRoutesByOrgArgsForCall(0)
+				Expect(orgGUID).To(Equal("dc2-org-guid"))
+			})
+		})
+	})
+
+	When("the --output flag is set", func() {
+		BeforeEach(func() {
+			cmd.Output = flag.OutputFormat{Format: flag.OutputJSON}
+			fakeActor.GetRoutesBySpaceReturns([]resources.Route{{GUID: "route-guid"}}, v7action.Warnings{"warning-1"}, nil)
+			fakeActor.GetRouteSummariesReturns([]v7action.RouteSummary{
+				{
+					Route:      resources.Route{GUID: "route-guid", Host: "host", URL: "host.domain.com/path", Path: "/path", Protocol: "http"},
+					DomainName: "domain.com",
+					SpaceName:  "some-space",
+					AppNames:   []string{"app-1"},
+				},
+			}, nil, nil)
+		})
+
+		It("displays the routes as JSON without the header", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			var routes []map[string]interface{}
+			Expect(json.Unmarshal(testUI.Out.(*Buffer).Contents(), &routes)).To(Succeed())
+			Expect(routes).To(Equal([]map[string]interface{}{
+				{
+					"guid":             "route-guid",
+					"url":              "host.domain.com/path",
+					"space":            "some-space",
+					"host":             "host",
+					"domain":           "domain.com",
+					"port":             float64(0),
+					"path":             "/path",
+					"protocol":         "http",
+					"app_protocols":    []interface{}{},
+					"apps":             []interface{}{"app-1"},
+					"service_instance": "",
+				},
+			}))
+			Expect(testUI.Err).To(Say("warning-1"))
+		})
+	})
 })
@@ -1,17 +1,25 @@
 package v7
 
 import (
+	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/util/ui"
 )
 
 type SecurityGroupsCommand struct {
 	BaseCommand
 
-	usage           interface{} `usage:"CF_NAME security-groups"`
-	relatedCommands interface{} `related_commands:"bind-running-security-group, bind-security-group, bind-staging-security-group, security-group"`
+	Output          flag.OutputFormat `long:"output" description:"Display the security groups as json, yaml or csv"`
+	Format          flag.GoTemplate   `long:"format" description:"Display the security groups with the given Go template"`
+	JSONPath        flag.JSONPath     `long:"jsonpath" description:"Display the fields of the security groups selected by the given JSONPath expression"`
+	usage           interface{}       `usage:"CF_NAME security-groups [--output json|yaml|csv] [--format TEMPLATE] [--jsonpath EXPRESSION]"`
+	relatedCommands interface{}       `related_commands:"bind-running-security-group, bind-security-group, bind-staging-security-group, security-group"`
 }
 
 func (cmd SecurityGroupsCommand) Execute(args []string) error {
+	if err := cmd.output().validate(); err != nil {
+		return err
+	}
+
 	err := cmd.SharedActor.CheckTarget(false, false)
 	if err != nil {
 		return err
@@ -22,10 +30,12 @@ func (cmd SecurityGroupsCommand) Execute(args []string) error {
 		return err
 	}
 
-	cmd.UI.DisplayTextWithFlavor("Getting security groups as {{.Username}}...", map[string]interface{}{
-		"Username": user.Name,
-	})
-	cmd.UI.DisplayNewline()
+	if !cmd.output().IsSet() {
+		cmd.UI.DisplayTextWithFlavor("Getting security groups as {{.Username}}...", map[string]interface{}{
+			"Username": user.Name,
+		})
+		cmd.UI.DisplayNewline()
+	}
 
 	securityGroupSummaries, warnings, err := cmd.Actor.GetSecurityGroups()
 	cmd.UI.DisplayWarnings(warnings)
@@ -33,6 +43,14 @@ func (cmd SecurityGroupsCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.output().IsSet() {
+		output := []SecurityGroupOutput{}
+		for _, securityGroupSummary := range securityGroupSummaries {
+			output = append(output, securityGroupOutput(securityGroupSummary))
+		}
+		return cmd.output().display(cmd.UI, output)
+	}
+
 	if len(securityGroupSummaries) == 0 {
 		cmd.UI.DisplayText("No security groups found.")
 		return nil
@@ -67,3 +85,7 @@ func (cmd SecurityGroupsCommand) Execute(args []string) error {
 
 	return nil
 }
+
+func (cmd SecurityGroupsCommand) output() structuredOutput {
+	return structuredOutput{output: cmd.Output, format: cmd.Format, jsonPath: cmd.JSONPath}
+}
@@ -1,10 +1,13 @@
 package v7_test
 
 import (
+	"encoding/json"
+
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/cf/errors"
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
 	. "code.cloudfoundry.org/cli/command/v7"
 	"code.cloudfoundry.org/cli/command/v7/v7fakes"
 	"code.cloudfoundry.org/cli/resources"
@@ -167,4 +170,44 @@ var _ = Describe("Security Groups Command", func() {
 			})
 		})
 	})
+
+	When("the --output flag is set", func() {
+		BeforeEach(func() {
+			cmd.Output = flag.OutputFormat{Format: flag.OutputJSON}
+			fakeActor.GetSecurityGroupsReturns([]v7action.SecurityGroupSummary{
+				{
+					Name:  "public_networks",
+					Rules: []resources.Rule{{Protocol: "all", Destination: "0.0.0.0-9.255.255.255"}},
+					SecurityGroupSpaces: []v7action.SecurityGroupSpace{
+						{OrgName: "org-1", SpaceName: "space-1", Lifecycle: "running"},
+					},
+				},
+				{Name: "unbound"},
+			}, v7action.Warnings{"warning-1"}, nil)
+		})
+
+		It("displays the security groups as JSON without the header", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			var securityGroups []map[string]interface{}
+			Expect(json.Unmarshal(testUI.Out.(*Buffer).Contents(), &securityGroups)).To(Succeed())
+			Expect(securityGroups).To(Equal([]map[string]interface{}{
+				{
+					"name": "public_networks",
+					"rules": []interface{}{
+						map[string]interface{}{"protocol": "all", "destination": "0.0.0.0-9.255.255.255"},
+					},
+					"bindings": []interface{}{
+						map[string]interface{}{"org": "org-1", "space": "space-1", "lifecycle": "running"},
+					},
+				},
+				{
+					"name":     "unbound",
+					"rules":    []interface{}{},
+					"bindings": []interface{}{},
+				},
+			}))
+			Expect(testUI.Err).To(Say("warning-1"))
+		})
+	})
 })
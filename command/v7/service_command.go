@@ -6,6 +6,7 @@ import (
 
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/resources"
 	"code.cloudfoundry.org/cli/util/ui"
 )
@@ -18,11 +19,29 @@ type ServiceCommand struct {
 	RequiredArgs    flag.ServiceInstance `positional-args:"yes"`
 	ShowGUID        bool                 `long:"guid" description:"Retrieve and display the given service instances's guid. All other output is suppressed."`
 	Params          bool                 `long:"params" description:"Retrieve and display the given service instances's parameters. All other output is suppressed."`
-	usage           interface{}          `usage:"CF_NAME service SERVICE_INSTANCE"`
+	Output          flag.OutputFormat    `long:"output" description:"Display the service instance as json, yaml or csv"`
+	Format          flag.GoTemplate      `long:"format" description:"Display the service instance with the given Go template"`
+	JSONPath        flag.JSONPath        `long:"jsonpath" description:"Display the fields of the service instance selected by the given JSONPath expression"`
+	usage           interface{}          `usage:"CF_NAME service SERVICE_INSTANCE [--output json|yaml|csv] [--format TEMPLATE] [--jsonpath EXPRESSION]"`
 	relatedCommands interface{}          `related_commands:"bind-service, rename-service, update-service"`
 }
 
 func (cmd ServiceCommand) Execute(args []string) error {
+	if err := cmd.output().validate(); err != nil {
+		return err
+	}
+
+	if cmd.output().IsSet() && (cmd.ShowGUID || cmd.Params) {
+		args := []string{cmd.output().flagName()}
+		if cmd.ShowGUID {
+			args = append(args, "--guid")
+		}
+		if cmd.Params {
+			args = append(args, "--params")
+		}
+		return translatableerror.ArgumentCombinationError{Args: args}
+	}
+
 	if err := cmd.SharedActor.CheckTarget(true, true); err != nil {
 		return err
 	}
@@ -67,8 +86,10 @@ func (cmd ServiceCommand) fetchAndDisplayParams() error {
 }
 
 func (cmd ServiceCommand) fetchAndDisplayDetails() error {
-	if err := cmd.displayIntro(); err != nil {
-		return err
+	if !cmd.output().IsSet() {
+		if err := cmd.displayIntro(); err != nil {
+			return err
+		}
 	}
 
 	serviceInstanceWithDetails, warnings, err := cmd.Actor.GetServiceInstanceDetails(
@@ -81,6 +102,10 @@ func (cmd ServiceCommand) fetchAndDisplayDetails() error {
 		return err
 	}
 
+	if cmd.output().IsSet() {
+		return cmd.output().display(cmd.UI, serviceOutput(serviceInstanceWithDetails))
+	}
+
 	switch {
 	case serviceInstanceWithDetails.Type == resources.UserProvidedServiceInstance:
 		cmd.displayPropertiesUserProvided(serviceInstanceWithDetails)
@@ -253,3 +278,7 @@ func (cmd ServiceCommand) displayBoundApps(serviceInstanceWithDetails v7action.S
 	cmd.UI.DisplayTableWithHeader(indent, table, ui.DefaultTableSpacePadding)
 	cmd.UI.DisplayNewline()
 }
+
+func (cmd ServiceCommand) output() structuredOutput {
+	return structuredOutput{output: cmd.Output, format: cmd.Format, jsonPath: cmd.JSONPath}
+}
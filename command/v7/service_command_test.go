@@ -1,11 +1,14 @@
 package v7_test
 
 import (
+	"encoding/json"
 	"errors"
 	"strings"
 
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v7"
 	"code.cloudfoundry.org/cli/command/v7/v7fakes"
 	"code.cloudfoundry.org/cli/resources"
@@ -832,4 +835,60 @@ var _ = Describe("service command", func() {
 			Expect(executeErr).To(MatchError("explode"))
 		})
 	})
+
+	When("the --output flag is set", func() {
+		BeforeEach(func() {
+			cmd.Output = flag.OutputFormat{Format: flag.OutputJSON}
+			fakeActor.GetServiceInstanceDetailsReturns(
+				v7action.ServiceInstanceDetails{
+					ServiceInstance: resources.ServiceInstance{
+						GUID: "some-guid",
+						Name: "some-instance",
+						Type: resources.ManagedServiceInstance,
+						Tags: types.NewOptionalStringSlice("foo"),
+					},
+					ServiceBrokerName: "some-broker",
+					ServiceOffering:   resources.ServiceOffering{Name: "some-offering"},
+					ServicePlan:       resources.ServicePlan{Name: "some-plan"},
+					UpgradeStatus:     v7action.ServiceInstanceUpgradeStatus{State: v7action.ServiceInstanceUpgradeAvailable},
+				},
+				v7action.Warnings{"warning-1"},
+				nil,
+			)
+		})
+
+		It("displays the service instance as JSON without the header", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeActor.GetCurrentUserCallCount()).To(Equal(0))
+
+			var instance map[string]interface{}
+			Expect(json.Unmarshal(testUI.Out.(*Buffer).Contents(), &instance)).To(Succeed())
+			Expect(instance).To(Equal(map[string]interface{}{
+				"name":           "some-instance",
+				"guid":           "some-guid",
+				"type":           "managed",
+				"broker":         "some-broker",
+				"offering":       "some-offering",
+				"plan":           "some-plan",
+				"tags":           []interface{}{"foo"},
+				"offering_tags":  []interface{}{},
+				"last_operation": nil,
+				"bound_apps":     []interface{}{},
+				"upgrade":        "available",
+			}))
+			Expect(testUI.Err).To(Say("warning-1"))
+		})
+
+		When("the --params flag is also set", func() {
+			BeforeEach(func() {
+				cmd.Params = true
+			})
+
+			It("returns an argument combination error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+					Args: []string{"--output", "--params"},
+				}))
+			})
+		})
+	})
 })
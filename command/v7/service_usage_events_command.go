@@ -0,0 +1,156 @@
+package v7
+
+import (
+	"strconv"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+type ServiceUsageEventsCommand struct {
+	BaseCommand
+
+	usage           interface{}       `usage:"CF_NAME service-usage-events [--after-guid GUID] [--since TIME] [--until TIME] [--summarize] [--output json|yaml|csv]\n\nEXAMPLES:\n   CF_NAME service-usage-events --since 2h\n   CF_NAME service-usage-events --after-guid 8c2b3c5e-0a7f-4f4d-9a0d-6d1f1b0b6a4e --output json\n   CF_NAME service-usage-events --since 2021-03-01 --until 2021-04-01 --summarize --output csv"`
+	relatedCommands interface{}       `related_commands:"app-usage-events, audit-events"`
+	AfterGUID       string            `long:"after-guid" description:"Only show events that happened after the event with this GUID, such as the last event shown previously"`
+	Since           flag.Timestamp    `long:"since" description:"Only show events at or after this time, such as 2021-03-04T05:06:07Z, 2021-03-04 or 2h (ago)"`
+	Until           flag.Timestamp    `long:"until" description:"Only show events at or before this time, such as 2021-03-04T05:06:07Z, 2021-03-04 or 2h (ago)"`
+	Summarize       bool              `long:"summarize" description:"Show the service instances and instance-hours of every space between --since and --until (or now) instead of the events"`
+	Output          flag.OutputFormat `long:"output" description:"Display the events or summary as json, yaml or csv"`
+	Format          flag.GoTemplate   `long:"format" description:"Display the events or summary with the given Go template"`
+	JSONPath        flag.JSONPath     `long:"jsonpath" description:"Display the fields of the events or summary selected by the given JSONPath expression"`
+}
+
+func (cmd ServiceUsageEventsCommand) Execute(args []string) error {
+	if err := cmd.output().validate(); err != nil {
+		return err
+	}
+	if err := validateTimeRange(cmd.Since, cmd.Until); err != nil {
+		return err
+	}
+
+	err := cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	filter := v7action.UsageEventFilter{
+		AfterGUID: cmd.AfterGUID,
+		Since:     cmd.Since.Time,
+		Until:     cmd.Until.Time,
+	}
+
+	if cmd.Summarize {
+		return cmd.displaySummary(user.Name, filter)
+	}
+
+	if !cmd.output().IsSet() {
+		cmd.UI.DisplayTextWithFlavor("Getting service usage events as {{.CurrentUser}}...", map[string]interface{}{
+			"CurrentUser": user.Name,
+		})
+		cmd.UI.DisplayNewline()
+	}
+
+	events, warnings, err := cmd.Actor.GetServiceUsageEvents(filter)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if cmd.output().IsSet() {
+		output := []ServiceUsageEventOutput{}
+		for _, event := range events {
+			output = append(output, serviceUsageEventOutput(event))
+		}
+		return cmd.output().display(cmd.UI, output)
+	}
+
+	if len(events) == 0 {
+		cmd.UI.DisplayText("No service usage events found.")
+		return nil
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("guid"),
+			cmd.UI.TranslateText("time"),
+			cmd.UI.TranslateText("state"),
+			cmd.UI.TranslateText("service instance"),
+			cmd.UI.TranslateText("offering"),
+			cmd.UI.TranslateText("plan"),
+			cmd.UI.TranslateText("space"),
+		},
+	}
+	for _, event := range events {
+		table = append(table, []string{
+			event.GUID,
+			event.CreatedAt.Local().Format("2006-01-02T15:04:05.00-0700"),
+			event.State,
+			event.ServiceInstanceName,
+			event.ServiceOfferingName,
+			event.ServicePlanName,
+			event.SpaceName,
+		})
+	}
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+
+	return nil
+}
+
+func (cmd ServiceUsageEventsCommand) displaySummary(userName string, filter v7action.UsageEventFilter) error {
+	if !cmd.output().IsSet() {
+		cmd.UI.DisplayTextWithFlavor("Summarizing service usage as {{.CurrentUser}}...", map[string]interface{}{
+			"CurrentUser": userName,
+		})
+		cmd.UI.DisplayNewline()
+	}
+
+	summaries, warnings, err := cmd.Actor.GetServiceUsageSummary(filter)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if cmd.output().IsSet() {
+		output := []ServiceUsageSummaryOutput{}
+		for _, summary := range summaries {
+			output = append(output, ServiceUsageSummaryOutput(summary))
+		}
+		return cmd.output().display(cmd.UI, output)
+	}
+
+	if len(summaries) == 0 {
+		cmd.UI.DisplayText("No service usage found.")
+		return nil
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("org"),
+			cmd.UI.TranslateText("space"),
+			cmd.UI.TranslateText("service instances"),
+			cmd.UI.TranslateText("instance-hours"),
+		},
+	}
+	for _, summary := range summaries {
+		table = append(table, []string{
+			summary.OrganizationName,
+			summary.SpaceName,
+			strconv.Itoa(summary.ServiceInstances),
+			formatUsageHours(summary.InstanceHours),
+		})
+	}
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+
+	return nil
+}
+
+func (cmd ServiceUsageEventsCommand) output() structuredOutput {
+	return structuredOutput{output: cmd.Output, format: cmd.Format, jsonPath: cmd.JSONPath}
+}
@@ -0,0 +1,151 @@
+package v7_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("service-usage-events Command", func() {
+	var (
+		cmd             ServiceUsageEventsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeActor
+		executeErr      error
+		eventTime       time.Time
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeActor)
+
+		cmd = ServiceUsageEventsCommand{
+			BaseCommand: BaseCommand{
+				UI:          testUI,
+				Config:      fakeConfig,
+				Actor:       fakeActor,
+				SharedActor: fakeSharedActor,
+			},
+		}
+
+		fakeActor.GetCurrentUserReturns(configv3.User{Name: "steve"}, nil)
+
+		eventTime = time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC)
+		fakeActor.GetServiceUsageEventsReturns([]v7action.ServiceUsageEvent{
+			{
+				GUID:                "event-1",
+				CreatedAt:           eventTime,
+				State:               "CREATED",
+				ServiceInstanceName: "some-db",
+				ServiceOfferingName: "postgres",
+				ServicePlanName:     "small",
+				SpaceName:           "some-space",
+			},
+		}, v7action.Warnings{"events-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: "faceman"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: "faceman"}))
+			Expect(fakeActor.GetServiceUsageEventsCallCount()).To(Equal(0))
+		})
+	})
+
+	It("displays the events", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+
+		Expect(testUI.Out).To(Say(`Getting service usage events as steve\.\.\.`))
+		Expect(testUI.Out).To(Say(`guid\s+time\s+state\s+service instance\s+offering\s+plan\s+space`))
+		Expect(testUI.Out).To(Say(`event-1\s+%s\s+CREATED\s+some-db\s+postgres\s+small\s+some-space`, eventTime.Local().Format("2006-01-02T15:04:05.00-0700")))
+		Expect(testUI.Err).To(Say("events-warning"))
+
+		Expect(fakeActor.GetServiceUsageEventsArgsForCall(0)).To(Equal(v7action.UsageEventFilter{}))
+	})
+
+	When("--until is earlier than --since", func() {
+		BeforeEach(func() {
+			cmd.Since = flag.Timestamp{Time: eventTime, IsSet: true}
+			cmd.Until = flag.Timestamp{Time: eventTime.Add(-time.Hour), IsSet: true}
+		})
+
+		It("returns an incorrect usage error without getting events", func() {
+			Expect(executeErr).To(MatchError(translatableerror.IncorrectUsageError{Message: "--until must not be earlier than --since"}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+			Expect(fakeActor.GetServiceUsageEventsCallCount()).To(Equal(0))
+		})
+	})
+
+	When("there are no events", func() {
+		BeforeEach(func() {
+			cmd.AfterGUID = "last-event-guid"
+			fakeActor.GetServiceUsageEventsReturns(nil, nil, nil)
+		})
+
+		It("says so", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeActor.GetServiceUsageEventsArgsForCall(0)).To(Equal(v7action.UsageEventFilter{AfterGUID: "last-event-guid"}))
+			Expect(testUI.Out).To(Say("No service usage events found."))
+		})
+	})
+
+	When("the --summarize flag is set", func() {
+		BeforeEach(func() {
+			cmd.Summarize = true
+			cmd.Until = flag.Timestamp{Time: eventTime, IsSet: true}
+			fakeActor.GetServiceUsageSummaryReturns([]v7action.ServiceUsageSummary{
+				{
+					OrganizationName: "some-org",
+					SpaceName:        "some-space",
+					ServiceInstances: 2,
+					InstanceHours:    13,
+				},
+			}, v7action.Warnings{"summary-warning"}, nil)
+		})
+
+		It("displays the usage of every space instead of the events", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeActor.GetServiceUsageEventsCallCount()).To(Equal(0))
+			Expect(fakeActor.GetServiceUsageSummaryArgsForCall(0)).To(Equal(v7action.UsageEventFilter{Until: eventTime}))
+
+			Expect(testUI.Out).To(Say(`Summarizing service usage as steve\.\.\.`))
+			Expect(testUI.Out).To(Say(`org\s+space\s+service instances\s+instance-hours`))
+			Expect(testUI.Out).To(Say(`some-org\s+some-space\s+2\s+13\.00`))
+			Expect(testUI.Err).To(Say("summary-warning"))
+		})
+
+		When("summarizing fails", func() {
+			BeforeEach(func() {
+				fakeActor.GetServiceUsageSummaryReturns(nil, v7action.Warnings{"summary-warning"}, errors.New("some-error"))
+			})
+
+			It("returns the error and displays warnings", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(testUI.Err).To(Say("summary-warning"))
+			})
+		})
+	})
+})
@@ -2,10 +2,14 @@ package v7
 
 import (
 	"strings"
+	"sync"
 
 	"code.cloudfoundry.org/cli/resources"
 
 	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/types"
 	"code.cloudfoundry.org/cli/util/ui"
 )
@@ -13,31 +17,104 @@ import (
 type ServicesCommand struct {
 	BaseCommand
 
-	OmitApps        bool        `long:"no-apps" description:"Do not retrieve bound apps information."`
-	relatedCommands interface{} `related_commands:"create-service, marketplace"`
+	FoundationsFile flag.PathWithExistenceCheck `long:"foundations-file" description:"Run against every foundation listed in the given YAML file"`
+	OmitApps        bool                        `long:"no-apps" description:"Do not retrieve bound apps information."`
+	Output          flag.OutputFormat           `long:"output" description:"Display the service instances as json, yaml or csv"`
+	Format          flag.GoTemplate             `long:"format" description:"Display the service instances with the given Go template"`
+	JSONPath        flag.JSONPath               `long:"jsonpath" description:"Display the fields of the service instances selected by the given JSONPath expression"`
+	relatedCommands interface{}                 `related_commands:"create-service, marketplace"`
+}
+
+func (cmd *ServicesCommand) Setup(config command.Config, ui command.UI) error {
+	if cmd.FoundationsFile != "" {
+		cmd.SetupForFoundations(config, ui)
+		return nil
+	}
+	return cmd.BaseCommand.Setup(config, ui)
 }
 
 func (cmd ServicesCommand) Execute(args []string) error {
-	if err := cmd.SharedActor.CheckTarget(true, true); err != nil {
+	if err := cmd.output().validate(); err != nil {
 		return err
 	}
 
-	if err := cmd.displayMessage(); err != nil {
+	if cmd.FoundationsFile != "" && cmd.output().IsSet() {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--foundations-file", cmd.output().flagName()},
+		}
+	}
+
+	if cmd.FoundationsFile != "" {
+		return cmd.executeOnFoundations()
+	}
+
+	if err := cmd.SharedActor.CheckTarget(true, true); err != nil {
 		return err
 	}
 
+	if !cmd.output().IsSet() {
+		if err := cmd.displayMessage(); err != nil {
+			return err
+		}
+	}
+
 	instances, warnings, err := cmd.Actor.GetServiceInstancesForSpace(cmd.Config.TargetedSpace().GUID, cmd.OmitApps)
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
 		return err
 	}
 
+	if cmd.output().IsSet() {
+		output := []ServiceInstanceOutput{}
+		for _, instance := range instances {
+			output = append(output, serviceInstanceOutput(instance))
+		}
+		return cmd.output().display(cmd.UI, output)
+	}
+
 	cmd.displayTable(instances)
 	return nil
 }
 
 func (cmd ServicesCommand) Usage() string {
-	return "CF_NAME services"
+	return "CF_NAME services [--no-apps] [--output json|yaml|csv] [--format TEMPLATE] [--jsonpath EXPRESSION]"
+}
+
+func (cmd ServicesCommand) executeOnFoundations() error {
+	cmd.UI.DisplayTextWithFlavor("Getting service instances from foundations in {{.FoundationsFile}}...", map[string]interface{}{
+		"FoundationsFile": cmd.FoundationsFile,
+	})
+	cmd.UI.DisplayNewline()
+
+	var mutex sync.Mutex
+	instances := map[string][]v7action.ServiceInstance{}
+	results, err := cmd.FoundationsRunner.RunOnFoundations(string(cmd.FoundationsFile), true, true, func(target FoundationTarget) (v7action.Warnings, error) {
+		foundationInstances, warnings, err := target.Actor.GetServiceInstancesForSpace(target.Space.GUID, cmd.OmitApps)
+		mutex.Lock()
+		defer mutex.Unlock()
+		instances[target.Name] = foundationInstances
+		return warnings, err
+	})
+	if err != nil {
+		return err
+	}
+
+	table := NewServicesTable(false, cmd.OmitApps, cmd.Config.TableOptions().Wide)
+	var foundations []string
+	for _, result := range results {
+		for _, si := range instances[result.Name] {
+			table.AppendRow(si)
+			foundations = append(foundations, result.Name)
+		}
+	}
+
+	if len(foundations) == 0 {
+		cmd.UI.DisplayText("No service instances found.")
+	} else {
+		cmd.UI.DisplayTableWithHeader("", withFoundationColumn(cmd.UI, table.table, foundations), ui.DefaultTableSpacePadding)
+	}
+
+	return displayFoundationResults(cmd.UI, results)
 }
 
 func (cmd ServicesCommand) displayMessage() error {
@@ -62,7 +139,7 @@ func (cmd ServicesCommand) displayTable(instances []v7action.ServiceInstance) {
 		return
 	}
 
-	table := NewServicesTable(false, cmd.OmitApps)
+	table := NewServicesTable(false, cmd.OmitApps, cmd.Config.TableOptions().Wide)
 
 	for _, si := range instances {
 		table.AppendRow(si)
@@ -92,12 +169,14 @@ type ServicesTable struct {
 	table    [][]string
 	short    bool
 	showApps bool
+	wide     bool
 }
 
-func NewServicesTable(short bool, omitApps bool) *ServicesTable {
+func NewServicesTable(short bool, omitApps bool, wide bool) *ServicesTable {
 	t := &ServicesTable{
 		short:    short,
 		showApps: !omitApps,
+		wide:     wide,
 	}
 
 	return t.withHeaders()
@@ -116,6 +195,9 @@ func (t *ServicesTable) withHeaders() *ServicesTable {
 		}
 		headers = append(headers, "last operation", "broker", "upgrade available")
 	}
+	if t.wide {
+		headers = append(headers, wideColumns...)
+	}
 	t.table = [][]string{headers}
 	return t
 }
@@ -133,5 +215,12 @@ func (t *ServicesTable) AppendRow(si v7action.ServiceInstance) {
 		}
 		row = append(row, si.LastOperation, si.ServiceBrokerName, upgradeAvailableString(si.UpgradeAvailable))
 	}
+	if t.wide {
+		row = append(row, wideTableRow(si.GUID, si.CreatedAt, si.UpdatedAt, si.Metadata)...)
+	}
 	t.table = append(t.table, row)
 }
+
+func (cmd ServicesCommand) output() structuredOutput {
+	return structuredOutput{output: cmd.Output, format: cmd.Format, jsonPath: cmd.JSONPath}
+}
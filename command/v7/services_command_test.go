@@ -1,9 +1,13 @@
 package v7_test
 
 import (
+	"encoding/json"
+
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/cf/errors"
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v7"
 	"code.cloudfoundry.org/cli/command/v7/v7fakes"
 	"code.cloudfoundry.org/cli/resources"
@@ -163,6 +167,38 @@ var _ = Describe("services command", func() {
 		})
 	})
 
+	When("the --wide flag is set", func() {
+		BeforeEach(func() {
+			fakeConfig.TableOptionsReturns(configv3.TableOptions{Wide: true})
+			fakeActor.GetServiceInstancesForSpaceReturns(
+				[]v7action.ServiceInstance{
+					{
+						GUID:                "msi1-guid",
+						Name:                "msi1",
+						Type:                resources.ManagedServiceInstance,
+						ServicePlanName:     "fake-plan-1",
+						ServiceOfferingName: "fake-offering-1",
+						ServiceBrokerName:   "fake-broker-1",
+						LastOperation:       "create succeeded",
+						CreatedAt:           "2021-01-01T00:00:00Z",
+						UpdatedAt:           "2021-01-02T00:00:00Z",
+						Metadata:            &resources.Metadata{Labels: map[string]types.NullString{"env": types.NewNullString("prod")}},
+					},
+				},
+				nil,
+				nil,
+			)
+		})
+
+		It("adds the guid, timestamps and labels columns", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			Expect(testUI.Out).To(SatisfyAll(
+				Say(`name\s+offering\s+plan\s+bound apps\s+last operation\s+broker\s+upgrade available\s+guid\s+created\s+updated\s+labels\n`),
+				Say(`msi1\s+fake-offering-1\s+fake-plan-1\s+create succeeded\s+fake-broker-1\s+msi1-guid\s+2021-01-01T00:00:00Z\s+2021-01-02T00:00:00Z\s+env=prod\n`),
+			))
+		})
+	})
+
 	When("there are no service instances", func() {
 		BeforeEach(func() {
 			fakeActor.GetServiceInstancesForSpaceReturns(
@@ -213,4 +249,116 @@ var _ = Describe("services command", func() {
 			Expect(executeErr).To(MatchError("a bad thing happened"))
 		})
 	})
+
+	When("the --foundations-file flag is set", func() {
+		var (
+			fakeFoundationsRunner *v7fakes.FakeFoundationsRunner
+			dc2Actor              *v7fakes.FakeActor
+		)
+
+		BeforeEach(func() {
+			cmd.FoundationsFile = "foundations.yml"
+			fakeFoundationsRunner = new(v7fakes.FakeFoundationsRunner)
+			cmd.FoundationsRunner = fakeFoundationsRunner
+
+			dc2Actor = new(v7fakes.FakeActor)
+			dc2Actor.GetServiceInstancesForSpaceReturns(nil, v7action.Warnings{"dc2-warning"}, errors.New("dc2 is down"))
+
+			runOnFoundationTargets(fakeFoundationsRunner,
+				FoundationTarget{Name: "dc1", Actor: fakeActor, Space: resources.Space{GUID: "dc1-space-guid"}},
+				FoundationTarget{Name: "dc2", Actor: dc2Actor, Space: resources.Space{GUID: "dc2-space-guid"}},
+			)
+		})
+
+		It("lists the service instances of every foundation with a foundation column", func() {
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+			_, targetOrg, targetSpace, _ := fakeFoundationsRunner.RunOnFoundationsArgsForCall(0)
+			Expect(targetOrg).To(BeTrue())
+			Expect(targetSpace).To(BeTrue())
+
+			spaceGUID, _ := fakeActor.GetServiceInstancesForSpaceArgsForCall(0)
+			Expect(spaceGUID).To(Equal("dc1-space-guid"))
+
+			Expect(testUI.Out).To(Say(`Getting service instances from foundations in foundations.yml\.\.\.`))
+			Expect(testUI.Out).To(Say(`foundation\s+name\s+offering\s+plan`))
+			Expect(testUI.Out).To(Say(`dc1\s+msi1\s+fake-offering-1\s+fake-plan-1`))
+		})
+
+		It("displays the warnings and errors of each foundation and fails", func() {
+			Expect(testUI.Err).To(Say("dc1: something silly"))
+			Expect(testUI.Err).To(Say("dc2: dc2-warning"))
+			Expect(testUI.Err).To(Say("dc2: dc2 is down"))
+			Expect(executeErr).To(MatchError(translatableerror.FoundationsFailedError{Names: []string{"dc2"}}))
+		})
+	})
+
+	When("the --output flag is set", func() {
+		BeforeEach(func() {
+			cmd.Output = flag.OutputFormat{Format: flag.OutputJSON}
+			fakeActor.GetServiceInstancesForSpaceReturns(
+				[]v7action.ServiceInstance{
+					{
+						Name:                "msi1",
+						Type:                resources.ManagedServiceInstance,
+						ServicePlanName:     "fake-plan-1",
+						ServiceOfferingName: "fake-offering-1",
+						ServiceBrokerName:   "fake-broker-1",
+						BoundApps:           []string{"app-1"},
+						LastOperation:       "create succeeded",
+						UpgradeAvailable:    types.NewOptionalBoolean(true),
+					},
+					{
+						Name:          "upsi",
+						Type:          resources.UserProvidedServiceInstance,
+						LastOperation: "create succeeded",
+					},
+				},
+				v7action.Warnings{"warning-1"},
+				nil,
+			)
+		})
+
+		It("displays the service instances as JSON without the header", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeActor.GetCurrentUserCallCount()).To(Equal(0))
+
+			var instances []map[string]interface{}
+			Expect(json.Unmarshal(testUI.Out.(*Buffer).Contents(), &instances)).To(Succeed())
+			Expect(instances).To(Equal([]map[string]interface{}{
+				{
+					"name":              "msi1",
+					"type":              "managed",
+					"offering":          "fake-offering-1",
+					"plan":              "fake-plan-1",
+					"broker":            "fake-broker-1",
+					"bound_apps":        []interface{}{"app-1"},
+					"last_operation":    "create succeeded",
+					"upgrade_available": true,
+				},
+				{
+					"name":              "upsi",
+					"type":              "user-provided",
+					"offering":          "user-provided",
+					"plan":              "",
+					"broker":            "",
+					"bound_apps":        []interface{}{},
+					"last_operation":    "create succeeded",
+					"upgrade_available": nil,
+				},
+			}))
+			Expect(testUI.Err).To(Say("warning-1"))
+		})
+
+		When("the --foundations-file flag is also set", func() {
+			BeforeEach(func() {
+				cmd.FoundationsFile = "foundations.yml"
+			})
+
+			It("returns an argument combination error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+					Args: []string{"--foundations-file", "--output"},
+				}))
+			})
+		})
+	})
 })
@@ -2,6 +2,7 @@ package shared
 
 import (
 	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cassette"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 	ccWrapper "code.cloudfoundry.org/cli/api/cloudcontroller/wrapper"
 	"code.cloudfoundry.org/cli/api/router"
@@ -10,11 +11,17 @@ import (
 	uaaWrapper "code.cloudfoundry.org/cli/api/uaa/wrapper"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/configv3"
 )
 
 func GetNewClientsAndConnectToCF(config command.Config, ui command.UI, minVersionV3 string) (*ccv3.Client, *uaa.Client, *router.Client, error) {
 	var err error
 
+	err = targetEnvAPI(config, ui)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
 	uaaClient, err := newWrappedUAAClient(config, ui)
 	if err != nil {
 		return nil, nil, nil, err
@@ -25,7 +32,10 @@ func GetNewClientsAndConnectToCF(config command.Config, ui command.UI, minVersio
 		return nil, nil, nil, err
 	}
 
-	ccClient := NewAuthWrappedCloudControllerClient(config, ui, uaaClient)
+	ccClient, err := NewAuthWrappedCloudControllerClient(config, ui, uaaClient)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	ccClient, err = connectToCF(config, ui, ccClient, minVersionV3)
 	if err != nil {
@@ -35,8 +45,40 @@ func GetNewClientsAndConnectToCF(config command.Config, ui command.UI, minVersio
 	return ccClient, uaaClient, routingClient, err
 }
 
-func NewWrappedCloudControllerClient(config command.Config, ui command.UI, extraWrappers ...ccv3.ConnectionWrapper) *ccv3.Client {
+// targetEnvAPI looks up the endpoints of the API given in CF_API the first
+// time it is used, like 'cf api' does for the persisted target. They are kept
+// with the session for the API once it is written.
+func targetEnvAPI(config command.Config, ui command.UI) error {
+	envAPI := config.EnvTarget().API
+	if envAPI == "" || config.APIVersion() != "" || !configv3.IsSameAPI(envAPI, config.Target()) {
+		return nil
+	}
+
+	certs, err := TargetTLSCertificates(config)
+	if err != nil {
+		return err
+	}
+
+	ccClient, err := NewWrappedCloudControllerClient(config, ui)
+	if err != nil {
+		return err
+	}
+	warnings, err := v7action.NewActor(ccClient, config, nil, nil, nil, nil).SetTarget(v7action.TargetSettings{
+		URL:                config.Target(),
+		SkipSSLValidation:  config.SkipSSLValidation(),
+		TLSCertificates:    certs,
+		DialTimeout:        config.DialTimeout(),
+		CircuitBreakerFile: config.CircuitBreakerFile(),
+	})
+	ui.DisplayWarnings(warnings)
+	return err
+}
+
+func NewWrappedCloudControllerClient(config command.Config, ui command.UI, extraWrappers ...ccv3.ConnectionWrapper) (*ccv3.Client, error) {
 	ccWrappers := []ccv3.ConnectionWrapper{}
+	if config.Timings() {
+		ccWrappers = append(ccWrappers, ccWrapper.NewRequestTimer(ui.RequestTimings()))
+	}
 
 	verbose, location := config.Verbose()
 	if verbose {
@@ -45,20 +87,41 @@ func NewWrappedCloudControllerClient(config command.Config, ui command.UI, extra
 	if location != nil {
 		ccWrappers = append(ccWrappers, ccWrapper.NewRequestLogger(ui.RequestLoggerFileWriter(location)))
 	}
+	if harPath := config.TraceHAR(); harPath != "" {
+		ccWrappers = append(ccWrappers, ccWrapper.NewHARLogger(ui.RequestLoggerHARWriter(harPath)))
+	}
 
 	ccWrappers = append(ccWrappers, extraWrappers...)
-	ccWrappers = append(ccWrappers, ccWrapper.NewRetryRequest(config.RequestRetryCount()))
+	retryWrapper := ccWrapper.NewRetryRequest(config.RequestRetryCount())
+	retryWrapper.SetRateLimitNotifier(rateLimitNotifier{ui: ui})
+	ccWrappers = append(ccWrappers, retryWrapper)
+	if ttl := config.HTTPCacheTTL(); ttl > 0 {
+		ccWrappers = append(ccWrappers, ccWrapper.NewResponseCache(config.HTTPCacheDirectory(), ttl))
+	}
+
+	player, recorder, err := newCassette(config)
+	if err != nil {
+		return nil, err
+	}
+	var rawWrappers []ccv3.ConnectionWrapper
+	if player != nil {
+		rawWrappers = append(rawWrappers, ccWrapper.NewCassettePlayer(player))
+	} else if recorder != nil {
+		rawWrappers = append(rawWrappers, ccWrapper.NewCassetteRecorder(recorder))
+	}
 
 	return ccv3.NewClient(ccv3.Config{
 		AppName:            config.BinaryName(),
 		AppVersion:         config.BinaryVersion(),
 		JobPollingTimeout:  config.OverallPollingTimeout(),
 		JobPollingInterval: config.PollingInterval(),
+		PageConcurrency:    config.PageConcurrency(),
+		RawWrappers:        rawWrappers,
 		Wrappers:           ccWrappers,
-	})
+	}), nil
 }
 
-func NewAuthWrappedCloudControllerClient(config command.Config, ui command.UI, uaaClient *uaa.Client) *ccv3.Client {
+func NewAuthWrappedCloudControllerClient(config command.Config, ui command.UI, uaaClient *uaa.Client) (*ccv3.Client, error) {
 	var authWrapper ccv3.ConnectionWrapper
 	authWrapper = ccWrapper.NewUAAAuthentication(uaaClient, config)
 	if config.IsCFOnK8s() {
@@ -75,13 +138,29 @@ func newWrappedUAAClient(config command.Config, ui command.UI) (*uaa.Client, err
 	var err error
 	verbose, location := config.Verbose()
 
+	player, recorder, err := newCassette(config)
+	if err != nil {
+		return nil, err
+	}
+
 	uaaClient := uaa.NewClient(config)
+	if player != nil {
+		uaaClient.WrapConnection(uaaWrapper.NewCassettePlayer(player))
+	} else if recorder != nil {
+		uaaClient.WrapConnection(uaaWrapper.NewCassetteRecorder(recorder))
+	}
+	if config.Timings() {
+		uaaClient.WrapConnection(uaaWrapper.NewRequestTimer(ui.RequestTimings()))
+	}
 	if verbose {
 		uaaClient.WrapConnection(uaaWrapper.NewRequestLogger(ui.RequestLoggerTerminalDisplay()))
 	}
 	if location != nil {
 		uaaClient.WrapConnection(uaaWrapper.NewRequestLogger(ui.RequestLoggerFileWriter(location)))
 	}
+	if harPath := config.TraceHAR(); harPath != "" {
+		uaaClient.WrapConnection(uaaWrapper.NewHARLogger(ui.RequestLoggerHARWriter(harPath)))
+	}
 
 	uaaAuthWrapper := uaaWrapper.NewUAAAuthentication(uaaClient, config)
 	uaaClient.WrapConnection(uaaAuthWrapper)
@@ -96,17 +175,38 @@ func newWrappedUAAClient(config command.Config, ui command.UI) (*uaa.Client, err
 }
 
 func newWrappedRoutingClient(config command.Config, ui command.UI, uaaClient *uaa.Client) (*router.Client, error) {
+	certs, err := TargetTLSCertificates(config)
+	if err != nil {
+		return nil, err
+	}
+
 	routingConfig := router.Config{
 		AppName:    config.BinaryName(),
 		AppVersion: config.BinaryVersion(),
 		ConnectionConfig: router.ConnectionConfig{
 			DialTimeout:       config.DialTimeout(),
 			SkipSSLValidation: config.SkipSSLValidation(),
+			TLSCertificates:   certs,
 		},
 		RoutingEndpoint: config.RoutingEndpoint(),
 	}
 
-	routingWrappers := []router.ConnectionWrapper{routingWrapper.NewErrorWrapper()}
+	player, recorder, err := newCassette(config)
+	if err != nil {
+		return nil, err
+	}
+
+	var routingWrappers []router.ConnectionWrapper
+	if player != nil {
+		routingWrappers = append(routingWrappers, routingWrapper.NewCassettePlayer(player))
+	} else if recorder != nil {
+		routingWrappers = append(routingWrappers, routingWrapper.NewCassetteRecorder(recorder))
+	}
+	routingWrappers = append(routingWrappers, routingWrapper.NewErrorWrapper())
+
+	if config.Timings() {
+		routingWrappers = append(routingWrappers, routingWrapper.NewRequestTimer(ui.RequestTimings()))
+	}
 
 	verbose, location := config.Verbose()
 
@@ -118,6 +218,10 @@ func newWrappedRoutingClient(config command.Config, ui command.UI, uaaClient *ua
 		routingWrappers = append(routingWrappers, routingWrapper.NewRequestLogger(ui.RequestLoggerFileWriter(location)))
 	}
 
+	if harPath := config.TraceHAR(); harPath != "" {
+		routingWrappers = append(routingWrappers, routingWrapper.NewHARLogger(ui.RequestLoggerHARWriter(harPath)))
+	}
+
 	authWrapper := routingWrapper.NewUAAAuthentication(uaaClient, config)
 
 	routingWrappers = append(routingWrappers, authWrapper)
@@ -128,6 +232,25 @@ func newWrappedRoutingClient(config command.Config, ui command.UI, uaaClient *ua
 	return routingClient, nil
 }
 
+// newCassette returns the player for the cassette replayed with
+// CF_REPLAY_CASSETTE, or else the recorder for the cassette recorded with
+// CF_RECORD_CASSETTE. Both are nil when neither is set.
+func newCassette(config command.Config) (*cassette.Player, *cassette.Recorder, error) {
+	if cassettePath := config.ReplayCassette(); cassettePath != "" {
+		player, err := cassette.ReadPlayer(cassettePath)
+		if err != nil {
+			return nil, nil, err
+		}
+		return player, nil, nil
+	}
+
+	if cassettePath := config.RecordCassette(); cassettePath != "" {
+		return nil, cassette.NewRecorder(cassettePath), nil
+	}
+
+	return nil, nil, nil
+}
+
 func connectToCF(config command.Config, ui command.UI, ccClient *ccv3.Client, minVersionV3 string) (*ccv3.Client, error) {
 	if config.Target() == "" {
 		return nil, translatableerror.NoAPISetError{
@@ -135,10 +258,18 @@ func connectToCF(config command.Config, ui command.UI, ccClient *ccv3.Client, mi
 		}
 	}
 
+	certs, err := TargetTLSCertificates(config)
+	if err != nil {
+		return nil, err
+	}
+
 	ccClient.TargetCF(ccv3.TargetSettings{
-		URL:               config.Target(),
-		SkipSSLValidation: config.SkipSSLValidation(),
-		DialTimeout:       config.DialTimeout(),
+		URL:                config.Target(),
+		FailoverURLs:       config.TargetFailoverURLs(),
+		CircuitBreakerFile: config.CircuitBreakerFile(),
+		SkipSSLValidation:  config.SkipSSLValidation(),
+		TLSCertificates:    certs,
+		DialTimeout:        config.DialTimeout(),
 	})
 
 	if minVersionV3 != "" {
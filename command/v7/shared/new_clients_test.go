@@ -1,17 +1,27 @@
 package shared_test
 
 import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
 	"runtime"
 	"time"
 
+	"code.cloudfoundry.org/cli/api/cassette"
 	"code.cloudfoundry.org/cli/command/commandfakes"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/util"
+	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/ui"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/ghttp"
 )
 
 var _ = Describe("New Clients", func() {
@@ -38,6 +48,34 @@ var _ = Describe("New Clients", func() {
 		})
 	})
 
+	When("the client key file saved for the target cannot be read", func() {
+		BeforeEach(func() {
+			fakeConfig.TargetReturns("https://api.example.com")
+			fakeConfig.TLSCertificatesReturns(util.TLSCertificates{}, errors.New("client key file some-key-file could not be read"))
+		})
+
+		It("returns an InvalidTLSCertificatesError", func() {
+			_, _, _, err := GetNewClientsAndConnectToCF(fakeConfig, testUI, "")
+			Expect(err).To(MatchError(translatableerror.InvalidTLSCertificatesError{
+				Message: "client key file some-key-file could not be read",
+			}))
+		})
+	})
+
+	When("the certificates saved for the target cannot be loaded", func() {
+		BeforeEach(func() {
+			fakeConfig.TargetReturns("https://api.example.com")
+			fakeConfig.TLSCertificatesReturns(util.TLSCertificates{CACert: "not-a-cert"}, nil)
+		})
+
+		It("returns an InvalidTLSCertificatesError", func() {
+			_, _, _, err := GetNewClientsAndConnectToCF(fakeConfig, testUI, "")
+			Expect(err).To(MatchError(translatableerror.InvalidTLSCertificatesError{
+				Message: "no PEM encoded certificates found in CA certificate",
+			}))
+		})
+	})
+
 	When("the DialTimeout is set", func() {
 		BeforeEach(func() {
 			if runtime.GOOS == "windows" {
@@ -57,9 +95,104 @@ var _ = Describe("New Clients", func() {
 		})
 	})
 
+	When("CF_API is targeted for the first time", func() {
+		var server *ghttp.Server
+
+		BeforeEach(func() {
+			server = ghttp.NewServer()
+			server.RouteToHandler(http.MethodGet, "/", ghttp.RespondWith(http.StatusOK, fmt.Sprintf(`{
+				"links": {
+					"self": {"href": "%[1]s"},
+					"cloud_controller_v3": {"href": "%[1]s/v3", "meta": {"version": "3.99.0"}},
+					"login": {"href": "https://login.example.com"},
+					"uaa": {"href": "https://uaa.example.com"}
+				}
+			}`, server.URL())))
+
+			fakeConfig.EnvTargetReturns(configv3.EnvTarget{API: server.URL()})
+			fakeConfig.TargetReturns(server.URL())
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("looks up the endpoints of the API", func() {
+			_, _, _, err := GetNewClientsAndConnectToCF(fakeConfig, testUI, "")
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeConfig.SetTargetInformationCallCount()).To(Equal(1))
+			targetInfo := fakeConfig.SetTargetInformationArgsForCall(0)
+			Expect(targetInfo.Api).To(Equal(server.URL()))
+			Expect(targetInfo.ApiVersion).To(Equal("3.99.0"))
+			Expect(targetInfo.UAA).To(Equal("https://uaa.example.com"))
+			Expect(targetInfo.Auth).To(Equal("https://login.example.com"))
+		})
+
+		When("the traffic is recorded in a cassette", func() {
+			var (
+				cassetteDir  string
+				cassettePath string
+			)
+
+			BeforeEach(func() {
+				var err error
+				cassetteDir, err = ioutil.TempDir("", "cassette")
+				Expect(err).NotTo(HaveOccurred())
+
+				cassettePath = filepath.Join(cassetteDir, "cassette.json")
+				fakeConfig.RecordCassetteReturns(cassettePath)
+			})
+
+			AfterEach(func() {
+				Expect(os.RemoveAll(cassetteDir)).To(Succeed())
+			})
+
+			It("records the lookup", func() {
+				_, _, _, err := GetNewClientsAndConnectToCF(fakeConfig, testUI, "")
+				Expect(err).NotTo(HaveOccurred())
+
+				recorded, err := cassette.Read(cassettePath)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(recorded.Interactions).To(HaveLen(1))
+				Expect(recorded.Interactions[0].Request.URL).To(Equal(server.URL() + "/"))
+			})
+		})
+
+		When("the endpoints are already known", func() {
+			BeforeEach(func() {
+				fakeConfig.APIVersionReturns("3.99.0")
+			})
+
+			It("does not look them up again", func() {
+				_, _, _, err := GetNewClientsAndConnectToCF(fakeConfig, testUI, "")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(fakeConfig.SetTargetInformationCallCount()).To(Equal(0))
+				Expect(server.ReceivedRequests()).To(BeEmpty())
+			})
+		})
+	})
+
+	When("the cassette to replay cannot be read", func() {
+		BeforeEach(func() {
+			fakeConfig.TargetReturns("https://api.example.com")
+			fakeConfig.ReplayCassetteReturns(filepath.Join("does", "not", "exist.json"))
+		})
+
+		It("returns the error", func() {
+			_, _, _, err := GetNewClientsAndConnectToCF(fakeConfig, testUI, "")
+			Expect(err).To(HaveOccurred())
+			Expect(os.IsNotExist(err)).To(BeTrue())
+
+			_, err = NewWrappedCloudControllerClient(fakeConfig, testUI)
+			Expect(os.IsNotExist(err)).To(BeTrue())
+		})
+	})
+
 	When("not targeting", func() {
 		It("does not target", func() {
-			ccClient := NewWrappedCloudControllerClient(fakeConfig, testUI)
+			ccClient, err := NewWrappedCloudControllerClient(fakeConfig, testUI)
+			Expect(err).NotTo(HaveOccurred())
 			Expect(ccClient).ToNot(BeNil())
 			Expect(fakeConfig.SkipSSLValidationCallCount()).To(Equal(0))
 		})
@@ -1,11 +1,20 @@
 package shared
 
 import (
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cfnetworking-cli-api/cfnetworking"
 	"code.cloudfoundry.org/cfnetworking-cli-api/cfnetworking/cfnetv1"
 	"code.cloudfoundry.org/cfnetworking-cli-api/cfnetworking/wrapper"
+	ccWrapper "code.cloudfoundry.org/cli/api/cloudcontroller/wrapper"
 	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util"
 )
 
 // NewNetworkingClient creates a new cfnetworking client.
@@ -16,6 +25,22 @@ func NewNetworkingClient(apiURL string, config command.Config, uaaClient *uaa.Cl
 
 	wrappers := []cfnetv1.ConnectionWrapper{}
 
+	certs, err := TargetTLSCertificates(config)
+	if err != nil {
+		return nil, err
+	}
+	if certs != (util.TLSCertificates{}) {
+		wrappers = append(wrappers, &networkingTLSConnection{
+			certs:             certs,
+			dialTimeout:       config.DialTimeout(),
+			skipSSLValidation: config.SkipSSLValidation(),
+		})
+	}
+
+	if config.Timings() {
+		wrappers = append(wrappers, &networkingRequestTimer{output: ui.RequestTimings()})
+	}
+
 	verbose, location := config.Verbose()
 	if verbose {
 		wrappers = append(wrappers, wrapper.NewRequestLogger(ui.RequestLoggerTerminalDisplay()))
@@ -23,6 +48,9 @@ func NewNetworkingClient(apiURL string, config command.Config, uaaClient *uaa.Cl
 	if location != nil {
 		wrappers = append(wrappers, wrapper.NewRequestLogger(ui.RequestLoggerFileWriter(location)))
 	}
+	if harPath := config.TraceHAR(); harPath != "" {
+		wrappers = append(wrappers, &networkingHARLogger{output: ui.RequestLoggerHARWriter(harPath)})
+	}
 
 	authWrapper := wrapper.NewUAAAuthentication(uaaClient, config)
 	wrappers = append(wrappers, authWrapper)
@@ -38,3 +66,89 @@ func NewNetworkingClient(apiURL string, config command.Config, uaaClient *uaa.Cl
 		Wrappers:          wrappers,
 	}), nil
 }
+
+// networkingTLSConnection replaces the default cfnetworking connection, which
+// only knows about skipping SSL validation, with one that uses the target's
+// trusted CA and client certificate. It must be the first wrapper.
+type networkingTLSConnection struct {
+	connection        cfnetworking.Connection
+	certs             util.TLSCertificates
+	dialTimeout       time.Duration
+	skipSSLValidation bool
+}
+
+func (c *networkingTLSConnection) Make(request *cfnetworking.Request, passedResponse *cfnetworking.Response) error {
+	return c.connection.Make(request, passedResponse)
+}
+
+func (c *networkingTLSConnection) Wrap(_ cfnetworking.Connection) cfnetworking.Connection {
+	// The certificates were validated when the client was created.
+	tlsConfig, _ := util.NewTLSConfigWithCertificates(c.certs, c.skipSSLValidation)
+	tr := &http.Transport{
+		TLSClientConfig: tlsConfig,
+		Proxy:           http.ProxyFromEnvironment,
+		DialContext: (&net.Dialer{
+			KeepAlive: 30 * time.Second,
+			Timeout:   c.dialTimeout,
+		}).DialContext,
+	}
+
+	c.connection = cfnetworking.NewErrorWrapper().Wrap(&cfnetworking.NetworkingConnection{
+		HTTPClient: &http.Client{Transport: tr},
+	})
+	return c
+}
+
+// networkingHARLogger records requests to and responses from the network
+// policy API in an HTTP Archive. The cfnetworking package has no wrapper for
+// it.
+type networkingHARLogger struct {
+	connection cfnetworking.Connection
+	output     ccWrapper.HARWriter
+}
+
+func (logger *networkingHARLogger) Make(request *cfnetworking.Request, passedResponse *cfnetworking.Response) error {
+	var requestBody []byte
+	if request.Body != nil && strings.Contains(request.Header.Get("Content-Type"), "json") {
+		var err error
+		requestBody, err = ioutil.ReadAll(request.Body)
+		if err != nil {
+			return err
+		}
+		err = request.ResetBody()
+		if err != nil {
+			return err
+		}
+	}
+
+	startedAt := time.Now()
+	err := logger.connection.Make(request, passedResponse)
+	logger.output.WriteEntry(request.Request, requestBody, passedResponse.HTTPResponse, passedResponse.RawResponse, startedAt, err)
+
+	return err
+}
+
+func (logger *networkingHARLogger) Wrap(innerconnection cfnetworking.Connection) cfnetworking.Connection {
+	logger.connection = innerconnection
+	return logger
+}
+
+// networkingRequestTimer records the duration of every request to the
+// network policy API. The cfnetworking package has no wrapper for it.
+type networkingRequestTimer struct {
+	connection cfnetworking.Connection
+	output     ccWrapper.TimingsRecorder
+}
+
+func (timer *networkingRequestTimer) Make(request *cfnetworking.Request, passedResponse *cfnetworking.Response) error {
+	startedAt := time.Now()
+	err := timer.connection.Make(request, passedResponse)
+	timer.output.RecordRequest(request.Request, passedResponse.HTTPResponse, len(passedResponse.RawResponse), startedAt)
+
+	return err
+}
+
+func (timer *networkingRequestTimer) Wrap(innerconnection cfnetworking.Connection) cfnetworking.Connection {
+	timer.connection = innerconnection
+	return timer
+}
@@ -0,0 +1,19 @@
+package shared
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/command"
+)
+
+// rateLimitNotifier tells the user when a request is waiting for the Cloud
+// Controller rate limit to reset.
+type rateLimitNotifier struct {
+	ui command.UI
+}
+
+func (notifier rateLimitNotifier) RateLimited(wait time.Duration) {
+	notifier.ui.DisplayWarning("Rate limit reached, waiting {{.Wait}} before retrying...", map[string]interface{}{
+		"Wait": wait.Round(time.Second),
+	})
+}
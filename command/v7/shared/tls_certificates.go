@@ -0,0 +1,72 @@
+package shared
+
+import (
+	"io/ioutil"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util"
+)
+
+// ReadTLSCertificates reads the PEM encoded CA certificate, client
+// certificate and client key from the given paths and validates them. Empty
+// paths are skipped; the client certificate and key must be given together.
+// The absolute path of the client key is kept so it can be read again later.
+func ReadTLSCertificates(caCertPath string, clientCertPath string, clientKeyPath string) (util.TLSCertificates, error) {
+	if (clientCertPath == "") != (clientKeyPath == "") {
+		return util.TLSCertificates{}, translatableerror.RequiredFlagsError{
+			Arg1: "--client-cert",
+			Arg2: "--client-key",
+		}
+	}
+
+	var certs util.TLSCertificates
+	for _, file := range []struct {
+		path  string
+		value *string
+	}{
+		{caCertPath, &certs.CACert},
+		{clientCertPath, &certs.ClientCert},
+		{clientKeyPath, &certs.ClientKey},
+	} {
+		if file.path == "" {
+			continue
+		}
+		contents, err := ioutil.ReadFile(file.path)
+		if err != nil {
+			return util.TLSCertificates{}, err
+		}
+		*file.value = string(contents)
+	}
+
+	if err := certs.Validate(); err != nil {
+		return util.TLSCertificates{}, translatableerror.InvalidTLSCertificatesError{Message: err.Error()}
+	}
+
+	if clientKeyPath != "" {
+		var err error
+		certs.ClientKeyFile, err = filepath.Abs(clientKeyPath)
+		if err != nil {
+			return util.TLSCertificates{}, err
+		}
+	}
+
+	return certs, nil
+}
+
+// TargetTLSCertificates returns the certificates saved for the targeted API.
+// It returns an error if the client key file cannot be read or the
+// certificates cannot be loaded.
+func TargetTLSCertificates(config command.Config) (util.TLSCertificates, error) {
+	certs, err := config.TLSCertificates()
+	if err != nil {
+		return util.TLSCertificates{}, translatableerror.InvalidTLSCertificatesError{Message: err.Error()}
+	}
+
+	if err := certs.Validate(); err != nil {
+		return util.TLSCertificates{}, translatableerror.InvalidTLSCertificatesError{Message: err.Error()}
+	}
+
+	return certs, nil
+}
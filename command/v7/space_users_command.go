@@ -11,11 +11,18 @@ type SpaceUsersCommand struct {
 	BaseCommand
 
 	RequiredArgs    flag.SpaceUsersArgs `positional-args:"yes"`
-	usage           interface{}         `usage:"CF_NAME space-users ORG SPACE"`
+	Output          flag.OutputFormat   `long:"output" description:"Display the roles of the users as json, yaml or csv"`
+	Format          flag.GoTemplate     `long:"format" description:"Display the roles of the users with the given Go template"`
+	JSONPath        flag.JSONPath       `long:"jsonpath" description:"Display the fields of the roles of the users selected by the given JSONPath expression"`
+	usage           interface{}         `usage:"CF_NAME space-users ORG SPACE [--output json|yaml|csv] [--format TEMPLATE] [--jsonpath EXPRESSION]\n\nEXAMPLES:\n   CF_NAME space-users my-org dev\n   CF_NAME space-users my-org dev --output csv"`
 	relatedCommands interface{}         `related_commands:"org-users, orgs, set-space-role, spaces, unset-space-role"`
 }
 
 func (cmd *SpaceUsersCommand) Execute(args []string) error {
+	if err := cmd.output().validate(); err != nil {
+		return err
+	}
+
 	err := cmd.SharedActor.CheckTarget(false, false)
 	if err != nil {
 		return err
@@ -26,12 +33,14 @@ func (cmd *SpaceUsersCommand) Execute(args []string) error {
 		return err
 	}
 
-	cmd.UI.DisplayTextWithFlavor("Getting users in org {{.Org}} / space {{.Space}} as {{.CurrentUser}}...", map[string]interface{}{
-		"Org":         cmd.RequiredArgs.Organization,
-		"Space":       cmd.RequiredArgs.Space,
-		"CurrentUser": user.Name,
-	})
-	cmd.UI.DisplayNewline()
+	if !cmd.output().IsSet() {
+		cmd.UI.DisplayTextWithFlavor("Getting users in org {{.Org}} / space {{.Space}} as {{.CurrentUser}}...", map[string]interface{}{
+			"Org":         cmd.RequiredArgs.Organization,
+			"Space":       cmd.RequiredArgs.Space,
+			"CurrentUser": user.Name,
+		})
+		cmd.UI.DisplayNewline()
+	}
 
 	org, warnings, err := cmd.Actor.GetOrganizationByName(cmd.RequiredArgs.Organization)
 	cmd.UI.DisplayWarnings(warnings)
@@ -50,6 +59,15 @@ func (cmd *SpaceUsersCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.output().IsSet() {
+		return cmd.output().display(cmd.UI, userRolesOutput(spaceUsersByRoleType,
+			constant.SpaceManagerRole,
+			constant.SpaceDeveloperRole,
+			constant.SpaceSupporterRole,
+			constant.SpaceAuditorRole,
+		))
+	}
+
 	cmd.displaySpaceUsers(spaceUsersByRoleType)
 
 	return nil
@@ -81,3 +99,7 @@ func (cmd SpaceUsersCommand) displayRoleGroup(usersWithRole []resources.User, ro
 
 	cmd.UI.DisplayNewline()
 }
+
+func (cmd SpaceUsersCommand) output() structuredOutput {
+	return structuredOutput{output: cmd.Output, format: cmd.Format, jsonPath: cmd.JSONPath}
+}
@@ -7,6 +7,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
 	. "code.cloudfoundry.org/cli/command/v7"
 	"code.cloudfoundry.org/cli/command/v7/v7fakes"
 	"code.cloudfoundry.org/cli/resources"
@@ -215,6 +216,25 @@ var _ = Describe("space-users Command", func() {
 
 							Expect(fakeActor.GetOrganizationByNameCallCount()).To(Equal(1))
 						})
+
+						When("the --output flag is set to csv", func() {
+							BeforeEach(func() {
+								cmd.Output = flag.OutputFormat{Format: flag.OutputCSV}
+							})
+
+							It("displays a row for every role of every user", func() {
+								Expect(executeErr).ToNot(HaveOccurred())
+
+								Expect(testUI.Out).ToNot(Say("Getting users"))
+								Expect(testUI.Out).To(Say(`role,guid,username,presentation_name,origin\n`))
+								Expect(testUI.Out).To(Say(`space_manager,abby-user-guid,,abby,ldap\n`))
+								Expect(testUI.Out).To(Say(`space_manager,client-guid,,admin,client\n`))
+								Expect(testUI.Out).To(Say(`space_developer,spaceDeveloper-guid,,billing-manager,uaa\n`))
+								Expect(testUI.Out).To(Say(`space_supporter,spaceSupporter-guid,,fred,uaa\n`))
+								Expect(testUI.Out).To(Say(`space_auditor,spaceAuditor-guid,,org-auditor,uaa\n`))
+								Expect(testUI.Err).To(Say("get-space-users-by-name-warning"))
+							})
+						})
 					})
 
 					When("There are no space users", func() {
@@ -1,6 +1,12 @@
 package v7
 
 import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/resources"
 	"code.cloudfoundry.org/cli/util/ui"
 )
@@ -8,12 +14,38 @@ import (
 type SpacesCommand struct {
 	BaseCommand
 
-	usage           interface{} `usage:"CF_NAME spaces [--labels SELECTOR]\n\nEXAMPLES:\n   CF_NAME spaces\n   CF_NAME spaces --labels 'environment in (production,staging),tier in (backend)'\n   CF_NAME spaces --labels 'env=dev,!chargeback-code,tier in (backend,worker)'"`
-	relatedCommands interface{} `related_commands:"create-space, set-space-role, space, space-users"`
-	Labels          string      `long:"labels" description:"Selector to filter spaces by labels"`
+	usage           interface{}                 `usage:"CF_NAME spaces [--labels SELECTOR] [--foundations-file PATH] [--output json|yaml|csv] [--format TEMPLATE] [--jsonpath EXPRESSION]\n\nEXAMPLES:\n   CF_NAME spaces\n   CF_NAME spaces --labels 'environment in (production,staging),tier in (backend)'\n   CF_NAME spaces --labels 'env=dev,!chargeback-code,tier in (backend,worker)'\n   CF_NAME spaces --foundations-file foundations.yml\n   CF_NAME spaces --output json"`
+	relatedCommands interface{}                 `related_commands:"create-space, set-space-role, space, space-users"`
+	FoundationsFile flag.PathWithExistenceCheck `long:"foundations-file" description:"Run against every foundation listed in the given YAML file"`
+	Labels          string                      `long:"labels" description:"Selector to filter spaces by labels"`
+	Output          flag.OutputFormat           `long:"output" description:"Display the spaces as json, yaml or csv"`
+	Format          flag.GoTemplate             `long:"format" description:"Display the spaces with the given Go template"`
+	JSONPath        flag.JSONPath               `long:"jsonpath" description:"Display the fields of the spaces selected by the given JSONPath expression"`
+}
+
+func (cmd *SpacesCommand) Setup(config command.Config, ui command.UI) error {
+	if cmd.FoundationsFile != "" {
+		cmd.SetupForFoundations(config, ui)
+		return nil
+	}
+	return cmd.BaseCommand.Setup(config, ui)
 }
 
 func (cmd SpacesCommand) Execute([]string) error {
+	if err := cmd.output().validate(); err != nil {
+		return err
+	}
+
+	if cmd.FoundationsFile != "" && cmd.output().IsSet() {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--foundations-file", cmd.output().flagName()},
+		}
+	}
+
+	if cmd.FoundationsFile != "" {
+		return cmd.executeOnFoundations()
+	}
+
 	err := cmd.SharedActor.CheckTarget(true, false)
 	if err != nil {
 		return err
@@ -24,11 +56,13 @@ func (cmd SpacesCommand) Execute([]string) error {
 		return err
 	}
 
-	cmd.UI.DisplayTextWithFlavor("Getting spaces in org {{.OrgName}} as {{.CurrentUser}}...", map[string]interface{}{
-		"OrgName":     cmd.Config.TargetedOrganization().Name,
-		"CurrentUser": user.Name,
-	})
-	cmd.UI.DisplayNewline()
+	if !cmd.output().IsSet() {
+		cmd.UI.DisplayTextWithFlavor("Getting spaces in org {{.OrgName}} as {{.CurrentUser}}...", map[string]interface{}{
+			"OrgName":     cmd.Config.TargetedOrganization().Name,
+			"CurrentUser": user.Name,
+		})
+		cmd.UI.DisplayNewline()
+	}
 
 	spaces, warnings, err := cmd.Actor.GetOrganizationSpacesWithLabelSelector(cmd.Config.TargetedOrganization().GUID, cmd.Labels)
 	cmd.UI.DisplayWarnings(warnings)
@@ -36,21 +70,72 @@ func (cmd SpacesCommand) Execute([]string) error {
 		return err
 	}
 
+	if cmd.output().IsSet() {
+		output := []SpaceOutput{}
+		for _, space := range spaces {
+			output = append(output, SpaceOutput{Name: space.Name, GUID: space.GUID})
+		}
+		return cmd.output().display(cmd.UI, output)
+	}
+
 	if len(spaces) == 0 {
 		cmd.UI.DisplayText("No spaces found.")
 	} else {
-		cmd.displaySpaces(spaces)
+		cmd.UI.DisplayTableWithHeader("", cmd.spacesTable(spaces), ui.DefaultTableSpacePadding)
 	}
 
 	return nil
 }
 
-func (cmd SpacesCommand) displaySpaces(spaces []resources.Space) {
+func (cmd SpacesCommand) executeOnFoundations() error {
+	cmd.UI.DisplayTextWithFlavor("Getting spaces from foundations in {{.FoundationsFile}}...", map[string]interface{}{
+		"FoundationsFile": cmd.FoundationsFile,
+	})
+	cmd.UI.DisplayNewline()
+
+	var mutex sync.Mutex
+	spacesByFoundation := map[string][]resources.Space{}
+	results, err := cmd.FoundationsRunner.RunOnFoundations(string(cmd.FoundationsFile), true, false, func(target FoundationTarget) (v7action.Warnings, error) {
+		spaces, warnings, err := target.Actor.GetOrganizationSpacesWithLabelSelector(target.Organization.GUID, cmd.Labels)
+		mutex.Lock()
+		defer mutex.Unlock()
+		spacesByFoundation[target.Name] = spaces
+		return warnings, err
+	})
+	if err != nil {
+		return err
+	}
+
+	var (
+		spaces      []resources.Space
+		foundations []string
+	)
+	for _, result := range results {
+		for _, space := range spacesByFoundation[result.Name] {
+			spaces = append(spaces, space)
+			foundations = append(foundations, result.Name)
+		}
+	}
+
+	if len(foundations) == 0 {
+		cmd.UI.DisplayText("No spaces found.")
+	} else {
+		cmd.UI.DisplayTableWithHeader("", withFoundationColumn(cmd.UI, cmd.spacesTable(spaces), foundations), ui.DefaultTableSpacePadding)
+	}
+
+	return displayFoundationResults(cmd.UI, results)
+}
+
+func (cmd SpacesCommand) spacesTable(spaces []resources.Space) [][]string {
 	table := [][]string{{cmd.UI.TranslateText("name")}}
 
 	for _, space := range spaces {
 		table = append(table, []string{space.Name})
 	}
 
-	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+	return table
+}
+
+func (cmd SpacesCommand) output() structuredOutput {
+	return structuredOutput{output: cmd.Output, format: cmd.Format, jsonPath: cmd.JSONPath}
 }
@@ -1,11 +1,14 @@
 package v7_test
 
 import (
+	"encoding/json"
 	"errors"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v7"
 	"code.cloudfoundry.org/cli/command/v7/v7fakes"
 	"code.cloudfoundry.org/cli/resources"
@@ -183,4 +186,86 @@ var _ = Describe("spaces Command", func() {
 			})
 		})
 	})
+
+	When("the --foundations-file flag is set", func() {
+		var (
+			fakeFoundationsRunner *v7fakes.FakeFoundationsRunner
+			dc2Actor              *v7fakes.FakeActor
+		)
+
+		BeforeEach(func() {
+			cmd.FoundationsFile = "foundations.yml"
+			cmd.Labels = "some-label=value"
+			fakeFoundationsRunner = new(v7fakes.FakeFoundationsRunner)
+			cmd.FoundationsRunner = fakeFoundationsRunner
+
+			fakeActor.GetOrganizationSpacesWithLabelSelectorReturns(
+				[]resources.Space{{Name: "space-1"}, {Name: "space-2"}},
+				v7action.Warnings{"dc1-warning"},
+				nil,
+			)
+
+			dc2Actor = new(v7fakes.FakeActor)
+			dc2Actor.GetOrganizationSpacesWithLabelSelectorReturns(nil, nil, errors.New("dc2 is down"))
+
+			runOnFoundationTargets(fakeFoundationsRunner,
+				FoundationTarget{Name: "dc1", Actor: fakeActor, Organization: resources.Organization{GUID: "dc1-org-guid"}},
+				FoundationTarget{Name: "dc2", Actor: dc2Actor, Organization: resources.Organization{GUID: "dc2-org-guid"}},
+			)
+		})
+
+		It("lists the spaces of every foundation with a foundation column", func() {
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+			_, targetOrg, targetSpace, _ := fakeFoundationsRunner.RunOnFoundationsArgsForCall(0)
+			Expect(targetOrg).To(BeTrue())
+			Expect(targetSpace).To(BeFalse())
+
+			orgGUID, labelSelector := fakeActor.GetOrganizationSpacesWithLabelSelectorArgsForCall(0)
+			Expect(orgGUID).To(Equal("dc1-org-guid"))
+			Expect(labelSelector).To(Equal("some-label=value"))
+
+			Expect(testUI.Out).To(Say(`Getting spaces from foundations in foundations.yml\.\.\.`))
+			Expect(testUI.Out).To(Say(`foundation\s+name`))
+			Expect(testUI.Out).To(Say(`dc1\s+space-1`))
+			Expect(testUI.Out).To(Say(`dc1\s+space-2`))
+		})
+
+		It("displays the warnings and errors of each foundation and fails", func() {
+			Expect(testUI.Err).To(Say("dc1: dc1-warning"))
+			Expect(testUI.Err).To(Say("dc2: dc2 is down"))
+			Expect(executeErr).To(MatchError(translatableerror.FoundationsFailedError{Names: []string{"dc2"}}))
+		})
+	})
+
+	When("the --output flag is set", func() {
+		BeforeEach(func() {
+			cmd.Output = flag.OutputFormat{Format: flag.OutputJSON}
+			fakeActor.GetOrganizationSpacesWithLabelSelectorReturns([]resources.Space{
+				{Name: "space-1", GUID: "space-guid-1"},
+			}, v7action.Warnings{"warning-1"}, nil)
+		})
+
+		It("displays the spaces as JSON without the header", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			var spaces []map[string]interface{}
+			Expect(json.Unmarshal(testUI.Out.(*Buffer).Contents(), &spaces)).To(Succeed())
+			Expect(spaces).To(Equal([]map[string]interface{}{
+				{"name": "space-1", "guid": "space-guid-1"},
+			}))
+			Expect(testUI.Err).To(Say("warning-1"))
+		})
+
+		When("the --foundations-file flag is also set", func() {
+			BeforeEach(func() {
+				cmd.FoundationsFile = "foundations.yml"
+			})
+
+			It("returns an argument combination error", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+					Args: []string{"--foundations-file", "--output"},
+				}))
+			})
+		})
+	})
 })
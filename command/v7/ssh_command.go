@@ -42,9 +42,7 @@ func (cmd *SSHCommand) Setup(config command.Config, ui command.UI) error {
 		return err
 	}
 
-	sharedActor := sharedaction.NewActor(config)
-	cmd.SharedActor = sharedActor
-	cmd.SSHActor = sharedActor
+	cmd.SSHActor = sharedaction.NewActor(config)
 	cmd.SSHClient = clissh.NewDefaultSecureShell()
 
 	return nil
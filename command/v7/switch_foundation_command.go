@@ -0,0 +1,50 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+)
+
+type SwitchFoundationCommand struct {
+	UI     command.UI
+	Config command.Config
+
+	RequiredArgs    flag.FoundationName `positional-args:"yes"`
+	usage           interface{}         `usage:"CF_NAME switch-foundation FOUNDATION_NAME"`
+	relatedCommands interface{}         `related_commands:"add-foundation, foundations, target"`
+}
+
+func (cmd *SwitchFoundationCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+
+	return nil
+}
+
+func (cmd SwitchFoundationCommand) Execute(args []string) error {
+	name := cmd.RequiredArgs.Foundation
+
+	cmd.UI.DisplayTextWithFlavor("Switching to foundation {{.Foundation}}...", map[string]interface{}{
+		"Foundation": name,
+	})
+
+	err := cmd.Config.SwitchFoundation(name)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+
+	table := [][]string{
+		{cmd.UI.TranslateText("API endpoint:"), cmd.Config.Target()},
+	}
+	if cmd.Config.HasTargetedOrganization() {
+		table = append(table, []string{cmd.UI.TranslateText("org:"), cmd.Config.TargetedOrganizationName()})
+	}
+	if cmd.Config.HasTargetedSpace() {
+		table = append(table, []string{cmd.UI.TranslateText("space:"), cmd.Config.TargetedSpace().Name})
+	}
+	cmd.UI.DisplayKeyValueTable("", table, 3)
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package v7_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("switch-foundation command", func() {
+	var (
+		cmd        SwitchFoundationCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		cmd = SwitchFoundationCommand{
+			UI:           testUI,
+			Config:       fakeConfig,
+			RequiredArgs: flag.FoundationName{Foundation: "dc2"},
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("the foundation exists", func() {
+		BeforeEach(func() {
+			fakeConfig.TargetReturns("https://api.dc2.com")
+			fakeConfig.HasTargetedOrganizationReturns(true)
+			fakeConfig.TargetedOrganizationNameReturns("some-org")
+			fakeConfig.HasTargetedSpaceReturns(true)
+			fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space"})
+		})
+
+		It("switches to the foundation and displays its target", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeConfig.SwitchFoundationCallCount()).To(Equal(1))
+			Expect(fakeConfig.SwitchFoundationArgsForCall(0)).To(Equal("dc2"))
+
+			Expect(testUI.Out).To(Say(`Switching to foundation dc2\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say(`API endpoint:\s+https://api.dc2.com`))
+			Expect(testUI.Out).To(Say(`org:\s+some-org`))
+			Expect(testUI.Out).To(Say(`space:\s+some-space`))
+		})
+	})
+
+	When("the foundation does not exist", func() {
+		BeforeEach(func() {
+			fakeConfig.SwitchFoundationReturns(actionerror.FoundationNotFoundError{Name: "dc2"})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(actionerror.FoundationNotFoundError{Name: "dc2"}))
+		})
+	})
+})
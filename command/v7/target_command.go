@@ -2,22 +2,44 @@ package v7
 
 import (
 	"fmt"
+	"strconv"
 
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
 )
 
 type TargetCommand struct {
 	BaseCommand
 
-	Organization    string      `short:"o" description:"Organization"`
-	Space           string      `short:"s" description:"Space"`
-	usage           interface{} `usage:"CF_NAME target [-o ORG] [-s SPACE]"`
-	relatedCommands interface{} `related_commands:"create-org, create-space, login, orgs, spaces"`
+	RequiredArgs    flag.PreviousTarget `positional-args:"yes"`
+	Organization    string              `short:"o" description:"Organization"`
+	Space           string              `short:"s" description:"Space"`
+	History         bool                `long:"history" description:"List recently targeted APIs, orgs and spaces and select one to target, or target the one with the given number"`
+	usage           interface{}         `usage:"CF_NAME target [-o ORG] [-s SPACE]\n   CF_NAME target -\n   CF_NAME target --history [NUMBER]\n\nEXAMPLES:\n   CF_NAME target -o my-org -s dev\n   CF_NAME target - (switch back to the previous API, org and space)\n   CF_NAME target --history 3 (switch to the third most recent target)"`
+	relatedCommands interface{}         `related_commands:"create-org, create-space, login, orgs, spaces"`
+
+	ActorReloader ActorReloader
+}
+
+func (cmd *TargetCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.ActorReloader = ActualActorReloader{}
+	return cmd.BaseCommand.Setup(config, ui)
 }
 
 func (cmd *TargetCommand) Execute(args []string) error {
-	err := cmd.SharedActor.CheckTarget(false, false)
+	err := cmd.validateArgs()
+	if err != nil {
+		return err
+	}
+
+	if cmd.RequiredArgs.Previous != "" || cmd.History {
+		return cmd.targetFromHistory()
+	}
+
+	err = cmd.SharedActor.CheckTarget(false, false)
 	if err != nil {
 		return err
 	}
@@ -28,6 +50,10 @@ func (cmd *TargetCommand) Execute(args []string) error {
 		return err
 	}
 
+	if cmd.Organization != "" || cmd.Space != "" {
+		cmd.Config.RecordTarget()
+	}
+
 	switch {
 	case cmd.Organization != "" && cmd.Space != "":
 		err = cmd.setOrgAndSpace()
@@ -36,44 +62,206 @@ func (cmd *TargetCommand) Execute(args []string) error {
 			return err
 		}
 	case cmd.Organization != "":
-		err = cmd.setOrg()
+		var orgGUID string
+		orgGUID, err = cmd.setOrg()
 		if err != nil {
 			cmd.clearTargets()
 			return err
 		}
-		err = cmd.autoTargetSpace(cmd.Config.TargetedOrganization().GUID)
+		err = cmd.autoTargetSpace(orgGUID)
 		if err != nil {
 			cmd.clearTargets()
 			return err
 		}
 	case cmd.Space != "":
-		err = cmd.setSpace()
+		var orgGUID string
+		orgGUID, err = cmd.targetedOrgGUID()
+		if err != nil {
+			cmd.clearTargets()
+			return err
+		}
+		err = cmd.setSpace(orgGUID)
 		if err != nil {
 			cmd.clearTargets()
 			return err
 		}
 	}
 
-	cmd.displayTargetTable(user)
+	if cmd.Organization != "" || cmd.Space != "" {
+		cmd.Config.RecordTarget()
+	}
 
-	if !cmd.Config.HasTargetedOrganization() {
-		cmd.UI.DisplayText("No org or space targeted, use '{{.CFTargetCommand}}'",
-			map[string]interface{}{
-				"CFTargetCommand": fmt.Sprintf("%s target -o ORG -s SPACE", cmd.Config.BinaryName()),
-			})
-		return nil
+	cmd.displayTarget(user)
+	return nil
+}
+
+func (cmd TargetCommand) validateArgs() error {
+	if cmd.History {
+		if _, ok := cmd.historyIndex(); !ok && cmd.RequiredArgs.Previous != "" {
+			return translatableerror.IncorrectUsageError{
+				Message: fmt.Sprintf("unexpected argument '%s'", cmd.RequiredArgs.Previous),
+			}
+		}
+	} else if cmd.RequiredArgs.Previous != "" && cmd.RequiredArgs.Previous != "-" {
+		return translatableerror.IncorrectUsageError{
+			Message: fmt.Sprintf("unexpected argument '%s'", cmd.RequiredArgs.Previous),
+		}
 	}
 
-	if !cmd.Config.HasTargetedSpace() {
-		cmd.UI.DisplayText("No space targeted, use '{{.CFTargetCommand}}'",
-			map[string]interface{}{
-				"CFTargetCommand": fmt.Sprintf("%s target -s SPACE", cmd.Config.BinaryName()),
+	var args []string
+	if cmd.RequiredArgs.Previous != "" && !cmd.History {
+		args = append(args, "-")
+	}
+	if cmd.History {
+		args = append(args, "--history")
+	}
+	if len(args) > 0 && cmd.Organization != "" {
+		args = append(args, "-o")
+	}
+	if len(args) > 0 && cmd.Space != "" {
+		args = append(args, "-s")
+	}
+	if len(args) > 1 {
+		return translatableerror.ArgumentCombinationError{Args: args}
+	}
+	return nil
+}
+
+// targetFromHistory switches to the previous target or to the one selected
+// from the target history. When the API changes, the tokens stored for it are
+// reused if they are still valid. Tokens are only stored for the target
+// history in the encrypted token store, so without it the user has to log in
+// again.
+func (cmd *TargetCommand) targetFromHistory() error {
+	var (
+		entry configv3.TargetHistoryEntry
+		found bool
+		err   error
+	)
+	if cmd.History {
+		entry, found, err = cmd.selectFromHistory()
+		if err != nil || !found {
+			return err
+		}
+	} else {
+		entry, found = cmd.Config.PreviousTarget()
+		if !found {
+			return translatableerror.NoPreviousTargetError{BinaryName: cmd.Config.BinaryName()}
+		}
+	}
+
+	cmd.Config.RecordTarget()
+	if cmd.Config.SwitchToTarget(entry) {
+		cmd.Actor, err = cmd.ActorReloader.Reload(cmd.Config, cmd.UI)
+		if err != nil {
+			return err
+		}
+
+		if cmd.Config.RefreshToken() == "" {
+			return translatableerror.NotLoggedInError{BinaryName: cmd.Config.BinaryName()}
+		}
+
+		_, err = cmd.Actor.RefreshAccessToken()
+		if err != nil {
+			cmd.Config.SetTokenInformation("", "", "")
+			cmd.UI.DisplayWarning("The stored tokens for {{.API}} are no longer valid.", map[string]interface{}{
+				"API": entry.API,
 			})
+			return translatableerror.NotLoggedInError{BinaryName: cmd.Config.BinaryName()}
+		}
 	}
 
+	err = cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Actor.GetCurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.Config.RecordTarget()
+	cmd.displayTarget(user)
 	return nil
 }
 
+// historyIndex returns the number of the recent target given with --history,
+// counting from 1, and false if no valid number was given.
+func (cmd TargetCommand) historyIndex() (int, bool) {
+	index, err := strconv.Atoi(cmd.RequiredArgs.Previous)
+	if err != nil || index < 1 {
+		return 0, false
+	}
+	return index, true
+}
+
+// selectFromHistory returns the recent target with the number given with
+// --history. Without a number it lists the target history and, in an
+// interactive terminal, prompts for the target to switch to. It returns false
+// if no target was selected.
+func (cmd *TargetCommand) selectFromHistory() (configv3.TargetHistoryEntry, bool, error) {
+	history := cmd.Config.TargetHistory()
+	if len(history) == 0 {
+		return configv3.TargetHistoryEntry{}, false, translatableerror.NoPreviousTargetError{BinaryName: cmd.Config.BinaryName()}
+	}
+
+	if index, ok := cmd.historyIndex(); ok {
+		if index > len(history) {
+			return configv3.TargetHistoryEntry{}, false, translatableerror.TargetHistoryIndexError{Index: index, BinaryName: cmd.Config.BinaryName()}
+		}
+		return history[index-1], true, nil
+	}
+
+	choices := make([]string, len(history))
+	for i, entry := range history {
+		choices[i] = cmd.targetHistoryChoice(entry)
+	}
+
+	cmd.UI.DisplayText("Recent targets:")
+	if !cmd.Config.IsTTY() {
+		for i, choice := range choices {
+			cmd.UI.DisplayText("{{.Index}}. {{.Target}}", map[string]interface{}{
+				"Index":  i + 1,
+				"Target": choice,
+			})
+		}
+		return configv3.TargetHistoryEntry{}, false, nil
+	}
+
+	var (
+		choice string
+		err    error
+	)
+	for {
+		choice, err = cmd.UI.DisplayTextMenu(choices, "Select a target (or press enter to skip):")
+		if err != ui.ErrInvalidIndex {
+			break
+		}
+	}
+	if err != nil || choice == "" {
+		return configv3.TargetHistoryEntry{}, false, err
+	}
+
+	for i, entry := range history {
+		if choices[i] == choice {
+			return entry, true, nil
+		}
+	}
+	return configv3.TargetHistoryEntry{}, false, nil
+}
+
+func (cmd TargetCommand) targetHistoryChoice(entry configv3.TargetHistoryEntry) string {
+	choice := entry.API
+	if entry.Organization.Name != "" {
+		choice += fmt.Sprintf(" %s %s", cmd.UI.TranslateText("org:"), entry.Organization.Name)
+	}
+	if entry.Space.Name != "" {
+		choice += fmt.Sprintf(" %s %s", cmd.UI.TranslateText("space:"), entry.Space.Name)
+	}
+	return choice
+}
+
 func (cmd TargetCommand) clearTargets() {
 	if cmd.Organization != "" {
 		cmd.Config.UnsetOrganizationAndSpaceInformation()
@@ -84,12 +272,12 @@ func (cmd TargetCommand) clearTargets() {
 
 // setOrgAndSpace sets organization and space
 func (cmd *TargetCommand) setOrgAndSpace() error {
-	err := cmd.setOrg()
+	orgGUID, err := cmd.setOrg()
 	if err != nil {
 		return err
 	}
 
-	err = cmd.setSpace()
+	err = cmd.setSpace(orgGUID)
 	if err != nil {
 		return err
 	}
@@ -97,18 +285,33 @@ func (cmd *TargetCommand) setOrgAndSpace() error {
 	return nil
 }
 
-// setOrg sets organization
-func (cmd *TargetCommand) setOrg() error {
+// setOrg sets organization and returns its GUID
+func (cmd *TargetCommand) setOrg() (string, error) {
 	org, warnings, err := cmd.Actor.GetOrganizationByName(cmd.Organization)
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	cmd.Config.SetOrganizationInformation(org.GUID, cmd.Organization)
 	cmd.Config.UnsetSpaceInformation()
 
-	return nil
+	return org.GUID, nil
+}
+
+// targetedOrgGUID returns the GUID of the targeted org, looking it up if the
+// org is targeted with CF_ORG.
+func (cmd *TargetCommand) targetedOrgGUID() (string, error) {
+	if !cmd.Config.HasTargetedOrganization() {
+		return "", translatableerror.NoOrganizationTargetedError{BinaryName: cmd.Config.BinaryName()}
+	}
+
+	_, err := cmd.SharedActor.RequireTargetedOrg()
+	if err != nil {
+		return "", err
+	}
+
+	return cmd.Config.TargetedOrganization().GUID, nil
 }
 
 // autoTargetSpace targets the space if there is only one space in the org
@@ -129,12 +332,8 @@ func (cmd *TargetCommand) autoTargetSpace(orgGUID string) error {
 }
 
 // setSpace sets space
-func (cmd *TargetCommand) setSpace() error {
-	if !cmd.Config.HasTargetedOrganization() {
-		return translatableerror.NoOrganizationTargetedError{BinaryName: cmd.Config.BinaryName()}
-	}
-
-	space, warnings, err := cmd.Actor.GetSpaceByNameAndOrganization(cmd.Space, cmd.Config.TargetedOrganization().GUID)
+func (cmd *TargetCommand) setSpace(orgGUID string) error {
+	space, warnings, err := cmd.Actor.GetSpaceByNameAndOrganization(cmd.Space, orgGUID)
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
 		return err
@@ -145,6 +344,27 @@ func (cmd *TargetCommand) setSpace() error {
 	return nil
 }
 
+// displayTarget displays the target and how to target an org or space if
+// none is targeted.
+func (cmd *TargetCommand) displayTarget(user configv3.User) {
+	cmd.displayTargetTable(user)
+
+	if !cmd.Config.HasTargetedOrganization() {
+		cmd.UI.DisplayText("No org or space targeted, use '{{.CFTargetCommand}}'",
+			map[string]interface{}{
+				"CFTargetCommand": fmt.Sprintf("%s target -o ORG -s SPACE", cmd.Config.BinaryName()),
+			})
+		return
+	}
+
+	if !cmd.Config.HasTargetedSpace() {
+		cmd.UI.DisplayText("No space targeted, use '{{.CFTargetCommand}}'",
+			map[string]interface{}{
+				"CFTargetCommand": fmt.Sprintf("%s target -s SPACE", cmd.Config.BinaryName()),
+			})
+	}
+}
+
 // displayTargetTable neatly displays target information.
 func (cmd *TargetCommand) displayTargetTable(user configv3.User) {
 	table := [][]string{
@@ -153,6 +373,10 @@ func (cmd *TargetCommand) displayTargetTable(user configv3.User) {
 		{cmd.UI.TranslateText("user:"), user.Name},
 	}
 
+	if foundation := cmd.Config.ActiveFoundation(); foundation != "" {
+		table = append([][]string{{cmd.UI.TranslateText("foundation:"), foundation}}, table...)
+	}
+
 	if cmd.Config.HasTargetedOrganization() {
 		table = append(table, []string{
 			cmd.UI.TranslateText("org:"), cmd.Config.TargetedOrganization().Name,
@@ -194,6 +194,10 @@ var _ = Describe("target Command", func() {
 								Expect(spaceGUID).To(Equal("some-space-guid"))
 								Expect(spaceName).To(Equal("some-space"))
 							})
+
+							It("records the previous and the new target in the target history", func() {
+								Expect(fakeConfig.RecordTargetCallCount()).To(Equal(2))
+							})
 						})
 
 						When("the space does not exist", func() {
@@ -213,6 +217,38 @@ var _ = Describe("target Command", func() {
 						})
 					})
 
+					When("the org is targeted with CF_ORG", func() {
+						BeforeEach(func() {
+							fakeConfig.HasTargetedOrganizationReturns(true)
+							fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "env-org"})
+							fakeSharedActor.RequireTargetedOrgStub = func() (string, error) {
+								fakeConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "env-org-guid", Name: "env-org"})
+								return "env-org", nil
+							}
+						})
+
+						It("looks up the org before looking for the space in it", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							Expect(fakeSharedActor.RequireTargetedOrgCallCount()).To(Equal(1))
+							spaceName, orgGUID := fakeActor.GetSpaceByNameAndOrganizationArgsForCall(0)
+							Expect(spaceName).To(Equal("some-space"))
+							Expect(orgGUID).To(Equal("env-org-guid"))
+						})
+
+						When("the org does not exist", func() {
+							BeforeEach(func() {
+								fakeSharedActor.RequireTargetedOrgStub = nil
+								fakeSharedActor.RequireTargetedOrgReturns("", actionerror.EnvOrganizationNotFoundError{Name: "env-org"})
+							})
+
+							It("returns the error", func() {
+								Expect(executeErr).To(MatchError(actionerror.EnvOrganizationNotFoundError{Name: "env-org"}))
+								Expect(fakeActor.GetSpaceByNameAndOrganizationCallCount()).To(Equal(0))
+							})
+						})
+					})
+
 					When("no org is targeted", func() {
 						It("returns NoOrgTargeted error and clears existing space", func() {
 							Expect(executeErr).To(MatchError(translatableerror.NoOrganizationTargetedError{BinaryName: "faceman"}))
@@ -506,4 +542,200 @@ var _ = Describe("target Command", func() {
 			})
 		})
 	})
+
+	When("switching to the previous target", func() {
+		var fakeActorReloader *v7fakes.FakeActorReloader
+
+		BeforeEach(func() {
+			fakeActorReloader = new(v7fakes.FakeActorReloader)
+			fakeActorReloader.ReloadReturns(fakeActor, nil)
+			cmd.ActorReloader = fakeActorReloader
+			cmd.RequiredArgs.Previous = "-"
+
+			fakeConfig.TargetReturns("https://api.dc1.com")
+			fakeActor.GetCurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+			fakeConfig.PreviousTargetReturns(configv3.TargetHistoryEntry{
+				API:          "https://api.dc1.com",
+				Organization: configv3.Organization{GUID: "some-org-guid", Name: "some-org"},
+				Space:        configv3.Space{GUID: "some-space-guid", Name: "some-space"},
+			}, true)
+		})
+
+		It("targets the previous target and records the change", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeConfig.SwitchToTargetCallCount()).To(Equal(1))
+			Expect(fakeConfig.SwitchToTargetArgsForCall(0).Space.Name).To(Equal("some-space"))
+			Expect(fakeConfig.RecordTargetCallCount()).To(Equal(2))
+			Expect(fakeActorReloader.ReloadCallCount()).To(Equal(0))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+
+			Expect(testUI.Out).To(Say("API endpoint:   https://api.dc1.com"))
+			Expect(testUI.Out).To(Say("user:           some-user"))
+		})
+
+		When("the previous target is on another API", func() {
+			BeforeEach(func() {
+				fakeConfig.SwitchToTargetReturns(true)
+				fakeConfig.RefreshTokenReturns("some-refresh-token")
+			})
+
+			It("reconnects and reuses the stored tokens", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeActorReloader.ReloadCallCount()).To(Equal(1))
+				Expect(fakeActor.RefreshAccessTokenCallCount()).To(Equal(1))
+				Expect(fakeConfig.SetTokenInformationCallCount()).To(Equal(0))
+			})
+
+			When("the stored tokens are no longer valid", func() {
+				BeforeEach(func() {
+					fakeActor.RefreshAccessTokenReturns("", errors.New("invalid token"))
+				})
+
+				It("clears the tokens and asks to log in", func() {
+					Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+					Expect(testUI.Err).To(Say("The stored tokens for https://api.dc1.com are no longer valid."))
+
+					Expect(fakeConfig.SetTokenInformationCallCount()).To(Equal(1))
+					accessToken, refreshToken, _ := fakeConfig.SetTokenInformationArgsForCall(0)
+					Expect(accessToken).To(BeEmpty())
+					Expect(refreshToken).To(BeEmpty())
+				})
+			})
+
+			When("no tokens are stored for the API", func() {
+				BeforeEach(func() {
+					fakeConfig.RefreshTokenReturns("")
+				})
+
+				It("asks to log in without refreshing the tokens", func() {
+					Expect(executeErr).To(MatchError(translatableerror.NotLoggedInError{BinaryName: binaryName}))
+					Expect(fakeActor.RefreshAccessTokenCallCount()).To(Equal(0))
+					Expect(testUI.Err).ToNot(Say("no longer valid"))
+				})
+			})
+		})
+
+		When("there is no previous target", func() {
+			BeforeEach(func() {
+				fakeConfig.PreviousTargetReturns(configv3.TargetHistoryEntry{}, false)
+			})
+
+			It("returns a NoPreviousTargetError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.NoPreviousTargetError{BinaryName: binaryName}))
+				Expect(fakeConfig.SwitchToTargetCallCount()).To(Equal(0))
+			})
+		})
+
+		When("an org is also given", func() {
+			BeforeEach(func() {
+				cmd.Organization = "some-org"
+			})
+
+			It("returns an ArgumentCombinationError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"-", "-o"}}))
+			})
+		})
+
+		When("the argument is not '-'", func() {
+			BeforeEach(func() {
+				cmd.RequiredArgs.Previous = "some-org"
+			})
+
+			It("returns an IncorrectUsageError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.IncorrectUsageError{Message: "unexpected argument 'some-org'"}))
+			})
+		})
+	})
+
+	When("the --history flag is given", func() {
+		var input *Buffer
+
+		BeforeEach(func() {
+			input = NewBuffer()
+			testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+			cmd.UI = testUI
+			cmd.History = true
+
+			fakeActor.GetCurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+			fakeConfig.TargetHistoryReturns([]configv3.TargetHistoryEntry{
+				{
+					API:          "https://api.dc1.com",
+					Organization: configv3.Organization{GUID: "org-1-guid", Name: "org-1"},
+					Space:        configv3.Space{GUID: "space-1-guid", Name: "space-1"},
+				},
+				{
+					API:          "https://api.dc2.com",
+					Organization: configv3.Organization{GUID: "org-2-guid", Name: "org-2"},
+				},
+			})
+		})
+
+		It("lists the recent targets", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Recent targets:"))
+			Expect(testUI.Out).To(Say(`1\. https://api\.dc1\.com org: org-1 space: space-1`))
+			Expect(testUI.Out).To(Say(`2\. https://api\.dc2\.com org: org-2\n`))
+			Expect(fakeConfig.SwitchToTargetCallCount()).To(Equal(0))
+		})
+
+		When("the terminal is interactive", func() {
+			BeforeEach(func() {
+				fakeConfig.IsTTYReturns(true)
+				_, err := input.Write([]byte("2\n"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("targets the selected target", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("Select a target"))
+				Expect(fakeConfig.SwitchToTargetCallCount()).To(Equal(1))
+				Expect(fakeConfig.SwitchToTargetArgsForCall(0).API).To(Equal("https://api.dc2.com"))
+			})
+		})
+
+		When("the number of a recent target is given", func() {
+			BeforeEach(func() {
+				cmd.RequiredArgs.Previous = "2"
+			})
+
+			It("targets it without listing the recent targets", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).ToNot(Say("Recent targets:"))
+				Expect(fakeConfig.SwitchToTargetCallCount()).To(Equal(1))
+				Expect(fakeConfig.SwitchToTargetArgsForCall(0).API).To(Equal("https://api.dc2.com"))
+			})
+
+			When("there are fewer recent targets", func() {
+				BeforeEach(func() {
+					cmd.RequiredArgs.Previous = "3"
+				})
+
+				It("returns a TargetHistoryIndexError", func() {
+					Expect(executeErr).To(MatchError(translatableerror.TargetHistoryIndexError{Index: 3, BinaryName: binaryName}))
+					Expect(fakeConfig.SwitchToTargetCallCount()).To(Equal(0))
+				})
+			})
+
+			When("the argument is not a number", func() {
+				BeforeEach(func() {
+					cmd.RequiredArgs.Previous = "-"
+				})
+
+				It("returns an IncorrectUsageError", func() {
+					Expect(executeErr).To(MatchError(translatableerror.IncorrectUsageError{Message: "unexpected argument '-'"}))
+				})
+			})
+		})
+
+		When("there is no target history", func() {
+			BeforeEach(func() {
+				fakeConfig.TargetHistoryReturns(nil)
+			})
+
+			It("returns a NoPreviousTargetError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.NoPreviousTargetError{BinaryName: binaryName}))
+			})
+		})
+	})
 })
@@ -5,6 +5,10 @@ import (
 	"reflect"
 	"strings"
 
+	"code.cloudfoundry.org/cli/actor/v7action"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
 	uuid "github.com/nu7hatch/gouuid"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -150,3 +154,23 @@ func setPositionalFlags(cmd interface{}, values ...interface{}) {
 
 	Fail(`Did not find a field with 'positional-args:"yes"' in the struct`)
 }
+
+// runOnFoundationTargets makes the fake runner call the command's function
+// once for every target, in order, and return a result for each of them.
+func runOnFoundationTargets(fakeRunner *v7fakes.FakeFoundationsRunner, targets ...v7.FoundationTarget) {
+	fakeRunner.ReadFoundationsStub = func(string) ([]configv3.FoundationsFileEntry, error) {
+		var entries []configv3.FoundationsFileEntry
+		for _, target := range targets {
+			entries = append(entries, configv3.FoundationsFileEntry{Name: target.Name})
+		}
+		return entries, nil
+	}
+	fakeRunner.RunOnFoundationsStub = func(_ string, _ bool, _ bool, run func(v7.FoundationTarget) (v7action.Warnings, error)) ([]v7.FoundationResult, error) {
+		var results []v7.FoundationResult
+		for _, target := range targets {
+			warnings, err := run(target)
+			results = append(results, v7.FoundationResult{Name: target.Name, Warnings: warnings, Err: err})
+		}
+		return results, nil
+	}
+}
@@ -1023,6 +1023,36 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetAppUsageEventsStub        func(v7action.UsageEventFilter) ([]v7action.AppUsageEvent, v7action.Warnings, error)
+	getAppUsageEventsMutex       sync.RWMutex
+	getAppUsageEventsArgsForCall []struct {
+		arg1 v7action.UsageEventFilter
+	}
+	getAppUsageEventsReturns struct {
+		result1 []v7action.AppUsageEvent
+		result2 v7action.Warnings
+		result3 error
+	}
+	getAppUsageEventsReturnsOnCall map[int]struct {
+		result1 []v7action.AppUsageEvent
+		result2 v7action.Warnings
+		result3 error
+	}
+	GetAppUsageSummaryStub        func(v7action.UsageEventFilter) ([]v7action.AppUsageSummary, v7action.Warnings, error)
+	getAppUsageSummaryMutex       sync.RWMutex
+	getAppUsageSummaryArgsForCall []struct {
+		arg1 v7action.UsageEventFilter
+	}
+	getAppUsageSummaryReturns struct {
+		result1 []v7action.AppUsageSummary
+		result2 v7action.Warnings
+		result3 error
+	}
+	getAppUsageSummaryReturnsOnCall map[int]struct {
+		result1 []v7action.AppUsageSummary
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetApplicationByNameAndSpaceStub        func(string, string) (resources.Application, v7action.Warnings, error)
 	getApplicationByNameAndSpaceMutex       sync.RWMutex
 	getApplicationByNameAndSpaceArgsForCall []struct {
@@ -1180,6 +1210,21 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetAuditEventsStub        func(v7action.AuditEventFilter) ([]v7action.AuditEvent, v7action.Warnings, error)
+	getAuditEventsMutex       sync.RWMutex
+	getAuditEventsArgsForCall []struct {
+		arg1 v7action.AuditEventFilter
+	}
+	getAuditEventsReturns struct {
+		result1 []v7action.AuditEvent
+		result2 v7action.Warnings
+		result3 error
+	}
+	getAuditEventsReturnsOnCall map[int]struct {
+		result1 []v7action.AuditEvent
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetBuildpackLabelsStub        func(string, string) (map[string]types.NullString, v7action.Warnings, error)
 	getBuildpackLabelsMutex       sync.RWMutex
 	getBuildpackLabelsArgsForCall []struct {
@@ -1404,6 +1449,20 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetInventoryStub        func() (v7action.Inventory, v7action.Warnings, error)
+	getInventoryMutex       sync.RWMutex
+	getInventoryArgsForCall []struct {
+	}
+	getInventoryReturns struct {
+		result1 v7action.Inventory
+		result2 v7action.Warnings
+		result3 error
+	}
+	getInventoryReturnsOnCall map[int]struct {
+		result1 v7action.Inventory
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetIsolationSegmentByNameStub        func(string) (resources.IsolationSegment, v7action.Warnings, error)
 	getIsolationSegmentByNameMutex       sync.RWMutex
 	getIsolationSegmentByNameArgsForCall []struct {
@@ -2206,6 +2265,36 @@ type FakeActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetServiceUsageEventsStub        func(v7action.UsageEventFilter) ([]v7action.ServiceUsageEvent, v7action.Warnings, error)
+	getServiceUsageEventsMutex       sync.RWMutex
+	getServiceUsageEventsArgsForCall []struct {
+		arg1 v7action.UsageEventFilter
+	}
+	getServiceUsageEventsReturns struct {
+		result1 []v7action.ServiceUsageEvent
+		result2 v7action.Warnings
+		result3 error
+	}
+	getServiceUsageEventsReturnsOnCall map[int]struct {
+		result1 []v7action.ServiceUsageEvent
+		result2 v7action.Warnings
+		result3 error
+	}
+	GetServiceUsageSummaryStub        func(v7action.UsageEventFilter) ([]v7action.ServiceUsageSummary, v7action.Warnings, error)
+	getServiceUsageSummaryMutex       sync.RWMutex
+	getServiceUsageSummaryArgsForCall []struct {
+		arg1 v7action.UsageEventFilter
+	}
+	getServiceUsageSummaryReturns struct {
+		result1 []v7action.ServiceUsageSummary
+		result2 v7action.Warnings
+		result3 error
+	}
+	getServiceUsageSummaryReturnsOnCall map[int]struct {
+		result1 []v7action.ServiceUsageSummary
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetSpaceByNameAndOrganizationStub        func(string, string) (resources.Space, v7action.Warnings, error)
 	getSpaceByNameAndOrganizationMutex       sync.RWMutex
 	getSpaceByNameAndOrganizationArgsForCall []struct {
@@ -7979,6 +8068,138 @@ func (fake *FakeActor) GetAppSummariesForSpaceReturnsOnCall(i int, result1 []v7a
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetAppUsageEvents(arg1 v7action.UsageEventFilter) ([]v7action.AppUsageEvent, v7action.Warnings, error) {
+	fake.getAppUsageEventsMutex.Lock()
+	ret, specificReturn := fake.getAppUsageEventsReturnsOnCall[len(fake.getAppUsageEventsArgsForCall)]
+	fake.getAppUsageEventsArgsForCall = append(fake.getAppUsageEventsArgsForCall, struct {
+		arg1 v7action.UsageEventFilter
+	}{arg1})
+	fake.recordInvocation("GetAppUsageEvents", []interface{}{arg1})
+	fake.getAppUsageEventsMutex.Unlock()
+	if fake.GetAppUsageEventsStub != nil {
+		return fake.GetAppUsageEventsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getAppUsageEventsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetAppUsageEventsCallCount() int {
+	fake.getAppUsageEventsMutex.RLock()
+	defer fake.getAppUsageEventsMutex.RUnlock()
+	return len(fake.getAppUsageEventsArgsForCall)
+}
+
+func (fake *FakeActor) GetAppUsageEventsCalls(stub func(v7action.UsageEventFilter) ([]v7action.AppUsageEvent, v7action.Warnings, error)) {
+	fake.getAppUsageEventsMutex.Lock()
+	defer fake.getAppUsageEventsMutex.Unlock()
+	fake.GetAppUsageEventsStub = stub
+}
+
+func (fake *FakeActor) GetAppUsageEventsArgsForCall(i int) v7action.UsageEventFilter {
+	fake.getAppUsageEventsMutex.RLock()
+	defer fake.getAppUsageEventsMutex.RUnlock()
+	argsForCall := fake.getAppUsageEventsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) GetAppUsageEventsReturns(result1 []v7action.AppUsageEvent, result2 v7action.Warnings, result3 error) {
+	fake.getAppUsageEventsMutex.Lock()
+	defer fake.getAppUsageEventsMutex.Unlock()
+	fake.GetAppUsageEventsStub = nil
+	fake.getAppUsageEventsReturns = struct {
+		result1 []v7action.AppUsageEvent
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetAppUsageEventsReturnsOnCall(i int, result1 []v7action.AppUsageEvent, result2 v7action.Warnings, result3 error) {
+	fake.getAppUsageEventsMutex.Lock()
+	defer fake.getAppUsageEventsMutex.Unlock()
+	fake.GetAppUsageEventsStub = nil
+	if fake.getAppUsageEventsReturnsOnCall == nil {
+		fake.getAppUsageEventsReturnsOnCall = make(map[int]struct {
+			result1 []v7action.AppUsageEvent
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getAppUsageEventsReturnsOnCall[i] = struct {
+		result1 []v7action.AppUsageEvent
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetAppUsageSummary(arg1 v7action.UsageEventFilter) ([]v7action.AppUsageSummary, v7action.Warnings, error) {
+	fake.getAppUsageSummaryMutex.Lock()
+	ret, specificReturn := fake.getAppUsageSummaryReturnsOnCall[len(fake.getAppUsageSummaryArgsForCall)]
+	fake.getAppUsageSummaryArgsForCall = append(fake.getAppUsageSummaryArgsForCall, struct {
+		arg1 v7action.UsageEventFilter
+	}{arg1})
+	fake.recordInvocation("GetAppUsageSummary", []interface{}{arg1})
+	fake.getAppUsageSummaryMutex.Unlock()
+	if fake.GetAppUsageSummaryStub != nil {
+		return fake.GetAppUsageSummaryStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getAppUsageSummaryReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetAppUsageSummaryCallCount() int {
+	fake.getAppUsageSummaryMutex.RLock()
+	defer fake.getAppUsageSummaryMutex.RUnlock()
+	return len(fake.getAppUsageSummaryArgsForCall)
+}
+
+func (fake *FakeActor) GetAppUsageSummaryCalls(stub func(v7action.UsageEventFilter) ([]v7action.AppUsageSummary, v7action.Warnings, error)) {
+	fake.getAppUsageSummaryMutex.Lock()
+	defer fake.getAppUsageSummaryMutex.Unlock()
+	fake.GetAppUsageSummaryStub = stub
+}
+
+func (fake *FakeActor) GetAppUsageSummaryArgsForCall(i int) v7action.UsageEventFilter {
+	fake.getAppUsageSummaryMutex.RLock()
+	defer fake.getAppUsageSummaryMutex.RUnlock()
+	argsForCall := fake.getAppUsageSummaryArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) GetAppUsageSummaryReturns(result1 []v7action.AppUsageSummary, result2 v7action.Warnings, result3 error) {
+	fake.getAppUsageSummaryMutex.Lock()
+	defer fake.getAppUsageSummaryMutex.Unlock()
+	fake.GetAppUsageSummaryStub = nil
+	fake.getAppUsageSummaryReturns = struct {
+		result1 []v7action.AppUsageSummary
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetAppUsageSummaryReturnsOnCall(i int, result1 []v7action.AppUsageSummary, result2 v7action.Warnings, result3 error) {
+	fake.getAppUsageSummaryMutex.Lock()
+	defer fake.getAppUsageSummaryMutex.Unlock()
+	fake.GetAppUsageSummaryStub = nil
+	if fake.getAppUsageSummaryReturnsOnCall == nil {
+		fake.getAppUsageSummaryReturnsOnCall = make(map[int]struct {
+			result1 []v7action.AppUsageSummary
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getAppUsageSummaryReturnsOnCall[i] = struct {
+		result1 []v7action.AppUsageSummary
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetApplicationByNameAndSpace(arg1 string, arg2 string) (resources.Application, v7action.Warnings, error) {
 	fake.getApplicationByNameAndSpaceMutex.Lock()
 	ret, specificReturn := fake.getApplicationByNameAndSpaceReturnsOnCall[len(fake.getApplicationByNameAndSpaceArgsForCall)]
@@ -8651,6 +8872,72 @@ func (fake *FakeActor) GetApplicationsByNamesAndSpaceReturnsOnCall(i int, result
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetAuditEvents(arg1 v7action.AuditEventFilter) ([]v7action.AuditEvent, v7action.Warnings, error) {
+	fake.getAuditEventsMutex.Lock()
+	ret, specificReturn := fake.getAuditEventsReturnsOnCall[len(fake.getAuditEventsArgsForCall)]
+	fake.getAuditEventsArgsForCall = append(fake.getAuditEventsArgsForCall, struct {
+		arg1 v7action.AuditEventFilter
+	}{arg1})
+	fake.recordInvocation("GetAuditEvents", []interface{}{arg1})
+	fake.getAuditEventsMutex.Unlock()
+	if fake.GetAuditEventsStub != nil {
+		return fake.GetAuditEventsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getAuditEventsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetAuditEventsCallCount() int {
+	fake.getAuditEventsMutex.RLock()
+	defer fake.getAuditEventsMutex.RUnlock()
+	return len(fake.getAuditEventsArgsForCall)
+}
+
+func (fake *FakeActor) GetAuditEventsCalls(stub func(v7action.AuditEventFilter) ([]v7action.AuditEvent, v7action.Warnings, error)) {
+	fake.getAuditEventsMutex.Lock()
+	defer fake.getAuditEventsMutex.Unlock()
+	fake.GetAuditEventsStub = stub
+}
+
+func (fake *FakeActor) GetAuditEventsArgsForCall(i int) v7action.AuditEventFilter {
+	fake.getAuditEventsMutex.RLock()
+	defer fake.getAuditEventsMutex.RUnlock()
+	argsForCall := fake.getAuditEventsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) GetAuditEventsReturns(result1 []v7action.AuditEvent, result2 v7action.Warnings, result3 error) {
+	fake.getAuditEventsMutex.Lock()
+	defer fake.getAuditEventsMutex.Unlock()
+	fake.GetAuditEventsStub = nil
+	fake.getAuditEventsReturns = struct {
+		result1 []v7action.AuditEvent
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetAuditEventsReturnsOnCall(i int, result1 []v7action.AuditEvent, result2 v7action.Warnings, result3 error) {
+	fake.getAuditEventsMutex.Lock()
+	defer fake.getAuditEventsMutex.Unlock()
+	fake.GetAuditEventsStub = nil
+	if fake.getAuditEventsReturnsOnCall == nil {
+		fake.getAuditEventsReturnsOnCall = make(map[int]struct {
+			result1 []v7action.AuditEvent
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getAuditEventsReturnsOnCall[i] = struct {
+		result1 []v7action.AuditEvent
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetBuildpackLabels(arg1 string, arg2 string) (map[string]types.NullString, v7action.Warnings, error) {
 	fake.getBuildpackLabelsMutex.Lock()
 	ret, specificReturn := fake.getBuildpackLabelsReturnsOnCall[len(fake.getBuildpackLabelsArgsForCall)]
@@ -9611,6 +9898,64 @@ func (fake *FakeActor) GetGlobalStagingSecurityGroupsReturnsOnCall(i int, result
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetInventory() (v7action.Inventory, v7action.Warnings, error) {
+	fake.getInventoryMutex.Lock()
+	ret, specificReturn := fake.getInventoryReturnsOnCall[len(fake.getInventoryArgsForCall)]
+	fake.getInventoryArgsForCall = append(fake.getInventoryArgsForCall, struct {
+	}{})
+	fake.recordInvocation("GetInventory", []interface{}{})
+	fake.getInventoryMutex.Unlock()
+	if fake.GetInventoryStub != nil {
+		return fake.GetInventoryStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getInventoryReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetInventoryCallCount() int {
+	fake.getInventoryMutex.RLock()
+	defer fake.getInventoryMutex.RUnlock()
+	return len(fake.getInventoryArgsForCall)
+}
+
+func (fake *FakeActor) GetInventoryCalls(stub func() (v7action.Inventory, v7action.Warnings, error)) {
+	fake.getInventoryMutex.Lock()
+	defer fake.getInventoryMutex.Unlock()
+	fake.GetInventoryStub = stub
+}
+
+func (fake *FakeActor) GetInventoryReturns(result1 v7action.Inventory, result2 v7action.Warnings, result3 error) {
+	fake.getInventoryMutex.Lock()
+	defer fake.getInventoryMutex.Unlock()
+	fake.GetInventoryStub = nil
+	fake.getInventoryReturns = struct {
+		result1 v7action.Inventory
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetInventoryReturnsOnCall(i int, result1 v7action.Inventory, result2 v7action.Warnings, result3 error) {
+	fake.getInventoryMutex.Lock()
+	defer fake.getInventoryMutex.Unlock()
+	fake.GetInventoryStub = nil
+	if fake.getInventoryReturnsOnCall == nil {
+		fake.getInventoryReturnsOnCall = make(map[int]struct {
+			result1 v7action.Inventory
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getInventoryReturnsOnCall[i] = struct {
+		result1 v7action.Inventory
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetIsolationSegmentByName(arg1 string) (resources.IsolationSegment, v7action.Warnings, error) {
 	fake.getIsolationSegmentByNameMutex.Lock()
 	ret, specificReturn := fake.getIsolationSegmentByNameReturnsOnCall[len(fake.getIsolationSegmentByNameArgsForCall)]
@@ -13010,6 +13355,138 @@ func (fake *FakeActor) GetServicePlanLabelsReturnsOnCall(i int, result1 map[stri
 	}{result1, result2, result3}
 }
 
+func (fake *FakeActor) GetServiceUsageEvents(arg1 v7action.UsageEventFilter) ([]v7action.ServiceUsageEvent, v7action.Warnings, error) {
+	fake.getServiceUsageEventsMutex.Lock()
+	ret, specificReturn := fake.getServiceUsageEventsReturnsOnCall[len(fake.getServiceUsageEventsArgsForCall)]
+	fake.getServiceUsageEventsArgsForCall = append(fake.getServiceUsageEventsArgsForCall, struct {
+		arg1 v7action.UsageEventFilter
+	}{arg1})
+	fake.recordInvocation("GetServiceUsageEvents", []interface{}{arg1})
+	fake.getServiceUsageEventsMutex.Unlock()
+	if fake.GetServiceUsageEventsStub != nil {
+		return fake.GetServiceUsageEventsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getServiceUsageEventsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetServiceUsageEventsCallCount() int {
+	fake.getServiceUsageEventsMutex.RLock()
+	defer fake.getServiceUsageEventsMutex.RUnlock()
+	return len(fake.getServiceUsageEventsArgsForCall)
+}
+
+func (fake *FakeActor) GetServiceUsageEventsCalls(stub func(v7action.UsageEventFilter) ([]v7action.ServiceUsageEvent, v7action.Warnings, error)) {
+	fake.getServiceUsageEventsMutex.Lock()
+	defer fake.getServiceUsageEventsMutex.Unlock()
+	fake.GetServiceUsageEventsStub = stub
+}
+
+func (fake *FakeActor) GetServiceUsageEventsArgsForCall(i int) v7action.UsageEventFilter {
+	fake.getServiceUsageEventsMutex.RLock()
+	defer fake.getServiceUsageEventsMutex.RUnlock()
+	argsForCall := fake.getServiceUsageEventsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) GetServiceUsageEventsReturns(result1 []v7action.ServiceUsageEvent, result2 v7action.Warnings, result3 error) {
+	fake.getServiceUsageEventsMutex.Lock()
+	defer fake.getServiceUsageEventsMutex.Unlock()
+	fake.GetServiceUsageEventsStub = nil
+	fake.getServiceUsageEventsReturns = struct {
+		result1 []v7action.ServiceUsageEvent
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetServiceUsageEventsReturnsOnCall(i int, result1 []v7action.ServiceUsageEvent, result2 v7action.Warnings, result3 error) {
+	fake.getServiceUsageEventsMutex.Lock()
+	defer fake.getServiceUsageEventsMutex.Unlock()
+	fake.GetServiceUsageEventsStub = nil
+	if fake.getServiceUsageEventsReturnsOnCall == nil {
+		fake.getServiceUsageEventsReturnsOnCall = make(map[int]struct {
+			result1 []v7action.ServiceUsageEvent
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceUsageEventsReturnsOnCall[i] = struct {
+		result1 []v7action.ServiceUsageEvent
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetServiceUsageSummary(arg1 v7action.UsageEventFilter) ([]v7action.ServiceUsageSummary, v7action.Warnings, error) {
+	fake.getServiceUsageSummaryMutex.Lock()
+	ret, specificReturn := fake.getServiceUsageSummaryReturnsOnCall[len(fake.getServiceUsageSummaryArgsForCall)]
+	fake.getServiceUsageSummaryArgsForCall = append(fake.getServiceUsageSummaryArgsForCall, struct {
+		arg1 v7action.UsageEventFilter
+	}{arg1})
+	fake.recordInvocation("GetServiceUsageSummary", []interface{}{arg1})
+	fake.getServiceUsageSummaryMutex.Unlock()
+	if fake.GetServiceUsageSummaryStub != nil {
+		return fake.GetServiceUsageSummaryStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getServiceUsageSummaryReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeActor) GetServiceUsageSummaryCallCount() int {
+	fake.getServiceUsageSummaryMutex.RLock()
+	defer fake.getServiceUsageSummaryMutex.RUnlock()
+	return len(fake.getServiceUsageSummaryArgsForCall)
+}
+
+func (fake *FakeActor) GetServiceUsageSummaryCalls(stub func(v7action.UsageEventFilter) ([]v7action.ServiceUsageSummary, v7action.Warnings, error)) {
+	fake.getServiceUsageSummaryMutex.Lock()
+	defer fake.getServiceUsageSummaryMutex.Unlock()
+	fake.GetServiceUsageSummaryStub = stub
+}
+
+func (fake *FakeActor) GetServiceUsageSummaryArgsForCall(i int) v7action.UsageEventFilter {
+	fake.getServiceUsageSummaryMutex.RLock()
+	defer fake.getServiceUsageSummaryMutex.RUnlock()
+	argsForCall := fake.getServiceUsageSummaryArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeActor) GetServiceUsageSummaryReturns(result1 []v7action.ServiceUsageSummary, result2 v7action.Warnings, result3 error) {
+	fake.getServiceUsageSummaryMutex.Lock()
+	defer fake.getServiceUsageSummaryMutex.Unlock()
+	fake.GetServiceUsageSummaryStub = nil
+	fake.getServiceUsageSummaryReturns = struct {
+		result1 []v7action.ServiceUsageSummary
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeActor) GetServiceUsageSummaryReturnsOnCall(i int, result1 []v7action.ServiceUsageSummary, result2 v7action.Warnings, result3 error) {
+	fake.getServiceUsageSummaryMutex.Lock()
+	defer fake.getServiceUsageSummaryMutex.Unlock()
+	fake.GetServiceUsageSummaryStub = nil
+	if fake.getServiceUsageSummaryReturnsOnCall == nil {
+		fake.getServiceUsageSummaryReturnsOnCall = make(map[int]struct {
+			result1 []v7action.ServiceUsageSummary
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceUsageSummaryReturnsOnCall[i] = struct {
+		result1 []v7action.ServiceUsageSummary
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeActor) GetSpaceByNameAndOrganization(arg1 string, arg2 string) (resources.Space, v7action.Warnings, error) {
 	fake.getSpaceByNameAndOrganizationMutex.Lock()
 	ret, specificReturn := fake.getSpaceByNameAndOrganizationReturnsOnCall[len(fake.getSpaceByNameAndOrganizationArgsForCall)]
@@ -19292,6 +19769,10 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.getAppFeatureMutex.RUnlock()
 	fake.getAppSummariesForSpaceMutex.RLock()
 	defer fake.getAppSummariesForSpaceMutex.RUnlock()
+	fake.getAppUsageEventsMutex.RLock()
+	defer fake.getAppUsageEventsMutex.RUnlock()
+	fake.getAppUsageSummaryMutex.RLock()
+	defer fake.getAppUsageSummaryMutex.RUnlock()
 	fake.getApplicationByNameAndSpaceMutex.RLock()
 	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
 	fake.getApplicationDropletsMutex.RLock()
@@ -19312,6 +19793,8 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.getApplicationTasksMutex.RUnlock()
 	fake.getApplicationsByNamesAndSpaceMutex.RLock()
 	defer fake.getApplicationsByNamesAndSpaceMutex.RUnlock()
+	fake.getAuditEventsMutex.RLock()
+	defer fake.getAuditEventsMutex.RUnlock()
 	fake.getBuildpackLabelsMutex.RLock()
 	defer fake.getBuildpackLabelsMutex.RUnlock()
 	fake.getBuildpacksMutex.RLock()
@@ -19342,6 +19825,8 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.getGlobalRunningSecurityGroupsMutex.RUnlock()
 	fake.getGlobalStagingSecurityGroupsMutex.RLock()
 	defer fake.getGlobalStagingSecurityGroupsMutex.RUnlock()
+	fake.getInventoryMutex.RLock()
+	defer fake.getInventoryMutex.RUnlock()
 	fake.getIsolationSegmentByNameMutex.RLock()
 	defer fake.getIsolationSegmentByNameMutex.RUnlock()
 	fake.getIsolationSegmentSummariesMutex.RLock()
@@ -19446,6 +19931,10 @@ func (fake *FakeActor) Invocations() map[string][][]interface{} {
 	defer fake.getServicePlanByNameOfferingAndBrokerMutex.RUnlock()
 	fake.getServicePlanLabelsMutex.RLock()
 	defer fake.getServicePlanLabelsMutex.RUnlock()
+	fake.getServiceUsageEventsMutex.RLock()
+	defer fake.getServiceUsageEventsMutex.RUnlock()
+	fake.getServiceUsageSummaryMutex.RLock()
+	defer fake.getServiceUsageSummaryMutex.RUnlock()
 	fake.getSpaceByNameAndOrganizationMutex.RLock()
 	defer fake.getSpaceByNameAndOrganizationMutex.RUnlock()
 	fake.getSpaceFeatureMutex.RLock()
@@ -0,0 +1,44 @@
+package v7
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/resources"
+)
+
+// wideColumns are the columns that the global '--wide' flag appends to
+// resource tables.
+var wideColumns = []string{"guid", "created", "updated", "labels"}
+
+func wideTableHeader(ui command.UI) []string {
+	header := make([]string, len(wideColumns))
+	for i, column := range wideColumns {
+		header[i] = ui.TranslateText(column)
+	}
+	return header
+}
+
+// wideTableRow returns the values of the '--wide' columns for one resource.
+// Timestamps are kept in RFC3339 so that they sort chronologically with
+// '--sort-by'.
+func wideTableRow(guid string, createdAt string, updatedAt string, metadata *resources.Metadata) []string {
+	return []string{guid, createdAt, updatedAt, labelsList(metadata)}
+}
+
+func labelsList(metadata *resources.Metadata) string {
+	if metadata == nil {
+		return ""
+	}
+
+	var labels []string
+	for key, value := range metadata.Labels {
+		if value.IsSet {
+			labels = append(labels, fmt.Sprintf("%s=%s", key, value.Value))
+		}
+	}
+	sort.Strings(labels)
+	return strings.Join(labels, ", ")
+}
@@ -0,0 +1,435 @@
+package fakecf
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	appStarted = "STARTED"
+	appStopped = "STOPPED"
+
+	lifecycleBuildpack = "buildpack"
+	lifecycleDocker    = "docker"
+
+	defaultMemoryInMB = 1024
+	defaultDiskInMB   = 1024
+)
+
+func (s *Server) getApps(w http.ResponseWriter, r *http.Request, _ map[string]string) error {
+	resources := []resource{}
+	for _, app := range s.apps {
+		space := s.findSpace(app.SpaceGUID)
+		if filterMatches(r, "names", app.Name) &&
+			filterMatches(r, "guids", app.GUID) &&
+			filterMatches(r, "space_guids", app.SpaceGUID) &&
+			filterMatches(r, "organization_guids", space.OrganizationGUID) &&
+			filterMatches(r, "lifecycle_type", app.LifecycleType) {
+			resources = append(resources, s.renderApp(app))
+		}
+	}
+	return s.respondWithList(w, r, resources)
+}
+
+func (s *Server) getApp(w http.ResponseWriter, r *http.Request, params map[string]string) error {
+	app := s.findApp(params["guid"])
+	if app == nil {
+		return notFoundError("App")
+	}
+	return respondWithJSON(w, http.StatusOK, s.renderApp(app))
+}
+
+func (s *Server) postApp(w http.ResponseWriter, r *http.Request, _ map[string]string) error {
+	var body struct {
+		Name          string `json:"name"`
+		Relationships struct {
+			Space struct {
+				Data struct {
+					GUID string `json:"guid"`
+				} `json:"data"`
+			} `json:"space"`
+		} `json:"relationships"`
+		Lifecycle struct {
+			Type string `json:"type"`
+			Data struct {
+				Buildpacks []string `json:"buildpacks"`
+				Stack      string   `json:"stack"`
+			} `json:"data"`
+		} `json:"lifecycle"`
+	}
+	err := decodeBody(r, &body)
+	if err != nil {
+		return err
+	}
+
+	if body.Name == "" {
+		return unprocessableEntityError("Name can't be blank")
+	}
+	if s.findSpace(body.Relationships.Space.Data.GUID) == nil {
+		return unprocessableEntityError("Invalid space. Ensure that the space exists and you have access to it.")
+	}
+	if s.findAppByName(body.Relationships.Space.Data.GUID, body.Name) != nil {
+		return unprocessableEntityError("App with the name '%s' already exists.", body.Name)
+	}
+
+	app := s.createApp(body.Relationships.Space.Data.GUID, body.Name)
+	if body.Lifecycle.Type == lifecycleDocker {
+		app.LifecycleType = lifecycleDocker
+	}
+	if len(body.Lifecycle.Data.Buildpacks) > 0 {
+		app.Buildpacks = body.Lifecycle.Data.Buildpacks
+	}
+	if body.Lifecycle.Data.Stack != "" {
+		app.Stack = body.Lifecycle.Data.Stack
+	}
+
+	return respondWithJSON(w, http.StatusCreated, s.renderApp(app))
+}
+
+func (s *Server) deleteApp(w http.ResponseWriter, r *http.Request, params map[string]string) error {
+	app := s.findApp(params["guid"])
+	if app == nil {
+		return notFoundError("App")
+	}
+
+	var apps []*App
+	for _, other := range s.apps {
+		if other != app {
+			apps = append(apps, other)
+		}
+	}
+	s.apps = apps
+
+	var processes []*Process
+	for _, process := range s.processes {
+		if process.AppGUID != app.GUID {
+			processes = append(processes, process)
+		}
+	}
+	s.processes = processes
+
+	for _, route := range s.routes {
+		route.unmap(app.GUID)
+	}
+
+	return s.respondWithJob(w, "app.delete", nil)
+}
+
+func (s *Server) postAppStart(w http.ResponseWriter, r *http.Request, params map[string]string) error {
+	app := s.findApp(params["guid"])
+	if app == nil {
+		return notFoundError("App")
+	}
+
+	err := s.startApp(app)
+	if err != nil {
+		return err
+	}
+	return respondWithJSON(w, http.StatusOK, s.renderApp(app))
+}
+
+func (s *Server) postAppStop(w http.ResponseWriter, r *http.Request, params map[string]string) error {
+	app := s.findApp(params["guid"])
+	if app == nil {
+		return notFoundError("App")
+	}
+
+	s.stopApp(app)
+	return respondWithJSON(w, http.StatusOK, s.renderApp(app))
+}
+
+func (s *Server) postAppRestart(w http.ResponseWriter, r *http.Request, params map[string]string) error {
+	app := s.findApp(params["guid"])
+	if app == nil {
+		return notFoundError("App")
+	}
+
+	if app.State == appStarted {
+		s.stopApp(app)
+	}
+	err := s.startApp(app)
+	if err != nil {
+		return err
+	}
+	return respondWithJSON(w, http.StatusOK, s.renderApp(app))
+}
+
+func (s *Server) getAppCurrentDroplet(w http.ResponseWriter, r *http.Request, params map[string]string) error {
+	app := s.findApp(params["guid"])
+	if app == nil {
+		return notFoundError("App")
+	}
+
+	droplet := s.findDroplet(app.DropletGUID)
+	if droplet == nil {
+		return notFoundError("Droplet")
+	}
+	return respondWithJSON(w, http.StatusOK, s.renderDroplet(droplet))
+}
+
+func (s *Server) patchAppCurrentDroplet(w http.ResponseWriter, r *http.Request, params map[string]string) error {
+	app := s.findApp(params["guid"])
+	if app == nil {
+		return notFoundError("App")
+	}
+
+	var body struct {
+		Data struct {
+			GUID string `json:"guid"`
+		} `json:"data"`
+	}
+	err := decodeBody(r, &body)
+	if err != nil {
+		return err
+	}
+
+	droplet := s.findDroplet(body.Data.GUID)
+	if droplet == nil || droplet.AppGUID != app.GUID || droplet.State != dropletStaged {
+		return unprocessableEntityError("Unable to assign current droplet. Ensure the droplet exists and belongs to this app.")
+	}
+	app.DropletGUID = droplet.GUID
+
+	return respondWithJSON(w, http.StatusOK, map[string]interface{}{
+		"data": map[string]string{"guid": droplet.GUID},
+		"links": map[string]interface{}{
+			"self":    s.link("/v3/apps/%s/relationships/current_droplet", app.GUID),
+			"related": s.link("/v3/apps/%s/droplets/current", app.GUID),
+		},
+	})
+}
+
+func (s *Server) getAppProcesses(w http.ResponseWriter, r *http.Request, params map[string]string) error {
+	app := s.findApp(params["guid"])
+	if app == nil {
+		return notFoundError("App")
+	}
+
+	resources := []resource{}
+	for _, process := range s.appProcesses(app.GUID) {
+		resources = append(resources, s.renderProcess(process))
+	}
+	return s.respondWithList(w, r, resources)
+}
+
+func (s *Server) getAppProcess(w http.ResponseWriter, r *http.Request, params map[string]string) error {
+	process := s.findAppProcess(params["guid"], params["type"])
+	if process == nil {
+		return notFoundError("Process")
+	}
+	return respondWithJSON(w, http.StatusOK, s.renderProcess(process))
+}
+
+func (s *Server) getAppRoutes(w http.ResponseWriter, r *http.Request, params map[string]string) error {
+	app := s.findApp(params["guid"])
+	if app == nil {
+		return notFoundError("App")
+	}
+
+	resources := []resource{}
+	for _, route := range s.routes {
+		if route.mapsTo(app.GUID) {
+			resources = append(resources, s.renderRoute(route))
+		}
+	}
+	return s.respondWithList(w, r, resources)
+}
+
+func (s *Server) getProcesses(w http.ResponseWriter, r *http.Request, _ map[string]string) error {
+	resources := []resource{}
+	for _, process := range s.processes {
+		if filterMatches(r, "guids", process.GUID) &&
+			filterMatches(r, "app_guids", process.AppGUID) &&
+			filterMatches(r, "types", process.Type) {
+			resources = append(resources, s.renderProcess(process))
+		}
+	}
+	return s.respondWithList(w, r, resources)
+}
+
+func (s *Server) getProcess(w http.ResponseWriter, r *http.Request, params map[string]string) error {
+	process := s.findProcess(params["guid"])
+	if process == nil {
+		return notFoundError("Process")
+	}
+	return respondWithJSON(w, http.StatusOK, s.renderProcess(process))
+}
+
+// getProcessStats reports every instance of a started app with a droplet as
+// running, and every instance of any other app as down.
+func (s *Server) getProcessStats(w http.ResponseWriter, r *http.Request, params map[string]string) error {
+	process := s.findProcess(params["guid"])
+	if process == nil {
+		return notFoundError("Process")
+	}
+	app := s.findApp(process.AppGUID)
+
+	instances := []resource{}
+	for index := 0; index < process.Instances; index++ {
+		state, uptime := "DOWN", 0
+		if app.State == appStarted && app.DropletGUID != "" {
+			state, uptime = "RUNNING", int(time.Since(app.StartedAt).Seconds())
+		}
+
+		instances = append(instances, resource{
+			"type":              process.Type,
+			"index":             index,
+			"state":             state,
+			"host":              "127.0.0.1",
+			"uptime":            uptime,
+			"mem_quota":         process.MemoryInMB * 1024 * 1024,
+			"disk_quota":        process.DiskInMB * 1024 * 1024,
+			"fds_quota":         16384,
+			"isolation_segment": nil,
+			"details":           nil,
+			"usage": map[string]interface{}{
+				"time": formatTime(time.Now()),
+				"cpu":  0,
+				"mem":  0,
+				"disk": 0,
+			},
+		})
+	}
+
+	return respondWithJSON(w, http.StatusOK, map[string]interface{}{"resources": instances})
+}
+
+func (s *Server) getProcessSidecars(w http.ResponseWriter, r *http.Request, params map[string]string) error {
+	if s.findProcess(params["guid"]) == nil {
+		return notFoundError("Process")
+	}
+	return s.respondWithList(w, r, []resource{})
+}
+
+// createApp adds a stopped buildpack app with a web process.
+func (s *Server) createApp(spaceGUID string, name string) *App {
+	app := &App{
+		GUID:          mustGUID(),
+		Name:          name,
+		SpaceGUID:     spaceGUID,
+		State:         appStopped,
+		LifecycleType: lifecycleBuildpack,
+		Buildpacks:    []string{},
+		Stack:         DefaultStack,
+		CreatedAt:     time.Now(),
+	}
+	s.apps = append(s.apps, app)
+	s.createProcess(app.GUID, "web")
+	return app
+}
+
+func (s *Server) createProcess(appGUID string, processType string) *Process {
+	process := &Process{
+		GUID:            mustGUID(),
+		AppGUID:         appGUID,
+		Type:            processType,
+		MemoryInMB:      defaultMemoryInMB,
+		DiskInMB:        defaultDiskInMB,
+		HealthCheckType: "process",
+		CreatedAt:       time.Now(),
+	}
+	if processType == "web" {
+		process.Instances = 1
+		process.HealthCheckType = "port"
+	}
+	s.processes = append(s.processes, process)
+	return process
+}
+
+func (s *Server) startApp(app *App) error {
+	if app.DropletGUID == "" {
+		return unprocessableEntityError("Assign a droplet before starting this app.")
+	}
+
+	app.State = appStarted
+	app.StartedAt = time.Now()
+	s.emitLog(app.GUID, "API", "0", fmt.Sprintf(`Updated app with guid %s ({"state"=>"STARTED"})`, app.GUID))
+	for _, process := range s.appProcesses(app.GUID) {
+		for index := 0; index < process.Instances; index++ {
+			instance := fmt.Sprint(index)
+			s.emitLog(app.GUID, "CELL", instance, fmt.Sprintf("Starting app instance (index %d) with guid %s", index, app.GUID))
+			s.emitLog(app.GUID, "APP/PROC/"+strings.ToUpper(process.Type), instance, fmt.Sprintf("Instance %d of %s started", index, app.Name))
+		}
+	}
+	return nil
+}
+
+func (s *Server) stopApp(app *App) {
+	app.State = appStopped
+	s.emitLog(app.GUID, "API", "0", fmt.Sprintf(`Updated app with guid %s ({"state"=>"STOPPED"})`, app.GUID))
+}
+
+func (s *Server) renderApp(app *App) resource {
+	lifecycle := map[string]interface{}{"type": app.LifecycleType, "data": map[string]interface{}{}}
+	if app.LifecycleType == lifecycleBuildpack {
+		lifecycle["data"] = map[string]interface{}{
+			"buildpacks": app.Buildpacks,
+			"stack":      app.Stack,
+		}
+	}
+
+	return resource{
+		"guid":       app.GUID,
+		"name":       app.Name,
+		"state":      app.State,
+		"created_at": formatTime(app.CreatedAt),
+		"updated_at": formatTime(app.CreatedAt),
+		"lifecycle":  lifecycle,
+		"relationships": map[string]interface{}{
+			"space": relationship(app.SpaceGUID),
+		},
+		"metadata": emptyMetadata(),
+		"links": map[string]interface{}{
+			"self":            s.link("/v3/apps/%s", app.GUID),
+			"space":           s.link("/v3/spaces/%s", app.SpaceGUID),
+			"processes":       s.link("/v3/apps/%s/processes", app.GUID),
+			"packages":        s.link("/v3/apps/%s/packages", app.GUID),
+			"current_droplet": s.link("/v3/apps/%s/droplets/current", app.GUID),
+			"droplets":        s.link("/v3/apps/%s/droplets", app.GUID),
+			"start":           s.link("/v3/apps/%s/actions/start", app.GUID),
+			"stop":            s.link("/v3/apps/%s/actions/stop", app.GUID),
+		},
+	}
+}
+
+func (s *Server) renderProcess(process *Process) resource {
+	var command interface{}
+	if process.Command != "" {
+		command = process.Command
+	}
+
+	healthCheckData := map[string]interface{}{
+		"timeout":            nil,
+		"invocation_timeout": nil,
+	}
+	if process.HealthCheckType == "http" {
+		healthCheckData["endpoint"] = process.HealthCheckEndpoint
+	}
+
+	return resource{
+		"guid":                               process.GUID,
+		"type":                               process.Type,
+		"command":                            command,
+		"instances":                          process.Instances,
+		"memory_in_mb":                       process.MemoryInMB,
+		"disk_in_mb":                         process.DiskInMB,
+		"log_rate_limit_in_bytes_per_second": -1,
+		"health_check": map[string]interface{}{
+			"type": process.HealthCheckType,
+			"data": healthCheckData,
+		},
+		"created_at": formatTime(process.CreatedAt),
+		"updated_at": formatTime(process.CreatedAt),
+		"relationships": map[string]interface{}{
+			"app": relationship(process.AppGUID),
+		},
+		"metadata": emptyMetadata(),
+		"links": map[string]interface{}{
+			"self":  s.link("/v3/processes/%s", process.GUID),
+			"app":   s.link("/v3/apps/%s", process.AppGUID),
+			"space": s.link("/v3/spaces/%s", s.findApp(process.AppGUID).SpaceGUID),
+			"stats": s.link("/v3/processes/%s/stats", process.GUID),
+		},
+	}
+}
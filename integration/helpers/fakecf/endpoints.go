@@ -0,0 +1,81 @@
+package fakecf
+
+import "net/http"
+
+func (s *Server) apiEndpoints() []endpoint {
+	return []endpoint{
+		{http.MethodGet, "/", false, s.getRoot},
+
+		// UAA
+		{http.MethodGet, "/login", false, s.getLogin},
+		{http.MethodPost, "/oauth/token", false, s.postOAuthToken},
+		{http.MethodDelete, "/oauth/token/revoke/:token_id", false, s.deleteOAuthToken},
+
+		// Log Cache
+		{http.MethodGet, "/api/v1/info", false, s.getLogCacheInfo},
+		{http.MethodGet, "/api/v1/read/:source_id", true, s.getLogCacheRead},
+
+		// Cloud Controller
+		{http.MethodGet, "/v3/organizations", true, s.getOrganizations},
+		{http.MethodGet, "/v3/organizations/:guid", true, s.getOrganization},
+		{http.MethodGet, "/v3/organizations/:guid/domains", true, s.getDomains},
+		{http.MethodGet, "/v3/organizations/:guid/domains/default", true, s.getDefaultDomain},
+		{http.MethodGet, "/v3/spaces", true, s.getSpaces},
+		{http.MethodGet, "/v3/spaces/:guid", true, s.getSpace},
+		{http.MethodPost, "/v3/spaces/:guid/actions/apply_manifest", true, s.postApplyManifest},
+		{http.MethodPost, "/v3/spaces/:guid/manifest_diff", true, s.postManifestDiff},
+		{http.MethodGet, "/v3/domains", true, s.getDomains},
+		{http.MethodGet, "/v3/domains/:guid", true, s.getDomain},
+		{http.MethodGet, "/v3/stacks", true, s.getStacks},
+		{http.MethodGet, "/v3/jobs/:guid", true, s.getJob},
+
+		{http.MethodGet, "/v3/apps", true, s.getApps},
+		{http.MethodPost, "/v3/apps", true, s.postApp},
+		{http.MethodGet, "/v3/apps/:guid", true, s.getApp},
+		{http.MethodDelete, "/v3/apps/:guid", true, s.deleteApp},
+		{http.MethodPost, "/v3/apps/:guid/actions/start", true, s.postAppStart},
+		{http.MethodPost, "/v3/apps/:guid/actions/stop", true, s.postAppStop},
+		{http.MethodPost, "/v3/apps/:guid/actions/restart", true, s.postAppRestart},
+		{http.MethodGet, "/v3/apps/:guid/droplets/current", true, s.getAppCurrentDroplet},
+		{http.MethodPatch, "/v3/apps/:guid/relationships/current_droplet", true, s.patchAppCurrentDroplet},
+		{http.MethodGet, "/v3/apps/:guid/processes", true, s.getAppProcesses},
+		{http.MethodGet, "/v3/apps/:guid/processes/:type", true, s.getAppProcess},
+		{http.MethodGet, "/v3/apps/:guid/routes", true, s.getAppRoutes},
+
+		{http.MethodGet, "/v3/processes", true, s.getProcesses},
+		{http.MethodGet, "/v3/processes/:guid", true, s.getProcess},
+		{http.MethodGet, "/v3/processes/:guid/stats", true, s.getProcessStats},
+		{http.MethodGet, "/v3/processes/:guid/sidecars", true, s.getProcessSidecars},
+
+		{http.MethodPost, "/v3/resource_matches", true, s.postResourceMatches},
+		{http.MethodGet, "/v3/packages", true, s.getPackages},
+		{http.MethodPost, "/v3/packages", true, s.postPackage},
+		{http.MethodGet, "/v3/packages/:guid", true, s.getPackage},
+		{http.MethodPost, "/v3/packages/:guid/upload", true, s.postPackageUpload},
+		{http.MethodGet, "/v3/packages/:guid/droplets", true, s.getPackageDroplets},
+		{http.MethodPost, "/v3/builds", true, s.postBuild},
+		{http.MethodGet, "/v3/builds/:guid", true, s.getBuild},
+		{http.MethodGet, "/v3/droplets", true, s.getDroplets},
+		{http.MethodGet, "/v3/droplets/:guid", true, s.getDroplet},
+
+		{http.MethodGet, "/v3/routes", true, s.getRoutes},
+		{http.MethodGet, "/v3/service_instances", true, s.getServiceInstances},
+		{http.MethodPost, "/v3/service_instances", true, s.postServiceInstance},
+		{http.MethodGet, "/v3/service_instances/:guid", true, s.getServiceInstance},
+	}
+}
+
+func (s *Server) getRoot(w http.ResponseWriter, r *http.Request, _ map[string]string) error {
+	return respondWithJSON(w, http.StatusOK, map[string]interface{}{
+		"links": map[string]interface{}{
+			"self": s.link(""),
+			"cloud_controller_v3": map[string]interface{}{
+				"href": s.URL() + "/v3",
+				"meta": map[string]string{"version": CCAPIVersion},
+			},
+			"uaa":       s.link(""),
+			"login":     s.link(""),
+			"log_cache": s.link(""),
+		},
+	})
+}
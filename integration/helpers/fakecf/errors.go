@@ -0,0 +1,94 @@
+package fakecf
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// ccError is a Cloud Controller V3 error. It is rendered the way the Cloud
+// Controller renders errors, so the CLI converts it to the same error as it
+// would for a real Cloud Controller.
+type ccError struct {
+	status int
+	Code   int    `json:"code"`
+	Title  string `json:"title"`
+	Detail string `json:"detail"`
+}
+
+func (e ccError) Error() string {
+	return e.Detail
+}
+
+func notFoundError(resource string) error {
+	return ccError{
+		status: http.StatusNotFound,
+		Code:   10010,
+		Title:  "CF-ResourceNotFound",
+		Detail: fmt.Sprintf("%s not found", resource),
+	}
+}
+
+func unprocessableEntityError(format string, args ...interface{}) ccError {
+	return ccError{
+		status: http.StatusUnprocessableEntity,
+		Code:   10008,
+		Title:  "CF-UnprocessableEntity",
+		Detail: fmt.Sprintf(format, args...),
+	}
+}
+
+func messageParseError(err error) error {
+	return ccError{
+		status: http.StatusBadRequest,
+		Code:   1001,
+		Title:  "CF-MessageParseError",
+		Detail: fmt.Sprintf("Request invalid due to parse error: %s", err),
+	}
+}
+
+func invalidAuthTokenError() error {
+	return ccError{
+		status: http.StatusUnauthorized,
+		Code:   1000,
+		Title:  "CF-InvalidAuthToken",
+		Detail: "Invalid Auth Token",
+	}
+}
+
+// uaaError is a UAA error, rendered the way UAA renders errors.
+type uaaError struct {
+	status      int
+	Type        string `json:"error"`
+	Description string `json:"error_description"`
+}
+
+func (e uaaError) Error() string {
+	return e.Description
+}
+
+func badCredentialsError() error {
+	return uaaError{
+		status:      http.StatusUnauthorized,
+		Type:        "unauthorized",
+		Description: "Bad credentials",
+	}
+}
+
+func invalidTokenError() error {
+	return uaaError{
+		status:      http.StatusUnauthorized,
+		Type:        "invalid_token",
+		Description: "Invalid refresh token",
+	}
+}
+
+func handleError(w http.ResponseWriter, err error) {
+	switch e := err.(type) {
+	case ccError:
+		_ = respondWithJSON(w, e.status, map[string][]ccError{"errors": {e}})
+	case uaaError:
+		_ = respondWithJSON(w, e.status, e)
+	default:
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
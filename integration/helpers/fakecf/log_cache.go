@@ -0,0 +1,120 @@
+package fakecf
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultLogLimit = 100
+	maxLogLimit     = 1000
+)
+
+// LogMessage is a log line held by the fake Log Cache.
+type LogMessage struct {
+	// SourceID is the GUID of the app the log belongs to.
+	SourceID string
+	// SourceType is the component that emitted the log, such as APP/PROC/WEB,
+	// STG or API.
+	SourceType string
+	InstanceID string
+	Message    string
+	// Error is true for logs written to stderr.
+	Error     bool
+	Timestamp time.Time
+}
+
+// AddLog adds a log line from the first instance of the app with the given
+// GUID, as if the app had written it to stdout.
+func (s *Server) AddLog(appGUID string, message string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.emitLog(appGUID, "APP/PROC/WEB", "0", message)
+}
+
+// emitLog adds a log line with a timestamp later than any before it, so
+// that logs emitted in quick succession keep their order.
+func (s *Server) emitLog(appGUID string, sourceType string, instanceID string, message string) {
+	timestamp := time.Now()
+	if len(s.logs) > 0 && !timestamp.After(s.logs[len(s.logs)-1].Timestamp) {
+		timestamp = s.logs[len(s.logs)-1].Timestamp.Add(time.Nanosecond)
+	}
+
+	s.logs = append(s.logs, LogMessage{
+		SourceID:   appGUID,
+		SourceType: sourceType,
+		InstanceID: instanceID,
+		Message:    message,
+		Timestamp:  timestamp,
+	})
+}
+
+func (s *Server) getLogCacheInfo(w http.ResponseWriter, r *http.Request, _ map[string]string) error {
+	return respondWithJSON(w, http.StatusOK, map[string]string{
+		"version":   "2.11.4",
+		"vm_uptime": "1",
+	})
+}
+
+// getLogCacheRead serves the envelopes of a source in the JSON encoding of
+// the Log Cache read response. Only log envelopes are held.
+func (s *Server) getLogCacheRead(w http.ResponseWriter, r *http.Request, params map[string]string) error {
+	query := r.URL.Query()
+
+	startTime := time.Unix(0, 0)
+	if nanos, err := strconv.ParseInt(query.Get("start_time"), 10, 64); err == nil {
+		startTime = time.Unix(0, nanos)
+	}
+	var endTime time.Time
+	if nanos, err := strconv.ParseInt(query.Get("end_time"), 10, 64); err == nil {
+		endTime = time.Unix(0, nanos)
+	}
+	limit := positiveInt(query.Get("limit"), defaultLogLimit)
+	if limit > maxLogLimit {
+		limit = maxLogLimit
+	}
+
+	wantsLogs := len(query["envelope_types"]) == 0
+	for _, envelopeType := range query["envelope_types"] {
+		wantsLogs = wantsLogs || envelopeType == "LOG" || envelopeType == "ANY"
+	}
+
+	var logs []LogMessage
+	for _, log := range s.logs {
+		if wantsLogs && log.SourceID == params["source_id"] && !log.Timestamp.Before(startTime) && (endTime.IsZero() || log.Timestamp.Before(endTime)) {
+			logs = append(logs, log)
+		}
+	}
+	if query.Get("descending") == "true" {
+		sort.SliceStable(logs, func(i, j int) bool { return logs[i].Timestamp.After(logs[j].Timestamp) })
+	}
+	if len(logs) > limit {
+		logs = logs[:limit]
+	}
+
+	batch := []map[string]interface{}{}
+	for _, log := range logs {
+		logType := "OUT"
+		if log.Error {
+			logType = "ERR"
+		}
+
+		batch = append(batch, map[string]interface{}{
+			"timestamp":   strconv.FormatInt(log.Timestamp.UnixNano(), 10),
+			"source_id":   log.SourceID,
+			"instance_id": log.InstanceID,
+			"tags":        map[string]string{"source_type": log.SourceType},
+			"log": map[string]interface{}{
+				"payload": []byte(log.Message),
+				"type":    logType,
+			},
+		})
+	}
+
+	return respondWithJSON(w, http.StatusOK, map[string]interface{}{
+		"envelopes": map[string]interface{}{"batch": batch},
+	})
+}
@@ -0,0 +1,260 @@
+package fakecf
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/bytefmt"
+	"code.cloudfoundry.org/cli/util/manifestparser"
+	"code.cloudfoundry.org/cli/util/randomword"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	jobComplete = "COMPLETE"
+	jobFailed   = "FAILED"
+)
+
+// postApplyManifest applies a manifest the way the Cloud Controller does:
+// apps are created when they do not exist, and their processes and routes
+// are updated to match the manifest. Apps are neither staged nor started.
+func (s *Server) postApplyManifest(w http.ResponseWriter, r *http.Request, params map[string]string) error {
+	space := s.findSpace(params["guid"])
+	if space == nil {
+		return notFoundError("Space")
+	}
+
+	manifest, err := readManifest(r)
+	if err != nil {
+		return err
+	}
+
+	for index, manifestApp := range manifest.Applications {
+		if manifestApp.Name == "" {
+			return unprocessableEntityError("For application at index %d: Name must not be empty", index)
+		}
+	}
+
+	var applyErr error
+	for _, manifestApp := range manifest.Applications {
+		applyErr = s.applyManifestApp(space, manifestApp)
+		if applyErr != nil {
+			break
+		}
+	}
+	return s.respondWithJob(w, "space.apply_manifest", applyErr)
+}
+
+// postManifestDiff reports no differences, which the CLI displays as an
+// unchanged manifest.
+func (s *Server) postManifestDiff(w http.ResponseWriter, r *http.Request, params map[string]string) error {
+	if s.findSpace(params["guid"]) == nil {
+		return notFoundError("Space")
+	}
+
+	_, err := readManifest(r)
+	if err != nil {
+		return err
+	}
+	return respondWithJSON(w, http.StatusCreated, map[string]interface{}{"diff": []interface{}{}})
+}
+
+// getJob reports the job as it finished when it was created.
+func (s *Server) getJob(w http.ResponseWriter, r *http.Request, params map[string]string) error {
+	job := s.findJob(params["guid"])
+	if job == nil {
+		return notFoundError("Job")
+	}
+
+	jobErrors := []ccError{}
+	jobErrors = append(jobErrors, job.Errors...)
+
+	return respondWithJSON(w, http.StatusOK, resource{
+		"guid":       job.GUID,
+		"operation":  job.Operation,
+		"state":      job.State,
+		"errors":     jobErrors,
+		"warnings":   []interface{}{},
+		"created_at": formatTime(job.CreatedAt),
+		"updated_at": formatTime(job.CreatedAt),
+		"links": map[string]interface{}{
+			"self": s.link("/v3/jobs/%s", job.GUID),
+		},
+	})
+}
+
+// respondWithJob responds with the location of a job for an operation that
+// has already been carried out, which failed if err is not nil.
+func (s *Server) respondWithJob(w http.ResponseWriter, operation string, err error) error {
+	job := &job{
+		GUID:      mustGUID(),
+		Operation: operation,
+		State:     jobComplete,
+		CreatedAt: time.Now(),
+	}
+	if err != nil {
+		jobErr, ok := err.(ccError)
+		if !ok {
+			return err
+		}
+		job.State = jobFailed
+		job.Errors = []ccError{jobErr}
+	}
+	s.jobs = append(s.jobs, job)
+
+	w.Header().Set("Location", s.URL()+"/v3/jobs/"+job.GUID)
+	w.WriteHeader(http.StatusAccepted)
+	return nil
+}
+
+func readManifest(r *http.Request) (manifestparser.Manifest, error) {
+	var manifest manifestparser.Manifest
+
+	rawManifest, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return manifest, err
+	}
+
+	err = yaml.Unmarshal(rawManifest, &manifest)
+	if err != nil {
+		return manifest, ccError{
+			status: http.StatusBadRequest,
+			Code:   1001,
+			Title:  "CF-MessageParseError",
+			Detail: "Request invalid due to parse error: invalid request body",
+		}
+	}
+	return manifest, nil
+}
+
+func (s *Server) applyManifestApp(space *Space, manifestApp manifestparser.Application) error {
+	app := s.findAppByName(space.GUID, manifestApp.Name)
+	if app == nil {
+		app = s.createApp(space.GUID, manifestApp.Name)
+	}
+
+	if manifestApp.Docker != nil {
+		app.LifecycleType = lifecycleDocker
+	}
+	if buildpacks, ok := manifestApp.RemainingManifestFields["buildpacks"].([]interface{}); ok {
+		app.Buildpacks = []string{}
+		for _, buildpack := range buildpacks {
+			app.Buildpacks = append(app.Buildpacks, fmt.Sprint(buildpack))
+		}
+	}
+	if manifestApp.Stack != "" {
+		app.Stack = manifestApp.Stack
+	}
+
+	command, hasCommand := manifestApp.RemainingManifestFields["command"]
+	err := updateProcess(s.findAppProcess(app.GUID, "web"), manifestparser.Process{
+		Instances:           manifestApp.Instances,
+		Memory:              manifestApp.Memory,
+		DiskQuota:           manifestApp.DiskQuota,
+		HealthCheckType:     manifestApp.HealthCheckType,
+		HealthCheckEndpoint: manifestApp.HealthCheckEndpoint,
+	}, command, hasCommand)
+	if err != nil {
+		return err
+	}
+
+	for _, manifestProcess := range manifestApp.Processes {
+		process := s.findAppProcess(app.GUID, manifestProcess.Type)
+		if process == nil {
+			process = s.createProcess(app.GUID, manifestProcess.Type)
+		}
+
+		command, hasCommand := manifestProcess.RemainingManifestFields["command"]
+		err = updateProcess(process, manifestProcess, command, hasCommand)
+		if err != nil {
+			return err
+		}
+	}
+
+	return s.applyManifestRoutes(space, app, manifestApp)
+}
+
+func (s *Server) applyManifestRoutes(space *Space, app *App, manifestApp manifestparser.Application) error {
+	if manifestApp.NoRoute {
+		for _, route := range s.routes {
+			route.unmap(app.GUID)
+		}
+		return nil
+	}
+
+	if routes, ok := manifestApp.RemainingManifestFields["routes"].([]interface{}); ok {
+		for _, manifestRoute := range routes {
+			routeFields, _ := manifestRoute.(map[interface{}]interface{})
+			url := fmt.Sprint(routeFields["route"])
+
+			path := ""
+			if index := strings.Index(url, "/"); index >= 0 {
+				url, path = url[:index], url[index:]
+			}
+
+			domain, host := s.findDomainForURL(url)
+			if domain == nil {
+				return unprocessableEntityError("The route '%s' did not match any existing domains.", fmt.Sprint(routeFields["route"]))
+			}
+
+			err := s.mapRoute(space.GUID, domain, host, path, app.GUID)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	hasRoutes := false
+	for _, route := range s.routes {
+		hasRoutes = hasRoutes || route.mapsTo(app.GUID)
+	}
+	if hasRoutes || !(manifestApp.DefaultRoute || manifestApp.RandomRoute) || len(s.domains) == 0 {
+		return nil
+	}
+
+	host := strings.ToLower(app.Name)
+	if manifestApp.RandomRoute {
+		host += "-" + randomword.NewGenerator().Babble()
+	}
+	return s.mapRoute(space.GUID, s.domains[0], host, "", app.GUID)
+}
+
+func updateProcess(process *Process, manifestProcess manifestparser.Process, command interface{}, hasCommand bool) error {
+	if manifestProcess.Instances != nil {
+		process.Instances = *manifestProcess.Instances
+	}
+
+	if manifestProcess.Memory != "" {
+		memory, err := bytefmt.ToMegabytes(manifestProcess.Memory)
+		if err != nil {
+			return unprocessableEntityError("Process \"%s\": Memory must use a supported unit: B, K, KB, M, MB, G, GB, T, or TB", process.Type)
+		}
+		process.MemoryInMB = memory
+	}
+
+	if manifestProcess.DiskQuota != "" {
+		disk, err := bytefmt.ToMegabytes(manifestProcess.DiskQuota)
+		if err != nil {
+			return unprocessableEntityError("Process \"%s\": Disk quota must use a supported unit: B, K, KB, M, MB, G, GB, T, or TB", process.Type)
+		}
+		process.DiskInMB = disk
+	}
+
+	if manifestProcess.HealthCheckType != "" {
+		process.HealthCheckType = string(manifestProcess.HealthCheckType)
+		process.HealthCheckEndpoint = manifestProcess.HealthCheckEndpoint
+	}
+
+	if hasCommand {
+		process.Command = ""
+		if command != nil {
+			process.Command = fmt.Sprint(command)
+		}
+	}
+
+	return nil
+}
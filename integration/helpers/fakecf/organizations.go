@@ -0,0 +1,158 @@
+package fakecf
+
+import (
+	"net/http"
+)
+
+func (s *Server) getOrganizations(w http.ResponseWriter, r *http.Request, _ map[string]string) error {
+	resources := []resource{}
+	for _, org := range s.organizations {
+		if filterMatches(r, "names", org.Name) && filterMatches(r, "guids", org.GUID) {
+			resources = append(resources, s.renderOrganization(org))
+		}
+	}
+	return s.respondWithList(w, r, resources)
+}
+
+func (s *Server) getOrganization(w http.ResponseWriter, r *http.Request, params map[string]string) error {
+	org := s.findOrganization(params["guid"])
+	if org == nil {
+		return notFoundError("Organization")
+	}
+	return respondWithJSON(w, http.StatusOK, s.renderOrganization(org))
+}
+
+func (s *Server) getSpaces(w http.ResponseWriter, r *http.Request, _ map[string]string) error {
+	resources := []resource{}
+	includedOrgs := []resource{}
+	includedOrgGUIDs := map[string]bool{}
+	for _, space := range s.spaces {
+		if filterMatches(r, "names", space.Name) && filterMatches(r, "guids", space.GUID) && filterMatches(r, "organization_guids", space.OrganizationGUID) {
+			resources = append(resources, s.renderSpace(space))
+			if org := s.findOrganization(space.OrganizationGUID); org != nil && !includedOrgGUIDs[org.GUID] {
+				includedOrgGUIDs[org.GUID] = true
+				includedOrgs = append(includedOrgs, s.renderOrganization(org))
+			}
+		}
+	}
+
+	if r.URL.Query().Get("include") != "organization" {
+		return s.respondWithList(w, r, resources)
+	}
+	return s.respondWithIncludedList(w, r, resources, map[string][]resource{"organizations": includedOrgs})
+}
+
+func (s *Server) getSpace(w http.ResponseWriter, r *http.Request, params map[string]string) error {
+	space := s.findSpace(params["guid"])
+	if space == nil {
+		return notFoundError("Space")
+	}
+	return respondWithJSON(w, http.StatusOK, s.renderSpace(space))
+}
+
+func (s *Server) getDomains(w http.ResponseWriter, r *http.Request, params map[string]string) error {
+	if orgGUID, ok := params["guid"]; ok && s.findOrganization(orgGUID) == nil {
+		return notFoundError("Organization")
+	}
+
+	resources := []resource{}
+	for _, domain := range s.domains {
+		if filterMatches(r, "names", domain.Name) && filterMatches(r, "guids", domain.GUID) {
+			resources = append(resources, s.renderDomain(domain))
+		}
+	}
+	return s.respondWithList(w, r, resources)
+}
+
+func (s *Server) getDomain(w http.ResponseWriter, r *http.Request, params map[string]string) error {
+	domain := s.findDomain(params["guid"])
+	if domain == nil {
+		return notFoundError("Domain")
+	}
+	return respondWithJSON(w, http.StatusOK, s.renderDomain(domain))
+}
+
+func (s *Server) getDefaultDomain(w http.ResponseWriter, r *http.Request, params map[string]string) error {
+	if s.findOrganization(params["guid"]) == nil {
+		return notFoundError("Organization")
+	}
+	if len(s.domains) == 0 {
+		return notFoundError("Domain")
+	}
+	return respondWithJSON(w, http.StatusOK, s.renderDomain(s.domains[0]))
+}
+
+func (s *Server) getStacks(w http.ResponseWriter, r *http.Request, _ map[string]string) error {
+	resources := []resource{}
+	if filterMatches(r, "names", DefaultStack) {
+		resources = append(resources, resource{
+			"guid":        DefaultStack,
+			"name":        DefaultStack,
+			"description": "Cloud Foundry Linux-based filesystem",
+			"metadata":    emptyMetadata(),
+		})
+	}
+	return s.respondWithList(w, r, resources)
+}
+
+func (s *Server) renderOrganization(org *Organization) resource {
+	return resource{
+		"guid":       org.GUID,
+		"name":       org.Name,
+		"created_at": formatTime(org.CreatedAt),
+		"updated_at": formatTime(org.CreatedAt),
+		"suspended":  false,
+		"metadata":   emptyMetadata(),
+		"links": map[string]interface{}{
+			"self":    s.link("/v3/organizations/%s", org.GUID),
+			"domains": s.link("/v3/organizations/%s/domains", org.GUID),
+		},
+	}
+}
+
+func (s *Server) renderSpace(space *Space) resource {
+	return resource{
+		"guid":       space.GUID,
+		"name":       space.Name,
+		"created_at": formatTime(space.CreatedAt),
+		"updated_at": formatTime(space.CreatedAt),
+		"relationships": map[string]interface{}{
+			"organization": relationship(space.OrganizationGUID),
+		},
+		"metadata": emptyMetadata(),
+		"links": map[string]interface{}{
+			"self":         s.link("/v3/spaces/%s", space.GUID),
+			"organization": s.link("/v3/organizations/%s", space.OrganizationGUID),
+		},
+	}
+}
+
+func (s *Server) renderDomain(domain *Domain) resource {
+	return resource{
+		"guid":                domain.GUID,
+		"name":                domain.Name,
+		"created_at":          formatTime(domain.CreatedAt),
+		"updated_at":          formatTime(domain.CreatedAt),
+		"internal":            false,
+		"supported_protocols": []string{"http"},
+		"metadata":            emptyMetadata(),
+		"links": map[string]interface{}{
+			"self":               s.link("/v3/domains/%s", domain.GUID),
+			"route_reservations": s.link("/v3/domains/%s/route_reservations", domain.GUID),
+		},
+	}
+}
+
+func relationship(guid string) map[string]interface{} {
+	if guid == "" {
+		return map[string]interface{}{"data": nil}
+	}
+	return map[string]interface{}{"data": map[string]string{"guid": guid}}
+}
+
+func emptyMetadata() map[string]interface{} {
+	return map[string]interface{}{
+		"labels":      map[string]string{},
+		"annotations": map[string]string{},
+	}
+}
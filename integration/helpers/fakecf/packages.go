@@ -0,0 +1,308 @@
+package fakecf
+
+import (
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+)
+
+const (
+	packageAwaitingUpload   = "AWAITING_UPLOAD"
+	packageProcessingUpload = "PROCESSING_UPLOAD"
+	packageReady            = "READY"
+
+	buildStaging  = "STAGING"
+	buildStaged   = "STAGED"
+	dropletStaged = "STAGED"
+
+	detectedBuildpack = "fake_buildpack"
+)
+
+// postResourceMatches matches none of the resources, so the CLI uploads
+// every file.
+func (s *Server) postResourceMatches(w http.ResponseWriter, r *http.Request, _ map[string]string) error {
+	_, err := io.Copy(ioutil.Discard, r.Body)
+	if err != nil {
+		return err
+	}
+	return respondWithJSON(w, http.StatusCreated, map[string]interface{}{"resources": []interface{}{}})
+}
+
+func (s *Server) getPackages(w http.ResponseWriter, r *http.Request, _ map[string]string) error {
+	resources := []resource{}
+	for _, pkg := range s.packages {
+		if filterMatches(r, "guids", pkg.GUID) &&
+			filterMatches(r, "app_guids", pkg.AppGUID) &&
+			filterMatches(r, "states", pkg.State) &&
+			filterMatches(r, "types", pkg.Type) {
+			resources = append(resources, s.renderPackage(pkg))
+		}
+	}
+	return s.respondWithList(w, r, resources)
+}
+
+func (s *Server) postPackage(w http.ResponseWriter, r *http.Request, _ map[string]string) error {
+	var body struct {
+		Type          string `json:"type"`
+		Relationships struct {
+			App struct {
+				Data struct {
+					GUID string `json:"guid"`
+				} `json:"data"`
+			} `json:"app"`
+		} `json:"relationships"`
+		Data struct {
+			Image string `json:"image"`
+		} `json:"data"`
+	}
+	err := decodeBody(r, &body)
+	if err != nil {
+		return err
+	}
+
+	if s.findApp(body.Relationships.App.Data.GUID) == nil {
+		return unprocessableEntityError("App must exist")
+	}
+
+	pkg := &pkg{
+		GUID:      mustGUID(),
+		AppGUID:   body.Relationships.App.Data.GUID,
+		Type:      body.Type,
+		State:     packageAwaitingUpload,
+		CreatedAt: time.Now(),
+	}
+	if body.Type == lifecycleDocker {
+		pkg.State = packageReady
+		pkg.DockerImage = body.Data.Image
+	}
+	s.packages = append(s.packages, pkg)
+
+	return respondWithJSON(w, http.StatusCreated, s.renderPackage(pkg))
+}
+
+// getPackage completes the processing of an uploaded package.
+func (s *Server) getPackage(w http.ResponseWriter, r *http.Request, params map[string]string) error {
+	pkg := s.findPackage(params["guid"])
+	if pkg == nil {
+		return notFoundError("Package")
+	}
+
+	if pkg.State == packageProcessingUpload {
+		pkg.State = packageReady
+	}
+	return respondWithJSON(w, http.StatusOK, s.renderPackage(pkg))
+}
+
+// postPackageUpload accepts and discards the bits of a package.
+func (s *Server) postPackageUpload(w http.ResponseWriter, r *http.Request, params map[string]string) error {
+	pkg := s.findPackage(params["guid"])
+	if pkg == nil {
+		return notFoundError("Package")
+	}
+
+	_, err := io.Copy(ioutil.Discard, r.Body)
+	if err != nil {
+		return err
+	}
+
+	if pkg.Type != "bits" || pkg.State != packageAwaitingUpload {
+		return unprocessableEntityError("Package may be uploaded only once.")
+	}
+	pkg.State = packageProcessingUpload
+
+	return respondWithJSON(w, http.StatusOK, s.renderPackage(pkg))
+}
+
+func (s *Server) getPackageDroplets(w http.ResponseWriter, r *http.Request, params map[string]string) error {
+	if s.findPackage(params["guid"]) == nil {
+		return notFoundError("Package")
+	}
+
+	resources := []resource{}
+	for _, droplet := range s.droplets {
+		if droplet.PackageGUID == params["guid"] && filterMatches(r, "states", droplet.State) {
+			resources = append(resources, s.renderDroplet(droplet))
+		}
+	}
+	return s.respondWithList(w, r, resources)
+}
+
+func (s *Server) postBuild(w http.ResponseWriter, r *http.Request, _ map[string]string) error {
+	var body struct {
+		Package struct {
+			GUID string `json:"guid"`
+		} `json:"package"`
+	}
+	err := decodeBody(r, &body)
+	if err != nil {
+		return err
+	}
+
+	pkg := s.findPackage(body.Package.GUID)
+	if pkg == nil {
+		return unprocessableEntityError("Unable to use package. Ensure that the package exists and you have access to it.")
+	}
+	if pkg.State != packageReady {
+		return unprocessableEntityError("Package must be in a READY state.")
+	}
+
+	build := &build{
+		GUID:        mustGUID(),
+		AppGUID:     pkg.AppGUID,
+		PackageGUID: pkg.GUID,
+		State:       buildStaging,
+		CreatedAt:   time.Now(),
+	}
+	s.builds = append(s.builds, build)
+	s.emitLog(build.AppGUID, "STG", "0", "Downloading buildpacks...")
+	s.emitLog(build.AppGUID, "STG", "0", "Staging app...")
+
+	return respondWithJSON(w, http.StatusCreated, s.renderBuild(build))
+}
+
+// getBuild completes staging, which creates the droplet of the build.
+func (s *Server) getBuild(w http.ResponseWriter, r *http.Request, params map[string]string) error {
+	build := s.findBuild(params["guid"])
+	if build == nil {
+		return notFoundError("Build")
+	}
+
+	if build.State == buildStaging {
+		s.stage(build)
+	}
+	return respondWithJSON(w, http.StatusOK, s.renderBuild(build))
+}
+
+func (s *Server) getDroplets(w http.ResponseWriter, r *http.Request, _ map[string]string) error {
+	resources := []resource{}
+	for _, droplet := range s.droplets {
+		if filterMatches(r, "guids", droplet.GUID) &&
+			filterMatches(r, "app_guids", droplet.AppGUID) &&
+			filterMatches(r, "states", droplet.State) {
+			resources = append(resources, s.renderDroplet(droplet))
+		}
+	}
+	return s.respondWithList(w, r, resources)
+}
+
+func (s *Server) getDroplet(w http.ResponseWriter, r *http.Request, params map[string]string) error {
+	droplet := s.findDroplet(params["guid"])
+	if droplet == nil {
+		return notFoundError("Droplet")
+	}
+	return respondWithJSON(w, http.StatusOK, s.renderDroplet(droplet))
+}
+
+func (s *Server) stage(build *build) {
+	pkg := s.findPackage(build.PackageGUID)
+	droplet := &droplet{
+		GUID:        mustGUID(),
+		AppGUID:     build.AppGUID,
+		PackageGUID: build.PackageGUID,
+		State:       dropletStaged,
+		Image:       pkg.DockerImage,
+		CreatedAt:   time.Now(),
+	}
+	if app := s.findApp(build.AppGUID); app != nil && pkg.Type != lifecycleDocker {
+		droplet.Buildpack = detectedBuildpack
+		if len(app.Buildpacks) > 0 {
+			droplet.Buildpack = app.Buildpacks[0]
+		}
+	}
+	s.droplets = append(s.droplets, droplet)
+
+	build.State = buildStaged
+	build.DropletGUID = droplet.GUID
+	s.emitLog(build.AppGUID, "STG", "0", "Uploading droplet...")
+	s.emitLog(build.AppGUID, "STG", "0", "Staging complete")
+}
+
+func (s *Server) renderPackage(pkg *pkg) resource {
+	data := map[string]interface{}{}
+	if pkg.Type == lifecycleDocker {
+		data["image"] = pkg.DockerImage
+	}
+
+	return resource{
+		"guid":       pkg.GUID,
+		"type":       pkg.Type,
+		"state":      pkg.State,
+		"data":       data,
+		"created_at": formatTime(pkg.CreatedAt),
+		"updated_at": formatTime(pkg.CreatedAt),
+		"relationships": map[string]interface{}{
+			"app": relationship(pkg.AppGUID),
+		},
+		"metadata": emptyMetadata(),
+		"links": map[string]interface{}{
+			"self":     s.link("/v3/packages/%s", pkg.GUID),
+			"upload":   s.link("/v3/packages/%s/upload", pkg.GUID),
+			"download": s.link("/v3/packages/%s/download", pkg.GUID),
+			"app":      s.link("/v3/apps/%s", pkg.AppGUID),
+		},
+	}
+}
+
+func (s *Server) renderBuild(build *build) resource {
+	var droplet interface{}
+	if build.DropletGUID != "" {
+		droplet = map[string]string{"guid": build.DropletGUID}
+	}
+
+	return resource{
+		"guid":       build.GUID,
+		"state":      build.State,
+		"error":      nil,
+		"package":    map[string]string{"guid": build.PackageGUID},
+		"droplet":    droplet,
+		"created_at": formatTime(build.CreatedAt),
+		"updated_at": formatTime(build.CreatedAt),
+		"relationships": map[string]interface{}{
+			"app": relationship(build.AppGUID),
+		},
+		"metadata": emptyMetadata(),
+		"links": map[string]interface{}{
+			"self": s.link("/v3/builds/%s", build.GUID),
+			"app":  s.link("/v3/apps/%s", build.AppGUID),
+		},
+	}
+}
+
+func (s *Server) renderDroplet(droplet *droplet) resource {
+	rendered := resource{
+		"guid":       droplet.GUID,
+		"state":      droplet.State,
+		"error":      nil,
+		"image":      nil,
+		"checksum":   nil,
+		"created_at": formatTime(droplet.CreatedAt),
+		"updated_at": formatTime(droplet.CreatedAt),
+		"lifecycle":  map[string]interface{}{"type": lifecycleDocker, "data": map[string]interface{}{}},
+		"relationships": map[string]interface{}{
+			"app": relationship(droplet.AppGUID),
+		},
+		"metadata": emptyMetadata(),
+		"links": map[string]interface{}{
+			"self":    s.link("/v3/droplets/%s", droplet.GUID),
+			"package": s.link("/v3/packages/%s", droplet.PackageGUID),
+			"app":     s.link("/v3/apps/%s", droplet.AppGUID),
+		},
+	}
+
+	if droplet.Buildpack == "" {
+		rendered["image"] = droplet.Image
+		return rendered
+	}
+
+	rendered["lifecycle"] = map[string]interface{}{"type": lifecycleBuildpack, "data": map[string]interface{}{}}
+	rendered["stack"] = DefaultStack
+	rendered["checksum"] = map[string]string{"type": "sha256", "value": "0000000000000000000000000000000000000000000000000000000000000000"}
+	rendered["buildpacks"] = []map[string]string{{
+		"name":           droplet.Buildpack,
+		"buildpack_name": droplet.Buildpack,
+		"detect_output":  droplet.Buildpack,
+		"version":        "1.0.0",
+	}}
+	return rendered
+}
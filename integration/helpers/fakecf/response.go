@@ -0,0 +1,138 @@
+package fakecf
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const defaultPerPage = 50
+
+type resource map[string]interface{}
+
+func respondWithJSON(w http.ResponseWriter, status int, body interface{}) error {
+	raw, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, err = w.Write(raw)
+	return err
+}
+
+// respondWithList responds with a page of the resources, ordered and
+// paginated according to the order_by, page and per_page query parameters.
+func (s *Server) respondWithList(w http.ResponseWriter, r *http.Request, resources []resource) error {
+	return s.respondWithIncludedList(w, r, resources, nil)
+}
+
+// respondWithIncludedList responds like respondWithList, with the given
+// related resources included in the response.
+func (s *Server) respondWithIncludedList(w http.ResponseWriter, r *http.Request, resources []resource, included map[string][]resource) error {
+	query := r.URL.Query()
+	orderResources(resources, query.Get("order_by"))
+
+	perPage := positiveInt(query.Get("per_page"), defaultPerPage)
+	page := positiveInt(query.Get("page"), 1)
+	totalPages := (len(resources) + perPage - 1) / perPage
+	if totalPages == 0 {
+		totalPages = 1
+	}
+
+	pageLink := func(page int) interface{} {
+		if page < 1 || page > totalPages {
+			return nil
+		}
+		pageQuery := r.URL.Query()
+		pageQuery.Set("page", strconv.Itoa(page))
+		pageQuery.Set("per_page", strconv.Itoa(perPage))
+		return s.link("%s?%s", r.URL.Path, pageQuery.Encode())
+	}
+
+	start := (page - 1) * perPage
+	if start > len(resources) {
+		start = len(resources)
+	}
+	end := start + perPage
+	if end > len(resources) {
+		end = len(resources)
+	}
+
+	body := map[string]interface{}{
+		"pagination": map[string]interface{}{
+			"total_results": len(resources),
+			"total_pages":   totalPages,
+			"first":         pageLink(1),
+			"last":          pageLink(totalPages),
+			"next":          pageLink(page + 1),
+			"previous":      pageLink(page - 1),
+		},
+		"resources": resources[start:end],
+	}
+	if included != nil {
+		body["included"] = included
+	}
+	return respondWithJSON(w, http.StatusOK, body)
+}
+
+// orderResources sorts the resources by one of their fields, in descending
+// order when the field is prefixed with a minus. Resources with the same
+// value stay in the order they were created, newest first when descending.
+func orderResources(resources []resource, orderBy string) {
+	if orderBy == "" {
+		return
+	}
+
+	field := strings.TrimPrefix(orderBy, "-")
+	descending := field != orderBy
+	if descending {
+		for i, j := 0, len(resources)-1; i < j; i, j = i+1, j-1 {
+			resources[i], resources[j] = resources[j], resources[i]
+		}
+	}
+
+	sort.SliceStable(resources, func(i, j int) bool {
+		left, right := fmt.Sprint(resources[i][field]), fmt.Sprint(resources[j][field])
+		if descending {
+			return left > right
+		}
+		return left < right
+	})
+}
+
+// filterMatches returns true if the query does not filter on the given key,
+// or if one of the comma separated values it filters on is the given value.
+func filterMatches(r *http.Request, key string, value string) bool {
+	filter := r.URL.Query().Get(key)
+	if filter == "" {
+		return true
+	}
+
+	for _, filterValue := range strings.Split(filter, ",") {
+		if filterValue == value {
+			return true
+		}
+	}
+	return false
+}
+
+func decodeBody(r *http.Request, body interface{}) error {
+	err := json.NewDecoder(r.Body).Decode(body)
+	if err != nil {
+		return messageParseError(err)
+	}
+	return nil
+}
+
+func positiveInt(value string, defaultValue int) int {
+	number, err := strconv.Atoi(value)
+	if err != nil || number < 1 {
+		return defaultValue
+	}
+	return number
+}
@@ -0,0 +1,124 @@
+package fakecf
+
+import (
+	"net/http"
+	"time"
+)
+
+func (s *Server) getRoutes(w http.ResponseWriter, r *http.Request, _ map[string]string) error {
+	resources := []resource{}
+	for _, route := range s.routes {
+		space := s.findSpace(route.SpaceGUID)
+		if filterMatches(r, "guids", route.GUID) &&
+			filterMatches(r, "space_guids", route.SpaceGUID) &&
+			filterMatches(r, "organization_guids", space.OrganizationGUID) &&
+			filterMatches(r, "domain_guids", route.DomainGUID) &&
+			filterMatches(r, "hosts", route.Host) &&
+			filterMatches(r, "paths", route.Path) &&
+			s.appGUIDsFilterMatches(r, route) {
+			resources = append(resources, s.renderRoute(route))
+		}
+	}
+	return s.respondWithList(w, r, resources)
+}
+
+func (s *Server) appGUIDsFilterMatches(r *http.Request, route *Route) bool {
+	if r.URL.Query().Get("app_guids") == "" {
+		return true
+	}
+
+	for _, destination := range route.Destinations {
+		if filterMatches(r, "app_guids", destination.AppGUID) {
+			return true
+		}
+	}
+	return false
+}
+
+// mapRoute maps the route to the web process of the app, creating the route
+// if it does not exist.
+func (s *Server) mapRoute(spaceGUID string, domain *Domain, host string, path string, appGUID string) error {
+	route := s.findRoute(domain.GUID, host, path)
+	if route == nil {
+		route = &Route{
+			GUID:       mustGUID(),
+			SpaceGUID:  spaceGUID,
+			DomainGUID: domain.GUID,
+			Host:       host,
+			Path:       path,
+			CreatedAt:  time.Now(),
+		}
+		s.routes = append(s.routes, route)
+	}
+
+	if route.SpaceGUID != spaceGUID {
+		return unprocessableEntityError("The app cannot be mapped to route %s because the route is not in this space.", s.routeURL(route))
+	}
+	if route.mapsTo(appGUID) {
+		return nil
+	}
+
+	route.Destinations = append(route.Destinations, Destination{
+		GUID:        mustGUID(),
+		AppGUID:     appGUID,
+		ProcessType: "web",
+	})
+	return nil
+}
+
+func (route *Route) unmap(appGUID string) {
+	var destinations []Destination
+	for _, destination := range route.Destinations {
+		if destination.AppGUID != appGUID {
+			destinations = append(destinations, destination)
+		}
+	}
+	route.Destinations = destinations
+}
+
+func (s *Server) routeURL(route *Route) string {
+	url := s.findDomain(route.DomainGUID).Name
+	if route.Host != "" {
+		url = route.Host + "." + url
+	}
+	return url + route.Path
+}
+
+func (s *Server) renderRoute(route *Route) resource {
+	destinations := []map[string]interface{}{}
+	for _, destination := range route.Destinations {
+		destinations = append(destinations, map[string]interface{}{
+			"guid": destination.GUID,
+			"app": map[string]interface{}{
+				"guid":    destination.AppGUID,
+				"process": map[string]string{"type": destination.ProcessType},
+			},
+			"weight":   nil,
+			"port":     8080,
+			"protocol": "http1",
+		})
+	}
+
+	return resource{
+		"guid":         route.GUID,
+		"protocol":     "http",
+		"host":         route.Host,
+		"path":         route.Path,
+		"port":         nil,
+		"url":          s.routeURL(route),
+		"destinations": destinations,
+		"created_at":   formatTime(route.CreatedAt),
+		"updated_at":   formatTime(route.CreatedAt),
+		"relationships": map[string]interface{}{
+			"space":  relationship(route.SpaceGUID),
+			"domain": relationship(route.DomainGUID),
+		},
+		"metadata": emptyMetadata(),
+		"links": map[string]interface{}{
+			"self":         s.link("/v3/routes/%s", route.GUID),
+			"space":        s.link("/v3/spaces/%s", route.SpaceGUID),
+			"domain":       s.link("/v3/domains/%s", route.DomainGUID),
+			"destinations": s.link("/v3/routes/%s/destinations", route.GUID),
+		},
+	}
+}
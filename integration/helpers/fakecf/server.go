@@ -0,0 +1,201 @@
+// Package fakecf provides an in-process fake of the Cloud Controller V3 API,
+// UAA and Log Cache, served from a single listener. It keeps orgs, spaces,
+// apps, processes, packages, droplets, routes and service instances in memory
+// and is realistic enough for 'cf api', 'cf auth', 'cf target', 'cf push',
+// 'cf apps' and 'cf logs --recent' to work against it without any network
+// access.
+//
+// A scenario test starts a server, seeds it and points the CLI at it:
+//
+//	server := fakecf.NewServer()
+//	defer server.Close()
+//	org := server.CreateOrganization("some-org")
+//	server.CreateSpace(org.GUID, "some-space")
+//
+//	cf api <server.URL()>
+//	cf auth admin admin
+//	cf target -o some-org -s some-space
+//	cf push some-app -p some-dir
+//
+// Asynchronous operations, such as package processing, staging and jobs,
+// complete the first time they are polled.
+package fakecf
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	uuid "github.com/nu7hatch/gouuid"
+)
+
+const (
+	// CCAPIVersion is the Cloud Controller V3 API version the server reports.
+	CCAPIVersion = "3.128.0"
+
+	// UAAVersion is the UAA version the server reports.
+	UAAVersion = "4.30.0"
+
+	// DefaultUsername and DefaultPassword are the credentials of the user
+	// every server starts with.
+	DefaultUsername = "admin"
+	DefaultPassword = "admin"
+
+	// DefaultDomain is the shared domain every server starts with. Default
+	// routes are created on it.
+	DefaultDomain = "example.com"
+
+	// DefaultStack is the only stack on the server.
+	DefaultStack = "cflinuxfs3"
+)
+
+type handlerFunc func(w http.ResponseWriter, r *http.Request, params map[string]string) error
+
+type endpoint struct {
+	method        string
+	path          string
+	authenticated bool
+	handler       handlerFunc
+}
+
+// Server is a fake Cloud Controller, UAA and Log Cache. All of its methods
+// are safe to call while the CLI is talking to it.
+type Server struct {
+	server    *httptest.Server
+	endpoints []endpoint
+
+	lock             sync.Mutex
+	users            map[string]user
+	clients          map[string]string
+	accessTokens     map[string]token
+	refreshTokens    map[string]token
+	organizations    []*Organization
+	spaces           []*Space
+	domains          []*Domain
+	apps             []*App
+	processes        []*Process
+	packages         []*pkg
+	builds           []*build
+	droplets         []*droplet
+	routes           []*Route
+	serviceInstances []*ServiceInstance
+	jobs             []*job
+	logs             []LogMessage
+}
+
+// NewServer starts a fake server listening on plain HTTP on localhost.
+func NewServer() *Server {
+	server := newServer()
+	server.server = httptest.NewServer(server)
+	return server
+}
+
+// NewTLSServer starts a fake server listening on HTTPS on localhost, with a
+// self-signed certificate. Target it with 'cf api --skip-ssl-validation'.
+func NewTLSServer() *Server {
+	server := newServer()
+	server.server = httptest.NewTLSServer(server)
+	return server
+}
+
+func newServer() *Server {
+	server := &Server{
+		users:         map[string]user{},
+		clients:       map[string]string{},
+		accessTokens:  map[string]token{},
+		refreshTokens: map[string]token{},
+	}
+	server.endpoints = server.apiEndpoints()
+
+	server.AddUser(DefaultUsername, DefaultPassword)
+	server.CreateDomain(DefaultDomain)
+
+	return server
+}
+
+// URL returns the URL of the server, which serves the Cloud Controller, UAA
+// and Log Cache APIs.
+func (s *Server) URL() string {
+	return s.server.URL
+}
+
+// Close shuts the server down.
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// ServeHTTP routes the request to its handler. Requests are handled one at a
+// time.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for _, endpoint := range s.endpoints {
+		if endpoint.method != r.Method {
+			continue
+		}
+		params, ok := matchPath(endpoint.path, r.URL.Path)
+		if !ok {
+			continue
+		}
+
+		var err error
+		if endpoint.authenticated {
+			err = s.authenticate(r)
+		}
+		if err == nil {
+			err = endpoint.handler(w, r, params)
+		}
+		if err != nil {
+			handleError(w, err)
+		}
+		return
+	}
+
+	handleError(w, ccError{
+		status: http.StatusNotFound,
+		Code:   10000,
+		Title:  "CF-NotFound",
+		Detail: fmt.Sprintf("Unknown request %s %s", r.Method, r.URL.Path),
+	})
+}
+
+// matchPath matches a request path against an endpoint path, in which segments
+// starting with a colon capture the matching request segment.
+func matchPath(endpointPath string, requestPath string) (map[string]string, bool) {
+	endpointSegments := strings.Split(strings.Trim(endpointPath, "/"), "/")
+	requestSegments := strings.Split(strings.Trim(requestPath, "/"), "/")
+	if len(endpointSegments) != len(requestSegments) {
+		return nil, false
+	}
+
+	params := map[string]string{}
+	for i, segment := range endpointSegments {
+		switch {
+		case strings.HasPrefix(segment, ":") && requestSegments[i] != "":
+			params[segment[1:]] = requestSegments[i]
+		case segment != requestSegments[i]:
+			return nil, false
+		}
+	}
+	return params, true
+}
+
+func (s *Server) link(format string, args ...interface{}) map[string]string {
+	return map[string]string{"href": s.URL() + fmt.Sprintf(format, args...)}
+}
+
+func mustGUID() string {
+	rawGUID, err := uuid.NewV4()
+	if err != nil {
+		panic(err)
+	}
+	return rawGUID.String()
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
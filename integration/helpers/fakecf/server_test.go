@@ -0,0 +1,213 @@
+package fakecf_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	. "code.cloudfoundry.org/cli/integration/helpers/fakecf"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Server", func() {
+	var (
+		server      *Server
+		accessToken string
+		space       Space
+	)
+
+	request := func(method string, path string, contentType string, body io.Reader) *http.Response {
+		req, err := http.NewRequest(method, server.URL()+path, body)
+		Expect(err).NotTo(HaveOccurred())
+		if contentType != "" {
+			req.Header.Set("Content-Type", contentType)
+		}
+		if accessToken != "" {
+			req.Header.Set("Authorization", "bearer "+accessToken)
+		}
+
+		response, err := http.DefaultClient.Do(req)
+		Expect(err).NotTo(HaveOccurred())
+		return response
+	}
+
+	decode := func(response *http.Response) map[string]interface{} {
+		defer response.Body.Close()
+		var body map[string]interface{}
+		Expect(json.NewDecoder(response.Body).Decode(&body)).To(Succeed())
+		return body
+	}
+
+	requestToken := func(form url.Values) *http.Response {
+		return request(http.MethodPost, "/oauth/token", "application/x-www-form-urlencoded", strings.NewReader(form.Encode()))
+	}
+
+	BeforeEach(func() {
+		server = NewServer()
+		accessToken = ""
+
+		org := server.CreateOrganization("some-org")
+		space = server.CreateSpace(org.GUID, "some-space")
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("links the CC, UAA and Log Cache to itself", func() {
+		response := request(http.MethodGet, "/", "", nil)
+		Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+		links := decode(response)["links"].(map[string]interface{})
+		Expect(links["cloud_controller_v3"]).To(HaveKeyWithValue("href", server.URL()+"/v3"))
+		Expect(links["uaa"]).To(HaveKeyWithValue("href", server.URL()))
+		Expect(links["log_cache"]).To(HaveKeyWithValue("href", server.URL()))
+	})
+
+	Describe("authentication", func() {
+		It("issues tokens for the default user", func() {
+			response := requestToken(url.Values{
+				"grant_type": {"password"},
+				"username":   {DefaultUsername},
+				"password":   {DefaultPassword},
+			})
+			Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+			body := decode(response)
+			Expect(body["access_token"]).NotTo(BeEmpty())
+			Expect(body["refresh_token"]).NotTo(BeEmpty())
+		})
+
+		It("issues tokens for added clients", func() {
+			server.AddClient("some-client", "some-secret")
+
+			response := requestToken(url.Values{
+				"grant_type":    {"client_credentials"},
+				"client_id":     {"some-client"},
+				"client_secret": {"some-secret"},
+			})
+			Expect(response.StatusCode).To(Equal(http.StatusOK))
+		})
+
+		It("rejects bad credentials", func() {
+			response := requestToken(url.Values{
+				"grant_type": {"password"},
+				"username":   {DefaultUsername},
+				"password":   {"wrong"},
+			})
+			Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			Expect(decode(response)).To(HaveKeyWithValue("error", "unauthorized"))
+		})
+
+		It("rejects CC requests without a valid token", func() {
+			accessToken = "not-a-token"
+
+			response := request(http.MethodGet, "/v3/organizations", "", nil)
+			Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+
+			errors := decode(response)["errors"].([]interface{})
+			Expect(errors[0]).To(HaveKeyWithValue("title", "CF-InvalidAuthToken"))
+		})
+	})
+
+	When("authenticated", func() {
+		BeforeEach(func() {
+			body := decode(requestToken(url.Values{
+				"grant_type": {"password"},
+				"username":   {DefaultUsername},
+				"password":   {DefaultPassword},
+			}))
+			accessToken = body["access_token"].(string)
+		})
+
+		It("lists spaces by name and organization", func() {
+			response := request(http.MethodGet, "/v3/spaces?names=some-space&organization_guids="+space.OrganizationGUID, "", nil)
+			Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+			body := decode(response)
+			Expect(body["pagination"]).To(HaveKeyWithValue("total_results", BeEquivalentTo(1)))
+			resources := body["resources"].([]interface{})
+			Expect(resources[0]).To(HaveKeyWithValue("guid", space.GUID))
+		})
+
+		It("responds with a CC error for unknown resources", func() {
+			response := request(http.MethodGet, "/v3/apps/some-guid", "", nil)
+			Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+
+			errors := decode(response)["errors"].([]interface{})
+			Expect(errors[0]).To(HaveKeyWithValue("title", "CF-ResourceNotFound"))
+		})
+
+		When("a manifest is applied", func() {
+			var app App
+
+			BeforeEach(func() {
+				response := request(http.MethodPost, "/v3/spaces/"+space.GUID+"/actions/apply_manifest", "application/x-yaml", strings.NewReader(`
+applications:
+- name: some-app
+  memory: 256M
+  default-route: true
+`))
+				Expect(response.StatusCode).To(Equal(http.StatusAccepted))
+
+				job := decode(request(http.MethodGet, strings.TrimPrefix(response.Header.Get("Location"), server.URL()), "", nil))
+				Expect(job).To(HaveKeyWithValue("state", "COMPLETE"))
+
+				var ok bool
+				app, ok = server.App(space.GUID, "some-app")
+				Expect(ok).To(BeTrue())
+			})
+
+			It("creates the app with a web process and a default route", func() {
+				Expect(app.State).To(Equal("STOPPED"))
+
+				processes := server.Processes(app.GUID)
+				Expect(processes).To(HaveLen(1))
+				Expect(processes[0].Type).To(Equal("web"))
+				Expect(processes[0].MemoryInMB).To(BeEquivalentTo(256))
+
+				routes := server.Routes(app.GUID)
+				Expect(routes).To(HaveLen(1))
+				Expect(routes[0].Host).To(Equal("some-app"))
+			})
+
+			It("stages and starts the app", func() {
+				pkg := decode(request(http.MethodPost, "/v3/packages", "application/json", strings.NewReader(`{"type":"bits","relationships":{"app":{"data":{"guid":"`+app.GUID+`"}}}}`)))
+				packagePath := "/v3/packages/" + pkg["guid"].(string)
+
+				response := request(http.MethodPost, packagePath+"/upload", "application/zip", strings.NewReader("some-bits"))
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+				Expect(decode(request(http.MethodGet, packagePath, "", nil))).To(HaveKeyWithValue("state", "READY"))
+
+				build := decode(request(http.MethodPost, "/v3/builds", "application/json", strings.NewReader(`{"package":{"guid":"`+pkg["guid"].(string)+`"}}`)))
+				build = decode(request(http.MethodGet, "/v3/builds/"+build["guid"].(string), "", nil))
+				Expect(build).To(HaveKeyWithValue("state", "STAGED"))
+				dropletGUID := build["droplet"].(map[string]interface{})["guid"].(string)
+
+				response = request(http.MethodPatch, "/v3/apps/"+app.GUID+"/relationships/current_droplet", "application/json", strings.NewReader(`{"data":{"guid":"`+dropletGUID+`"}}`))
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+				response = request(http.MethodPost, "/v3/apps/"+app.GUID+"/actions/start", "", nil)
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+				Expect(decode(response)).To(HaveKeyWithValue("state", "STARTED"))
+
+				stats := decode(request(http.MethodGet, "/v3/processes/"+server.Processes(app.GUID)[0].GUID+"/stats", "", nil))
+				Expect(stats["resources"].([]interface{})[0]).To(HaveKeyWithValue("state", "RUNNING"))
+			})
+
+			It("serves the logs of the app from Log Cache", func() {
+				server.AddLog(app.GUID, "some-log")
+
+				body := decode(request(http.MethodGet, "/api/v1/read/"+app.GUID+"?envelope_types=LOG&descending=true", "", nil))
+				batch := body["envelopes"].(map[string]interface{})["batch"].([]interface{})
+				Expect(batch).To(HaveLen(1))
+
+				log := batch[0].(map[string]interface{})["log"].(map[string]interface{})
+				Expect(log).To(HaveKeyWithValue("payload", "c29tZS1sb2c="))
+			})
+		})
+	})
+})
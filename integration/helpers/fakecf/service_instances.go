@@ -0,0 +1,109 @@
+package fakecf
+
+import (
+	"net/http"
+	"time"
+)
+
+const userProvidedServiceInstance = "user-provided"
+
+func (s *Server) getServiceInstances(w http.ResponseWriter, r *http.Request, _ map[string]string) error {
+	resources := []resource{}
+	for _, serviceInstance := range s.serviceInstances {
+		space := s.findSpace(serviceInstance.SpaceGUID)
+		if filterMatches(r, "names", serviceInstance.Name) &&
+			filterMatches(r, "guids", serviceInstance.GUID) &&
+			filterMatches(r, "space_guids", serviceInstance.SpaceGUID) &&
+			filterMatches(r, "organization_guids", space.OrganizationGUID) &&
+			filterMatches(r, "type", userProvidedServiceInstance) {
+			resources = append(resources, s.renderServiceInstance(serviceInstance))
+		}
+	}
+	return s.respondWithList(w, r, resources)
+}
+
+func (s *Server) getServiceInstance(w http.ResponseWriter, r *http.Request, params map[string]string) error {
+	serviceInstance := s.findServiceInstance(params["guid"])
+	if serviceInstance == nil {
+		return notFoundError("Service instance")
+	}
+	return respondWithJSON(w, http.StatusOK, s.renderServiceInstance(serviceInstance))
+}
+
+// postServiceInstance creates user-provided service instances. There are no
+// service brokers, so managed service instances cannot be created.
+func (s *Server) postServiceInstance(w http.ResponseWriter, r *http.Request, _ map[string]string) error {
+	var body struct {
+		Type          string `json:"type"`
+		Name          string `json:"name"`
+		Relationships struct {
+			Space struct {
+				Data struct {
+					GUID string `json:"guid"`
+				} `json:"data"`
+			} `json:"space"`
+		} `json:"relationships"`
+	}
+	err := decodeBody(r, &body)
+	if err != nil {
+		return err
+	}
+
+	if body.Type != userProvidedServiceInstance {
+		return unprocessableEntityError("Only user-provided service instances can be created.")
+	}
+	if body.Name == "" {
+		return unprocessableEntityError("Name can't be blank")
+	}
+	if s.findSpace(body.Relationships.Space.Data.GUID) == nil {
+		return unprocessableEntityError("Invalid space. Ensure that the space exists and you have access to it.")
+	}
+	for _, serviceInstance := range s.serviceInstances {
+		if serviceInstance.SpaceGUID == body.Relationships.Space.Data.GUID && serviceInstance.Name == body.Name {
+			return unprocessableEntityError("The service instance name is taken: %s.", body.Name)
+		}
+	}
+
+	serviceInstance := s.createServiceInstance(body.Relationships.Space.Data.GUID, body.Name)
+	return respondWithJSON(w, http.StatusCreated, s.renderServiceInstance(serviceInstance))
+}
+
+func (s *Server) createServiceInstance(spaceGUID string, name string) *ServiceInstance {
+	serviceInstance := &ServiceInstance{
+		GUID:      mustGUID(),
+		Name:      name,
+		SpaceGUID: spaceGUID,
+		CreatedAt: time.Now(),
+	}
+	s.serviceInstances = append(s.serviceInstances, serviceInstance)
+	return serviceInstance
+}
+
+func (s *Server) renderServiceInstance(serviceInstance *ServiceInstance) resource {
+	return resource{
+		"guid":              serviceInstance.GUID,
+		"name":              serviceInstance.Name,
+		"type":              userProvidedServiceInstance,
+		"tags":              []string{},
+		"syslog_drain_url":  "",
+		"route_service_url": "",
+		"created_at":        formatTime(serviceInstance.CreatedAt),
+		"updated_at":        formatTime(serviceInstance.CreatedAt),
+		"last_operation": map[string]interface{}{
+			"type":        "create",
+			"state":       "succeeded",
+			"description": "Operation succeeded",
+			"created_at":  formatTime(serviceInstance.CreatedAt),
+			"updated_at":  formatTime(serviceInstance.CreatedAt),
+		},
+		"relationships": map[string]interface{}{
+			"space": relationship(serviceInstance.SpaceGUID),
+		},
+		"metadata": emptyMetadata(),
+		"links": map[string]interface{}{
+			"self":        s.link("/v3/service_instances/%s", serviceInstance.GUID),
+			"space":       s.link("/v3/spaces/%s", serviceInstance.SpaceGUID),
+			"credentials": s.link("/v3/service_instances/%s/credentials", serviceInstance.GUID),
+		},
+	}
+}
@@ -0,0 +1,351 @@
+package fakecf
+
+import (
+	"sort"
+	"time"
+)
+
+// Organization is an organization on the fake Cloud Controller.
+type Organization struct {
+	GUID      string
+	Name      string
+	CreatedAt time.Time
+}
+
+// Space is a space on the fake Cloud Controller.
+type Space struct {
+	GUID             string
+	Name             string
+	OrganizationGUID string
+	CreatedAt        time.Time
+}
+
+// Domain is a shared domain on the fake Cloud Controller.
+type Domain struct {
+	GUID      string
+	Name      string
+	CreatedAt time.Time
+}
+
+// App is an app on the fake Cloud Controller.
+type App struct {
+	GUID          string
+	Name          string
+	SpaceGUID     string
+	State         string
+	LifecycleType string
+	Buildpacks    []string
+	Stack         string
+	DropletGUID   string
+	CreatedAt     time.Time
+	StartedAt     time.Time
+}
+
+// Process is a process of an app on the fake Cloud Controller.
+type Process struct {
+	GUID                string
+	AppGUID             string
+	Type                string
+	Command             string
+	Instances           int
+	MemoryInMB          uint64
+	DiskInMB            uint64
+	HealthCheckType     string
+	HealthCheckEndpoint string
+	CreatedAt           time.Time
+}
+
+// Route is a route on the fake Cloud Controller.
+type Route struct {
+	GUID         string
+	SpaceGUID    string
+	DomainGUID   string
+	Host         string
+	Path         string
+	Destinations []Destination
+	CreatedAt    time.Time
+}
+
+// Destination maps a route to a process of an app.
+type Destination struct {
+	GUID        string
+	AppGUID     string
+	ProcessType string
+}
+
+// ServiceInstance is a user-provided service instance on the fake Cloud
+// Controller.
+type ServiceInstance struct {
+	GUID      string
+	Name      string
+	SpaceGUID string
+	CreatedAt time.Time
+}
+
+type pkg struct {
+	GUID        string
+	AppGUID     string
+	Type        string
+	State       string
+	DockerImage string
+	CreatedAt   time.Time
+}
+
+type build struct {
+	GUID        string
+	AppGUID     string
+	PackageGUID string
+	DropletGUID string
+	State       string
+	CreatedAt   time.Time
+}
+
+type droplet struct {
+	GUID        string
+	AppGUID     string
+	PackageGUID string
+	State       string
+	Buildpack   string
+	Image       string
+	CreatedAt   time.Time
+}
+
+type job struct {
+	GUID      string
+	Operation string
+	State     string
+	Errors    []ccError
+	CreatedAt time.Time
+}
+
+// CreateOrganization adds an organization.
+func (s *Server) CreateOrganization(name string) Organization {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	org := &Organization{GUID: mustGUID(), Name: name, CreatedAt: time.Now()}
+	s.organizations = append(s.organizations, org)
+	return *org
+}
+
+// CreateSpace adds a space to the organization with the given GUID.
+func (s *Server) CreateSpace(orgGUID string, name string) Space {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	space := &Space{GUID: mustGUID(), Name: name, OrganizationGUID: orgGUID, CreatedAt: time.Now()}
+	s.spaces = append(s.spaces, space)
+	return *space
+}
+
+// CreateDomain adds a shared domain.
+func (s *Server) CreateDomain(name string) Domain {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	domain := &Domain{GUID: mustGUID(), Name: name, CreatedAt: time.Now()}
+	s.domains = append(s.domains, domain)
+	return *domain
+}
+
+// CreateServiceInstance adds a user-provided service instance to the space
+// with the given GUID.
+func (s *Server) CreateServiceInstance(spaceGUID string, name string) ServiceInstance {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return *s.createServiceInstance(spaceGUID, name)
+}
+
+// App returns the app with the given name in the space with the given GUID.
+func (s *Server) App(spaceGUID string, name string) (App, bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	app := s.findAppByName(spaceGUID, name)
+	if app == nil {
+		return App{}, false
+	}
+	return *app, true
+}
+
+// Processes returns the processes of the app with the given GUID.
+func (s *Server) Processes(appGUID string) []Process {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	var processes []Process
+	for _, process := range s.appProcesses(appGUID) {
+		processes = append(processes, *process)
+	}
+	return processes
+}
+
+// Routes returns the routes mapped to the app with the given GUID.
+func (s *Server) Routes(appGUID string) []Route {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	var routes []Route
+	for _, route := range s.routes {
+		if route.mapsTo(appGUID) {
+			routes = append(routes, *route)
+		}
+	}
+	return routes
+}
+
+func (s *Server) findOrganization(guid string) *Organization {
+	for _, org := range s.organizations {
+		if org.GUID == guid {
+			return org
+		}
+	}
+	return nil
+}
+
+func (s *Server) findSpace(guid string) *Space {
+	for _, space := range s.spaces {
+		if space.GUID == guid {
+			return space
+		}
+	}
+	return nil
+}
+
+func (s *Server) findDomain(guid string) *Domain {
+	for _, domain := range s.domains {
+		if domain.GUID == guid {
+			return domain
+		}
+	}
+	return nil
+}
+
+// findDomainForURL returns the domain with the longest name the URL ends
+// with, and the host in front of it.
+func (s *Server) findDomainForURL(url string) (*Domain, string) {
+	domains := append([]*Domain(nil), s.domains...)
+	sort.SliceStable(domains, func(i, j int) bool {
+		return len(domains[i].Name) > len(domains[j].Name)
+	})
+
+	for _, domain := range domains {
+		if url == domain.Name {
+			return domain, ""
+		}
+		if len(url) > len(domain.Name) && url[len(url)-len(domain.Name)-1:] == "."+domain.Name {
+			return domain, url[:len(url)-len(domain.Name)-1]
+		}
+	}
+	return nil, ""
+}
+
+func (s *Server) findApp(guid string) *App {
+	for _, app := range s.apps {
+		if app.GUID == guid {
+			return app
+		}
+	}
+	return nil
+}
+
+func (s *Server) findAppByName(spaceGUID string, name string) *App {
+	for _, app := range s.apps {
+		if app.SpaceGUID == spaceGUID && app.Name == name {
+			return app
+		}
+	}
+	return nil
+}
+
+func (s *Server) findProcess(guid string) *Process {
+	for _, process := range s.processes {
+		if process.GUID == guid {
+			return process
+		}
+	}
+	return nil
+}
+
+func (s *Server) findAppProcess(appGUID string, processType string) *Process {
+	for _, process := range s.processes {
+		if process.AppGUID == appGUID && process.Type == processType {
+			return process
+		}
+	}
+	return nil
+}
+
+func (s *Server) appProcesses(appGUID string) []*Process {
+	var processes []*Process
+	for _, process := range s.processes {
+		if process.AppGUID == appGUID {
+			processes = append(processes, process)
+		}
+	}
+	return processes
+}
+
+func (s *Server) findPackage(guid string) *pkg {
+	for _, pkg := range s.packages {
+		if pkg.GUID == guid {
+			return pkg
+		}
+	}
+	return nil
+}
+
+func (s *Server) findBuild(guid string) *build {
+	for _, build := range s.builds {
+		if build.GUID == guid {
+			return build
+		}
+	}
+	return nil
+}
+
+func (s *Server) findDroplet(guid string) *droplet {
+	for _, droplet := range s.droplets {
+		if droplet.GUID == guid {
+			return droplet
+		}
+	}
+	return nil
+}
+
+func (s *Server) findRoute(domainGUID string, host string, path string) *Route {
+	for _, route := range s.routes {
+		if route.DomainGUID == domainGUID && route.Host == host && route.Path == path {
+			return route
+		}
+	}
+	return nil
+}
+
+func (s *Server) findServiceInstance(guid string) *ServiceInstance {
+	for _, serviceInstance := range s.serviceInstances {
+		if serviceInstance.GUID == guid {
+			return serviceInstance
+		}
+	}
+	return nil
+}
+
+func (s *Server) findJob(guid string) *job {
+	for _, job := range s.jobs {
+		if job.GUID == guid {
+			return job
+		}
+	}
+	return nil
+}
+
+func (route Route) mapsTo(appGUID string) bool {
+	for _, destination := range route.Destinations {
+		if destination.AppGUID == appGUID {
+			return true
+		}
+	}
+	return false
+}
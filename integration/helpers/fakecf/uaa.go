@@ -0,0 +1,158 @@
+package fakecf
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/SermoDigital/jose/crypto"
+	"github.com/SermoDigital/jose/jws"
+)
+
+const (
+	accessTokenLifetime = time.Hour
+	oauthClient         = "cf"
+)
+
+type user struct {
+	GUID     string
+	Password string
+}
+
+// token is who an access or refresh token was issued to. Clients are issued
+// tokens with the client credentials grant, users with the password grant.
+type token struct {
+	Username  string
+	ClientID  string
+	ExpiresAt time.Time
+}
+
+// AddUser adds a user that can log in with the given password.
+func (s *Server) AddUser(username string, password string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.users[username] = user{GUID: mustGUID(), Password: password}
+}
+
+// AddClient adds a client that can authenticate with the client credentials
+// grant, as with 'cf auth --client-credentials'.
+func (s *Server) AddClient(clientID string, clientSecret string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.clients[clientID] = clientSecret
+}
+
+func (s *Server) getLogin(w http.ResponseWriter, r *http.Request, _ map[string]string) error {
+	return respondWithJSON(w, http.StatusOK, map[string]interface{}{
+		"app": map[string]string{"version": UAAVersion},
+		"links": map[string]string{
+			"uaa":   s.URL(),
+			"login": s.URL(),
+		},
+		"zone_name": "uaa",
+		"prompts": map[string][]string{
+			"username": {"text", "Email"},
+			"password": {"password", "Password"},
+		},
+	})
+}
+
+func (s *Server) postOAuthToken(w http.ResponseWriter, r *http.Request, _ map[string]string) error {
+	err := r.ParseForm()
+	if err != nil {
+		return err
+	}
+
+	var issuedTo token
+	switch r.PostForm.Get("grant_type") {
+	case "password":
+		user, ok := s.users[r.PostForm.Get("username")]
+		if !ok || user.Password != r.PostForm.Get("password") {
+			return badCredentialsError()
+		}
+		issuedTo = token{Username: r.PostForm.Get("username")}
+	case "client_credentials":
+		clientID := r.PostForm.Get("client_id")
+		secret, ok := s.clients[clientID]
+		if !ok || secret != r.PostForm.Get("client_secret") {
+			return badCredentialsError()
+		}
+		issuedTo = token{ClientID: clientID}
+	case "refresh_token":
+		var ok bool
+		issuedTo, ok = s.refreshTokens[r.PostForm.Get("refresh_token")]
+		if !ok {
+			return invalidTokenError()
+		}
+	default:
+		return uaaError{
+			status:      http.StatusBadRequest,
+			Type:        "unsupported_grant_type",
+			Description: "Unsupported grant type",
+		}
+	}
+
+	accessToken, err := s.issueAccessToken(issuedTo)
+	if err != nil {
+		return err
+	}
+	refreshToken := mustGUID() + "-r"
+	s.refreshTokens[refreshToken] = issuedTo
+
+	return respondWithJSON(w, http.StatusOK, map[string]interface{}{
+		"access_token":  accessToken,
+		"refresh_token": refreshToken,
+		"token_type":    "bearer",
+		"expires_in":    int(accessTokenLifetime.Seconds()),
+		"scope":         "openid cloud_controller.read cloud_controller.write cloud_controller.admin doppler.firehose uaa.user",
+		"jti":           mustGUID(),
+	})
+}
+
+func (s *Server) deleteOAuthToken(w http.ResponseWriter, r *http.Request, _ map[string]string) error {
+	w.WriteHeader(http.StatusOK)
+	return nil
+}
+
+// issueAccessToken returns an unsigned JWT with the claims the CLI reads,
+// and remembers it so that requests authenticated with it are accepted.
+func (s *Server) issueAccessToken(issuedTo token) (string, error) {
+	issuedTo.ExpiresAt = time.Now().Add(accessTokenLifetime)
+
+	claims := jws.Claims{}
+	claims.SetExpiration(issuedTo.ExpiresAt)
+	claims.Set("jti", mustGUID())
+	if issuedTo.Username != "" {
+		claims.Set("user_name", issuedTo.Username)
+		claims.Set("user_id", s.users[issuedTo.Username].GUID)
+		claims.Set("origin", "uaa")
+		claims.Set("client_id", oauthClient)
+	} else {
+		claims.Set("client_id", issuedTo.ClientID)
+	}
+
+	rawToken, err := jws.NewJWT(claims, crypto.Unsecured).Serialize(nil)
+	if err != nil {
+		return "", err
+	}
+
+	s.accessTokens[string(rawToken)] = issuedTo
+	return string(rawToken), nil
+}
+
+// authenticate checks that the request carries an unexpired access token
+// issued by the server.
+func (s *Server) authenticate(r *http.Request) error {
+	authorization := r.Header.Get("Authorization")
+	if len(authorization) < len("bearer ") || !strings.EqualFold(authorization[:len("bearer ")], "bearer ") {
+		return invalidAuthTokenError()
+	}
+
+	issuedTo, ok := s.accessTokens[authorization[len("bearer "):]]
+	if !ok || time.Now().After(issuedTo.ExpiresAt) {
+		return invalidAuthTokenError()
+	}
+	return nil
+}
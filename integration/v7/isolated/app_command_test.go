@@ -43,7 +43,8 @@ var _ = Describe("app command", func() {
 				Eventually(session).Should(Say("USAGE:"))
 				Eventually(session).Should(Say("cf app APP_NAME"))
 				Eventually(session).Should(Say("OPTIONS:"))
-				Eventually(session).Should(Say("--guid      Retrieve and display the given app's guid.  All other health and status output for the app is suppressed."))
+				Eventually(session).Should(Say(`--foundations-file\s+Run against every foundation listed in the given YAML file`))
+				Eventually(session).Should(Say("--guid\\s+Retrieve and display the given app's guid.  All other health and status output for the app is suppressed."))
 				Eventually(session).Should(Say("SEE ALSO:"))
 				Eventually(session).Should(Say("apps, events, logs, map-route, push, unmap-route"))
 				Eventually(session).Should(Exit(0))
@@ -67,7 +67,19 @@ var _ = Describe("help", func() {
 				"[--vars-file VARS_FILE_PATH]...",
 			}
 
-			assertUsage(session, buildpackAppUsage, dockerAppUsage)
+			foundationsUsage := []string{
+				"cf",
+				PushCommandName,
+				"APP_NAME",
+				"--foundations-file",
+				"FOUNDATIONS_FILE",
+				"[--parallel]",
+				"[-f MANIFEST_PATH]",
+				"[--var KEY=VALUE]",
+				"[--vars-file VARS_FILE_PATH]...",
+			}
+
+			assertUsage(session, buildpackAppUsage, dockerAppUsage, foundationsUsage)
 
 			Eventually(session).Should(Say("OPTIONS:"))
 			Eventually(session).Should(Say(`--app-start-timeout, -t`))
@@ -77,6 +89,7 @@ var _ = Describe("help", func() {
 			Eventually(session).Should(Say(`--docker-username`))
 			Eventually(session).Should(Say(`--droplet`))
 			Eventually(session).Should(Say(`--endpoint`))
+			Eventually(session).Should(Say(`--foundations-file`))
 			Eventually(session).Should(Say(`--health-check-type, -u`))
 			Eventually(session).Should(Say(`--instances, -i`))
 			Eventually(session).Should(Say(`--log-rate-limit, -l\s+Log rate limit per second, in bytes \(e.g. 128B, 4K, 1M\). -l=-1 represents unlimited`))
@@ -86,6 +99,7 @@ var _ = Describe("help", func() {
 			Eventually(session).Should(Say(`--no-route`))
 			Eventually(session).Should(Say(`--no-start`))
 			Eventually(session).Should(Say(`--no-wait`))
+			Eventually(session).Should(Say(`--parallel`))
 			Eventually(session).Should(Say(`--path, -p`))
 			Eventually(session).Should(Say(`--random-route`))
 			Eventually(session).Should(Say(`--stack, -s`))
@@ -0,0 +1,76 @@
+package selfcontained_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/integration/helpers"
+	"code.cloudfoundry.org/cli/integration/helpers/fakecf"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("a push against the fake Cloud Controller", func() {
+	var (
+		server *fakecf.Server
+		space  fakecf.Space
+		appDir string
+	)
+
+	BeforeEach(func() {
+		server = fakecf.NewServer()
+		org := server.CreateOrganization("some-org")
+		space = server.CreateSpace(org.GUID, "some-space")
+
+		var err error
+		appDir, err = ioutil.TempDir("", "fakecf-app")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ioutil.WriteFile(filepath.Join(appDir, "index.html"), []byte("hello"), 0644)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		server.Close()
+		Expect(os.RemoveAll(appDir)).To(Succeed())
+	})
+
+	It("targets, pushes, lists the app and shows its logs", func() {
+		session := helpers.CF("api", server.URL())
+		Eventually(session).Should(Say(`Setting API endpoint to %s\.\.\.`, server.URL()))
+		Eventually(session).Should(gexec.Exit(0))
+
+		session = helpers.CF("auth", fakecf.DefaultUsername, fakecf.DefaultPassword)
+		Eventually(session).Should(Say("OK"))
+		Eventually(session).Should(gexec.Exit(0))
+
+		session = helpers.CF("target", "-o", "some-org", "-s", "some-space")
+		Eventually(session).Should(Say(`org:\s+some-org`))
+		Eventually(session).Should(Say(`space:\s+some-space`))
+		Eventually(session).Should(gexec.Exit(0))
+
+		session = helpers.CF("push", "some-app", "-p", appDir)
+		Eventually(session).Should(Say(`Pushing app some-app to org some-org / space some-space as %s\.\.\.`, fakecf.DefaultUsername))
+		Eventually(session).Should(Say("Staging app and tracing logs..."))
+		Eventually(session).Should(Say(`name:\s+some-app`))
+		Eventually(session).Should(Say(`#0\s+running`))
+		Eventually(session).Should(gexec.Exit(0))
+
+		app, found := server.App(space.GUID, "some-app")
+		Expect(found).To(BeTrue())
+		Expect(app.State).To(Equal("STARTED"))
+
+		session = helpers.CF("apps")
+		Eventually(session).Should(Say(`name\s+requested state\s+processes\s+routes`))
+		Eventually(session).Should(Say(`some-app\s+started\s+web:1/1`))
+		Eventually(session).Should(gexec.Exit(0))
+
+		server.AddLog(app.GUID, "hello from some-app")
+
+		session = helpers.CF("logs", "some-app", "--recent")
+		Eventually(session).Should(Say(`\[STG/0\]\s+OUT Staging complete`))
+		Eventually(session).Should(Say(`\[APP/PROC/WEB/0\]\s+OUT hello from some-app`))
+		Eventually(session).Should(gexec.Exit(0))
+	})
+})
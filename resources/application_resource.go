@@ -11,6 +11,10 @@ import (
 type Application struct {
 	// GUID is the unique application identifier.
 	GUID string
+	// CreatedAt is the time with zone when the application was created.
+	CreatedAt string
+	// UpdatedAt is the time with zone when the application was last updated.
+	UpdatedAt string
 	// StackName is the name of the stack on which the application runs.
 	StackName string
 	// LifecycleBuildpacks is a list of the names of buildpacks.
@@ -74,6 +78,8 @@ func (a *Application) UnmarshalJSON(data []byte) error {
 	}
 
 	a.GUID = ccApp.GUID
+	a.CreatedAt = ccApp.CreatedAt
+	a.UpdatedAt = ccApp.UpdatedAt
 	a.StackName = lifecycle.Data.Stack
 	a.LifecycleBuildpacks = lifecycle.Data.Buildpacks
 	a.LifecycleType = lifecycle.Type
@@ -113,6 +119,8 @@ type ccApplication struct {
 	Relationships Relationships             `json:"relationships,omitempty"`
 	Lifecycle     interface{}               `json:"lifecycle,omitempty"`
 	GUID          string                    `json:"guid,omitempty"`
+	CreatedAt     string                    `json:"created_at,omitempty"`
+	UpdatedAt     string                    `json:"updated_at,omitempty"`
 	State         constant.ApplicationState `json:"state,omitempty"`
 	Metadata      *Metadata                 `json:"metadata,omitempty"`
 }
@@ -9,6 +9,8 @@ import (
 type Droplet struct {
 	//Buildpacks are the detected buildpacks from the staging process.
 	Buildpacks []DropletBuildpack `json:"buildpacks,omitempty"`
+	// Checksum is the hash of the droplet bits.
+	Checksum DropletChecksum `json:"checksum"`
 	// CreatedAt is the timestamp that the Cloud Controller created the droplet.
 	CreatedAt string `json:"created_at"`
 	// GUID is the unique droplet identifier.
@@ -35,3 +37,12 @@ type DropletBuildpack struct {
 	// Version is the version of the detected buildpack.
 	Version string `json:"version"`
 }
+
+// DropletChecksum is the hash of a droplet and the algorithm used to
+// calculate it.
+type DropletChecksum struct {
+	// Type is the hashing algorithm, either sha256 or sha1.
+	Type string `json:"type"`
+	// Value is the hash.
+	Value string `json:"value"`
+}
@@ -31,6 +31,8 @@ type Route struct {
 	URL          string
 	Destinations []RouteDestination
 	Metadata     *Metadata
+	CreatedAt    string
+	UpdatedAt    string
 }
 
 func (r Route) MarshalJSON() ([]byte, error) {
@@ -85,6 +87,8 @@ func (r *Route) UnmarshalJSON(data []byte) error {
 		URL          string             `json:"url,omitempty"`
 		Destinations []RouteDestination `json:"destinations,omitempty"`
 		Metadata     *Metadata          `json:"metadata,omitempty"`
+		CreatedAt    string             `json:"created_at,omitempty"`
+		UpdatedAt    string             `json:"updated_at,omitempty"`
 
 		Relationships struct {
 			Space struct {
@@ -115,6 +119,8 @@ func (r *Route) UnmarshalJSON(data []byte) error {
 	r.URL = alias.URL
 	r.Destinations = alias.Destinations
 	r.Metadata = alias.Metadata
+	r.CreatedAt = alias.CreatedAt
+	r.UpdatedAt = alias.UpdatedAt
 
 	return nil
 }
@@ -41,6 +41,10 @@ type ServiceInstance struct {
 	Parameters types.OptionalObject `jsonry:"parameters"`
 	// LastOperation is the last operation on the service instance
 	LastOperation LastOperation `jsonry:"last_operation"`
+	// CreatedAt is the time with zone when the service instance was created
+	CreatedAt string `jsonry:"created_at,omitempty"`
+	// UpdatedAt is the time with zone when the service instance was last updated
+	UpdatedAt string `jsonry:"updated_at,omitempty"`
 
 	Metadata *Metadata `json:"metadata,omitempty"`
 }
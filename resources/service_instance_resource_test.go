@@ -38,6 +38,8 @@ var _ = Describe("service instance resource", func() {
 		Entry("dashboard", ServiceInstance{DashboardURL: types.NewOptionalString("https://fake-dashboard.com")}, `{"dashboard_url": "https://fake-dashboard.com"}`),
 		Entry("dashboard empty", ServiceInstance{DashboardURL: types.NewOptionalString("https://fake-dashboard.com")}, `{"dashboard_url": "https://fake-dashboard.com"}`),
 		Entry("upgrade available", ServiceInstance{UpgradeAvailable: types.NewOptionalBoolean(false)}, `{"upgrade_available": false}`),
+		Entry("created at", ServiceInstance{CreatedAt: "2021-01-01T00:00:00Z"}, `{"created_at": "2021-01-01T00:00:00Z"}`),
+		Entry("updated at", ServiceInstance{UpdatedAt: "2021-01-02T00:00:00Z"}, `{"updated_at": "2021-01-02T00:00:00Z"}`),
 		Entry(
 			"credentials",
 			ServiceInstance{
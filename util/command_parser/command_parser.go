@@ -60,15 +60,38 @@ func (p *CommandParser) ParseCommandFromArgs(ui *ui.UI, args []string) (int, err
 func (p *CommandParser) executionWrapper(cmd flags.Commander, args []string) error {
 	cfConfig := p.Config
 	cfConfig.Flags = configv3.FlagOverride{
-		Verbose: common.Commands.VerboseOrVersion,
+		Foundation: common.Commands.Foundation,
+		Table:      tableOptions(),
+		Timings:    common.Commands.Timings,
+		Verbose:    common.Commands.VerboseOrVersion,
 	}
+	p.UI.TableOptions = cfConfig.TableOptions()
 	defer p.UI.FlushDeferred()
+	if cfConfig.Timings() {
+		defer p.UI.DisplayRequestTimings()
+	}
 
 	err := preventExtraArgs(args)
 	if err != nil {
 		return p.handleError(err)
 	}
 
+	if cfConfig.Flags.Foundation != "" {
+		err = cfConfig.SelectFoundation(cfConfig.Flags.Foundation)
+		if err != nil {
+			return p.handleError(err)
+		}
+	}
+
+	switch cmd.(type) {
+	case *common.HelpCommand, *common.VersionCommand:
+	default:
+		err = cfConfig.TokenStoreError()
+		if err != nil {
+			return p.handleError(err)
+		}
+	}
+
 	err = cfConfig.CreatePluginHome()
 	if err != nil {
 		return p.handleError(err)
@@ -266,3 +289,19 @@ func isCommand(s string) bool {
 func isOption(s string) bool {
 	return strings.HasPrefix(s, "-")
 }
+
+func tableOptions() configv3.TableOptions {
+	options := configv3.TableOptions{
+		Columns:   common.Commands.Columns,
+		SortBy:    common.Commands.SortBy,
+		NoHeaders: common.Commands.NoHeaders,
+		Wide:      common.Commands.Wide,
+	}
+	for _, filter := range common.Commands.Filter {
+		options.Filters = append(options.Filters, configv3.TableFilter{
+			Column: filter.Column,
+			Value:  filter.Value,
+		})
+	}
+	return options
+}
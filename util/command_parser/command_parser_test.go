@@ -78,4 +78,35 @@ var _ = Describe("Command 'Parser'", func() {
 		})
 
 	})
+
+	Describe("the timings flag", func() {
+		var parser command_parser.CommandParser
+
+		BeforeEach(func() {
+			common.Commands.VerboseOrVersion = false
+			common.Commands.Timings = false
+			var err error
+
+			parser, err = command_parser.NewCommandParser()
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			common.Commands.Timings = false
+		})
+
+		It("sets the timings flag", func() {
+			exitCode, err := parser.ParseCommandFromArgs(pluginUI, []string{"--timings", "help"})
+			Expect(exitCode).To(Equal(0))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(parser.Config.Timings()).To(BeTrue())
+		})
+
+		It("doesn't turn timings on by default", func() {
+			exitCode, err := parser.ParseCommandFromArgs(pluginUI, []string{"help"})
+			Expect(exitCode).To(Equal(0))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(parser.Config.Timings()).To(BeFalse())
+		})
+	})
 })
@@ -0,0 +1,10 @@
+package configv3
+
+import "path/filepath"
+
+// CircuitBreakerFile returns the file that remembers which CC API endpoints
+// were recently unavailable, so that later commands fail over to the next
+// endpoint straight away.
+func (config *Config) CircuitBreakerFile() string {
+	return filepath.Join(configDirectory(), "circuit_breaker.json")
+}
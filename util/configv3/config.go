@@ -32,6 +32,43 @@ type Config struct {
 
 	pluginsConfig PluginsConfig
 
+	// selectedFoundation is the name of the foundation used by this
+	// invocation.
+	selectedFoundation string
+
+	// persistedSession is the target and session written to the top level
+	// of .cf/config.json while another foundation is selected.
+	persistedSession Foundation
+
+	// envSession is true when the API given in CF_API is not a saved
+	// foundation. Its target and session are used in memory and written to
+	// the EnvSessions instead of the top level of .cf/config.json.
+	envSession bool
+
+	// detached is true for in-memory copies of the config that talk to
+	// another foundation and are never written to disk.
+	detached bool
+
+	// persistedContents are the contents of .cf/config.json as this process
+	// last read or wrote them. They are compared with the current contents
+	// to find the changes that are merged into the file when it is written.
+	persistedContents interface{}
+
+	// tokenStore is the unlocked encrypted token store, or nil if the store
+	// is not used or could not be unlocked. tokenStoreErr holds the reason it
+	// could not be unlocked.
+	tokenStore    *tokenStore
+	tokenStoreErr error
+
+	// persistedSecrets are the contents of the token store as this process
+	// last read or wrote them.
+	persistedSecrets interface{}
+
+	// envOrganizationGUID and envSpaceGUID are the GUIDs of the org and
+	// space named in CF_ORG and CF_SPACE once they have been looked up.
+	envOrganizationGUID string
+	envSpaceGUID        string
+
 	UserConfig
 }
 
@@ -107,6 +144,13 @@ func (config *Config) Verbose() (bool, []string) {
 	return verbose, filePath
 }
 
+// Timings returns true if a summary of the API requests made by the command
+// should be displayed when it finishes. It is set with the '--timings' global
+// flag.
+func (config *Config) Timings() bool {
+	return config.Flags.Timings
+}
+
 func (config *Config) SetKubernetesAuthInfo(authInfo string) {
 	config.ConfigFile.CFOnK8s.AuthInfo = authInfo
 }
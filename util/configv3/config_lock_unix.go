@@ -0,0 +1,41 @@
+//go:build !windows
+// +build !windows
+
+package configv3
+
+import (
+	"os"
+	"syscall"
+)
+
+// lockConfigFile takes an exclusive advisory lock on the given lock file,
+// waiting for other cf processes to release it. The returned function
+// releases the lock.
+func lockConfigFile(lockFilePath string) (func(), error) {
+	return lockFileWith(lockFilePath, syscall.LOCK_EX)
+}
+
+// tryLockConfigFile takes an exclusive advisory lock on the given lock file
+// like lockConfigFile, but returns an error instead of waiting when another
+// cf process holds it.
+func tryLockConfigFile(lockFilePath string) (func(), error) {
+	return lockFileWith(lockFilePath, syscall.LOCK_EX|syscall.LOCK_NB)
+}
+
+func lockFileWith(lockFilePath string, how int) (func(), error) {
+	lockFile, err := os.OpenFile(lockFilePath, os.O_CREATE|os.O_RDWR, 0600)
+	if err != nil {
+		return nil, err
+	}
+
+	err = syscall.Flock(int(lockFile.Fd()), how)
+	if err != nil {
+		lockFile.Close()
+		return nil, err
+	}
+
+	return func() {
+		_ = syscall.Flock(int(lockFile.Fd()), syscall.LOCK_UN)
+		lockFile.Close()
+	}, nil
+}
@@ -0,0 +1,42 @@
+//go:build windows
+// +build windows
+
+package configv3
+
+import (
+	"os"
+
+	"golang.org/x/sys/windows"
+)
+
+// lockConfigFile takes an exclusive lock on the given lock file, waiting for
+// other cf processes to release it. The returned function releases the lock.
+func lockConfigFile(lockFilePath string) (func(), error) {
+	return lockFileWith(lockFilePath, windows.LOCKFILE_EXCLUSIVE_LOCK)
+}
+
+// tryLockConfigFile takes an exclusive lock on the given lock file like
+// lockConfigFile, but returns an error instead of waiting when another cf
+// process holds it.
+func tryLockConfigFile(lockFilePath string) (func(), error) {
+	return lockFileWith(lockFilePath, windows.LOCKFILE_EXCLUSIVE_LOCK|windows.LOCKFILE_FAIL_IMMEDIATELY)
+}
+
+func lockFileWith(lockFilePath string, flags uint32) (func(), error) {
+	lockFile, err := os.OpenFile(lockFilePath, os.O_CREATE|os.O_RDWR, 0600)
+	if err != nil {
+		return nil, err
+	}
+
+	handle := windows.Handle(lockFile.Fd())
+	err = windows.LockFileEx(handle, flags, 0, 1, 0, &windows.Overlapped{})
+	if err != nil {
+		lockFile.Close()
+		return nil, err
+	}
+
+	return func() {
+		_ = windows.UnlockFileEx(handle, 0, 1, 0, &windows.Overlapped{})
+		lockFile.Close()
+	}, nil
+}
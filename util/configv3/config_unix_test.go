@@ -8,6 +8,8 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"syscall"
+	"time"
 
 	. "code.cloudfoundry.org/cli/util/configv3"
 
@@ -133,3 +135,45 @@ var _ = Describe("Verbose", func() {
 		})
 	})
 })
+
+var _ = Describe("LoadConfig while another cf process holds the config lock", func() {
+	var (
+		homeDir  string
+		lockFile *os.File
+	)
+
+	BeforeEach(func() {
+		homeDir = setup()
+
+		configDir := filepath.Join(homeDir, ".cf")
+		Expect(os.MkdirAll(configDir, 0777)).To(Succeed())
+		tmpFile, err := ioutil.TempFile(configDir, "temp-config")
+		Expect(err).ToNot(HaveOccurred())
+		tmpFile.Close()
+
+		lockFile, err = os.OpenFile(filepath.Join(configDir, "config.json.lock"), os.O_CREATE|os.O_RDWR, 0600)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(syscall.Flock(int(lockFile.Fd()), syscall.LOCK_EX)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		_ = syscall.Flock(int(lockFile.Fd()), syscall.LOCK_UN)
+		lockFile.Close()
+		teardown(homeDir)
+	})
+
+	It("does not wait for the lock and leaves the temp files alone", func() {
+		loaded := make(chan error, 1)
+		go func() {
+			defer GinkgoRecover()
+			_, err := LoadConfig()
+			loaded <- err
+		}()
+
+		Eventually(loaded, 5*time.Second).Should(Receive(BeNil()))
+
+		tempFileNames, err := filepath.Glob(filepath.Join(homeDir, ".cf", "temp-config?*"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(tempFileNames).To(HaveLen(1))
+	})
+})
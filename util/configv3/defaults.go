@@ -19,6 +19,10 @@ const (
 	// Developer Note: Due to bugs in using MaxInt64 during comparison, the above
 	// was chosen as a replacement.
 
+	// DefaultPageConcurrency is the default number of pages of a Cloud
+	// Controller list that are fetched at the same time.
+	DefaultPageConcurrency = 4
+
 	// DefaultPollingInterval is the time between consecutive polls of a status.
 	DefaultPollingInterval = 3 * time.Second
 
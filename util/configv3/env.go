@@ -8,23 +8,33 @@ import (
 
 // EnvOverride represents all the environment variables read by the CF CLI
 type EnvOverride struct {
-	BinaryName       string
-	CFColor          string
-	CFDialTimeout    string
-	CFHome           string
-	CFLogLevel       string
-	CFPassword       string
-	CFPluginHome     string
-	CFStagingTimeout string
-	CFStartupTimeout string
-	CFTrace          string
-	CFUsername       string
-	DockerPassword   string
-	Experimental     string
-	ForceTTY         string
-	HTTPSProxy       string
-	Lang             string
-	LCAll            string
+	BinaryName             string
+	CFAPI                  string
+	CFColor                string
+	CFDialTimeout          string
+	CFHome                 string
+	CFHTTPCacheTTL         string
+	CFLogLevel             string
+	CFOrg                  string
+	CFPageConcurrency      string
+	CFPassword             string
+	CFPluginHome           string
+	CFRecordCassette       string
+	CFReplayCassette       string
+	CFSpace                string
+	CFStagingTimeout       string
+	CFStartupTimeout       string
+	CFTokenStoreKeyFile    string
+	CFTokenStorePassphrase string
+	CFTrace                string
+	CFTraceHAR             string
+	CFUsername             string
+	DockerPassword         string
+	Experimental           string
+	ForceTTY               string
+	HTTPSProxy             string
+	Lang                   string
+	LCAll                  string
 }
 
 // BinaryName returns the running name of the CF CLI
@@ -43,8 +53,8 @@ func (config *Config) CFUsername() string {
 }
 
 // DialTimeout returns the timeout to use when dialing. This is based off of:
-//   1. The $CF_DIAL_TIMEOUT environment variable if set
-//   2. Falling back to the default
+//  1. The $CF_DIAL_TIMEOUT environment variable if set
+//  2. Falling back to the default
 func (config *Config) DialTimeout() time.Duration {
 	if config.ENV.CFDialTimeout != "" {
 		envVal, err := strconv.ParseInt(config.ENV.CFDialTimeout, 10, 64)
@@ -63,8 +73,8 @@ func (config *Config) DockerPassword() string {
 
 // Experimental returns whether or not to run experimental CLI commands. This
 // is based on the following:
-//   1. The $CF_CLI_EXPERIMENTAL environment variable if set
-//   2. Defaults to false
+//  1. The $CF_CLI_EXPERIMENTAL environment variable if set
+//  2. Defaults to false
 func (config *Config) Experimental() bool {
 	if config.ENV.Experimental != "" {
 		envVal, err := strconv.ParseBool(config.ENV.Experimental)
@@ -78,8 +88,8 @@ func (config *Config) Experimental() bool {
 
 // HTTPSProxy returns the proxy url that the CLI should use. The url is based
 // off of:
-//   1. The $https_proxy environment variable if set
-//   2. Defaults to the empty string
+//  1. The $https_proxy environment variable if set
+//  2. Defaults to the empty string
 func (config *Config) HTTPSProxy() string {
 	return config.ENV.HTTPSProxy
 }
@@ -112,10 +122,25 @@ func (config *Config) LogLevel() int {
 	return 0
 }
 
+// RecordCassette returns the path of the cassette file that requests to the
+// Cloud Controller, UAA, Log Cache and the routing API are recorded in, from
+// the $CF_RECORD_CASSETTE environment variable. Requests are not recorded when
+// it is empty.
+func (config *Config) RecordCassette() string {
+	return config.ENV.CFRecordCassette
+}
+
+// ReplayCassette returns the path of the cassette file that API responses are
+// replayed from instead of making requests, from the $CF_REPLAY_CASSETTE
+// environment variable. Requests are made as usual when it is empty.
+func (config *Config) ReplayCassette() string {
+	return config.ENV.CFReplayCassette
+}
+
 // StagingTimeout returns the max time an application staging should take. The
 // time is based off of:
-//   1. The $CF_STAGING_TIMEOUT environment variable if set
-//   2. Defaults to the DefaultStagingTimeout
+//  1. The $CF_STAGING_TIMEOUT environment variable if set
+//  2. Defaults to the DefaultStagingTimeout
 func (config *Config) StagingTimeout() time.Duration {
 	if config.ENV.CFStagingTimeout != "" {
 		timeoutInMin, err := strconv.ParseFloat(config.ENV.CFStagingTimeout, 64)
@@ -130,8 +155,8 @@ func (config *Config) StagingTimeout() time.Duration {
 
 // StartupTimeout returns the max time an application should take to start. The
 // time is based off of:
-//   1. The $CF_STARTUP_TIMEOUT environment variable if set
-//   2. Defaults to the DefaultStartupTimeout
+//  1. The $CF_STARTUP_TIMEOUT environment variable if set
+//  2. Defaults to the DefaultStartupTimeout
 func (config *Config) StartupTimeout() time.Duration {
 	if config.ENV.CFStartupTimeout != "" {
 		timeoutInMin, err := strconv.ParseFloat(config.ENV.CFStartupTimeout, 64)
@@ -0,0 +1,127 @@
+package configv3
+
+import (
+	"strings"
+)
+
+const (
+	// APIEnvVar, OrganizationEnvVar and SpaceEnvVar target an API, org and
+	// space for a single invocation without changing the config file.
+	APIEnvVar          = "CF_API"
+	OrganizationEnvVar = "CF_ORG"
+	SpaceEnvVar        = "CF_SPACE"
+)
+
+// EnvTarget is the API, org and space targeted with CF_API, CF_ORG and
+// CF_SPACE. It overrides the targets in the config file in memory only.
+type EnvTarget struct {
+	API          string
+	Organization string
+	Space        string
+}
+
+// IsSet returns true if any part of the target comes from the environment.
+func (target EnvTarget) IsSet() bool {
+	return target.API != "" || target.Organization != "" || target.Space != ""
+}
+
+// String lists the environment variables that are set, for example
+// "CF_ORG=my-org, CF_SPACE=dev".
+func (target EnvTarget) String() string {
+	var variables []string
+	for _, variable := range []struct{ name, value string }{
+		{APIEnvVar, target.API},
+		{OrganizationEnvVar, target.Organization},
+		{SpaceEnvVar, target.Space},
+	} {
+		if variable.value != "" {
+			variables = append(variables, variable.name+"="+variable.value)
+		}
+	}
+	return strings.Join(variables, ", ")
+}
+
+// EnvTarget returns the API, org and space set in the environment.
+func (config *Config) EnvTarget() EnvTarget {
+	return EnvTarget{
+		API:          config.ENV.CFAPI,
+		Organization: config.ENV.CFOrg,
+		Space:        config.ENV.CFSpace,
+	}
+}
+
+// SetEnvOrganizationGUID records the GUID of the org named in CF_ORG once it
+// has been looked up.
+func (config *Config) SetEnvOrganizationGUID(guid string) {
+	config.envOrganizationGUID = guid
+}
+
+// SetEnvSpaceGUID records the GUID of the space named in CF_SPACE once it has
+// been looked up.
+func (config *Config) SetEnvSpaceGUID(guid string) {
+	config.envSpaceGUID = guid
+}
+
+// IsSameAPI returns true if both API URLs refer to the same API endpoint.
+func IsSameAPI(api string, otherAPI string) bool {
+	return strings.EqualFold(strings.TrimRight(api, "/"), strings.TrimRight(otherAPI, "/"))
+}
+
+// organizationFromEnv returns true if CF_ORG names another org than the one
+// in the config file.
+func (config *Config) organizationFromEnv() bool {
+	return config.ENV.CFOrg != "" && config.ENV.CFOrg != config.ConfigFile.TargetedOrganization.Name
+}
+
+// spaceFromEnv returns true if CF_SPACE names another space than the one in
+// the config file, or if CF_ORG targets another org.
+func (config *Config) spaceFromEnv() bool {
+	if config.organizationFromEnv() {
+		return true
+	}
+	return config.ENV.CFSpace != "" && config.ENV.CFSpace != config.ConfigFile.TargetedSpace.Name
+}
+
+// targetEnvAPI targets the API given in CF_API for this invocation, unless
+// it is already the targeted API. The saved foundation for the API is used if
+// there is one. Otherwise the API is targeted in memory with the session kept
+// for it in EnvSessions, which has no tokens until 'cf auth' is run with the
+// same CF_API.
+func (config *Config) targetEnvAPI() {
+	api := config.ENV.CFAPI
+	if api == "" || IsSameAPI(api, config.ConfigFile.Target) {
+		return
+	}
+
+	for _, foundation := range config.Foundations() {
+		if IsSameAPI(api, foundation.Target) {
+			_ = config.SelectFoundation(foundation.Name)
+			return
+		}
+	}
+
+	config.saveSelectedFoundation()
+	config.selectedFoundation = ""
+	config.envSession = true
+
+	session, found := config.envSessionFor(api)
+	if !found {
+		session = Foundation{
+			SSHOAuthClient:       DefaultSSHOAuthClient,
+			Target:               strings.TrimRight(api, "/"),
+			UAAOAuthClient:       DefaultUAAOAuthClient,
+			UAAOAuthClientSecret: DefaultUAAOAuthClientSecret,
+		}
+	}
+	config.ConfigFile.applyFoundation(session)
+}
+
+// envSessionFor returns the session kept for the API given in CF_API.
+func (config *Config) envSessionFor(api string) (Foundation, bool) {
+	for sessionAPI, session := range config.ConfigFile.EnvSessions {
+		if IsSameAPI(api, sessionAPI) {
+			return session, true
+		}
+	}
+	return Foundation{}, false
+}
@@ -0,0 +1,162 @@
+package configv3_test
+
+import (
+	"os"
+
+	. "code.cloudfoundry.org/cli/util/configv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("EnvTarget", func() {
+	var (
+		homeDir string
+		config  *Config
+	)
+
+	BeforeEach(func() {
+		homeDir = setup()
+		setConfig(homeDir, `{
+			"ConfigVersion": 3,
+			"Target": "https://api.dc1.com",
+			"AccessToken": "dc1-token",
+			"OrganizationFields": {"GUID": "file-org-guid", "Name": "file-org"},
+			"SpaceFields": {"GUID": "file-space-guid", "Name": "file-space"},
+			"Foundations": {
+				"dc2": {"Target": "https://api.dc2.com", "AccessToken": "dc2-token"}
+			}
+		}`)
+	})
+
+	AfterEach(func() {
+		Expect(os.Unsetenv(APIEnvVar)).To(Succeed())
+		Expect(os.Unsetenv(OrganizationEnvVar)).To(Succeed())
+		Expect(os.Unsetenv(SpaceEnvVar)).To(Succeed())
+		teardown(homeDir)
+	})
+
+	JustBeforeEach(func() {
+		var err error
+		config, err = LoadConfig()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	When("no target is set in the environment", func() {
+		It("uses the target in the config file", func() {
+			Expect(config.EnvTarget().IsSet()).To(BeFalse())
+			Expect(config.TargetedOrganization().GUID).To(Equal("file-org-guid"))
+			Expect(config.TargetedSpace().GUID).To(Equal("file-space-guid"))
+		})
+	})
+
+	When("CF_ORG and CF_SPACE are set", func() {
+		BeforeEach(func() {
+			Expect(os.Setenv(OrganizationEnvVar, "env-org")).To(Succeed())
+			Expect(os.Setenv(SpaceEnvVar, "env-space")).To(Succeed())
+		})
+
+		It("targets them in memory until their GUIDs are looked up", func() {
+			Expect(config.EnvTarget().String()).To(Equal("CF_ORG=env-org, CF_SPACE=env-space"))
+			Expect(config.HasTargetedOrganization()).To(BeTrue())
+			Expect(config.HasTargetedSpace()).To(BeTrue())
+			Expect(config.TargetedOrganization()).To(Equal(Organization{Name: "env-org"}))
+			Expect(config.TargetedSpace()).To(Equal(Space{Name: "env-space"}))
+
+			config.SetEnvOrganizationGUID("env-org-guid")
+			config.SetEnvSpaceGUID("env-space-guid")
+			Expect(config.TargetedOrganization()).To(Equal(Organization{GUID: "env-org-guid", Name: "env-org"}))
+			Expect(config.TargetedSpace()).To(Equal(Space{GUID: "env-space-guid", Name: "env-space"}))
+		})
+
+		It("does not write them to the config file", func() {
+			config.SetEnvOrganizationGUID("env-org-guid")
+			Expect(config.WriteConfig()).To(Succeed())
+
+			writtenConfig, err := LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(writtenConfig.ConfigFile.TargetedOrganization.Name).To(Equal("file-org"))
+			Expect(writtenConfig.ConfigFile.TargetedSpace.Name).To(Equal("file-space"))
+		})
+	})
+
+	When("only CF_ORG is set to another org", func() {
+		BeforeEach(func() {
+			Expect(os.Setenv(OrganizationEnvVar, "env-org")).To(Succeed())
+		})
+
+		It("does not target a space", func() {
+			Expect(config.HasTargetedSpace()).To(BeFalse())
+			Expect(config.TargetedSpace()).To(Equal(Space{}))
+		})
+	})
+
+	When("CF_ORG names the org in the config file", func() {
+		BeforeEach(func() {
+			Expect(os.Setenv(OrganizationEnvVar, "file-org")).To(Succeed())
+		})
+
+		It("keeps the targeted org and space", func() {
+			Expect(config.TargetedOrganization().GUID).To(Equal("file-org-guid"))
+			Expect(config.TargetedSpace().GUID).To(Equal("file-space-guid"))
+		})
+	})
+
+	When("CF_API is the API of a saved foundation", func() {
+		BeforeEach(func() {
+			Expect(os.Setenv(APIEnvVar, "https://API.dc2.com/")).To(Succeed())
+		})
+
+		It("uses the foundation for this invocation", func() {
+			Expect(config.Target()).To(Equal("https://api.dc2.com"))
+			Expect(config.AccessToken()).To(Equal("dc2-token"))
+			Expect(config.ActiveFoundation()).To(Equal("dc2"))
+		})
+	})
+
+	When("CF_API is not the API of a saved foundation", func() {
+		BeforeEach(func() {
+			Expect(os.Setenv(APIEnvVar, "https://api.dc3.com/")).To(Succeed())
+		})
+
+		It("targets the API in memory without a session", func() {
+			Expect(config.Target()).To(Equal("https://api.dc3.com"))
+			Expect(config.AccessToken()).To(BeEmpty())
+			Expect(config.APIVersion()).To(BeEmpty())
+			Expect(config.TargetedOrganization()).To(Equal(Organization{}))
+			Expect(config.UAAOAuthClient()).To(Equal(DefaultUAAOAuthClient))
+			Expect(config.ActiveFoundation()).To(BeEmpty())
+		})
+
+		It("keeps the session for the API without changing the persisted target", func() {
+			config.SetTargetInformation(TargetInformationArgs{Api: "https://api.dc3.com", ApiVersion: "3.99.0"})
+			config.SetTokenInformation("dc3-token", "dc3-refresh-token", "ssh-client")
+			Expect(config.WriteConfig()).To(Succeed())
+
+			Expect(os.Unsetenv(APIEnvVar)).To(Succeed())
+			writtenConfig, err := LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(writtenConfig.Target()).To(Equal("https://api.dc1.com"))
+			Expect(writtenConfig.AccessToken()).To(Equal("dc1-token"))
+			Expect(writtenConfig.TargetedOrganization().Name).To(Equal("file-org"))
+
+			Expect(os.Setenv(APIEnvVar, "https://API.dc3.com")).To(Succeed())
+			envConfig, err := LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(envConfig.Target()).To(Equal("https://api.dc3.com"))
+			Expect(envConfig.APIVersion()).To(Equal("3.99.0"))
+			Expect(envConfig.AccessToken()).To(Equal("dc3-token"))
+		})
+	})
+})
+
+var _ = DescribeTable("IsSameAPI",
+	func(api string, otherAPI string, same bool) {
+		Expect(IsSameAPI(api, otherAPI)).To(Equal(same))
+	},
+	Entry("identical", "https://api.example.com", "https://api.example.com", true),
+	Entry("different case", "https://API.example.com", "https://api.example.com", true),
+	Entry("trailing slash", "https://api.example.com/", "https://api.example.com", true),
+	Entry("different host", "https://api.example.com", "https://api.other.com", false),
+)
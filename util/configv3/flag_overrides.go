@@ -2,5 +2,8 @@ package configv3
 
 // FlagOverride represents all the global flags passed to the CF CLI
 type FlagOverride struct {
-	Verbose bool
+	Foundation string
+	Table      TableOptions
+	Timings    bool
+	Verbose    bool
 }
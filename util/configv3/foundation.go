@@ -0,0 +1,297 @@
+package configv3
+
+import (
+	"sort"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/util/sorting"
+)
+
+// Foundation is a named profile holding the API target, session and
+// targeted org/space for one Cloud Foundry foundation.
+type Foundation struct {
+	Name string `json:"-"`
+
+	AccessToken              string       `json:"AccessToken"`
+	APIVersion               string       `json:"APIVersion"`
+	AuthorizationEndpoint    string       `json:"AuthorizationEndpoint"`
+	CACert                   string       `json:"CACert,omitempty"`
+	CFOnK8s                  CFOnK8s      `json:"CFOnK8s"`
+	ClientCert               string       `json:"ClientCert,omitempty"`
+	ClientKeyFile            string       `json:"ClientKeyFile,omitempty"`
+	DopplerEndpoint          string       `json:"DopplerEndPoint"`
+	LogCacheEndpoint         string       `json:"LogCacheEndPoint"`
+	MinCLIVersion            string       `json:"MinCLIVersion"`
+	MinRecommendedCLIVersion string       `json:"MinRecommendedCLIVersion"`
+	NetworkPolicyV1Endpoint  string       `json:"NetworkPolicyV1Endpoint"`
+	TargetedOrganization     Organization `json:"OrganizationFields"`
+	RefreshToken             string       `json:"RefreshToken"`
+	RoutingEndpoint          string       `json:"RoutingAPIEndpoint"`
+	TargetedSpace            Space        `json:"SpaceFields"`
+	SSHOAuthClient           string       `json:"SSHOAuthClient"`
+	SkipSSLValidation        bool         `json:"SSLDisabled"`
+	Target                   string       `json:"Target"`
+	TargetFailoverURLs       []string     `json:"TargetFailoverURLs,omitempty"`
+	UAAEndpoint              string       `json:"UaaEndpoint"`
+	UAAGrantType             string       `json:"UAAGrantType"`
+	UAAOAuthClient           string       `json:"UAAOAuthClient"`
+	UAAOAuthClientSecret     string       `json:"UAAOAuthClientSecret"`
+}
+
+// ActiveFoundation returns the name of the foundation used by the current
+// invocation. This is either the foundation selected with the global
+// '--foundation' flag or the persisted active foundation. It returns an empty
+// string if no named foundation is in use.
+func (config *Config) ActiveFoundation() string {
+	return config.selectedFoundation
+}
+
+// AddFoundation saves the current API target and session under the given
+// name, replacing any existing foundation with the same name. If no named
+// foundation is in use yet, the new foundation becomes the active one.
+func (config *Config) AddFoundation(name string) {
+	if config.ConfigFile.Foundations == nil {
+		config.ConfigFile.Foundations = map[string]Foundation{}
+	}
+	config.ConfigFile.Foundations[name] = config.ConfigFile.currentFoundation()
+
+	if config.selectedFoundation == "" {
+		config.ConfigFile.ActiveFoundation = name
+		config.selectedFoundation = name
+	}
+}
+
+// Foundations returns the saved foundations sorted by name.
+func (config *Config) Foundations() []Foundation {
+	foundations := make([]Foundation, 0, len(config.ConfigFile.Foundations))
+	for name, foundation := range config.ConfigFile.Foundations {
+		foundation.Name = name
+		foundations = append(foundations, foundation)
+	}
+
+	sort.Slice(foundations, func(i, j int) bool {
+		return sorting.LessIgnoreCase(foundations[i].Name, foundations[j].Name)
+	})
+	return foundations
+}
+
+// ForFoundation returns an in-memory copy of the config that uses the given
+// foundation's target and session instead of the current ones. The copy is
+// used to talk to several foundations in one invocation and is never written
+// to disk. It ignores the target given in CF_API, CF_ORG and CF_SPACE.
+func (config *Config) ForFoundation(foundation Foundation) *Config {
+	detached := *config
+	detached.ConfigFile.applyFoundation(foundation)
+	if detached.ConfigFile.UAAOAuthClient == "" {
+		detached.ConfigFile.UAAOAuthClient = DefaultUAAOAuthClient
+		detached.ConfigFile.UAAOAuthClientSecret = DefaultUAAOAuthClientSecret
+	}
+	detached.selectedFoundation = foundation.Name
+	detached.persistedSession = Foundation{}
+	detached.envSession = false
+	detached.detached = true
+	detached.ENV.CFAPI = ""
+	detached.ENV.CFOrg = ""
+	detached.ENV.CFSpace = ""
+	detached.UserConfig = DynamicUserConfig{
+		ConfigFile:           &detached.ConfigFile,
+		DefaultUserConfig:    DefaultUserConfig{ConfigFile: &detached.ConfigFile},
+		KubernetesUserConfig: KubernetesUserConfig{ConfigFile: &detached.ConfigFile},
+	}
+	return &detached
+}
+
+// GetFoundation returns the foundation with the given name and true if it
+// exists, otherwise it returns false.
+func (config *Config) GetFoundation(name string) (Foundation, bool) {
+	foundation, found := config.ConfigFile.Foundations[name]
+	foundation.Name = name
+	return foundation, found
+}
+
+// RemoveFoundation deletes the named foundation. Removing the active
+// foundation keeps its target and session as the unnamed default, removing a
+// foundation selected for this invocation only goes back to the active one.
+func (config *Config) RemoveFoundation(name string) {
+	delete(config.ConfigFile.Foundations, name)
+
+	if config.selectedFoundation == name {
+		if name != config.ConfigFile.ActiveFoundation {
+			config.ConfigFile.applyFoundation(config.persistedSession)
+			config.selectedFoundation = config.ConfigFile.ActiveFoundation
+		} else {
+			config.selectedFoundation = ""
+		}
+	}
+	if config.ConfigFile.ActiveFoundation == name {
+		config.ConfigFile.ActiveFoundation = ""
+	}
+}
+
+// SelectFoundation uses the named foundation for the rest of this invocation
+// without changing the persisted active foundation. Changes made to the
+// target and session are written back to the named foundation.
+func (config *Config) SelectFoundation(name string) error {
+	if name == config.selectedFoundation {
+		return nil
+	}
+
+	foundation, found := config.ConfigFile.Foundations[name]
+	if !found {
+		return actionerror.FoundationNotFoundError{Name: name}
+	}
+
+	config.saveSelectedFoundation()
+	config.ConfigFile.applyFoundation(foundation)
+	config.selectedFoundation = name
+	return nil
+}
+
+// SwitchFoundation makes the named foundation the active one. The current
+// target and session are saved to the previously active foundation first.
+// When no foundation is active, the switch is refused while an API is
+// targeted, as its target and session are not saved anywhere else and would
+// be lost.
+func (config *Config) SwitchFoundation(name string) error {
+	foundation, found := config.ConfigFile.Foundations[name]
+	if !found {
+		return actionerror.FoundationNotFoundError{Name: name}
+	}
+
+	if api := config.unsavedTarget(); api != "" {
+		return actionerror.UnsavedFoundationError{
+			API:        api,
+			BinaryName: config.BinaryName(),
+		}
+	}
+
+	config.switchFoundation(name, foundation)
+	return nil
+}
+
+// switchFoundation makes the foundation the active one, saving the current
+// target and session to the previously active foundation first.
+func (config *Config) switchFoundation(name string, foundation Foundation) {
+	config.saveSelectedFoundation()
+	config.ConfigFile.applyFoundation(foundation)
+	config.ConfigFile.ActiveFoundation = name
+	config.selectedFoundation = name
+}
+
+// unsavedTarget returns the API of the unnamed target and session written to
+// the top level of .cf/config.json when no foundation is active. It returns
+// an empty string if a foundation is active or no API is targeted.
+func (config *Config) unsavedTarget() string {
+	if config.ConfigFile.ActiveFoundation != "" {
+		return ""
+	}
+	if config.selectedFoundation != "" || config.envSession {
+		return config.persistedSession.Target
+	}
+	return config.ConfigFile.Target
+}
+
+// saveSelectedFoundation stores the current target and session in the
+// foundation in use, or remembers them as the persisted session when no named
+// foundation is in use.
+func (config *Config) saveSelectedFoundation() {
+	if config.selectedFoundation == "" {
+		config.persistedSession = config.ConfigFile.currentFoundation()
+		return
+	}
+
+	config.ConfigFile.Foundations[config.selectedFoundation] = config.ConfigFile.currentFoundation()
+	if config.selectedFoundation == config.ConfigFile.ActiveFoundation {
+		config.persistedSession = config.ConfigFile.currentFoundation()
+	}
+}
+
+// fileContents returns the JSONConfig that should be persisted. The
+// foundation in use is saved back to its profile, the session of an API
+// targeted with CF_API is saved to the EnvSessions, and the top level fields
+// always reflect the persisted active foundation.
+func (config *Config) fileContents() JSONConfig {
+	file := config.ConfigFile
+	if config.envSession {
+		file.EnvSessions = make(map[string]Foundation, len(config.ConfigFile.EnvSessions)+1)
+		for api, session := range config.ConfigFile.EnvSessions {
+			if !IsSameAPI(api, file.Target) {
+				file.EnvSessions[api] = session
+			}
+		}
+		file.EnvSessions[file.Target] = file.currentFoundation()
+		file.applyFoundation(config.persistedSession)
+		return file
+	}
+
+	if config.selectedFoundation == "" {
+		return file
+	}
+
+	file.Foundations = make(map[string]Foundation, len(config.ConfigFile.Foundations))
+	for name, foundation := range config.ConfigFile.Foundations {
+		file.Foundations[name] = foundation
+	}
+	file.Foundations[config.selectedFoundation] = file.currentFoundation()
+
+	if config.selectedFoundation != file.ActiveFoundation {
+		file.applyFoundation(config.persistedSession)
+	}
+	return file
+}
+
+func (file JSONConfig) currentFoundation() Foundation {
+	return Foundation{
+		AccessToken:              file.AccessToken,
+		APIVersion:               file.APIVersion,
+		AuthorizationEndpoint:    file.AuthorizationEndpoint,
+		CACert:                   file.CACert,
+		CFOnK8s:                  file.CFOnK8s,
+		ClientCert:               file.ClientCert,
+		ClientKeyFile:            file.ClientKeyFile,
+		DopplerEndpoint:          file.DopplerEndpoint,
+		LogCacheEndpoint:         file.LogCacheEndpoint,
+		MinCLIVersion:            file.MinCLIVersion,
+		MinRecommendedCLIVersion: file.MinRecommendedCLIVersion,
+		NetworkPolicyV1Endpoint:  file.NetworkPolicyV1Endpoint,
+		TargetedOrganization:     file.TargetedOrganization,
+		RefreshToken:             file.RefreshToken,
+		RoutingEndpoint:          file.RoutingEndpoint,
+		TargetedSpace:            file.TargetedSpace,
+		SSHOAuthClient:           file.SSHOAuthClient,
+		SkipSSLValidation:        file.SkipSSLValidation,
+		Target:                   file.Target,
+		TargetFailoverURLs:       file.TargetFailoverURLs,
+		UAAEndpoint:              file.UAAEndpoint,
+		UAAGrantType:             file.UAAGrantType,
+		UAAOAuthClient:           file.UAAOAuthClient,
+		UAAOAuthClientSecret:     file.UAAOAuthClientSecret,
+	}
+}
+
+func (file *JSONConfig) applyFoundation(foundation Foundation) {
+	file.AccessToken = foundation.AccessToken
+	file.APIVersion = foundation.APIVersion
+	file.AuthorizationEndpoint = foundation.AuthorizationEndpoint
+	file.CACert = foundation.CACert
+	file.CFOnK8s = foundation.CFOnK8s
+	file.ClientCert = foundation.ClientCert
+	file.ClientKeyFile = foundation.ClientKeyFile
+	file.DopplerEndpoint = foundation.DopplerEndpoint
+	file.LogCacheEndpoint = foundation.LogCacheEndpoint
+	file.MinCLIVersion = foundation.MinCLIVersion
+	file.MinRecommendedCLIVersion = foundation.MinRecommendedCLIVersion
+	file.NetworkPolicyV1Endpoint = foundation.NetworkPolicyV1Endpoint
+	file.TargetedOrganization = foundation.TargetedOrganization
+	file.RefreshToken = foundation.RefreshToken
+	file.RoutingEndpoint = foundation.RoutingEndpoint
+	file.TargetedSpace = foundation.TargetedSpace
+	file.SSHOAuthClient = foundation.SSHOAuthClient
+	file.SkipSSLValidation = foundation.SkipSSLValidation
+	file.Target = foundation.Target
+	file.TargetFailoverURLs = foundation.TargetFailoverURLs
+	file.UAAEndpoint = foundation.UAAEndpoint
+	file.UAAGrantType = foundation.UAAGrantType
+	file.UAAOAuthClient = foundation.UAAOAuthClient
+	file.UAAOAuthClientSecret = foundation.UAAOAuthClientSecret
+}
@@ -0,0 +1,268 @@
+package configv3_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/util/configv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Foundation", func() {
+	var (
+		homeDir string
+		config  *Config
+	)
+
+	BeforeEach(func() {
+		homeDir = setup()
+	})
+
+	AfterEach(func() {
+		teardown(homeDir)
+	})
+
+	readWrittenConfig := func() JSONConfig {
+		rawConfig, err := ioutil.ReadFile(filepath.Join(homeDir, ".cf", "config.json"))
+		Expect(err).ToNot(HaveOccurred())
+
+		var writtenConfig JSONConfig
+		Expect(json.Unmarshal(rawConfig, &writtenConfig)).To(Succeed())
+		return writtenConfig
+	}
+
+	When("no foundations are saved", func() {
+		BeforeEach(func() {
+			setConfig(homeDir, `{
+				"ConfigVersion": 3,
+				"Target": "https://api.dc1.com",
+				"AccessToken": "dc1-token",
+				"OrganizationFields": {"GUID": "dc1-org-guid", "Name": "dc1-org"}
+			}`)
+
+			var err error
+			config, err = LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("does not have an active foundation", func() {
+			Expect(config.ActiveFoundation()).To(BeEmpty())
+			Expect(config.Foundations()).To(BeEmpty())
+		})
+
+		Describe("AddFoundation", func() {
+			It("saves the current session and makes it the active foundation", func() {
+				config.AddFoundation("dc1")
+
+				Expect(config.ActiveFoundation()).To(Equal("dc1"))
+				foundation, found := config.GetFoundation("dc1")
+				Expect(found).To(BeTrue())
+				Expect(foundation.Name).To(Equal("dc1"))
+				Expect(foundation.Target).To(Equal("https://api.dc1.com"))
+				Expect(foundation.AccessToken).To(Equal("dc1-token"))
+				Expect(foundation.TargetedOrganization.Name).To(Equal("dc1-org"))
+			})
+		})
+	})
+
+	When("foundations are saved but none is active", func() {
+		BeforeEach(func() {
+			setConfig(homeDir, `{
+				"ConfigVersion": 3,
+				"Target": "https://api.dc1.com",
+				"AccessToken": "dc1-token",
+				"Foundations": {
+					"DC2": {"Target": "https://api.dc2.com", "AccessToken": "dc2-token"}
+				}
+			}`)
+
+			var err error
+			config, err = LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		Describe("SwitchFoundation", func() {
+			It("refuses to switch without saving the current target", func() {
+				err := config.SwitchFoundation("DC2")
+				Expect(err).To(MatchError(actionerror.UnsavedFoundationError{API: "https://api.dc1.com", BinaryName: config.BinaryName()}))
+				Expect(config.ActiveFoundation()).To(BeEmpty())
+				Expect(config.Target()).To(Equal("https://api.dc1.com"))
+			})
+
+			When("the current target is saved first", func() {
+				It("switches and keeps the saved target", func() {
+					config.AddFoundation("dc1")
+					Expect(config.SwitchFoundation("DC2")).To(Succeed())
+					Expect(config.WriteConfig()).To(Succeed())
+
+					writtenConfig := readWrittenConfig()
+					Expect(writtenConfig.ActiveFoundation).To(Equal("DC2"))
+					Expect(writtenConfig.Foundations["dc1"].AccessToken).To(Equal("dc1-token"))
+				})
+			})
+
+			When("no API is targeted", func() {
+				BeforeEach(func() {
+					setConfig(homeDir, `{
+						"ConfigVersion": 3,
+						"Foundations": {
+							"DC2": {"Target": "https://api.dc2.com", "AccessToken": "dc2-token"}
+						}
+					}`)
+
+					var err error
+					config, err = LoadConfig()
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("switches to the foundation", func() {
+					Expect(config.SwitchFoundation("DC2")).To(Succeed())
+					Expect(config.Target()).To(Equal("https://api.dc2.com"))
+				})
+			})
+		})
+	})
+
+	When("foundations are saved", func() {
+		BeforeEach(func() {
+			setConfig(homeDir, `{
+				"ConfigVersion": 3,
+				"ActiveFoundation": "dc1",
+				"Target": "https://api.dc1.com",
+				"AccessToken": "dc1-token",
+				"Foundations": {
+					"dc1": {"Target": "https://api.dc1.com", "AccessToken": "dc1-token"},
+					"DC2": {"Target": "https://api.dc2.com", "AccessToken": "dc2-token", "SpaceFields": {"GUID": "dc2-space-guid", "Name": "dc2-space"}}
+				}
+			}`)
+		})
+
+		JustBeforeEach(func() {
+			var err error
+			config, err = LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("uses the persisted active foundation", func() {
+			Expect(config.ActiveFoundation()).To(Equal("dc1"))
+			Expect(config.Target()).To(Equal("https://api.dc1.com"))
+		})
+
+		It("lists the foundations sorted by name", func() {
+			foundations := config.Foundations()
+			Expect(foundations).To(HaveLen(2))
+			Expect(foundations[0].Name).To(Equal("dc1"))
+			Expect(foundations[1].Name).To(Equal("DC2"))
+		})
+
+		When("a token changes", func() {
+			It("writes the change to the top level and to the active foundation", func() {
+				config.SetAccessToken("new-dc1-token")
+				Expect(config.WriteConfig()).To(Succeed())
+
+				writtenConfig := readWrittenConfig()
+				Expect(writtenConfig.AccessToken).To(Equal("new-dc1-token"))
+				Expect(writtenConfig.Foundations["dc1"].AccessToken).To(Equal("new-dc1-token"))
+			})
+		})
+
+		Describe("SelectFoundation", func() {
+			It("uses the selected foundation without changing the active foundation", func() {
+				Expect(config.SelectFoundation("DC2")).To(Succeed())
+				Expect(config.ActiveFoundation()).To(Equal("DC2"))
+				Expect(config.Target()).To(Equal("https://api.dc2.com"))
+				Expect(config.TargetedSpace().Name).To(Equal("dc2-space"))
+
+				config.SetAccessToken("new-dc2-token")
+				Expect(config.WriteConfig()).To(Succeed())
+
+				writtenConfig := readWrittenConfig()
+				Expect(writtenConfig.ActiveFoundation).To(Equal("dc1"))
+				Expect(writtenConfig.Target).To(Equal("https://api.dc1.com"))
+				Expect(writtenConfig.AccessToken).To(Equal("dc1-token"))
+				Expect(writtenConfig.Foundations["DC2"].AccessToken).To(Equal("new-dc2-token"))
+			})
+
+			When("the foundation does not exist", func() {
+				It("returns a FoundationNotFoundError", func() {
+					err := config.SelectFoundation("dc3")
+					Expect(err).To(MatchError(actionerror.FoundationNotFoundError{Name: "dc3"}))
+				})
+			})
+
+			When("the foundation is given as a global flag", func() {
+				It("selects the foundation while loading the config", func() {
+					var err error
+					config, err = LoadConfig(FlagOverride{Foundation: "DC2"})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(config.Target()).To(Equal("https://api.dc2.com"))
+				})
+			})
+		})
+
+		Describe("SwitchFoundation", func() {
+			It("saves the current foundation and persists the new active foundation", func() {
+				config.SetAccessToken("new-dc1-token")
+				Expect(config.SwitchFoundation("DC2")).To(Succeed())
+				Expect(config.Target()).To(Equal("https://api.dc2.com"))
+				Expect(config.WriteConfig()).To(Succeed())
+
+				writtenConfig := readWrittenConfig()
+				Expect(writtenConfig.ActiveFoundation).To(Equal("DC2"))
+				Expect(writtenConfig.Target).To(Equal("https://api.dc2.com"))
+				Expect(writtenConfig.AccessToken).To(Equal("dc2-token"))
+				Expect(writtenConfig.Foundations["dc1"].AccessToken).To(Equal("new-dc1-token"))
+			})
+		})
+
+		Describe("ForFoundation", func() {
+			It("returns a copy using the foundation that is never written", func() {
+				dc2, found := config.GetFoundation("DC2")
+				Expect(found).To(BeTrue())
+
+				foundationConfig := config.ForFoundation(dc2)
+				Expect(foundationConfig.Target()).To(Equal("https://api.dc2.com"))
+				Expect(foundationConfig.ActiveFoundation()).To(Equal("DC2"))
+				Expect(foundationConfig.UAAOAuthClient()).To(Equal(DefaultUAAOAuthClient))
+				Expect(config.Target()).To(Equal("https://api.dc1.com"))
+
+				foundationConfig.SetAccessToken("new-dc2-token")
+				Expect(foundationConfig.WriteConfig()).To(Succeed())
+				Expect(config.WriteConfig()).To(Succeed())
+
+				writtenConfig := readWrittenConfig()
+				Expect(writtenConfig.Target).To(Equal("https://api.dc1.com"))
+				Expect(writtenConfig.Foundations["DC2"].AccessToken).To(Equal("dc2-token"))
+			})
+		})
+
+		Describe("RemoveFoundation", func() {
+			When("removing the active foundation", func() {
+				It("keeps the session as the unnamed default", func() {
+					config.RemoveFoundation("dc1")
+					Expect(config.ActiveFoundation()).To(BeEmpty())
+					Expect(config.Target()).To(Equal("https://api.dc1.com"))
+					Expect(config.WriteConfig()).To(Succeed())
+
+					writtenConfig := readWrittenConfig()
+					Expect(writtenConfig.ActiveFoundation).To(BeEmpty())
+					Expect(writtenConfig.Foundations).To(HaveLen(1))
+					Expect(writtenConfig.Target).To(Equal("https://api.dc1.com"))
+				})
+			})
+
+			When("removing the selected foundation", func() {
+				It("restores the active foundation", func() {
+					Expect(config.SelectFoundation("DC2")).To(Succeed())
+					config.RemoveFoundation("DC2")
+					Expect(config.ActiveFoundation()).To(Equal("dc1"))
+					Expect(config.Target()).To(Equal("https://api.dc1.com"))
+				})
+			})
+		})
+	})
+})
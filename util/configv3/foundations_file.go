@@ -0,0 +1,77 @@
+package configv3
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"gopkg.in/yaml.v2"
+)
+
+// FoundationsFileEntry is one foundation listed in a foundations file. The
+// file never holds credentials, only the names of the environment variables
+// that do. VarsFiles are manifest variable files used only when pushing to
+// the foundation.
+type FoundationsFileEntry struct {
+	Name              string   `yaml:"name"`
+	API               string   `yaml:"api"`
+	SkipSSLValidation bool     `yaml:"skip_ssl_validation"`
+	CACert            string   `yaml:"ca_cert"`
+	ClientCert        string   `yaml:"client_cert"`
+	ClientKey         string   `yaml:"client_key"`
+	Org               string   `yaml:"org"`
+	Space             string   `yaml:"space"`
+	Origin            string   `yaml:"origin"`
+	UsernameEnv       string   `yaml:"username_env"`
+	PasswordEnv       string   `yaml:"password_env"`
+	ClientIDEnv       string   `yaml:"client_id_env"`
+	ClientSecretEnv   string   `yaml:"client_secret_env"`
+	VarsFiles         []string `yaml:"vars_files"`
+}
+
+type foundationsFile struct {
+	Foundations []FoundationsFileEntry `yaml:"foundations"`
+}
+
+// ReadFoundationsFile reads and validates the foundations file at the given
+// path. Every foundation needs a unique name, an API endpoint and either
+// user or client credentials.
+func ReadFoundationsFile(path string) ([]FoundationsFileEntry, error) {
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var file foundationsFile
+	err = yaml.UnmarshalStrict(raw, &file)
+	if err != nil {
+		return nil, actionerror.InvalidFoundationsFileError{Path: path, Message: err.Error()}
+	}
+
+	if len(file.Foundations) == 0 {
+		return nil, actionerror.InvalidFoundationsFileError{Path: path, Message: "no foundations listed"}
+	}
+
+	names := map[string]bool{}
+	for i, entry := range file.Foundations {
+		var message string
+		switch {
+		case entry.Name == "":
+			message = fmt.Sprintf("foundation %d has no name", i+1)
+		case names[entry.Name]:
+			message = fmt.Sprintf("foundation '%s' is listed more than once", entry.Name)
+		case entry.API == "":
+			message = fmt.Sprintf("foundation '%s' has no api", entry.Name)
+		case entry.ClientIDEnv == "" && (entry.UsernameEnv == "" || entry.PasswordEnv == ""):
+			message = fmt.Sprintf("foundation '%s' needs username_env and password_env, or client_id_env and client_secret_env", entry.Name)
+		case entry.ClientIDEnv != "" && entry.ClientSecretEnv == "":
+			message = fmt.Sprintf("foundation '%s' needs client_secret_env with client_id_env", entry.Name)
+		}
+		if message != "" {
+			return nil, actionerror.InvalidFoundationsFileError{Path: path, Message: message}
+		}
+		names[entry.Name] = true
+	}
+
+	return file.Foundations, nil
+}
@@ -0,0 +1,138 @@
+package configv3_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/util/configv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ReadFoundationsFile", func() {
+	var (
+		tempDir string
+		path    string
+
+		entries []FoundationsFileEntry
+		err     error
+	)
+
+	BeforeEach(func() {
+		var tempErr error
+		tempDir, tempErr = ioutil.TempDir("", "foundations-file")
+		Expect(tempErr).ToNot(HaveOccurred())
+		path = filepath.Join(tempDir, "foundations.yml")
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tempDir)).To(Succeed())
+	})
+
+	writeFoundationsFile := func(contents string) {
+		Expect(ioutil.WriteFile(path, []byte(contents), 0600)).To(Succeed())
+	}
+
+	JustBeforeEach(func() {
+		entries, err = ReadFoundationsFile(path)
+	})
+
+	When("the file is valid", func() {
+		BeforeEach(func() {
+			writeFoundationsFile(`---
+foundations:
+- name: dc1
+  api: https://api.dc1.com
+  org: some-org
+  space: some-space
+  username_env: DC1_USERNAME
+  password_env: DC1_PASSWORD
+  vars_files:
+  - vars-dc1.yml
+- name: dc2
+  api: https://api.dc2.com
+  skip_ssl_validation: true
+  client_id_env: DC2_CLIENT_ID
+  client_secret_env: DC2_CLIENT_SECRET
+`)
+		})
+
+		It("returns the foundations in order", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(entries).To(Equal([]FoundationsFileEntry{
+				{
+					Name:        "dc1",
+					API:         "https://api.dc1.com",
+					Org:         "some-org",
+					Space:       "some-space",
+					UsernameEnv: "DC1_USERNAME",
+					PasswordEnv: "DC1_PASSWORD",
+					VarsFiles:   []string{"vars-dc1.yml"},
+				},
+				{
+					Name:              "dc2",
+					API:               "https://api.dc2.com",
+					SkipSSLValidation: true,
+					ClientIDEnv:       "DC2_CLIENT_ID",
+					ClientSecretEnv:   "DC2_CLIENT_SECRET",
+				},
+			}))
+		})
+	})
+
+	When("the file does not exist", func() {
+		It("returns the error", func() {
+			Expect(os.IsNotExist(err)).To(BeTrue())
+		})
+	})
+
+	When("the file has unknown keys", func() {
+		BeforeEach(func() {
+			writeFoundationsFile("foundations:\n- name: dc1\n  password: hunter2\n")
+		})
+
+		It("returns an InvalidFoundationsFileError", func() {
+			Expect(err).To(BeAssignableToTypeOf(actionerror.InvalidFoundationsFileError{}))
+		})
+	})
+
+	When("no foundations are listed", func() {
+		BeforeEach(func() {
+			writeFoundationsFile("foundations: []\n")
+		})
+
+		It("returns an InvalidFoundationsFileError", func() {
+			Expect(err).To(MatchError(actionerror.InvalidFoundationsFileError{Path: path, Message: "no foundations listed"}))
+		})
+	})
+
+	When("a foundation is listed twice", func() {
+		BeforeEach(func() {
+			writeFoundationsFile(`---
+foundations:
+- {name: dc1, api: https://api.dc1.com, username_env: U, password_env: P}
+- {name: dc1, api: https://api.dc2.com, username_env: U, password_env: P}
+`)
+		})
+
+		It("returns an InvalidFoundationsFileError", func() {
+			Expect(err).To(MatchError(actionerror.InvalidFoundationsFileError{Path: path, Message: "foundation 'dc1' is listed more than once"}))
+		})
+	})
+
+	When("a foundation has no credentials", func() {
+		BeforeEach(func() {
+			writeFoundationsFile("foundations:\n- {name: dc1, api: https://api.dc1.com}\n")
+		})
+
+		It("returns an InvalidFoundationsFileError", func() {
+			Expect(err).To(MatchError(actionerror.InvalidFoundationsFileError{
+				Path:    path,
+				Message: "foundation 'dc1' needs username_env and password_env, or client_id_env and client_secret_env",
+			}))
+		})
+	})
+})
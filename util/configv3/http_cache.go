@@ -0,0 +1,47 @@
+package configv3
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"path/filepath"
+	"time"
+)
+
+// HTTPCacheDirectory returns the directory Cloud Controller GET responses are
+// cached in. Every API target and user gets a directory of its own.
+func (config *Config) HTTPCacheDirectory() string {
+	user, _ := config.CurrentUserName()
+	sum := sha256.Sum256([]byte(config.Target() + "\n" + user))
+	return filepath.Join(configDirectory(), "cache", hex.EncodeToString(sum[:8]))
+}
+
+// HTTPCacheTTL returns how long a cached Cloud Controller GET response is
+// used before it is revalidated. The cache is off when it is 0. The value is
+// based off of:
+//  1. The $CF_HTTP_CACHE_TTL environment variable if set to a duration
+//  2. The config file's HTTPCacheTTL value
+//  3. Defaults to 0
+func (config *Config) HTTPCacheTTL() time.Duration {
+	if config.ENV.CFHTTPCacheTTL != "" {
+		ttl, err := time.ParseDuration(config.ENV.CFHTTPCacheTTL)
+		if err == nil && ttl >= 0 {
+			return ttl
+		}
+	}
+
+	ttl, err := time.ParseDuration(config.ConfigFile.HTTPCacheTTL)
+	if err != nil || ttl < 0 {
+		return 0
+	}
+	return ttl
+}
+
+// SetHTTPCacheTTL sets how long cached responses are used before they are
+// revalidated. A TTL of 0 turns the cache off.
+func (config *Config) SetHTTPCacheTTL(ttl time.Duration) {
+	if ttl <= 0 {
+		config.ConfigFile.HTTPCacheTTL = ""
+		return
+	}
+	config.ConfigFile.HTTPCacheTTL = ttl.String()
+}
@@ -0,0 +1,81 @@
+package configv3_test
+
+import (
+	"time"
+
+	. "code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/configv3/configv3fakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("HTTP cache config", func() {
+	var config *Config
+
+	BeforeEach(func() {
+		config = &Config{}
+	})
+
+	Describe("HTTPCacheDirectory", func() {
+		var fakeUserConfig *configv3fakes.FakeUserConfig
+
+		BeforeEach(func() {
+			fakeUserConfig = new(configv3fakes.FakeUserConfig)
+			fakeUserConfig.CurrentUserNameReturns("some-user", nil)
+			config.UserConfig = fakeUserConfig
+			config.ConfigFile.Target = "https://api.example.com"
+		})
+
+		It("is different for every target and user", func() {
+			directory := config.HTTPCacheDirectory()
+			Expect(directory).To(ContainSubstring("cache"))
+
+			fakeUserConfig.CurrentUserNameReturns("other-user", nil)
+			Expect(config.HTTPCacheDirectory()).NotTo(Equal(directory))
+
+			fakeUserConfig.CurrentUserNameReturns("some-user", nil)
+			Expect(config.HTTPCacheDirectory()).To(Equal(directory))
+
+			config.ConfigFile.Target = "https://api.other.example.com"
+			Expect(config.HTTPCacheDirectory()).NotTo(Equal(directory))
+		})
+	})
+
+	Describe("HTTPCacheTTL", func() {
+		It("is off by default", func() {
+			Expect(config.HTTPCacheTTL()).To(BeZero())
+		})
+
+		When("the TTL is set in config", func() {
+			BeforeEach(func() {
+				config.SetHTTPCacheTTL(10 * time.Minute)
+			})
+
+			It("returns it", func() {
+				Expect(config.ConfigFile.HTTPCacheTTL).To(Equal("10m0s"))
+				Expect(config.HTTPCacheTTL()).To(Equal(10 * time.Minute))
+			})
+
+			When("CF_HTTP_CACHE_TTL is set", func() {
+				It("prefers the environment variable", func() {
+					config.ENV.CFHTTPCacheTTL = "0"
+					Expect(config.HTTPCacheTTL()).To(BeZero())
+				})
+
+				It("ignores values that are not durations", func() {
+					config.ENV.CFHTTPCacheTTL = "soon"
+					Expect(config.HTTPCacheTTL()).To(Equal(10 * time.Minute))
+				})
+			})
+
+			When("the TTL is set to 0", func() {
+				It("turns the cache off", func() {
+					config.SetHTTPCacheTTL(0)
+					Expect(config.ConfigFile.HTTPCacheTTL).To(BeEmpty())
+					Expect(config.HTTPCacheTTL()).To(BeZero())
+				})
+			})
+		})
+	})
+})
@@ -1,37 +1,54 @@
 package configv3
 
 import (
+	"io/ioutil"
+	"strconv"
 	"time"
+
+	"code.cloudfoundry.org/cli/util"
 )
 
 // JSONConfig represents .cf/config.json.
 type JSONConfig struct {
-	AccessToken              string             `json:"AccessToken"`
-	APIVersion               string             `json:"APIVersion"`
-	AsyncTimeout             int                `json:"AsyncTimeout"`
-	AuthorizationEndpoint    string             `json:"AuthorizationEndpoint"`
-	CFOnK8s                  CFOnK8s            `json:"CFOnK8s"`
-	ColorEnabled             string             `json:"ColorEnabled"`
-	ConfigVersion            int                `json:"ConfigVersion"`
-	DopplerEndpoint          string             `json:"DopplerEndPoint"`
-	Locale                   string             `json:"Locale"`
-	LogCacheEndpoint         string             `json:"LogCacheEndPoint"`
-	MinCLIVersion            string             `json:"MinCLIVersion"`
-	MinRecommendedCLIVersion string             `json:"MinRecommendedCLIVersion"`
-	NetworkPolicyV1Endpoint  string             `json:"NetworkPolicyV1Endpoint"`
-	TargetedOrganization     Organization       `json:"OrganizationFields"`
-	PluginRepositories       []PluginRepository `json:"PluginRepos"`
-	RefreshToken             string             `json:"RefreshToken"`
-	RoutingEndpoint          string             `json:"RoutingAPIEndpoint"`
-	TargetedSpace            Space              `json:"SpaceFields"`
-	SSHOAuthClient           string             `json:"SSHOAuthClient"`
-	SkipSSLValidation        bool               `json:"SSLDisabled"`
-	Target                   string             `json:"Target"`
-	Trace                    string             `json:"Trace"`
-	UAAEndpoint              string             `json:"UaaEndpoint"`
-	UAAGrantType             string             `json:"UAAGrantType"`
-	UAAOAuthClient           string             `json:"UAAOAuthClient"`
-	UAAOAuthClientSecret     string             `json:"UAAOAuthClientSecret"`
+	AccessToken              string                `json:"AccessToken"`
+	ActiveFoundation         string                `json:"ActiveFoundation,omitempty"`
+	APIVersion               string                `json:"APIVersion"`
+	AsyncTimeout             int                   `json:"AsyncTimeout"`
+	AuthorizationEndpoint    string                `json:"AuthorizationEndpoint"`
+	CACert                   string                `json:"CACert,omitempty"`
+	CFOnK8s                  CFOnK8s               `json:"CFOnK8s"`
+	ClientCert               string                `json:"ClientCert,omitempty"`
+	ClientKeyFile            string                `json:"ClientKeyFile,omitempty"`
+	ColorEnabled             string                `json:"ColorEnabled"`
+	ConfigVersion            int                   `json:"ConfigVersion"`
+	DopplerEndpoint          string                `json:"DopplerEndPoint"`
+	EncryptedTokenStore      bool                  `json:"EncryptedTokenStore,omitempty"`
+	EnvSessions              map[string]Foundation `json:"EnvSessions,omitempty"`
+	Foundations              map[string]Foundation `json:"Foundations,omitempty"`
+	HTTPCacheTTL             string                `json:"HTTPCacheTTL,omitempty"`
+	Locale                   string                `json:"Locale"`
+	LogCacheEndpoint         string                `json:"LogCacheEndPoint"`
+	MinCLIVersion            string                `json:"MinCLIVersion"`
+	MinRecommendedCLIVersion string                `json:"MinRecommendedCLIVersion"`
+	NetworkPolicyV1Endpoint  string                `json:"NetworkPolicyV1Endpoint"`
+	PageConcurrency          int                   `json:"PageConcurrency,omitempty"`
+	TargetedOrganization     Organization          `json:"OrganizationFields"`
+	PluginRepositories       []PluginRepository    `json:"PluginRepos"`
+	RefreshToken             string                `json:"RefreshToken"`
+	RoutingEndpoint          string                `json:"RoutingAPIEndpoint"`
+	TargetedSpace            Space                 `json:"SpaceFields"`
+	SSHOAuthClient           string                `json:"SSHOAuthClient"`
+	SkipSSLValidation        bool                  `json:"SSLDisabled"`
+	Target                   string                `json:"Target"`
+	TargetFailoverURLs       []string              `json:"TargetFailoverURLs,omitempty"`
+	TargetHistory            []TargetHistoryEntry  `json:"TargetHistory,omitempty"`
+	TargetSessions           map[string]Foundation `json:"TargetSessions,omitempty"`
+	Trace                    string                `json:"Trace"`
+	TraceHAR                 string                `json:"TraceHAR,omitempty"`
+	UAAEndpoint              string                `json:"UaaEndpoint"`
+	UAAGrantType             string                `json:"UAAGrantType"`
+	UAAOAuthClient           string                `json:"UAAOAuthClient"`
+	UAAOAuthClientSecret     string                `json:"UAAOAuthClientSecret"`
 }
 
 // Organization contains basic information about the targeted organization.
@@ -72,11 +89,14 @@ func (config *Config) AuthorizationEndpoint() string {
 
 // HasTargetedOrganization returns true if the organization is set.
 func (config *Config) HasTargetedOrganization() bool {
-	return config.ConfigFile.TargetedOrganization.GUID != ""
+	return config.organizationFromEnv() || config.ConfigFile.TargetedOrganization.GUID != ""
 }
 
 // HasTargetedSpace returns true if the space is set.
 func (config *Config) HasTargetedSpace() bool {
+	if config.spaceFromEnv() {
+		return config.ENV.CFSpace != ""
+	}
 	return config.ConfigFile.TargetedSpace.GUID != ""
 }
 
@@ -106,6 +126,25 @@ func (config *Config) NetworkPolicyV1Endpoint() string {
 	return config.ConfigFile.NetworkPolicyV1Endpoint
 }
 
+// PageConcurrency returns the number of pages of a Cloud Controller list that
+// are fetched at the same time. The value is based off of:
+//  1. The $CF_PAGE_CONCURRENCY environment variable if set to an integer > 0
+//  2. The config file's PageConcurrency value if > 0
+//  3. Defaults to the DefaultPageConcurrency
+func (config *Config) PageConcurrency() int {
+	if config.ENV.CFPageConcurrency != "" {
+		envVal, err := strconv.Atoi(config.ENV.CFPageConcurrency)
+		if err == nil && envVal > 0 {
+			return envVal
+		}
+	}
+
+	if config.ConfigFile.PageConcurrency > 0 {
+		return config.ConfigFile.PageConcurrency
+	}
+	return DefaultPageConcurrency
+}
+
 // RefreshToken returns the refresh token for getting a new access token.
 func (config *Config) RefreshToken() string {
 	return config.ConfigFile.RefreshToken
@@ -121,6 +160,11 @@ func (config *Config) SetAsyncTimeout(timeout int) {
 	config.ConfigFile.AsyncTimeout = timeout
 }
 
+// SetPageConcurrency sets the number of pages fetched at the same time.
+func (config *Config) SetPageConcurrency(concurrency int) {
+	config.ConfigFile.PageConcurrency = concurrency
+}
+
 // SetAccessToken sets the current access token.
 func (config *Config) SetAccessToken(accessToken string) {
 	config.ConfigFile.AccessToken = accessToken
@@ -170,6 +214,7 @@ type TargetInformationArgs struct {
 	ApiVersion        string
 	Auth              string
 	Doppler           string
+	FailoverURLs      []string
 	LogCache          string
 	MinCLIVersion     string
 	NetworkPolicyV1   string
@@ -177,18 +222,23 @@ type TargetInformationArgs struct {
 	SkipSSLValidation bool
 	UAA               string
 	CFOnK8s           bool
+	TLSCertificates   util.TLSCertificates
 }
 
 // SetTargetInformation sets the currently targeted CC API and related other
 // related API URLs.
 func (config *Config) SetTargetInformation(args TargetInformationArgs) {
 	config.ConfigFile.Target = args.Api
+	config.ConfigFile.TargetFailoverURLs = args.FailoverURLs
 	config.ConfigFile.APIVersion = args.ApiVersion
 	config.SetMinCLIVersion(args.MinCLIVersion)
 	config.ConfigFile.DopplerEndpoint = args.Doppler
 	config.ConfigFile.LogCacheEndpoint = args.LogCache
 	config.ConfigFile.RoutingEndpoint = args.Routing
 	config.ConfigFile.SkipSSLValidation = args.SkipSSLValidation
+	config.ConfigFile.CACert = args.TLSCertificates.CACert
+	config.ConfigFile.ClientCert = args.TLSCertificates.ClientCert
+	config.ConfigFile.ClientKeyFile = args.TLSCertificates.ClientKeyFile
 	config.ConfigFile.NetworkPolicyV1Endpoint = args.NetworkPolicyV1
 
 	config.ConfigFile.UAAEndpoint = args.UAA
@@ -239,6 +289,25 @@ func (config *Config) SkipSSLValidation() bool {
 	return config.ConfigFile.SkipSSLValidation
 }
 
+// TLSCertificates returns the trusted CA and client certificates to use when
+// connecting to the targeted API endpoint. The client key is read from the
+// file it was given in; an error is returned if it cannot be read.
+func (config *Config) TLSCertificates() (util.TLSCertificates, error) {
+	certs := util.TLSCertificates{
+		CACert:        config.ConfigFile.CACert,
+		ClientCert:    config.ConfigFile.ClientCert,
+		ClientKeyFile: config.ConfigFile.ClientKeyFile,
+	}
+	if certs.ClientKeyFile != "" {
+		clientKey, err := ioutil.ReadFile(certs.ClientKeyFile)
+		if err != nil {
+			return util.TLSCertificates{}, fmt.Errorf("client key file %s could not be read: %s", certs.ClientKeyFile, err)
+		}
+		certs.ClientKey = string(clientKey)
+	}
+	return certs, nil
+}
+
 // SSHOAuthClient returns the OAuth client id used for SSHing into
 // application/process containers.
 func (config *Config) SSHOAuthClient() string {
@@ -250,8 +319,19 @@ func (config *Config) Target() string {
 	return config.ConfigFile.Target
 }
 
-// TargetedOrganization returns the currently targeted organization.
+// TargetFailoverURLs returns the URLs of the same CC API as Target that
+// requests fail over to, in order, when it is unavailable.
+func (config *Config) TargetFailoverURLs() []string {
+	return config.ConfigFile.TargetFailoverURLs
+}
+
+// TargetedOrganization returns the currently targeted organization. An org
+// named in CF_ORG takes precedence over the one in the config file; its GUID
+// is empty until it has been looked up.
 func (config *Config) TargetedOrganization() Organization {
+	if config.organizationFromEnv() {
+		return Organization{GUID: config.envOrganizationGUID, Name: config.ENV.CFOrg}
+	}
 	return config.ConfigFile.TargetedOrganization
 }
 
@@ -260,8 +340,17 @@ func (config *Config) TargetedOrganizationName() string {
 	return config.TargetedOrganization().Name
 }
 
-// TargetedSpace returns the currently targeted space.
+// TargetedSpace returns the currently targeted space. A space named in
+// CF_SPACE takes precedence over the one in the config file; its GUID is
+// empty until it has been looked up. No space is targeted if CF_ORG targets
+// another org and CF_SPACE is not set.
 func (config *Config) TargetedSpace() Space {
+	if config.spaceFromEnv() {
+		if config.ENV.CFSpace == "" {
+			return Space{}
+		}
+		return Space{GUID: config.envSpaceGUID, Name: config.ENV.CFSpace}
+	}
 	return config.ConfigFile.TargetedSpace
 }
 
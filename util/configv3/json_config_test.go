@@ -1,9 +1,14 @@
 package configv3_test
 
 import (
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"time"
 
+	"code.cloudfoundry.org/cli/util"
 	"code.cloudfoundry.org/cli/util/configv3"
 	. "code.cloudfoundry.org/cli/util/configv3"
 
@@ -115,6 +120,46 @@ var _ = Describe("JSONConfig", func() {
 		})
 	})
 
+	Describe("PageConcurrency", func() {
+		BeforeEach(func() {
+			config = new(Config)
+		})
+
+		It("defaults to DefaultPageConcurrency", func() {
+			Expect(config.PageConcurrency()).To(Equal(DefaultPageConcurrency))
+		})
+
+		When("PageConcurrency is set in config", func() {
+			BeforeEach(func() {
+				config.ConfigFile.PageConcurrency = 8
+			})
+
+			It("returns the value from config", func() {
+				Expect(config.PageConcurrency()).To(Equal(8))
+			})
+
+			When("CF_PAGE_CONCURRENCY is set", func() {
+				BeforeEach(func() {
+					config.ENV.CFPageConcurrency = "2"
+				})
+
+				It("prefers the environment variable", func() {
+					Expect(config.PageConcurrency()).To(Equal(2))
+				})
+			})
+
+			When("CF_PAGE_CONCURRENCY is not a positive integer", func() {
+				BeforeEach(func() {
+					config.ENV.CFPageConcurrency = "0"
+				})
+
+				It("ignores the environment variable", func() {
+					Expect(config.PageConcurrency()).To(Equal(8))
+				})
+			})
+		})
+	})
+
 	Describe("RefreshToken", func() {
 		BeforeEach(func() {
 			rawConfig := fmt.Sprintf(`{ "RefreshToken":"some-token", "ConfigVersion": %d }`, CurrentConfigVersion)
@@ -131,6 +176,14 @@ var _ = Describe("JSONConfig", func() {
 		})
 	})
 
+	Describe("SetPageConcurrency", func() {
+		It("sets the page concurrency", func() {
+			config = new(Config)
+			config.SetPageConcurrency(6)
+			Expect(config.ConfigFile.PageConcurrency).To(Equal(6))
+		})
+	})
+
 	Describe("SetAsyncTimeout", func() {
 		It("sets the async timeout", func() {
 			config = new(Config)
@@ -199,6 +252,19 @@ var _ = Describe("JSONConfig", func() {
 	})
 
 	Describe("SetTargetInformation", func() {
+		var clientKeyFile string
+
+		BeforeEach(func() {
+			keyDir, err := ioutil.TempDir("", "client-key")
+			Expect(err).ToNot(HaveOccurred())
+			clientKeyFile = filepath.Join(keyDir, "client-key.pem")
+			Expect(ioutil.WriteFile(clientKeyFile, []byte("some-client-key"), 0600)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(filepath.Dir(clientKeyFile))).To(Succeed())
+		})
+
 		It("sets the api target and other related endpoints", func() {
 			config = &Config{
 				ConfigFile: JSONConfig{
@@ -223,6 +289,12 @@ var _ = Describe("JSONConfig", func() {
 				Routing:           "https://api.foo.com/routing",
 				SkipSSLValidation: true,
 				CFOnK8s:           true,
+				TLSCertificates: util.TLSCertificates{
+					CACert:        "some-ca-cert",
+					ClientCert:    "some-client-cert",
+					ClientKey:     "some-client-key",
+					ClientKeyFile: clientKeyFile,
+				},
 			})
 
 			Expect(config.ConfigFile.Target).To(Equal("https://api.foo.com"))
@@ -233,6 +305,27 @@ var _ = Describe("JSONConfig", func() {
 			Expect(config.ConfigFile.LogCacheEndpoint).To(Equal("https://log-cache.foo.com"))
 			Expect(config.ConfigFile.RoutingEndpoint).To(Equal("https://api.foo.com/routing"))
 			Expect(config.ConfigFile.SkipSSLValidation).To(BeTrue())
+			certs, err := config.TLSCertificates()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(certs).To(Equal(util.TLSCertificates{
+				CACert:        "some-ca-cert",
+				ClientCert:    "some-client-cert",
+				ClientKey:     "some-client-key",
+				ClientKeyFile: clientKeyFile,
+			}))
+
+			By("saving only the path of the client key", func() {
+				rawConfig, err := json.Marshal(config.ConfigFile)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(rawConfig)).ToNot(ContainSubstring(`"some-client-key"`))
+				Expect(config.ConfigFile.ClientKeyFile).To(Equal(clientKeyFile))
+			})
+
+			By("returning an error once the client key file is gone", func() {
+				Expect(os.Remove(clientKeyFile)).To(Succeed())
+				_, err := config.TLSCertificates()
+				Expect(err).To(MatchError(HavePrefix(fmt.Sprintf("client key file %s could not be read", clientKeyFile))))
+			})
 
 			Expect(config.ConfigFile.TargetedOrganization.GUID).To(BeEmpty())
 			Expect(config.ConfigFile.TargetedOrganization.Name).To(BeEmpty())
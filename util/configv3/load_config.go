@@ -106,6 +106,10 @@ func LoadConfig(flags ...FlagOverride) (*Config, error) {
 		}
 	}
 
+	if _, found := config.ConfigFile.Foundations[config.ConfigFile.ActiveFoundation]; found {
+		config.selectedFoundation = config.ConfigFile.ActiveFoundation
+	}
+
 	if config.ConfigFile.SSHOAuthClient == "" {
 		config.ConfigFile.SSHOAuthClient = DefaultSSHOAuthClient
 	}
@@ -116,22 +120,32 @@ func LoadConfig(flags ...FlagOverride) (*Config, error) {
 	}
 
 	config.ENV = EnvOverride{
-		BinaryName:       filepath.Base(os.Args[0]),
-		CFColor:          os.Getenv("CF_COLOR"),
-		CFDialTimeout:    os.Getenv("CF_DIAL_TIMEOUT"),
-		CFLogLevel:       os.Getenv("CF_LOG_LEVEL"),
-		CFPassword:       os.Getenv("CF_PASSWORD"),
-		CFPluginHome:     os.Getenv("CF_PLUGIN_HOME"),
-		CFStagingTimeout: os.Getenv("CF_STAGING_TIMEOUT"),
-		CFStartupTimeout: os.Getenv("CF_STARTUP_TIMEOUT"),
-		CFTrace:          os.Getenv("CF_TRACE"),
-		CFUsername:       os.Getenv("CF_USERNAME"),
-		DockerPassword:   os.Getenv("CF_DOCKER_PASSWORD"),
-		Experimental:     os.Getenv("CF_CLI_EXPERIMENTAL"),
-		ForceTTY:         os.Getenv("FORCE_TTY"),
-		HTTPSProxy:       os.Getenv("https_proxy"),
-		Lang:             os.Getenv("LANG"),
-		LCAll:            os.Getenv("LC_ALL"),
+		BinaryName:             filepath.Base(os.Args[0]),
+		CFAPI:                  os.Getenv(APIEnvVar),
+		CFColor:                os.Getenv("CF_COLOR"),
+		CFDialTimeout:          os.Getenv("CF_DIAL_TIMEOUT"),
+		CFHTTPCacheTTL:         os.Getenv("CF_HTTP_CACHE_TTL"),
+		CFLogLevel:             os.Getenv("CF_LOG_LEVEL"),
+		CFOrg:                  os.Getenv(OrganizationEnvVar),
+		CFPageConcurrency:      os.Getenv("CF_PAGE_CONCURRENCY"),
+		CFPassword:             os.Getenv("CF_PASSWORD"),
+		CFPluginHome:           os.Getenv("CF_PLUGIN_HOME"),
+		CFRecordCassette:       os.Getenv("CF_RECORD_CASSETTE"),
+		CFReplayCassette:       os.Getenv("CF_REPLAY_CASSETTE"),
+		CFSpace:                os.Getenv(SpaceEnvVar),
+		CFStagingTimeout:       os.Getenv("CF_STAGING_TIMEOUT"),
+		CFStartupTimeout:       os.Getenv("CF_STARTUP_TIMEOUT"),
+		CFTokenStoreKeyFile:    os.Getenv(TokenStoreKeyFileEnvVar),
+		CFTokenStorePassphrase: os.Getenv(TokenStorePassphraseEnvVar),
+		CFTrace:                os.Getenv("CF_TRACE"),
+		CFTraceHAR:             os.Getenv("CF_TRACE_HAR"),
+		CFUsername:             os.Getenv("CF_USERNAME"),
+		DockerPassword:         os.Getenv("CF_DOCKER_PASSWORD"),
+		Experimental:           os.Getenv("CF_CLI_EXPERIMENTAL"),
+		ForceTTY:               os.Getenv("FORCE_TTY"),
+		HTTPSProxy:             os.Getenv("https_proxy"),
+		Lang:                   os.Getenv("LANG"),
+		LCAll:                  os.Getenv("LC_ALL"),
 	}
 
 	err = config.loadPluginConfig()
@@ -139,10 +153,23 @@ func LoadConfig(flags ...FlagOverride) (*Config, error) {
 		return nil, err
 	}
 
+	if config.ConfigFile.EncryptedTokenStore {
+		config.tokenStoreErr = config.unlockTokenStore()
+	}
+
 	if len(flags) > 0 {
 		config.Flags = flags[0]
 	}
 
+	if config.Flags.Foundation != "" {
+		err = config.SelectFoundation(config.Flags.Foundation)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		config.targetEnvAPI()
+	}
+
 	pwd, err := os.Getwd()
 	if err != nil {
 		return nil, err
@@ -172,10 +199,29 @@ func LoadConfig(flags ...FlagOverride) (*Config, error) {
 		KubernetesUserConfig: KubernetesUserConfig{ConfigFile: &config.ConfigFile},
 	}
 
+	config.persistedContents, err = jsonContents(config.persistedFileContents())
+	if err != nil {
+		return nil, err
+	}
+
 	return &config, jsonError
 }
 
+// removeOldTempConfigFiles removes temp files left behind by interrupted
+// writes. Other cf processes only have temp files while they hold the config
+// lock, so nothing is removed if the lock is held or cannot be taken; loading
+// the config never waits for another cf process to finish writing.
 func removeOldTempConfigFiles() error {
+	if _, err := os.Stat(configDirectory()); err != nil {
+		return nil
+	}
+
+	unlock, err := tryLockConfigFile(configLockFilePath())
+	if err != nil {
+		return nil
+	}
+	defer unlock()
+
 	oldTempFileNames, err := filepath.Glob(filepath.Join(configDirectory(), "temp-config?*"))
 	if err != nil {
 		return err
@@ -0,0 +1,31 @@
+package configv3
+
+// TableOptions holds the global flags that reshape the tables printed by list
+// commands.
+type TableOptions struct {
+	// Columns lists the columns to display, in order. All columns are
+	// displayed when it is empty.
+	Columns []string
+	// SortBy is the column rows are sorted by.
+	SortBy string
+	// Filters only keeps rows that match every filter.
+	Filters []TableFilter
+	// NoHeaders omits the header row.
+	NoHeaders bool
+	// Wide adds GUIDs, timestamps and labels to the commands that support
+	// them.
+	Wide bool
+}
+
+// TableFilter keeps the table rows whose Column matches Value. Value may
+// contain '*' wildcards.
+type TableFilter struct {
+	Column string
+	Value  string
+}
+
+// TableOptions returns the table options given with the global '--columns',
+// '--sort-by', '--filter', '--no-headers' and '--wide' flags.
+func (config *Config) TableOptions() TableOptions {
+	return config.Flags.Table
+}
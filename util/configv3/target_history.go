@@ -0,0 +1,142 @@
+package configv3
+
+// maxTargetHistory is the number of targets kept in the target history.
+const maxTargetHistory = 10
+
+// TargetHistoryEntry is an API, org and space combination that was targeted.
+type TargetHistoryEntry struct {
+	API          string       `json:"API"`
+	Organization Organization `json:"OrganizationFields"`
+	Space        Space        `json:"SpaceFields"`
+}
+
+// TargetHistory returns the recently targeted API, org and space
+// combinations, most recent first.
+func (config *Config) TargetHistory() []TargetHistoryEntry {
+	return config.ConfigFile.TargetHistory
+}
+
+// RecordTarget moves the current API, org and space to the front of the
+// target history and remembers the session for the API so that it can be
+// reused when switching back to it. The tokens of the session are only
+// remembered when the encrypted token store is used. Targets set with CF_API,
+// CF_ORG and CF_SPACE are not recorded.
+func (config *Config) RecordTarget() {
+	current := config.currentTargetHistoryEntry()
+	if current.API == "" {
+		return
+	}
+
+	history := []TargetHistoryEntry{current}
+	for _, entry := range config.ConfigFile.TargetHistory {
+		if !entry.isSameTarget(current) && len(history) < maxTargetHistory {
+			history = append(history, entry)
+		}
+	}
+	config.ConfigFile.TargetHistory = history
+
+	if config.ConfigFile.TargetSessions == nil {
+		config.ConfigFile.TargetSessions = map[string]Foundation{}
+	}
+	config.ConfigFile.TargetSessions[current.API] = config.ConfigFile.currentFoundation()
+	for api := range config.ConfigFile.TargetSessions {
+		if !config.hasTargetHistoryForAPI(api) {
+			delete(config.ConfigFile.TargetSessions, api)
+		}
+	}
+	config.removeTargetSessionSecrets()
+}
+
+// PreviousTarget returns the most recent target in the history that is not
+// the current target and true, or false if there is none.
+func (config *Config) PreviousTarget() (TargetHistoryEntry, bool) {
+	current := config.currentTargetHistoryEntry()
+	for _, entry := range config.ConfigFile.TargetHistory {
+		if !entry.isSameTarget(current) {
+			return entry, true
+		}
+	}
+	return TargetHistoryEntry{}, false
+}
+
+// SwitchToTarget targets the API, org and space of a target history entry.
+// When the API changes, the saved foundation for the API or else the session
+// remembered for it is used, so that its tokens are reused. Without the
+// encrypted token store the remembered session has no tokens and the user has
+// to log in again. It returns true if the API changed.
+func (config *Config) SwitchToTarget(entry TargetHistoryEntry) bool {
+	apiChanged := !IsSameAPI(entry.API, config.ConfigFile.Target)
+	if apiChanged {
+		config.switchToAPI(entry.API)
+	}
+
+	config.ConfigFile.TargetedOrganization = entry.Organization
+	config.ConfigFile.TargetedSpace = entry.Space
+	return apiChanged
+}
+
+func (config *Config) switchToAPI(api string) {
+	if config.ConfigFile.TargetSessions == nil {
+		config.ConfigFile.TargetSessions = map[string]Foundation{}
+	}
+	if config.ConfigFile.Target != "" && config.hasTargetHistoryForAPI(config.ConfigFile.Target) {
+		config.ConfigFile.TargetSessions[config.ConfigFile.Target] = config.ConfigFile.currentFoundation()
+		config.removeTargetSessionSecrets()
+	}
+
+	// The session of an unnamed target was saved to the target sessions
+	// above, so it is not lost when switching to a foundation.
+	for _, foundation := range config.Foundations() {
+		if IsSameAPI(api, foundation.Target) {
+			config.switchFoundation(foundation.Name, foundation)
+			return
+		}
+	}
+
+	// The API is not a saved foundation, so it becomes the unnamed default
+	// target.
+	if config.selectedFoundation != "" {
+		config.saveSelectedFoundation()
+		config.selectedFoundation = ""
+		config.ConfigFile.ActiveFoundation = ""
+	}
+	session, found := config.ConfigFile.TargetSessions[api]
+	if !found {
+		session = Foundation{Target: api}
+	}
+	config.ConfigFile.applyFoundation(session)
+}
+
+// removeTargetSessionSecrets removes the tokens and client secrets from the
+// remembered sessions, unless they are kept in the encrypted token store.
+// Sessions for up to maxTargetHistory APIs are remembered, and their secrets
+// must not be left in config.json.
+func (config *Config) removeTargetSessionSecrets() {
+	if config.UsesEncryptedTokenStore() {
+		return
+	}
+	config.ConfigFile.TargetSessions = withoutSessionSecrets(config.ConfigFile.TargetSessions)
+}
+
+func (config *Config) currentTargetHistoryEntry() TargetHistoryEntry {
+	return TargetHistoryEntry{
+		API:          config.ConfigFile.Target,
+		Organization: config.ConfigFile.TargetedOrganization,
+		Space:        config.ConfigFile.TargetedSpace,
+	}
+}
+
+func (config *Config) hasTargetHistoryForAPI(api string) bool {
+	for _, entry := range config.ConfigFile.TargetHistory {
+		if entry.API == api {
+			return true
+		}
+	}
+	return false
+}
+
+func (entry TargetHistoryEntry) isSameTarget(other TargetHistoryEntry) bool {
+	return IsSameAPI(entry.API, other.API) &&
+		entry.Organization.GUID == other.Organization.GUID &&
+		entry.Space.GUID == other.Space.GUID
+}
@@ -0,0 +1,176 @@
+package configv3_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/util/configv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TargetHistory", func() {
+	var (
+		homeDir string
+		config  *Config
+	)
+
+	BeforeEach(func() {
+		homeDir = setup()
+		setConfig(homeDir, `{
+			"ConfigVersion": 3,
+			"Target": "https://api.dc1.com",
+			"AccessToken": "dc1-token",
+			"OrganizationFields": {"GUID": "org-1-guid", "Name": "org-1"},
+			"SpaceFields": {"GUID": "space-1-guid", "Name": "space-1"}
+		}`)
+
+		var err error
+		config, err = LoadConfig()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		teardown(homeDir)
+	})
+
+	targetSpace := func(guid string, name string) {
+		config.V7SetSpaceInformation(guid, name)
+		config.RecordTarget()
+	}
+
+	It("records the targets most recent first without duplicates", func() {
+		config.RecordTarget()
+		targetSpace("space-2-guid", "space-2")
+		targetSpace("space-1-guid", "space-1")
+
+		history := config.TargetHistory()
+		Expect(history).To(HaveLen(2))
+		Expect(history[0].Space.Name).To(Equal("space-1"))
+		Expect(history[1].Space.Name).To(Equal("space-2"))
+		Expect(history[1].API).To(Equal("https://api.dc1.com"))
+		Expect(history[1].Organization.Name).To(Equal("org-1"))
+	})
+
+	It("keeps at most 10 targets", func() {
+		for i := 0; i < 12; i++ {
+			targetSpace(string(rune('a'+i))+"-guid", string(rune('a'+i)))
+		}
+
+		history := config.TargetHistory()
+		Expect(history).To(HaveLen(10))
+		Expect(history[0].Space.Name).To(Equal("l"))
+	})
+
+	Describe("PreviousTarget", func() {
+		It("returns the most recent target that is not the current one", func() {
+			_, found := config.PreviousTarget()
+			Expect(found).To(BeFalse())
+
+			config.RecordTarget()
+			targetSpace("space-2-guid", "space-2")
+
+			previous, found := config.PreviousTarget()
+			Expect(found).To(BeTrue())
+			Expect(previous.Space.Name).To(Equal("space-1"))
+		})
+	})
+
+	Describe("SwitchToTarget", func() {
+		BeforeEach(func() {
+			config.RecordTarget()
+			config.SetTargetInformation(TargetInformationArgs{Api: "https://api.dc2.com", UAA: "https://uaa.dc2.com"})
+			config.SetAccessToken("dc2-token")
+			config.SetOrganizationInformation("org-2-guid", "org-2")
+			config.RecordTarget()
+		})
+
+		It("reuses the session of the API it switches to without its tokens", func() {
+			previous, found := config.PreviousTarget()
+			Expect(found).To(BeTrue())
+
+			Expect(config.SwitchToTarget(previous)).To(BeTrue())
+			Expect(config.Target()).To(Equal("https://api.dc1.com"))
+			Expect(config.AccessToken()).To(BeEmpty())
+			Expect(config.TargetedOrganization().Name).To(Equal("org-1"))
+			Expect(config.TargetedSpace().Name).To(Equal("space-1"))
+
+			previous, found = config.PreviousTarget()
+			Expect(found).To(BeTrue())
+			Expect(config.SwitchToTarget(previous)).To(BeTrue())
+			Expect(config.Target()).To(Equal("https://api.dc2.com"))
+			Expect(config.UAAEndpoint()).To(Equal("https://uaa.dc2.com"))
+			Expect(config.AccessToken()).To(BeEmpty())
+		})
+
+		It("persists the history and sessions without their tokens", func() {
+			Expect(config.WriteConfig()).To(Succeed())
+
+			rawConfig, err := ioutil.ReadFile(filepath.Join(homeDir, ".cf", "config.json"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(rawConfig)).ToNot(ContainSubstring("dc1-token"))
+
+			config, err := LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config.TargetHistory()).To(HaveLen(2))
+			Expect(config.ConfigFile.TargetSessions).To(HaveKey("https://api.dc1.com"))
+			Expect(config.ConfigFile.TargetSessions["https://api.dc1.com"].AccessToken).To(BeEmpty())
+		})
+
+		When("the encrypted token store is used", func() {
+			BeforeEach(func() {
+				Expect(os.Setenv(TokenStorePassphraseEnvVar, "some-passphrase")).To(Succeed())
+				Expect(config.EnableEncryptedTokenStore()).To(Succeed())
+				config.SetAccessToken("dc2-token")
+				config.RecordTarget()
+			})
+
+			AfterEach(func() {
+				Expect(os.Unsetenv(TokenStorePassphraseEnvVar)).To(Succeed())
+			})
+
+			It("reuses the tokens of the API it switches to", func() {
+				previous, found := config.PreviousTarget()
+				Expect(found).To(BeTrue())
+				Expect(config.SwitchToTarget(previous)).To(BeTrue())
+				Expect(config.Target()).To(Equal("https://api.dc1.com"))
+
+				previous, found = config.PreviousTarget()
+				Expect(found).To(BeTrue())
+				Expect(config.SwitchToTarget(previous)).To(BeTrue())
+				Expect(config.Target()).To(Equal("https://api.dc2.com"))
+				Expect(config.AccessToken()).To(Equal("dc2-token"))
+			})
+
+			It("keeps the tokens of the sessions out of config.json", func() {
+				Expect(config.WriteConfig()).To(Succeed())
+
+				rawConfig, err := ioutil.ReadFile(filepath.Join(homeDir, ".cf", "config.json"))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(rawConfig)).ToNot(ContainSubstring("dc2-token"))
+
+				config, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(config.ConfigFile.TargetSessions["https://api.dc2.com"].AccessToken).To(Equal("dc2-token"))
+			})
+		})
+
+		When("a saved foundation has the API", func() {
+			BeforeEach(func() {
+				config.AddFoundation("dc2")
+			})
+
+			It("switches to the foundation", func() {
+				Expect(config.SwitchToTarget(config.TargetHistory()[1])).To(BeTrue())
+				Expect(config.ActiveFoundation()).To(BeEmpty())
+				Expect(config.Target()).To(Equal("https://api.dc1.com"))
+
+				Expect(config.SwitchToTarget(config.TargetHistory()[0])).To(BeTrue())
+				Expect(config.ActiveFoundation()).To(Equal("dc2"))
+				Expect(config.AccessToken()).To(Equal("dc2-token"))
+			})
+		})
+	})
+})
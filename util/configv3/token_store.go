@@ -0,0 +1,355 @@
+package configv3
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	// TokenStorePassphraseEnvVar is the environment variable holding the
+	// passphrase of the encrypted token store.
+	TokenStorePassphraseEnvVar = "CF_TOKEN_STORE_PASSPHRASE"
+
+	// TokenStoreKeyFileEnvVar is the environment variable holding the path of
+	// a key file used instead of a passphrase.
+	TokenStoreKeyFileEnvVar = "CF_TOKEN_STORE_KEY_FILE"
+
+	tokenStoreVersion  = 1
+	tokenStoreSaltSize = 16
+)
+
+// tokenStoreSecrets are the secrets kept in the encrypted token store instead
+// of in config.json.
+type tokenStoreSecrets struct {
+	AccessToken          string                       `json:"AccessToken"`
+	EnvSessions          map[string]foundationSecrets `json:"EnvSessions,omitempty"`
+	Foundations          map[string]foundationSecrets `json:"Foundations,omitempty"`
+	RefreshToken         string                       `json:"RefreshToken"`
+	TargetSessions       map[string]foundationSecrets `json:"TargetSessions,omitempty"`
+	UAAOAuthClientSecret string                       `json:"UAAOAuthClientSecret"`
+}
+
+type foundationSecrets struct {
+	AccessToken          string `json:"AccessToken"`
+	RefreshToken         string `json:"RefreshToken"`
+	UAAOAuthClientSecret string `json:"UAAOAuthClientSecret"`
+}
+
+// tokenStoreFile is the on-disk format of the token store. The secrets are
+// encrypted with AES-GCM using a key derived from the passphrase or key file
+// with scrypt.
+type tokenStoreFile struct {
+	Version    int    `json:"version"`
+	Salt       []byte `json:"salt"`
+	Nonce      []byte `json:"nonce"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+type tokenStore struct {
+	path       string
+	passphrase []byte
+
+	// salt and key cache the last derived key, deriving it is slow on
+	// purpose.
+	salt []byte
+	key  []byte
+}
+
+// TokenStoreFilePath returns the location of the encrypted token store.
+func TokenStoreFilePath() string {
+	return filepath.Join(configDirectory(), "tokens.json.enc")
+}
+
+// EnableEncryptedTokenStore moves the access token, refresh token and client
+// secret of the current session and of every foundation into the encrypted
+// token store the next time the config is written. The store is keyed by the
+// passphrase or key file set in the environment.
+func (config *Config) EnableEncryptedTokenStore() error {
+	if config.tokenStore != nil {
+		return nil
+	}
+	if config.tokenStoreErr != nil {
+		return config.tokenStoreErr
+	}
+
+	passphrase, err := config.tokenStorePassphrase()
+	if err != nil {
+		return err
+	}
+
+	config.tokenStore = &tokenStore{path: TokenStoreFilePath(), passphrase: passphrase}
+	config.ConfigFile.EncryptedTokenStore = true
+	return nil
+}
+
+// TokenStoreError returns the error that prevented the encrypted token store
+// from being unlocked. It returns nil if the store is unlocked or not used.
+func (config *Config) TokenStoreError() error {
+	return config.tokenStoreErr
+}
+
+// UsesEncryptedTokenStore returns true if secrets are kept in the encrypted
+// token store instead of in config.json.
+func (config *Config) UsesEncryptedTokenStore() bool {
+	return config.ConfigFile.EncryptedTokenStore
+}
+
+func (config *Config) tokenStorePassphrase() ([]byte, error) {
+	if config.ENV.CFTokenStoreKeyFile != "" {
+		key, err := ioutil.ReadFile(config.ENV.CFTokenStoreKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		return bytes.TrimSpace(key), nil
+	}
+
+	if config.ENV.CFTokenStorePassphrase != "" {
+		return []byte(config.ENV.CFTokenStorePassphrase), nil
+	}
+
+	return nil, translatableerror.TokenStoreKeyNotSetError{
+		PassphraseEnvVar: TokenStorePassphraseEnvVar,
+		KeyFileEnvVar:    TokenStoreKeyFileEnvVar,
+	}
+}
+
+// unlockTokenStore reads the secrets from the encrypted token store into the
+// config.
+func (config *Config) unlockTokenStore() error {
+	passphrase, err := config.tokenStorePassphrase()
+	if err != nil {
+		return err
+	}
+
+	store := &tokenStore{path: TokenStoreFilePath(), passphrase: passphrase}
+	secrets, err := store.read()
+	if err != nil {
+		return err
+	}
+
+	config.persistedSecrets, err = jsonContents(secrets)
+	if err != nil {
+		return err
+	}
+
+	config.ConfigFile.applySecrets(secrets)
+	config.tokenStore = store
+	return nil
+}
+
+// writeTokenStore writes the secrets to the token store, merging them with
+// the changes other cf processes made since the store was read. It must be
+// called while holding the config lock.
+func (config *Config) writeTokenStore(secrets tokenStoreSecrets) error {
+	contents, err := jsonContents(secrets)
+	if err != nil {
+		return err
+	}
+
+	merged := secrets
+	if config.persistedSecrets != nil {
+		diskSecrets, err := config.tokenStore.read()
+		if err != nil {
+			return err
+		}
+		diskContents, err := jsonContents(diskSecrets)
+		if err != nil {
+			return err
+		}
+
+		merged = tokenStoreSecrets{}
+		err = fromJSONContents(mergeJSONContents(config.persistedSecrets, contents, diskContents), &merged)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = config.tokenStore.write(merged)
+	if err != nil {
+		return err
+	}
+
+	config.persistedSecrets = contents
+	return nil
+}
+
+func (store *tokenStore) cipher(salt []byte) (cipher.AEAD, error) {
+	if store.key == nil || !bytes.Equal(salt, store.salt) {
+		key, err := scrypt.Key(store.passphrase, salt, 1<<15, 8, 1, 32)
+		if err != nil {
+			return nil, err
+		}
+		store.salt = salt
+		store.key = key
+	}
+
+	block, err := aes.NewCipher(store.key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// read returns the secrets in the store, or no secrets if the store does not
+// exist yet.
+func (store *tokenStore) read() (tokenStoreSecrets, error) {
+	var secrets tokenStoreSecrets
+
+	rawStore, err := ioutil.ReadFile(store.path)
+	if os.IsNotExist(err) {
+		return secrets, nil
+	}
+	if err != nil {
+		return secrets, err
+	}
+
+	var file tokenStoreFile
+	err = json.Unmarshal(rawStore, &file)
+	if err != nil || file.Version != tokenStoreVersion {
+		return secrets, translatableerror.TokenStoreUnlockError{Path: store.path}
+	}
+
+	aead, err := store.cipher(file.Salt)
+	if err != nil {
+		return secrets, err
+	}
+
+	plaintext, err := aead.Open(nil, file.Nonce, file.Ciphertext, nil)
+	if err != nil {
+		return secrets, translatableerror.TokenStoreUnlockError{Path: store.path}
+	}
+
+	err = json.Unmarshal(plaintext, &secrets)
+	return secrets, err
+}
+
+func (store *tokenStore) write(secrets tokenStoreSecrets) error {
+	salt := store.salt
+	if salt == nil {
+		salt = make([]byte, tokenStoreSaltSize)
+		if _, err := rand.Read(salt); err != nil {
+			return err
+		}
+	}
+
+	aead, err := store.cipher(salt)
+	if err != nil {
+		return err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err = rand.Read(nonce); err != nil {
+		return err
+	}
+
+	plaintext, err := json.Marshal(secrets)
+	if err != nil {
+		return err
+	}
+
+	rawStore, err := json.MarshalIndent(tokenStoreFile{
+		Version:    tokenStoreVersion,
+		Salt:       salt,
+		Nonce:      nonce,
+		Ciphertext: aead.Seal(nil, nonce, plaintext, nil),
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tempStoreFile, err := ioutil.TempFile(filepath.Dir(store.path), "temp-config-tokens")
+	if err != nil {
+		return err
+	}
+	tempStoreFile.Close()
+
+	err = ioutil.WriteFile(tempStoreFile.Name(), rawStore, 0600)
+	if err != nil {
+		_ = os.Remove(tempStoreFile.Name())
+		return err
+	}
+
+	return os.Rename(tempStoreFile.Name(), store.path)
+}
+
+func (file JSONConfig) secrets() tokenStoreSecrets {
+	return tokenStoreSecrets{
+		AccessToken:          file.AccessToken,
+		EnvSessions:          sessionSecrets(file.EnvSessions),
+		Foundations:          sessionSecrets(file.Foundations),
+		RefreshToken:         file.RefreshToken,
+		TargetSessions:       sessionSecrets(file.TargetSessions),
+		UAAOAuthClientSecret: file.UAAOAuthClientSecret,
+	}
+}
+
+func (file *JSONConfig) applySecrets(secrets tokenStoreSecrets) {
+	file.AccessToken = secrets.AccessToken
+	file.RefreshToken = secrets.RefreshToken
+	file.UAAOAuthClientSecret = secrets.UAAOAuthClientSecret
+	applySessionSecrets(file.EnvSessions, secrets.EnvSessions)
+	applySessionSecrets(file.Foundations, secrets.Foundations)
+	applySessionSecrets(file.TargetSessions, secrets.TargetSessions)
+}
+
+func (file JSONConfig) withoutSecrets() JSONConfig {
+	file.EnvSessions = withoutSessionSecrets(file.EnvSessions)
+	file.Foundations = withoutSessionSecrets(file.Foundations)
+	file.TargetSessions = withoutSessionSecrets(file.TargetSessions)
+	file.AccessToken = ""
+	file.RefreshToken = ""
+	file.UAAOAuthClientSecret = ""
+	return file
+}
+
+// sessionSecrets returns the secrets of saved foundations or target
+// sessions, keyed like the sessions.
+func sessionSecrets(sessions map[string]Foundation) map[string]foundationSecrets {
+	if len(sessions) == 0 {
+		return nil
+	}
+
+	secrets := make(map[string]foundationSecrets, len(sessions))
+	for name, session := range sessions {
+		secrets[name] = foundationSecrets{
+			AccessToken:          session.AccessToken,
+			RefreshToken:         session.RefreshToken,
+			UAAOAuthClientSecret: session.UAAOAuthClientSecret,
+		}
+	}
+	return secrets
+}
+
+func applySessionSecrets(sessions map[string]Foundation, secrets map[string]foundationSecrets) {
+	for name, session := range sessions {
+		sessionSecrets := secrets[name]
+		session.AccessToken = sessionSecrets.AccessToken
+		session.RefreshToken = sessionSecrets.RefreshToken
+		session.UAAOAuthClientSecret = sessionSecrets.UAAOAuthClientSecret
+		sessions[name] = session
+	}
+}
+
+func withoutSessionSecrets(sessions map[string]Foundation) map[string]Foundation {
+	if sessions == nil {
+		return nil
+	}
+
+	withoutSecrets := make(map[string]Foundation, len(sessions))
+	for name, session := range sessions {
+		session.AccessToken = ""
+		session.RefreshToken = ""
+		session.UAAOAuthClientSecret = ""
+		withoutSecrets[name] = session
+	}
+	return withoutSecrets
+}
@@ -0,0 +1,168 @@
+package configv3_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/util/configv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Encrypted token store", func() {
+	var (
+		homeDir string
+		config  *Config
+	)
+
+	BeforeEach(func() {
+		homeDir = setup()
+		setConfig(homeDir, `{
+			"ConfigVersion": 3,
+			"Target": "https://api.foo.com",
+			"AccessToken": "bearer plaintext-access-token",
+			"RefreshToken": "plaintext-refresh-token",
+			"UAAOAuthClient": "some-client",
+			"UAAOAuthClientSecret": "plaintext-client-secret",
+			"Foundations": {
+				"dc1": {"Target": "https://api.dc1.com", "AccessToken": "bearer dc1-access-token", "RefreshToken": "dc1-refresh-token"}
+			}
+		}`)
+		Expect(os.Setenv(TokenStorePassphraseEnvVar, "some-passphrase")).To(Succeed())
+	})
+
+	AfterEach(func() {
+		Expect(os.Unsetenv(TokenStorePassphraseEnvVar)).To(Succeed())
+		Expect(os.Unsetenv(TokenStoreKeyFileEnvVar)).To(Succeed())
+		teardown(homeDir)
+	})
+
+	readFile := func(name string) string {
+		contents, err := ioutil.ReadFile(filepath.Join(homeDir, ".cf", name))
+		Expect(err).ToNot(HaveOccurred())
+		return string(contents)
+	}
+
+	migrate := func() {
+		var err error
+		config, err = LoadConfig()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(config.EnableEncryptedTokenStore()).To(Succeed())
+		Expect(config.WriteConfig()).To(Succeed())
+	}
+
+	It("moves the secrets from config.json into the token store", func() {
+		migrate()
+
+		Expect(config.UsesEncryptedTokenStore()).To(BeTrue())
+		configFile := readFile("config.json")
+		Expect(configFile).To(ContainSubstring(`"EncryptedTokenStore": true`))
+		Expect(configFile).ToNot(ContainSubstring("plaintext"))
+		Expect(configFile).ToNot(ContainSubstring("dc1-access-token"))
+		Expect(configFile).To(ContainSubstring("https://api.dc1.com"))
+
+		tokenStore := readFile("tokens.json.enc")
+		Expect(tokenStore).ToNot(ContainSubstring("plaintext"))
+	})
+
+	It("reads the secrets from the token store when the config is loaded", func() {
+		migrate()
+
+		var err error
+		config, err = LoadConfig()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(config.TokenStoreError()).ToNot(HaveOccurred())
+		Expect(config.AccessToken()).To(Equal("bearer plaintext-access-token"))
+		Expect(config.RefreshToken()).To(Equal("plaintext-refresh-token"))
+		Expect(config.UAAOAuthClientSecret()).To(Equal("plaintext-client-secret"))
+
+		dc1, _ := config.GetFoundation("dc1")
+		Expect(dc1.AccessToken).To(Equal("bearer dc1-access-token"))
+	})
+
+	It("saves refreshed tokens in the token store", func() {
+		migrate()
+
+		config.SetAccessToken("bearer new-access-token")
+		Expect(config.WriteConfig()).To(Succeed())
+		Expect(readFile("config.json")).ToNot(ContainSubstring("new-access-token"))
+
+		var err error
+		config, err = LoadConfig()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(config.AccessToken()).To(Equal("bearer new-access-token"))
+	})
+
+	When("the store is keyed by a key file", func() {
+		BeforeEach(func() {
+			keyFile := filepath.Join(homeDir, "token-key")
+			Expect(ioutil.WriteFile(keyFile, []byte("some-key\n"), 0600)).To(Succeed())
+			Expect(os.Unsetenv(TokenStorePassphraseEnvVar)).To(Succeed())
+			Expect(os.Setenv(TokenStoreKeyFileEnvVar, keyFile)).To(Succeed())
+		})
+
+		It("unlocks the store with the key file", func() {
+			migrate()
+
+			var err error
+			config, err = LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config.AccessToken()).To(Equal("bearer plaintext-access-token"))
+		})
+	})
+
+	When("neither a passphrase nor a key file is set", func() {
+		It("cannot be enabled", func() {
+			Expect(os.Unsetenv(TokenStorePassphraseEnvVar)).To(Succeed())
+
+			var err error
+			config, err = LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config.EnableEncryptedTokenStore()).To(MatchError(translatableerror.TokenStoreKeyNotSetError{
+				PassphraseEnvVar: "CF_TOKEN_STORE_PASSPHRASE",
+				KeyFileEnvVar:    "CF_TOKEN_STORE_KEY_FILE",
+			}))
+		})
+
+		It("reports that the store is locked", func() {
+			migrate()
+			Expect(os.Unsetenv(TokenStorePassphraseEnvVar)).To(Succeed())
+
+			var err error
+			config, err = LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config.TokenStoreError()).To(MatchError(translatableerror.TokenStoreKeyNotSetError{
+				PassphraseEnvVar: "CF_TOKEN_STORE_PASSPHRASE",
+				KeyFileEnvVar:    "CF_TOKEN_STORE_KEY_FILE",
+			}))
+			Expect(config.AccessToken()).To(BeEmpty())
+		})
+	})
+
+	When("the passphrase is wrong", func() {
+		It("reports that the store cannot be unlocked", func() {
+			migrate()
+			Expect(os.Setenv(TokenStorePassphraseEnvVar, "wrong-passphrase")).To(Succeed())
+
+			var err error
+			config, err = LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config.TokenStoreError()).To(MatchError(translatableerror.TokenStoreUnlockError{
+				Path: filepath.Join(homeDir, ".cf", "tokens.json.enc"),
+			}))
+
+			Expect(config.WriteConfig()).To(Succeed())
+			config, err = LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config.TokenStoreError()).To(HaveOccurred())
+
+			Expect(os.Setenv(TokenStorePassphraseEnvVar, "some-passphrase")).To(Succeed())
+			config, err = LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config.AccessToken()).To(Equal("bearer plaintext-access-token"))
+		})
+	})
+})
@@ -0,0 +1,38 @@
+package configv3
+
+import (
+	"path/filepath"
+	"strconv"
+)
+
+// TraceHAR returns the absolute path of the HTTP Archive file that requests
+// are recorded in, or an empty string when they are not recorded. This is
+// based off of:
+//  1. The $CF_TRACE_HAR environment variable if set to a file path, or
+//     'false' to turn recording off
+//  2. The config file's TraceHAR value
+//  3. Defaults to not recording requests
+func (config *Config) TraceHAR() string {
+	path := config.ConfigFile.TraceHAR
+	if config.ENV.CFTraceHAR != "" {
+		path = config.ENV.CFTraceHAR
+	}
+
+	if _, err := strconv.ParseBool(path); err == nil || path == "" {
+		return ""
+	}
+
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(config.detectedSettings.currentDirectory, path)
+	}
+	return path
+}
+
+// SetTraceHAR sets the path of the HTTP Archive file that requests are
+// recorded in. An empty path or 'false' turns recording off.
+func (config *Config) SetTraceHAR(path string) {
+	if _, err := strconv.ParseBool(path); err == nil {
+		path = ""
+	}
+	config.ConfigFile.TraceHAR = path
+}
@@ -0,0 +1,62 @@
+package configv3_test
+
+import (
+	. "code.cloudfoundry.org/cli/util/configv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TraceHAR", func() {
+	var config *Config
+
+	BeforeEach(func() {
+		config = &Config{}
+	})
+
+	It("does not record requests by default", func() {
+		Expect(config.TraceHAR()).To(BeEmpty())
+	})
+
+	When("a path is set in config", func() {
+		BeforeEach(func() {
+			config.SetTraceHAR("/some/path/trace.har")
+		})
+
+		It("returns it", func() {
+			Expect(config.ConfigFile.TraceHAR).To(Equal("/some/path/trace.har"))
+			Expect(config.TraceHAR()).To(Equal("/some/path/trace.har"))
+		})
+
+		When("CF_TRACE_HAR is set to a path", func() {
+			BeforeEach(func() {
+				config.ENV.CFTraceHAR = "/other/path/trace.har"
+			})
+
+			It("returns the environment value", func() {
+				Expect(config.TraceHAR()).To(Equal("/other/path/trace.har"))
+			})
+		})
+
+		When("CF_TRACE_HAR is set to false", func() {
+			BeforeEach(func() {
+				config.ENV.CFTraceHAR = "false"
+			})
+
+			It("does not record requests", func() {
+				Expect(config.TraceHAR()).To(BeEmpty())
+			})
+		})
+
+		When("the path is set to false", func() {
+			BeforeEach(func() {
+				config.SetTraceHAR("false")
+			})
+
+			It("removes it from config", func() {
+				Expect(config.ConfigFile.TraceHAR).To(BeEmpty())
+				Expect(config.TraceHAR()).To(BeEmpty())
+			})
+		})
+	})
+})
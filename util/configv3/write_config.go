@@ -1,10 +1,13 @@
 package configv3
 
 import (
+	"bytes"
 	"encoding/json"
 	"io/ioutil"
 	"os"
 	"os/signal"
+	"path/filepath"
+	"reflect"
 	"syscall"
 	"time"
 )
@@ -12,14 +15,41 @@ import (
 // WriteConfig creates the .cf directory and then writes the config.json. The
 // location of .cf directory is written in the same way LoadConfig reads .cf
 // directory.
+//
+// The config.json is locked while it is written so that cf processes sharing
+// a CF_HOME do not overwrite each other's changes. Only the settings this
+// process changed since it loaded the config are written, every other setting
+// keeps the value currently in the file.
 func (c *Config) WriteConfig() error {
-	rawConfig, err := json.MarshalIndent(c.ConfigFile, "", "  ")
+	if c.detached {
+		return nil
+	}
+
+	dir := configDirectory()
+	err := os.MkdirAll(dir, 0700)
 	if err != nil {
 		return err
 	}
 
-	dir := configDirectory()
-	err = os.MkdirAll(dir, 0700)
+	unlock, err := lockConfigFile(configLockFilePath())
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	if c.tokenStore != nil {
+		err = c.writeTokenStore(c.fileContents().secrets())
+		if err != nil {
+			return err
+		}
+	}
+
+	contents, err := jsonContents(c.persistedFileContents())
+	if err != nil {
+		return err
+	}
+
+	rawConfig, err := c.mergeWithConfigFile(contents)
 	if err != nil {
 		return err
 	}
@@ -45,6 +75,68 @@ func (c *Config) WriteConfig() error {
 		return err
 	}
 
+	err = renameConfigFile(tempConfigFileName)
+	if err != nil {
+		return err
+	}
+
+	c.persistedContents = contents
+	return nil
+}
+
+// mergeWithConfigFile returns the config.json that should be written. The
+// changes made since the config was loaded are applied to the file on disk,
+// which may have been written by another cf process in the meantime.
+func (c *Config) mergeWithConfigFile(contents interface{}) ([]byte, error) {
+	merged := contents
+	if c.persistedContents != nil {
+		diskContents, err := readConfigFileContents()
+		if err != nil {
+			return nil, err
+		}
+		if diskContents != nil {
+			merged = mergeJSONContents(c.persistedContents, contents, diskContents)
+		}
+	}
+
+	var mergedConfig JSONConfig
+	err := fromJSONContents(merged, &mergedConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.MarshalIndent(mergedConfig, "", "  ")
+}
+
+// persistedFileContents returns the fileContents without the secrets kept in
+// the encrypted token store.
+func (c *Config) persistedFileContents() JSONConfig {
+	file := c.fileContents()
+	if file.EncryptedTokenStore {
+		return file.withoutSecrets()
+	}
+	return file
+}
+
+// readConfigFileContents returns the current contents of config.json, or nil
+// if there is no config.json with the current config version to merge with.
+func readConfigFileContents() (interface{}, error) {
+	file, err := ioutil.ReadFile(ConfigFilePath())
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var configFile JSONConfig
+	if json.Unmarshal(file, &configFile) != nil || configFile.ConfigVersion != CurrentConfigVersion {
+		return nil, nil
+	}
+	return jsonContents(configFile)
+}
+
+func renameConfigFile(tempConfigFileName string) error {
 	for i := 0; i < 5; i++ {
 		if err := os.Rename(tempConfigFileName, ConfigFilePath()); err == nil {
 			return nil
@@ -56,6 +148,76 @@ func (c *Config) WriteConfig() error {
 	return os.Rename(tempConfigFileName, ConfigFilePath())
 }
 
+// jsonContents converts a persisted value to generic JSON values so that it
+// can be compared and merged key by key.
+func jsonContents(value interface{}) (interface{}, error) {
+	var contents interface{}
+	err := fromJSONContents(value, &contents)
+	return contents, err
+}
+
+// fromJSONContents converts generic JSON values back into a persisted value.
+func fromJSONContents(contents interface{}, value interface{}) error {
+	rawContents, err := json.Marshal(contents)
+	if err != nil {
+		return err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(rawContents))
+	decoder.UseNumber()
+	return decoder.Decode(value)
+}
+
+// mergeJSONContents applies the differences between base and ours to theirs.
+// JSON objects are merged key by key, so changes to different keys of the same
+// object are all kept. An object missing from base is merged as an empty one.
+// Any other value that was changed in ours replaces the one in theirs.
+func mergeJSONContents(base interface{}, ours interface{}, theirs interface{}) interface{} {
+	if reflect.DeepEqual(base, ours) {
+		return theirs
+	}
+
+	if base == nil {
+		base = map[string]interface{}{}
+	}
+	baseObject, baseIsObject := base.(map[string]interface{})
+	ourObject, ourIsObject := ours.(map[string]interface{})
+	theirObject, theirIsObject := theirs.(map[string]interface{})
+	if !baseIsObject || !ourIsObject || !theirIsObject {
+		return ours
+	}
+
+	merged := make(map[string]interface{}, len(theirObject))
+	for key, value := range theirObject {
+		merged[key] = value
+	}
+
+	for key, ourValue := range ourObject {
+		baseValue, inBase := baseObject[key]
+		theirValue, inTheirs := theirObject[key]
+		switch {
+		case inBase && reflect.DeepEqual(baseValue, ourValue):
+			continue
+		case inTheirs:
+			merged[key] = mergeJSONContents(baseValue, ourValue, theirValue)
+		default:
+			merged[key] = ourValue
+		}
+	}
+
+	for key := range baseObject {
+		if _, inOurs := ourObject[key]; !inOurs {
+			delete(merged, key)
+		}
+	}
+
+	return merged
+}
+
+func configLockFilePath() string {
+	return filepath.Join(configDirectory(), "config.json.lock")
+}
+
 // catchSignal tries to catch SIGHUP, SIGINT, SIGKILL, SIGQUIT and SIGTERM, and
 // Interrupt for removing temporarily created config files before the program
 // ends.  Note:  we cannot intercept a `kill -9`, so a well-timed `kill -9`
@@ -2,10 +2,12 @@ package configv3_test
 
 import (
 	"encoding/json"
+	"fmt"
 	"io/ioutil"
 	"path/filepath"
 	"sort"
 	"strings"
+	"sync"
 
 	"code.cloudfoundry.org/cli/util/configv3"
 	"gopkg.in/yaml.v2"
@@ -73,5 +75,81 @@ var _ = Describe("Config", func() {
 			caseInsensitive := func(i, j int) bool { return strings.ToLower(keys[i]) < strings.ToLower(keys[j]) }
 			Expect(sort.SliceIsSorted(keys, caseInsensitive)).To(BeTrue())
 		})
+
+		When("another process wrote the config after it was loaded", func() {
+			var writtenConfig configv3.JSONConfig
+
+			BeforeEach(func() {
+				setConfig(homeDir, `{
+					"ConfigVersion": 3,
+					"Target": "https://api.foo.com",
+					"AccessToken": "old-token",
+					"Foundations": {
+						"dc1": {"Target": "https://api.dc1.com", "AccessToken": "dc1-token"}
+					}
+				}`)
+
+				var err error
+				config, err = configv3.LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+
+				setConfig(homeDir, `{
+					"ConfigVersion": 3,
+					"Target": "https://api.foo.com",
+					"AccessToken": "old-token",
+					"RefreshToken": "their-refresh-token",
+					"OrganizationFields": {"GUID": "their-org-guid", "Name": "their-org"},
+					"Foundations": {
+						"dc1": {"Target": "https://api.dc1.com", "AccessToken": "dc1-token", "RefreshToken": "dc1-refresh-token"},
+						"dc2": {"Target": "https://api.dc2.com"}
+					}
+				}`)
+
+				config.SetAccessToken("our-token")
+				config.ConfigFile.Foundations["dc1"] = configv3.Foundation{Target: "https://api.dc1.com", AccessToken: "new-dc1-token"}
+				Expect(config.WriteConfig()).To(Succeed())
+
+				rawConfig, err := ioutil.ReadFile(filepath.Join(homeDir, ".cf", "config.json"))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(json.Unmarshal(rawConfig, &writtenConfig)).To(Succeed())
+			})
+
+			It("keeps the changes of both processes", func() {
+				Expect(writtenConfig.AccessToken).To(Equal("our-token"))
+				Expect(writtenConfig.RefreshToken).To(Equal("their-refresh-token"))
+				Expect(writtenConfig.TargetedOrganization.Name).To(Equal("their-org"))
+			})
+
+			It("merges changes to the same foundation setting by setting", func() {
+				Expect(writtenConfig.Foundations).To(HaveLen(2))
+				Expect(writtenConfig.Foundations["dc1"].AccessToken).To(Equal("new-dc1-token"))
+				Expect(writtenConfig.Foundations["dc1"].RefreshToken).To(Equal("dc1-refresh-token"))
+				Expect(writtenConfig.Foundations["dc2"].Target).To(Equal("https://api.dc2.com"))
+			})
+		})
+
+		When("several processes write the config at the same time", func() {
+			It("keeps the changes of every process", func() {
+				var wg sync.WaitGroup
+				for i := 0; i < 10; i++ {
+					wg.Add(1)
+					go func(i int) {
+						defer GinkgoRecover()
+						defer wg.Done()
+
+						processConfig, err := configv3.LoadConfig()
+						Expect(err).ToNot(HaveOccurred())
+						processConfig.AddFoundation(fmt.Sprintf("dc%d", i))
+						Expect(processConfig.WriteConfig()).To(Succeed())
+					}(i)
+				}
+				wg.Wait()
+
+				var err error
+				config, err = configv3.LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(config.Foundations()).To(HaveLen(10))
+			})
+		})
 	})
 })
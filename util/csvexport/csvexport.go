@@ -0,0 +1,185 @@
+// Package csvexport writes slices of structs as CSV with one row per element
+// and one column per field.
+package csvexport
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Write writes rows as CSV to w. Rows is a slice of structs, or a single
+// struct that is written as one row. The header row holds the json names of
+// the exported fields, in declaration order, and fields of embedded structs
+// become columns of their own. Lists of values are joined with "; ", maps are
+// written as sorted "key=value" pairs and nested structs as compact JSON.
+func Write(w io.Writer, rows interface{}) error {
+	value := reflect.ValueOf(rows)
+	for value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface {
+		value = value.Elem()
+	}
+
+	var elements []reflect.Value
+	switch value.Kind() {
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < value.Len(); i++ {
+			elements = append(elements, indirect(value.Index(i)))
+		}
+	case reflect.Struct:
+		elements = append(elements, value)
+	default:
+		return fmt.Errorf("cannot write %s as CSV", value.Kind())
+	}
+
+	elementType := indirectType(reflect.TypeOf(rows))
+	if elementType.Kind() == reflect.Slice || elementType.Kind() == reflect.Array {
+		elementType = indirectType(elementType.Elem())
+	}
+	if elementType.Kind() != reflect.Struct {
+		return fmt.Errorf("cannot write %s as CSV", elementType.Kind())
+	}
+
+	columns := structColumns(elementType, nil)
+	writer := csv.NewWriter(w)
+
+	header := make([]string, len(columns))
+	for i, column := range columns {
+		header[i] = column.name
+	}
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+
+	for _, element := range elements {
+		record := make([]string, len(columns))
+		for i, column := range columns {
+			cell, err := formatCell(fieldByIndex(element, column.index))
+			if err != nil {
+				return err
+			}
+			record[i] = cell
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+type column struct {
+	name  string
+	index []int
+}
+
+func structColumns(structType reflect.Type, parentIndex []int) []column {
+	var columns []column
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		index := append(append([]int{}, parentIndex...), i)
+
+		if field.Anonymous && indirectType(field.Type).Kind() == reflect.Struct {
+			columns = append(columns, structColumns(indirectType(field.Type), index)...)
+			continue
+		}
+		if field.PkgPath != "" {
+			continue
+		}
+
+		name := field.Name
+		if tag, ok := field.Tag.Lookup("json"); ok {
+			tagName := strings.Split(tag, ",")[0]
+			if tagName == "-" {
+				continue
+			}
+			if tagName != "" {
+				name = tagName
+			}
+		}
+		columns = append(columns, column{name: name, index: index})
+	}
+	return columns
+}
+
+// fieldByIndex is reflect.Value.FieldByIndex that returns an invalid value
+// instead of panicking on nil embedded pointers.
+func fieldByIndex(value reflect.Value, index []int) reflect.Value {
+	for _, i := range index {
+		value = indirect(value)
+		if !value.IsValid() {
+			return value
+		}
+		value = value.Field(i)
+	}
+	return value
+}
+
+func formatCell(value reflect.Value) (string, error) {
+	value = indirect(value)
+	if !value.IsValid() {
+		return "", nil
+	}
+
+	switch value.Kind() {
+	case reflect.String:
+		return value.String(), nil
+	case reflect.Bool:
+		return strconv.FormatBool(value.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(value.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(value.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(value.Float(), 'f', -1, 64), nil
+	case reflect.Slice, reflect.Array:
+		if elementKind := indirectType(value.Type().Elem()).Kind(); elementKind != reflect.Struct && elementKind != reflect.Map {
+			items := make([]string, value.Len())
+			for i := range items {
+				item, err := formatCell(value.Index(i))
+				if err != nil {
+					return "", err
+				}
+				items[i] = item
+			}
+			return strings.Join(items, "; "), nil
+		}
+		if value.Len() == 0 {
+			return "", nil
+		}
+	case reflect.Map:
+		if value.Type().Key().Kind() == reflect.String && indirectType(value.Type().Elem()).Kind() == reflect.String {
+			pairs := make([]string, 0, value.Len())
+			for _, key := range value.MapKeys() {
+				pairs = append(pairs, fmt.Sprintf("%s=%s", key.String(), indirect(value.MapIndex(key)).String()))
+			}
+			sort.Strings(pairs)
+			return strings.Join(pairs, "; "), nil
+		}
+	}
+
+	raw, err := json.Marshal(value.Interface())
+	return string(raw), err
+}
+
+func indirect(value reflect.Value) reflect.Value {
+	for value.IsValid() && (value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface) {
+		if value.IsNil() {
+			return reflect.Value{}
+		}
+		value = value.Elem()
+	}
+	return value
+}
+
+func indirectType(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t
+}
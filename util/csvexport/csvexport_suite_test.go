@@ -0,0 +1,13 @@
+package csvexport_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestCsvexport(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Csvexport Suite")
+}
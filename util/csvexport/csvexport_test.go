@@ -0,0 +1,72 @@
+package csvexport_test
+
+import (
+	"bytes"
+
+	"code.cloudfoundry.org/cli/util/csvexport"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+type process struct {
+	Type      string `json:"type"`
+	Instances int    `json:"instances"`
+}
+
+type Resource struct {
+	GUID string `json:"guid"`
+}
+
+type app struct {
+	Resource
+	Name      string            `json:"name"`
+	Limit     *int              `json:"limit"`
+	Routes    []string          `json:"routes"`
+	Labels    map[string]string `json:"labels,omitempty"`
+	Processes []process         `json:"processes"`
+	Ignored   string            `json:"-"`
+}
+
+var _ = Describe("Write", func() {
+	var buffer *bytes.Buffer
+
+	BeforeEach(func() {
+		buffer = new(bytes.Buffer)
+	})
+
+	It("writes one row per element with the json names as header", func() {
+		limit := 5
+		Expect(csvexport.Write(buffer, []app{
+			{
+				Resource:  Resource{GUID: "some-guid"},
+				Name:      "some, app",
+				Limit:     &limit,
+				Routes:    []string{"a.com", "b.com"},
+				Labels:    map[string]string{"tier": "web", "env": "prod"},
+				Processes: []process{{Type: "web", Instances: 2}},
+				Ignored:   "ignored",
+			},
+			{Name: "other-app"},
+		})).To(Succeed())
+
+		Expect(buffer.String()).To(Equal(
+			"guid,name,limit,routes,labels,processes\n" +
+				`some-guid,"some, app",5,a.com; b.com,env=prod; tier=web,"[{""type"":""web"",""instances"":2}]"` + "\n" +
+				",other-app,,,,\n",
+		))
+	})
+
+	It("writes a single struct as one row", func() {
+		Expect(csvexport.Write(buffer, app{Name: "some-app"})).To(Succeed())
+		Expect(buffer.String()).To(Equal("guid,name,limit,routes,labels,processes\n,some-app,,,,\n"))
+	})
+
+	It("writes only the header for an empty list", func() {
+		Expect(csvexport.Write(buffer, []app{})).To(Succeed())
+		Expect(buffer.String()).To(Equal("guid,name,limit,routes,labels,processes\n"))
+	})
+
+	It("returns an error for values that are not structs", func() {
+		Expect(csvexport.Write(buffer, []string{"a"})).To(MatchError("cannot write string as CSV"))
+	})
+})
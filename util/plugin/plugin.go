@@ -66,12 +66,27 @@ func PluginCommandNames() []string {
 }
 
 func RunPlugin(plugin configv3.Plugin) error {
-	_, commandUI, err := getCFConfigAndCommandUIObjects()
+	cfConfig, commandUI, err := getCFConfigAndCommandUIObjects()
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "%s\n", err.Error())
 		return err
 	}
 	defer commandUI.FlushDeferred()
+
+	// Plugins read the tokens from config.json through the legacy config and
+	// would run without a session.
+	if cfConfig.UsesEncryptedTokenStore() {
+		return handleError(translatableerror.TokenStorePluginUnsupportedError{PluginName: plugin.Name}, commandUI)
+	}
+
+	// Plugins read the target from config.json through the legacy config and
+	// would ignore the foundation and target selected for this invocation.
+	if override := targetOverride(cfConfig); override != "" {
+		return handleError(translatableerror.PluginTargetOverrideUnsupportedError{
+			PluginName: plugin.Name,
+			Override:   override,
+		}, commandUI)
+	}
 	pluginErr := plugin_transition.RunPlugin(plugin, commandUI)
 	if pluginErr != nil {
 		return handleError(pluginErr, commandUI)
@@ -79,9 +94,23 @@ func RunPlugin(plugin configv3.Plugin) error {
 	return nil
 }
 
+// targetOverride describes the '--foundation' flag or the CF_API, CF_ORG and
+// CF_SPACE variables given for this invocation, or returns "" if there are
+// none.
+func targetOverride(cfConfig *configv3.Config) string {
+	if common.Commands.Foundation != "" {
+		return "--foundation " + common.Commands.Foundation
+	}
+	if envTarget := cfConfig.EnvTarget(); envTarget.IsSet() {
+		return envTarget.String()
+	}
+	return ""
+}
+
 func getCFConfigAndCommandUIObjects() (*configv3.Config, *ui.UI, error) {
 	cfConfig, configErr := configv3.LoadConfig(configv3.FlagOverride{
-		Verbose: common.Commands.VerboseOrVersion,
+		Foundation: common.Commands.Foundation,
+		Verbose:    common.Commands.VerboseOrVersion,
 	})
 	if configErr != nil {
 		if _, ok := configErr.(translatableerror.EmptyConfigError); !ok {
@@ -9,8 +9,9 @@ import (
 )
 
 type ProgressBar struct {
-	ready chan bool
-	bar   *pb.ProgressBar
+	ready  chan bool
+	bar    *pb.ProgressBar
+	silent bool
 }
 
 func NewProgressBar() *ProgressBar {
@@ -19,6 +20,15 @@ func NewProgressBar() *ProgressBar {
 	}
 }
 
+// NewSilentProgressBar returns a progress bar that tracks uploads without
+// drawing anything, for uploads that run next to each other.
+func NewSilentProgressBar() *ProgressBar {
+	return &ProgressBar{
+		ready:  make(chan bool),
+		silent: true,
+	}
+}
+
 func (p *ProgressBar) Complete() {
 	// Adding sleep to ensure UI has finished drawing
 	time.Sleep(time.Second)
@@ -36,6 +46,7 @@ func (p *ProgressBar) NewProgressBarWrapper(reader io.Reader, sizeOfFile int64)
 	log.Debug("progress bar ready")
 	p.bar = pb.New(int(sizeOfFile)).SetUnits(pb.U_BYTES)
 	p.bar.ShowTimeLeft = false
+	p.bar.NotPrint = p.silent
 	p.bar.Start()
 	return p.bar.NewProxyReader(reader)
 }
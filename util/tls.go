@@ -3,10 +3,29 @@ package util
 import (
 	"crypto/tls"
 	"crypto/x509"
+	"errors"
 
 	"code.cloudfoundry.org/tlsconfig"
 )
 
+// TLSCertificates holds the PEM encoded certificates used when connecting to
+// an API target: an additional trusted CA and an optional client certificate
+// and key for mutual TLS. ClientKeyFile is the file the key was read from;
+// only the path is saved in the config, so the private key stays in its file.
+type TLSCertificates struct {
+	CACert        string
+	ClientCert    string
+	ClientKey     string
+	ClientKeyFile string
+}
+
+// Validate returns an error if the CA certificate cannot be parsed, if the
+// client certificate and key cannot be loaded, or if only one of them is set.
+func (certs TLSCertificates) Validate() error {
+	_, err := NewTLSConfigWithCertificates(certs, false)
+	return err
+}
+
 func NewTLSConfig(trustedCerts []*x509.Certificate, skipTLSValidation bool) *tls.Config {
 	config := &tls.Config{}
 
@@ -24,3 +43,35 @@ func NewTLSConfig(trustedCerts []*x509.Certificate, skipTLSValidation bool) *tls
 
 	return config
 }
+
+// NewTLSConfigWithCertificates returns a TLS config that trusts the system
+// certificate pool plus the given CA certificate, and presents the given
+// client certificate. It returns an error if the CA certificate cannot be
+// parsed or the client certificate and key cannot be loaded.
+func NewTLSConfigWithCertificates(certs TLSCertificates, skipTLSValidation bool) (*tls.Config, error) {
+	config := NewTLSConfig(nil, skipTLSValidation)
+
+	if certs.CACert != "" {
+		certPool, err := x509.SystemCertPool()
+		if err != nil {
+			certPool = x509.NewCertPool()
+		}
+		if !certPool.AppendCertsFromPEM([]byte(certs.CACert)) {
+			return nil, errors.New("no PEM encoded certificates found in CA certificate")
+		}
+		config.RootCAs = certPool
+	}
+
+	if certs.ClientCert != "" || certs.ClientKey != "" {
+		if certs.ClientCert == "" || certs.ClientKey == "" {
+			return nil, errors.New("client certificate and client key must be provided together")
+		}
+		clientCert, err := tls.X509KeyPair([]byte(certs.ClientCert), []byte(certs.ClientKey))
+		if err != nil {
+			return nil, err
+		}
+		config.Certificates = []tls.Certificate{clientCert}
+	}
+
+	return config, nil
+}
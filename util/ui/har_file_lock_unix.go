@@ -0,0 +1,23 @@
+//go:build !windows
+// +build !windows
+
+package ui
+
+import (
+	"os"
+	"syscall"
+)
+
+// lockHARFile takes an exclusive advisory lock on the open HAR file, waiting
+// for other cf processes that write to it. The returned function releases
+// the lock.
+func lockHARFile(file *os.File) (func(), error) {
+	err := syscall.Flock(int(file.Fd()), syscall.LOCK_EX)
+	if err != nil {
+		return nil, err
+	}
+
+	return func() {
+		_ = syscall.Flock(int(file.Fd()), syscall.LOCK_UN)
+	}, nil
+}
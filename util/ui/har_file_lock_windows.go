@@ -0,0 +1,25 @@
+//go:build windows
+// +build windows
+
+package ui
+
+import (
+	"os"
+
+	"golang.org/x/sys/windows"
+)
+
+// lockHARFile takes an exclusive lock on the open HAR file, waiting for
+// other cf processes that write to it. The returned function releases the
+// lock.
+func lockHARFile(file *os.File) (func(), error) {
+	handle := windows.Handle(file.Fd())
+	err := windows.LockFileEx(handle, windows.LOCKFILE_EXCLUSIVE_LOCK, 0, 1, 0, &windows.Overlapped{})
+	if err != nil {
+		return nil, err
+	}
+
+	return func() {
+		_ = windows.UnlockFileEx(handle, 0, 1, 0, &windows.Overlapped{})
+	}, nil
+}
@@ -23,16 +23,27 @@ type LogMessage interface {
 	SourceInstance() string
 }
 
-// DisplayLogMessage formats and outputs a given log message.
+// FoundationLogMessage is a log message from an app on one of several
+// foundations whose logs are displayed together.
+type FoundationLogMessage interface {
+	LogMessage
+	Foundation() string
+}
+
+// DisplayLogMessage formats and outputs a given log message. Every line of a
+// FoundationLogMessage is prefixed with the name of its foundation.
 func (ui *UI) DisplayLogMessage(message LogMessage, displayHeader bool) {
 	ui.terminalLock.Lock()
 	defer ui.terminalLock.Unlock()
 
 	var header string
+	if foundationMessage, ok := message.(FoundationLogMessage); ok {
+		header = fmt.Sprintf("[%s] ", foundationMessage.Foundation())
+	}
 	if displayHeader {
 		time := message.Timestamp().In(ui.TimezoneLocation).Format(LogTimestampFormat)
 
-		header = fmt.Sprintf("%s [%s/%s] %s ",
+		header += fmt.Sprintf("%s [%s/%s] %s ",
 			time,
 			message.SourceType(),
 			message.SourceInstance(),
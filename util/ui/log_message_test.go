@@ -105,6 +105,15 @@ var _ = Describe("Log Message", func() {
 			})
 		})
 
+		Context("log message from a foundation", func() {
+			It("prefixes every line with the foundation", func() {
+				message.MessageReturns("This is a log message\nThis is also a log message")
+				ui.DisplayLogMessage(foundationLogMessage{FakeLogMessage: message, foundation: "dc1"}, true)
+				Expect(out).To(Say(`\[dc1\] 2016-07-19T16:08:12.00-0700 \[APP/PROC/WEB/12\] OUT This is a log message\n`))
+				Expect(out).To(Say(`\[dc1\] 2016-07-19T16:08:12.00-0700 \[APP/PROC/WEB/12\] OUT This is also a log message\n`))
+			})
+		})
+
 		Context("error log lines", func() {
 			BeforeEach(func() {
 				message.TypeReturns("ERR")
@@ -116,3 +125,12 @@ var _ = Describe("Log Message", func() {
 		})
 	})
 })
+
+type foundationLogMessage struct {
+	*uifakes.FakeLogMessage
+	foundation string
+}
+
+func (message foundationLogMessage) Foundation() string {
+	return message.foundation
+}
@@ -0,0 +1,353 @@
+package ui
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/cli/version"
+)
+
+// harTrailer ends every file written by the RequestLoggerHARWriter. New
+// entries are added by overwriting it, so the file is valid JSON after every
+// request.
+const harTrailer = "\n]}}\n"
+
+// formKeysToSanitize extends the keys redacted from JSON with the client
+// secrets and passcodes sent to UAA in form bodies.
+var formKeysToSanitize = regexp.MustCompile("(?i)token|password|secret|passcode")
+
+type harNameValue struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type harPostData struct {
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text"`
+}
+
+type harRequest struct {
+	Method      string         `json:"method"`
+	URL         string         `json:"url"`
+	HTTPVersion string         `json:"httpVersion"`
+	Cookies     []harNameValue `json:"cookies"`
+	Headers     []harNameValue `json:"headers"`
+	QueryString []harNameValue `json:"queryString"`
+	PostData    *harPostData   `json:"postData,omitempty"`
+	HeadersSize int            `json:"headersSize"`
+	BodySize    int            `json:"bodySize"`
+}
+
+type harContent struct {
+	Size     int    `json:"size"`
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text,omitempty"`
+}
+
+type harResponse struct {
+	Status      int            `json:"status"`
+	StatusText  string         `json:"statusText"`
+	HTTPVersion string         `json:"httpVersion"`
+	Cookies     []harNameValue `json:"cookies"`
+	Headers     []harNameValue `json:"headers"`
+	Content     harContent     `json:"content"`
+	RedirectURL string         `json:"redirectURL"`
+	HeadersSize int            `json:"headersSize"`
+	BodySize    int            `json:"bodySize"`
+}
+
+type harTimings struct {
+	Send    float64 `json:"send"`
+	Wait    float64 `json:"wait"`
+	Receive float64 `json:"receive"`
+}
+
+type harEntry struct {
+	StartedDateTime string      `json:"startedDateTime"`
+	Time            float64     `json:"time"`
+	Request         harRequest  `json:"request"`
+	Response        harResponse `json:"response"`
+	Cache           struct{}    `json:"cache"`
+	Timings         harTimings  `json:"timings"`
+	Error           string      `json:"_error,omitempty"`
+}
+
+var (
+	harFileLocksLock sync.Mutex
+	harFileLocks     = map[string]*sync.Mutex{}
+)
+
+// harFileLock returns the lock for the HAR file at the given path. Every
+// writer for the same file shares it, including the writers of the UIs
+// created for each foundation of a parallel push.
+func harFileLock(filePath string) *sync.Mutex {
+	if absPath, err := filepath.Abs(filePath); err == nil {
+		filePath = absPath
+	}
+
+	harFileLocksLock.Lock()
+	defer harFileLocksLock.Unlock()
+
+	lock, ok := harFileLocks[filePath]
+	if !ok {
+		lock = &sync.Mutex{}
+		harFileLocks[filePath] = lock
+	}
+	return lock
+}
+
+// RequestLoggerHARWriter records requests and their responses in an HTTP
+// Archive (HAR) file that can be opened in browser developer tools.
+// Credentials are redacted the same way as in the trace output.
+type RequestLoggerHARWriter struct {
+	ui       *UI
+	lock     *sync.Mutex
+	filePath string
+}
+
+// RequestLoggerHARWriter returns a RequestLoggerHARWriter that adds entries
+// to the HAR file at the given path, creating it if needed.
+func (ui *UI) RequestLoggerHARWriter(filePath string) *RequestLoggerHARWriter {
+	return &RequestLoggerHARWriter{
+		ui:       ui,
+		lock:     harFileLock(filePath),
+		filePath: filePath,
+	}
+}
+
+// WriteEntry adds the request and its response to the HAR file. The response
+// is nil when the request failed before a response was received, in which
+// case requestErr says why. Failing to write the entry is displayed as a
+// warning.
+func (display *RequestLoggerHARWriter) WriteEntry(request *http.Request, requestBody []byte, response *http.Response, responseBody []byte, startedAt time.Time, requestErr error) {
+	entry := newHAREntry(request, requestBody, response, responseBody, startedAt, requestErr)
+
+	buffer := new(bytes.Buffer)
+	encoder := json.NewEncoder(buffer)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("  ", "  ")
+	err := encoder.Encode(entry)
+	if err == nil {
+		err = display.appendEntry(bytes.TrimSpace(buffer.Bytes()))
+	}
+	if err != nil {
+		display.ui.DisplayWarning(err.Error())
+	}
+}
+
+func (display *RequestLoggerHARWriter) appendEntry(entry []byte) error {
+	display.lock.Lock()
+	defer display.lock.Unlock()
+
+	err := os.MkdirAll(filepath.Dir(display.filePath), os.ModeDir|os.ModePerm)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(display.filePath, os.O_CREATE|os.O_RDWR, 0600)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	// Other cf processes may add entries to the same file.
+	unlock, err := lockHARFile(file)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	// Entries are added to the file when it ends with the trailer written by a
+	// previous command. Anything else is replaced by a new log.
+	offset, err := file.Seek(-int64(len(harTrailer)), io.SeekEnd)
+	if err == nil {
+		trailer := make([]byte, len(harTrailer))
+		_, err = io.ReadFull(file, trailer)
+		if err == nil && string(trailer) == harTrailer {
+			_, err = file.WriteAt(append(append([]byte(",\n  "), entry...), harTrailer...), offset)
+			return err
+		}
+	}
+
+	err = file.Truncate(0)
+	if err != nil {
+		return err
+	}
+	header := fmt.Sprintf(`{"log": {"version": "1.2", "creator": {"name": "cf", "version": %q}, "entries": [`+"\n  ", version.VersionString())
+	_, err = file.WriteAt(append(append([]byte(header), entry...), harTrailer...), 0)
+	return err
+}
+
+func newHAREntry(request *http.Request, requestBody []byte, response *http.Response, responseBody []byte, startedAt time.Time, requestErr error) harEntry {
+	elapsed := float64(time.Since(startedAt)) / float64(time.Millisecond)
+
+	entry := harEntry{
+		StartedDateTime: startedAt.Format(time.RFC3339Nano),
+		Time:            elapsed,
+		Request: harRequest{
+			Method:      request.Method,
+			URL:         sanitizeURL(redactQuery(request.URL).String()),
+			HTTPVersion: httpVersion(request.Proto),
+			Cookies:     []harNameValue{},
+			Headers:     harHeaders(request.Header, request.URL.Host),
+			QueryString: harQueryString(request.URL),
+			HeadersSize: -1,
+			BodySize:    len(requestBody),
+		},
+		Response: harResponse{
+			Cookies:     []harNameValue{},
+			Headers:     []harNameValue{},
+			HeadersSize: -1,
+			BodySize:    -1,
+		},
+		Timings: harTimings{Send: 0, Wait: elapsed, Receive: 0},
+	}
+
+	if len(requestBody) > 0 {
+		contentType := request.Header.Get("Content-Type")
+		entry.Request.PostData = &harPostData{
+			MimeType: contentType,
+			Text:     redactBody(contentType, requestBody),
+		}
+	}
+
+	if response == nil {
+		if requestErr != nil {
+			entry.Error = requestErr.Error()
+		}
+		return entry
+	}
+
+	contentType := response.Header.Get("Content-Type")
+	entry.Response.Status = response.StatusCode
+	entry.Response.StatusText = strings.TrimSpace(strings.TrimPrefix(response.Status, fmt.Sprint(response.StatusCode)))
+	entry.Response.HTTPVersion = httpVersion(response.Proto)
+	entry.Response.Headers = harHeaders(response.Header, "")
+	entry.Response.RedirectURL = response.Header.Get("Location")
+	entry.Response.BodySize = len(responseBody)
+	entry.Response.Content = harContent{
+		Size:     len(responseBody),
+		MimeType: contentType,
+		Text:     redactBody(contentType, responseBody),
+	}
+
+	return entry
+}
+
+func harHeaders(header http.Header, host string) []harNameValue {
+	headers := []harNameValue{}
+	if host != "" {
+		headers = append(headers, harNameValue{Name: "Host", Value: host})
+	}
+
+	redacted := RedactHeaders(header.Clone())
+	keys := make([]string, 0, len(redacted))
+	for key := range redacted {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		for _, value := range redacted[key] {
+			if key == "Cookie" {
+				value = RedactedValue
+			}
+			headers = append(headers, harNameValue{Name: key, Value: value})
+		}
+	}
+	return headers
+}
+
+func harQueryString(requestURL *url.URL) []harNameValue {
+	query := redactQuery(requestURL).Query()
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	queryString := []harNameValue{}
+	for _, key := range keys {
+		for _, value := range query[key] {
+			queryString = append(queryString, harNameValue{Name: key, Value: value})
+		}
+	}
+	return queryString
+}
+
+// redactQuery returns a copy of the URL with the values of token and
+// password parameters redacted.
+func redactQuery(requestURL *url.URL) *url.URL {
+	redacted := *requestURL
+	query := requestURL.Query()
+	for key := range query {
+		if formKeysToSanitize.MatchString(key) {
+			redacted.RawQuery = redactValues(query).Encode()
+			break
+		}
+	}
+	return &redacted
+}
+
+// SanitizeForm returns the URL-encoded form with the values of tokens,
+// passwords, secrets and passcodes redacted.
+func SanitizeForm(raw string) (string, error) {
+	values, err := url.ParseQuery(raw)
+	if err != nil {
+		return "", err
+	}
+	return redactValues(values).Encode(), nil
+}
+
+func redactValues(values url.Values) url.Values {
+	for key := range values {
+		if formKeysToSanitize.MatchString(key) {
+			values[key] = []string{RedactedValue}
+		}
+	}
+	return values
+}
+
+// redactBody returns the body with credentials redacted. JSON and form bodies
+// keep everything but the credentials, other bodies are hidden entirely.
+func redactBody(contentType string, body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	switch {
+	case strings.Contains(contentType, "x-www-form-urlencoded"):
+		sanitized, err := SanitizeForm(string(body))
+		if err == nil {
+			return sanitized
+		}
+	case strings.Contains(contentType, "json") || contentType == "":
+		sanitized, err := SanitizeJSON(body)
+		if err == nil {
+			return string(bytes.TrimSpace(sanitized))
+		}
+	}
+
+	if contentType == "" {
+		return "[Content Hidden]"
+	}
+	return fmt.Sprintf("[%s Content Hidden]", strings.Split(contentType, ";")[0])
+}
+
+func httpVersion(proto string) string {
+	if proto == "" {
+		return "HTTP/1.1"
+	}
+	return proto
+}
@@ -0,0 +1,216 @@
+package ui_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	. "code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("Request Logger HAR Writer", func() {
+	type harNameValue struct {
+		Name  string `json:"name"`
+		Value string `json:"value"`
+	}
+
+	type harEntry struct {
+		Request struct {
+			Method      string         `json:"method"`
+			URL         string         `json:"url"`
+			Headers     []harNameValue `json:"headers"`
+			QueryString []harNameValue `json:"queryString"`
+			PostData    *struct {
+				MimeType string `json:"mimeType"`
+				Text     string `json:"text"`
+			} `json:"postData"`
+		} `json:"request"`
+		Response struct {
+			Status     int            `json:"status"`
+			StatusText string         `json:"statusText"`
+			Headers    []harNameValue `json:"headers"`
+			Content    struct {
+				MimeType string `json:"mimeType"`
+				Text     string `json:"text"`
+			} `json:"content"`
+		} `json:"response"`
+		Error string `json:"_error"`
+	}
+
+	type harFile struct {
+		Log struct {
+			Version string     `json:"version"`
+			Entries []harEntry `json:"entries"`
+		} `json:"log"`
+	}
+
+	var (
+		ui      *UI
+		display *RequestLoggerHARWriter
+		tmpdir  string
+		harPath string
+
+		request  *http.Request
+		response *http.Response
+	)
+
+	readHAR := func() harFile {
+		raw, err := ioutil.ReadFile(harPath)
+		Expect(err).NotTo(HaveOccurred())
+
+		var har harFile
+		Expect(json.Unmarshal(raw, &har)).To(Succeed())
+		return har
+	}
+
+	BeforeEach(func() {
+		ui = NewTestUI(NewBuffer(), NewBuffer(), NewBuffer())
+
+		var err error
+		tmpdir, err = ioutil.TempDir("", "request_logger_har")
+		Expect(err).NotTo(HaveOccurred())
+		harPath = filepath.Join(tmpdir, "sub", "dir", "trace.har")
+		display = ui.RequestLoggerHARWriter(harPath)
+
+		request, err = http.NewRequest(http.MethodPost, "https://api.example.com/v3/apps?names=some-app&access_token=secret", nil)
+		Expect(err).NotTo(HaveOccurred())
+		request.Header.Set("Authorization", "bearer some-token")
+		request.Header.Set("Content-Type", "application/json")
+
+		response = &http.Response{
+			Status:     "201 Created",
+			StatusCode: http.StatusCreated,
+			Proto:      "HTTP/1.1",
+			Header: http.Header{
+				"Content-Type": {"application/json"},
+				"Set-Cookie":   {"some-cookie"},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tmpdir)).To(Succeed())
+	})
+
+	It("records the request and response with credentials redacted", func() {
+		display.WriteEntry(request, []byte(`{"name": "some-app", "password": "some-password"}`), response, []byte(`{"name": "some-app", "refresh_token": "some-token"}`), time.Now(), nil)
+
+		har := readHAR()
+		Expect(har.Log.Version).To(Equal("1.2"))
+		Expect(har.Log.Entries).To(HaveLen(1))
+
+		entry := har.Log.Entries[0]
+		Expect(entry.Request.Method).To(Equal(http.MethodPost))
+		Expect(entry.Request.URL).To(HavePrefix("https://api.example.com/v3/apps?"))
+		Expect(entry.Request.URL).NotTo(ContainSubstring("secret"))
+		Expect(entry.Request.QueryString).To(ConsistOf(
+			harNameValue{Name: "access_token", Value: RedactedValue},
+			harNameValue{Name: "names", Value: "some-app"},
+		))
+		Expect(entry.Request.Headers).To(ContainElement(harNameValue{Name: "Authorization", Value: RedactedValue}))
+		Expect(entry.Request.Headers).To(ContainElement(harNameValue{Name: "Host", Value: "api.example.com"}))
+		Expect(entry.Request.PostData.MimeType).To(Equal("application/json"))
+		Expect(entry.Request.PostData.Text).To(ContainSubstring(`"name": "some-app"`))
+		Expect(entry.Request.PostData.Text).To(ContainSubstring(`"password": "[PRIVATE DATA HIDDEN]"`))
+
+		Expect(entry.Response.Status).To(Equal(http.StatusCreated))
+		Expect(entry.Response.StatusText).To(Equal("Created"))
+		Expect(entry.Response.Headers).To(ContainElement(harNameValue{Name: "Set-Cookie", Value: RedactedValue}))
+		Expect(entry.Response.Content.MimeType).To(Equal("application/json"))
+		Expect(entry.Response.Content.Text).To(ContainSubstring(`"refresh_token": "[PRIVATE DATA HIDDEN]"`))
+	})
+
+	It("adds entries to a file it wrote before", func() {
+		display.WriteEntry(request, nil, response, nil, time.Now(), nil)
+		ui.RequestLoggerHARWriter(harPath).WriteEntry(request, nil, response, nil, time.Now(), nil)
+
+		Expect(readHAR().Log.Entries).To(HaveLen(2))
+	})
+
+	It("keeps every entry written concurrently through separate UIs", func() {
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				defer GinkgoRecover()
+				otherUI := NewTestUI(NewBuffer(), NewBuffer(), NewBuffer())
+				otherUI.RequestLoggerHARWriter(harPath).WriteEntry(request, nil, response, nil, time.Now(), nil)
+			}()
+		}
+		wg.Wait()
+
+		Expect(readHAR().Log.Entries).To(HaveLen(10))
+	})
+
+	When("the file was not written by the HAR writer", func() {
+		BeforeEach(func() {
+			Expect(os.MkdirAll(filepath.Dir(harPath), 0700)).To(Succeed())
+			Expect(ioutil.WriteFile(harPath, []byte("some trace output"), 0600)).To(Succeed())
+		})
+
+		It("replaces it", func() {
+			display.WriteEntry(request, nil, response, nil, time.Now(), nil)
+
+			Expect(readHAR().Log.Entries).To(HaveLen(1))
+		})
+	})
+
+	When("the request has a form body", func() {
+		BeforeEach(func() {
+			request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		})
+
+		It("redacts the credentials in it", func() {
+			display.WriteEntry(request, []byte("grant_type=password&username=admin&password=some-password&client_secret=some-secret"), response, nil, time.Now(), nil)
+
+			text := readHAR().Log.Entries[0].Request.PostData.Text
+			Expect(text).To(ContainSubstring("username=admin"))
+			Expect(text).NotTo(ContainSubstring("some-password"))
+			Expect(text).NotTo(ContainSubstring("some-secret"))
+		})
+	})
+
+	When("the request has another kind of body", func() {
+		BeforeEach(func() {
+			request.Header.Set("Content-Type", "application/zip")
+		})
+
+		It("hides it", func() {
+			display.WriteEntry(request, []byte("some-zip"), response, nil, time.Now(), nil)
+
+			Expect(readHAR().Log.Entries[0].Request.PostData.Text).To(Equal("[application/zip Content Hidden]"))
+		})
+	})
+
+	When("no response was received", func() {
+		It("records the error", func() {
+			display.WriteEntry(request, nil, nil, nil, time.Now(), errors.New("connection refused"))
+
+			entry := readHAR().Log.Entries[0]
+			Expect(entry.Response.Status).To(BeZero())
+			Expect(entry.Error).To(Equal("connection refused"))
+		})
+	})
+
+	When("the file cannot be written", func() {
+		BeforeEach(func() {
+			Expect(os.MkdirAll(harPath, 0700)).To(Succeed())
+		})
+
+		It("displays a warning", func() {
+			display.WriteEntry(request, nil, response, nil, time.Now(), nil)
+
+			Expect(ui.Err).To(Say("trace.har"))
+		})
+	})
+})
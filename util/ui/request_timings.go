@@ -0,0 +1,198 @@
+package ui
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"code.cloudfoundry.org/bytefmt"
+)
+
+// guidPathSegment matches the GUIDs in request paths, which are replaced by
+// ':guid' so that requests to the same endpoint are grouped together.
+var guidPathSegment = regexp.MustCompile(`(?i)/[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}(/|$)`)
+
+type requestTiming struct {
+	method   string
+	host     string
+	path     string
+	status   int
+	size     int
+	duration time.Duration
+}
+
+type endpointTimings struct {
+	method   string
+	host     string
+	path     string
+	statuses []int
+	requests int
+	size     int
+	total    time.Duration
+	max      time.Duration
+}
+
+// RequestTimings records the duration of every API request made while a
+// command runs, so that they can be summarized with DisplayRequestTimings when
+// it finishes. It is shared by all the API clients of the command.
+type RequestTimings struct {
+	lock     sync.Mutex
+	requests []requestTiming
+}
+
+// RequestTimings returns the RequestTimings of the command.
+func (ui *UI) RequestTimings() *RequestTimings {
+	return ui.requestTimings
+}
+
+// ShareRequestTimings makes the UI record requests in the given
+// RequestTimings, so that the requests made through a UI created for part of
+// a command are included in the command's summary.
+func (ui *UI) ShareRequestTimings(timings *RequestTimings) {
+	ui.requestTimings = timings
+}
+
+// RecordRequest records a request that took from startedAt until now. The
+// response is nil when the request failed before a response was received,
+// and responseSize is the size of the response body.
+func (timings *RequestTimings) RecordRequest(request *http.Request, response *http.Response, responseSize int, startedAt time.Time) {
+	timing := requestTiming{
+		method:   request.Method,
+		host:     request.URL.Host,
+		path:     pathTemplate(request.URL.Path),
+		size:     responseSize,
+		duration: time.Since(startedAt),
+	}
+	if response != nil {
+		timing.status = response.StatusCode
+	}
+
+	timings.lock.Lock()
+	defer timings.lock.Unlock()
+
+	timings.requests = append(timings.requests, timing)
+}
+
+// DisplayRequestTimings outputs the recorded requests to ui.Err, grouped by
+// host and endpoint and ordered by the total time spent on each endpoint,
+// followed by the totals over all requests. Grouping by host keeps the
+// requests to the APIs of different foundations apart.
+func (ui *UI) DisplayRequestTimings() {
+	ui.requestTimings.lock.Lock()
+	requests := append([]requestTiming{}, ui.requestTimings.requests...)
+	ui.requestTimings.lock.Unlock()
+
+	ui.terminalLock.Lock()
+	defer ui.terminalLock.Unlock()
+
+	fmt.Fprintf(ui.Err, "\n%s\n", ui.TranslateText("Request timings:"))
+	if len(requests) == 0 {
+		fmt.Fprintf(ui.Err, "%s\n", ui.TranslateText("No API requests were made."))
+		return
+	}
+
+	var (
+		endpoints []*endpointTimings
+		totalSize int
+		total     time.Duration
+	)
+	byEndpoint := map[string]*endpointTimings{}
+	for _, request := range requests {
+		key := request.method + " " + request.host + request.path
+		endpoint, ok := byEndpoint[key]
+		if !ok {
+			endpoint = &endpointTimings{method: request.method, host: request.host, path: request.path}
+			byEndpoint[key] = endpoint
+			endpoints = append(endpoints, endpoint)
+		}
+
+		endpoint.requests++
+		endpoint.size += request.size
+		endpoint.total += request.duration
+		if request.duration > endpoint.max {
+			endpoint.max = request.duration
+		}
+		if !containsStatus(endpoint.statuses, request.status) {
+			endpoint.statuses = append(endpoint.statuses, request.status)
+		}
+
+		totalSize += request.size
+		total += request.duration
+	}
+	sort.SliceStable(endpoints, func(i, j int) bool { return endpoints[i].total > endpoints[j].total })
+
+	writer := tabwriter.NewWriter(ui.Err, 0, 1, 3, ' ', 0)
+	fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+		ui.TranslateText("method"),
+		ui.TranslateText("host"),
+		ui.TranslateText("path"),
+		ui.TranslateText("status"),
+		ui.TranslateText("requests"),
+		ui.TranslateText("bytes"),
+		ui.TranslateText("total"),
+		ui.TranslateText("max"),
+	)
+	for _, endpoint := range endpoints {
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%d\t%s\t%s\t%s\n",
+			endpoint.method,
+			endpoint.host,
+			endpoint.path,
+			formatStatuses(endpoint.statuses),
+			endpoint.requests,
+			bytefmt.ByteSize(uint64(endpoint.size)),
+			formatDuration(endpoint.total),
+			formatDuration(endpoint.max),
+		)
+	}
+	fmt.Fprintf(writer, "%s\t\t\t\t%d\t%s\t%s\t\n",
+		ui.TranslateText("total"),
+		len(requests),
+		bytefmt.ByteSize(uint64(totalSize)),
+		formatDuration(total),
+	)
+	writer.Flush()
+}
+
+// pathTemplate returns the path with its GUIDs replaced by ':guid'.
+func pathTemplate(path string) string {
+	for guidPathSegment.MatchString(path) {
+		path = guidPathSegment.ReplaceAllString(path, "/:guid$1")
+	}
+	return path
+}
+
+func containsStatus(statuses []int, status int) bool {
+	for _, existing := range statuses {
+		if existing == status {
+			return true
+		}
+	}
+	return false
+}
+
+// formatStatuses returns the response statuses, with '-' standing for
+// requests that failed before a response was received.
+func formatStatuses(statuses []int) string {
+	sorted := append([]int{}, statuses...)
+	sort.Ints(sorted)
+
+	formatted := make([]string, 0, len(sorted))
+	for _, status := range sorted {
+		if status == 0 {
+			formatted = append(formatted, "-")
+		} else {
+			formatted = append(formatted, strconv.Itoa(status))
+		}
+	}
+	return strings.Join(formatted, ",")
+}
+
+func formatDuration(duration time.Duration) string {
+	return duration.Round(time.Millisecond).String()
+}
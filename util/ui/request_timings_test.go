@@ -0,0 +1,87 @@
+package ui_test
+
+import (
+	"net/http"
+	"time"
+
+	. "code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("Request Timings", func() {
+	var (
+		ui     *UI
+		errBuf *Buffer
+	)
+
+	record := func(method string, url string, status int, size int, duration time.Duration) {
+		request, err := http.NewRequest(method, url, nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		var response *http.Response
+		if status != 0 {
+			response = &http.Response{StatusCode: status}
+		}
+		ui.RequestTimings().RecordRequest(request, response, size, time.Now().Add(-duration))
+	}
+
+	BeforeEach(func() {
+		errBuf = NewBuffer()
+		ui = NewTestUI(nil, NewBuffer(), errBuf)
+	})
+
+	It("groups the requests by endpoint, slowest first, and totals them", func() {
+		record(http.MethodGet, "https://api.example.com/v3/apps?names=some-app", http.StatusOK, 1024, time.Second)
+		record(http.MethodGet, "https://api.example.com/v3/processes/0d7a8d5e-34a9-4e44-a2a5-1b0f2e1c6d4b/stats", http.StatusOK, 2048, 2*time.Second)
+		record(http.MethodGet, "https://api.example.com/v3/processes/7f5c0d8b-6a0e-4c3e-9a3c-2f6b9d1e8a7c/stats", http.StatusNotFound, 0, time.Second)
+		record(http.MethodGet, "https://log-cache.example.com/api/v1/read/0d7a8d5e-34a9-4e44-a2a5-1b0f2e1c6d4b", 0, 0, 0)
+
+		ui.DisplayRequestTimings()
+
+		Expect(errBuf).To(Say(`Request timings:`))
+		Expect(errBuf).To(Say(`method\s+host\s+path\s+status\s+requests\s+bytes\s+total\s+max`))
+		Expect(errBuf).To(Say(`GET\s+api\.example\.com\s+/v3/processes/:guid/stats\s+200,404\s+2\s+2K\s+3(\.\d+)?s\s+2(\.\d+)?s`))
+		Expect(errBuf).To(Say(`GET\s+api\.example\.com\s+/v3/apps\s+200\s+1\s+1K\s+1(\.\d+)?s\s+1(\.\d+)?s`))
+		Expect(errBuf).To(Say(`GET\s+log-cache\.example\.com\s+/api/v1/read/:guid\s+-\s+1\s+0B?\s+`))
+		Expect(errBuf).To(Say(`total\s+4\s+3K\s+4(\.\d+)?s`))
+	})
+
+	When("another UI shares the request timings", func() {
+		It("includes the requests made through the other UI", func() {
+			otherUI := NewTestUI(nil, NewBuffer(), NewBuffer())
+			otherUI.ShareRequestTimings(ui.RequestTimings())
+
+			request, err := http.NewRequest(http.MethodGet, "https://api.example.com/v3/apps", nil)
+			Expect(err).NotTo(HaveOccurred())
+			otherUI.RequestTimings().RecordRequest(request, &http.Response{StatusCode: http.StatusOK}, 1024, time.Now().Add(-time.Second))
+
+			ui.DisplayRequestTimings()
+
+			Expect(errBuf).To(Say(`GET\s+api\.example\.com\s+/v3/apps\s+200\s+1\s+1K`))
+		})
+	})
+
+	When("the same endpoint is requested on different hosts", func() {
+		It("keeps the hosts apart", func() {
+			record(http.MethodGet, "https://api.dc1.example.com/v3/apps", http.StatusOK, 1024, 2*time.Second)
+			record(http.MethodGet, "https://api.dc2.example.com/v3/apps", http.StatusOK, 1024, time.Second)
+
+			ui.DisplayRequestTimings()
+
+			Expect(errBuf).To(Say(`GET\s+api\.dc1\.example\.com\s+/v3/apps\s+200\s+1\s+`))
+			Expect(errBuf).To(Say(`GET\s+api\.dc2\.example\.com\s+/v3/apps\s+200\s+1\s+`))
+		})
+	})
+
+	When("no requests were made", func() {
+		It("says so", func() {
+			ui.DisplayRequestTimings()
+
+			Expect(errBuf).To(Say(`Request timings:`))
+			Expect(errBuf).To(Say(`No API requests were made\.`))
+		})
+	})
+})
@@ -79,11 +79,19 @@ func (ui *UI) DisplayNonWrappingTable(prefix string, table [][]string, padding i
 }
 
 // DisplayTableWithHeader outputs a simple non-wrapping table with bolded
-// headers.
+// headers. The table is filtered, sorted and reduced to the selected columns
+// according to ui.TableOptions first.
 func (ui *UI) DisplayTableWithHeader(prefix string, table [][]string, padding int) {
 	if len(table) == 0 {
 		return
 	}
+
+	table = ui.applyTableOptions(table)
+	if ui.TableOptions.NoHeaders {
+		ui.DisplayNonWrappingTable(prefix, table[1:], padding)
+		return
+	}
+
 	for i, str := range table[0] {
 		table[0][i] = ui.modifyColor(str, color.New(color.Bold))
 	}
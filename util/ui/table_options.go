@@ -0,0 +1,148 @@
+package ui
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/cli/util/sorting"
+)
+
+// applyTableOptions returns a copy of the table, header row included, with
+// the rows that do not match ui.TableOptions.Filters removed, sorted by
+// ui.TableOptions.SortBy and reduced to ui.TableOptions.Columns. Columns are
+// matched against the headers case insensitively, with '-' and '_' standing
+// in for spaces. Unknown columns are reported as warnings and ignored.
+func (ui *UI) applyTableOptions(table [][]string) [][]string {
+	options := ui.TableOptions
+	if len(options.Columns) == 0 && options.SortBy == "" && len(options.Filters) == 0 {
+		return table
+	}
+
+	header := table[0]
+	filters := ui.tableFilters(header)
+	rows := make([][]string, 0, len(table)-1)
+	for _, row := range table[1:] {
+		if rowMatches(row, filters) {
+			rows = append(rows, row)
+		}
+	}
+
+	if options.SortBy != "" {
+		if column, found := ui.tableColumn(header, options.SortBy); found {
+			sort.SliceStable(rows, func(i, j int) bool {
+				return lessTableCell(cell(rows[i], column), cell(rows[j], column))
+			})
+		}
+	}
+
+	result := append([][]string{header}, rows...)
+
+	var columns []int
+	for _, name := range options.Columns {
+		if column, found := ui.tableColumn(header, name); found {
+			columns = append(columns, column)
+		}
+	}
+	if len(columns) == 0 {
+		return result
+	}
+
+	for i, row := range result {
+		selected := make([]string, len(columns))
+		for j, column := range columns {
+			selected[j] = cell(row, column)
+		}
+		result[i] = selected
+	}
+	return result
+}
+
+type tableFilter struct {
+	column  int
+	pattern *regexp.Regexp
+}
+
+// tableFilters compiles ui.TableOptions.Filters into case insensitive
+// patterns in which '*' matches any characters.
+func (ui *UI) tableFilters(header []string) []tableFilter {
+	var filters []tableFilter
+	for _, filter := range ui.TableOptions.Filters {
+		column, found := ui.tableColumn(header, filter.Column)
+		if !found {
+			continue
+		}
+
+		parts := strings.Split(strings.TrimSpace(filter.Value), "*")
+		for i, part := range parts {
+			parts[i] = regexp.QuoteMeta(part)
+		}
+		filters = append(filters, tableFilter{
+			column:  column,
+			pattern: regexp.MustCompile("(?i)^" + strings.Join(parts, ".*") + "$"),
+		})
+	}
+	return filters
+}
+
+func rowMatches(row []string, filters []tableFilter) bool {
+	for _, filter := range filters {
+		if !filter.pattern.MatchString(strings.TrimSpace(cell(row, filter.column))) {
+			return false
+		}
+	}
+	return true
+}
+
+// tableColumn returns the index of the header matching name. It warns about
+// names that match no header.
+func (ui *UI) tableColumn(header []string, name string) (int, bool) {
+	for i, title := range header {
+		if title != "" && normalizeColumnName(title) == normalizeColumnName(name) {
+			return i, true
+		}
+	}
+
+	ui.DisplayWarning("Column '{{.Column}}' does not exist, use one of: {{.Columns}}", map[string]interface{}{
+		"Column":  name,
+		"Columns": strings.Join(nonEmpty(header), ", "),
+	})
+	return 0, false
+}
+
+func normalizeColumnName(name string) string {
+	name = strings.NewReplacer("-", " ", "_", " ").Replace(strings.ToLower(name))
+	return strings.Join(strings.Fields(name), " ")
+}
+
+// lessTableCell orders numbers numerically and everything else
+// alphabetically ignoring case.
+func lessTableCell(first string, second string) bool {
+	firstNumber, firstErr := strconv.ParseFloat(strings.TrimSpace(first), 64)
+	secondNumber, secondErr := strconv.ParseFloat(strings.TrimSpace(second), 64)
+	if firstErr == nil && secondErr == nil {
+		return firstNumber < secondNumber
+	}
+	if sorting.LessIgnoreCase(first, second) || sorting.LessIgnoreCase(second, first) {
+		return sorting.LessIgnoreCase(first, second)
+	}
+	return len(first) < len(second)
+}
+
+func cell(row []string, column int) string {
+	if column < len(row) {
+		return row[column]
+	}
+	return ""
+}
+
+func nonEmpty(values []string) []string {
+	var result []string
+	for _, value := range values {
+		if value != "" {
+			result = append(result, value)
+		}
+	}
+	return result
+}
@@ -80,5 +80,84 @@ var _ = Describe("Table", func() {
 			Expect(out).To(Say("\x1b\\[1mheader3\x1b\\[0m"))
 			Expect(out).To(Say("#0  data1    data2    data3"))
 		})
+
+		When("table options are set", func() {
+			var table [][]string
+
+			BeforeEach(func() {
+				table = [][]string{
+					{"name", "requested state", "instances"},
+					{"app-b", "started", "10"},
+					{"app-a", "stopped", "2"},
+					{"app-c", "started", "1"},
+				}
+			})
+
+			JustBeforeEach(func() {
+				ui.DisplayTableWithHeader("", table, 2)
+			})
+
+			When("columns are selected", func() {
+				BeforeEach(func() {
+					ui.TableOptions.Columns = []string{"instances", "NAME"}
+				})
+
+				It("displays only those columns, in that order", func() {
+					Expect(out).To(Say("\\x1b\\[1minstances\\x1b\\[0m  \\x1b\\[1mname\\x1b\\[0m\n"))
+					Expect(out).To(Say("10         app-b\n"))
+					Expect(out).To(Say("2          app-a\n"))
+					Expect(out).To(Say("1          app-c\n"))
+				})
+			})
+
+			When("a column does not exist", func() {
+				BeforeEach(func() {
+					ui.TableOptions.Columns = []string{"name", "routes"}
+				})
+
+				It("warns and ignores it", func() {
+					Expect(errBuff).To(Say("Column 'routes' does not exist, use one of: name, requested state, instances"))
+					Expect(out).To(Say("app-b\n"))
+				})
+			})
+
+			When("sorting by a column", func() {
+				BeforeEach(func() {
+					ui.TableOptions.SortBy = "instances"
+				})
+
+				It("sorts numbers numerically", func() {
+					Expect(out).To(Say("app-c  started          1\n"))
+					Expect(out).To(Say("app-a  stopped          2\n"))
+					Expect(out).To(Say("app-b  started          10\n"))
+				})
+			})
+
+			When("filtering by a column", func() {
+				BeforeEach(func() {
+					ui.TableOptions.Filters = []configv3.TableFilter{
+						{Column: "requested-state", Value: "STARTED"},
+						{Column: "name", Value: "app-*"},
+					}
+					ui.TableOptions.SortBy = "name"
+				})
+
+				It("only displays the matching rows", func() {
+					Expect(out).To(Say("app-b  started          10\n"))
+					Expect(out).To(Say("app-c  started          1\n"))
+					Expect(string(out.Contents())).ToNot(ContainSubstring("app-a"))
+				})
+			})
+
+			When("headers are turned off", func() {
+				BeforeEach(func() {
+					ui.TableOptions.NoHeaders = true
+				})
+
+				It("does not display the header row", func() {
+					Expect(string(out.Contents())).To(HavePrefix("app-b  started          10\n"))
+				})
+			})
+		})
 	})
 })
@@ -20,6 +20,7 @@ import (
 	"github.com/fatih/color"
 	runewidth "github.com/mattn/go-runewidth"
 	"github.com/vito/go-interact/interact"
+	"gopkg.in/yaml.v2"
 )
 
 var realExiter exiterFunc = os.Exit
@@ -95,7 +96,12 @@ type UI struct {
 
 	TimezoneLocation *time.Location
 
+	// TableOptions reshapes the tables displayed with DisplayTableWithHeader.
+	TableOptions configv3.TableOptions
+
 	deferred []string
+
+	requestTimings *RequestTimings
 }
 
 // NewUI will return a UI object where Out is set to STDOUT, In is set to
@@ -118,6 +124,7 @@ func NewUI(config Config) (*UI, error) {
 		terminalLock:      &sync.Mutex{},
 		Exiter:            realExiter,
 		fileLock:          &sync.Mutex{},
+		requestTimings:    &RequestTimings{},
 		Interactor:        realInteract,
 		IsTTY:             config.IsTTY(),
 		TerminalWidth:     config.TerminalWidth(),
@@ -144,6 +151,7 @@ func NewPluginUI(config Config, outBuffer io.Writer, errBuffer io.Writer) (*UI,
 		terminalLock:      &sync.Mutex{},
 		Exiter:            realExiter,
 		fileLock:          &sync.Mutex{},
+		requestTimings:    &RequestTimings{},
 		Interactor:        realInteract,
 		IsTTY:             config.IsTTY(),
 		TerminalWidth:     config.TerminalWidth(),
@@ -170,6 +178,7 @@ func NewTestUI(in io.Reader, out io.Writer, err io.Writer) *UI {
 		Interactor:        realInteract,
 		terminalLock:      &sync.Mutex{},
 		fileLock:          &sync.Mutex{},
+		requestTimings:    &RequestTimings{},
 		TimezoneLocation:  time.UTC,
 	}
 }
@@ -363,6 +372,33 @@ func (ui *UI) DisplayJSON(name string, jsonData interface{}) error {
 	return nil
 }
 
+// DisplayYAML encodes and indents the input as YAML and displays it to the
+// UI's `Out`. The input is converted through its JSON representation first,
+// so the YAML keys match the `json` tags of the input.
+func (ui *UI) DisplayYAML(yamlData interface{}) error {
+	ui.terminalLock.Lock()
+	defer ui.terminalLock.Unlock()
+
+	jsonData, err := json.Marshal(yamlData)
+	if err != nil {
+		return err
+	}
+
+	var value interface{}
+	err = yaml.Unmarshal(jsonData, &value)
+	if err != nil {
+		return err
+	}
+
+	buff, err := yaml.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(ui.Out, "%s", buff)
+	return nil
+}
+
 // FlushDeferred displays text previously deferred (using DeferText) to the UI's
 // `Out`.
 func (ui *UI) FlushDeferred() {
@@ -390,6 +426,20 @@ func (ui *UI) GetOut() io.Writer {
 	return ui.Out
 }
 
+// ReserveOutForData sends everything the UI writes to the output writer,
+// including "FAILED" and request traces, to the error writer instead. It
+// returns the original output writer so that the caller can write
+// machine-readable data to it.
+func (ui *UI) ReserveOutForData() io.Writer {
+	ui.terminalLock.Lock()
+	defer ui.terminalLock.Unlock()
+
+	out := ui.Out
+	ui.Out = ui.Err
+	ui.OutForInteraction = ui.Err
+	return out
+}
+
 // TranslateText passes the template through an internationalization function
 // to translate it to a pre-configured language, and returns the template with
 // templateValues substituted in. Only the first map in templateValues is used.
@@ -218,6 +218,30 @@ var _ = Describe("UI", func() {
 		})
 	})
 
+	Describe("DisplayYAML", func() {
+		It("displays the YAML with the keys of the JSON tags sorted", func() {
+			obj := struct {
+				Name  string   `json:"name"`
+				Count int      `json:"count"`
+				Tags  []string `json:"tags"`
+			}{
+				Name:  "some-name",
+				Count: 42,
+				Tags:  []string{"a", "b"},
+			}
+
+			Expect(ui.DisplayYAML(obj)).To(Succeed())
+
+			Expect(out).To(SatisfyAll(
+				Say("count: 42\n"),
+				Say("name: some-name\n"),
+				Say("tags:\n"),
+				Say("- a\n"),
+				Say("- b\n"),
+			))
+		})
+	})
+
 	Describe("DeferText", func() {
 		It("defers the template with map values substituted into ui.Out with a newline", func() {
 			ui.DeferText(
@@ -396,6 +420,20 @@ var _ = Describe("UI", func() {
 		})
 	})
 
+	Describe("ReserveOutForData", func() {
+		It("returns the output writer and sends all further output to ui.Err", func() {
+			data := ui.ReserveOutForData()
+			Expect(data).To(Equal(out))
+
+			ui.DisplayText("some-text")
+			ui.DisplayError(errors.New("some-error"))
+			Expect(out.Contents()).To(BeEmpty())
+			Expect(errBuff).To(Say("some-text"))
+			Expect(errBuff).To(Say("some-error"))
+			Expect(errBuff).To(Say("FAILED"))
+		})
+	})
+
 	Describe("TranslateText", func() {
 		It("returns the template", func() {
 			Expect(ui.TranslateText("some-template")).To(Equal("some-template"))